@@ -25,6 +25,8 @@ var (
 		Usage: "Perform common administrative operations",
 		Commands: []*cli.Command{
 			subcmdUser,
+			subcmdRepo,
+			subcmdIssues,
 			subcmdRepoSyncReleases,
 			subcmdRegenerate,
 			subcmdAuth,
@@ -0,0 +1,227 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/services/migrations"
+
+	"github.com/urfave/cli/v3"
+)
+
+var subcmdIssues = &cli.Command{
+	Name:  "issues",
+	Usage: "Export or import the issues of repositories",
+	Commands: []*cli.Command{
+		microcmdIssuesExport,
+		microcmdIssuesImport,
+	},
+}
+
+var microcmdIssuesExport = &cli.Command{
+	Name:        "export",
+	Usage:       "Export the issues of a repository as JSON or CSV",
+	Description: "The JSON archive contains the labels, the milestones and the comments of the issues, the CSV file only contains the issues.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "repo",
+			Aliases:  []string{"r"},
+			Usage:    "Full name of the repository (owner/name)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Usage:   "The file to write, the standard output is used if empty or '-'",
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Value: "json",
+			Usage: "The format of the export: json or csv",
+		},
+		&cli.StringFlag{
+			Name:  "state",
+			Value: "all",
+			Usage: "The state of the exported issues: open, closed or all",
+		},
+		&cli.StringFlag{
+			Name:  "labels",
+			Usage: "Only export the issues with all these labels, separated by commas",
+		},
+		&cli.StringFlag{
+			Name:  "milestone",
+			Usage: "Only export the issues of this milestone",
+		},
+		&cli.BoolFlag{
+			Name:  "no-comments",
+			Usage: "Don't export the comments of the issues",
+		},
+	},
+	Action: runIssuesExport,
+}
+
+var microcmdIssuesImport = &cli.Command{
+	Name:        "import",
+	Usage:       "Import issues from a JSON or CSV export into a repository",
+	Description: "The issues get new numbers. The labels and the milestones are matched by name or created. The issues and the comments are attributed to the local users with the same names, the others are posted by the doer.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "repo",
+			Aliases:  []string{"r"},
+			Usage:    "Full name of the repository (owner/name)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "file",
+			Aliases:  []string{"f"},
+			Usage:    "The file to import, the format is found by its extension (.json or .csv)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "doer",
+			Usage:    "The user importing the issues",
+			Required: true,
+		},
+		&cli.StringSliceFlag{
+			Name:  "user-map",
+			Usage: "Map a user name of the export to a local user name (old=new), can be repeated",
+		},
+		&cli.BoolFlag{
+			Name:  "no-user-mapping",
+			Usage: "Post all the issues and the comments as the doer and keep the names of their authors",
+		},
+	},
+	Action: runIssuesImport,
+}
+
+func getRepositoryByFullName(ctx context.Context, fullName string) (*repo_model.Repository, error) {
+	ownerName, repoName, ok := strings.Cut(fullName, "/")
+	if !ok || ownerName == "" || repoName == "" {
+		return nil, errors.New("the repository must be given as owner/name")
+	}
+	return repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName)
+}
+
+func runIssuesExport(ctx context.Context, c *cli.Command) error {
+	format := c.String("format")
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("invalid format %q", format)
+	}
+	opts := migrations.ExportIssuesOptions{IncludeComments: !c.Bool("no-comments")}
+	switch c.String("state") {
+	case "open":
+		opts.IsClosed = optional.Some(false)
+	case "closed":
+		opts.IsClosed = optional.Some(true)
+	case "all":
+	default:
+		return fmt.Errorf("invalid state %q", c.String("state"))
+	}
+
+	if err := initDB(ctx); err != nil {
+		return err
+	}
+	repo, err := getRepositoryByFullName(ctx, c.String("repo"))
+	if err != nil {
+		return err
+	}
+	if opts.LabelIDs, err = migrations.GetIssueArchiveLabelIDs(ctx, repo, c.String("labels")); err != nil {
+		return err
+	}
+	if opts.MilestoneIDs, err = migrations.GetIssueArchiveMilestoneIDs(ctx, repo, c.String("milestone")); err != nil {
+		return err
+	}
+
+	archive, err := migrations.ExportIssues(ctx, repo, opts)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if output := c.String("output"); output != "" && output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if format == "csv" {
+		err = migrations.WriteIssuesCSV(w, archive)
+	} else {
+		err = migrations.WriteIssueArchive(w, archive)
+	}
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "%d issues of %s have been exported\n", len(archive.Issues), repo.FullName())
+	return nil
+}
+
+func runIssuesImport(ctx context.Context, c *cli.Command) error {
+	opts := migrations.ImportIssuesOptions{
+		MapUsers: !c.Bool("no-user-mapping"),
+		UserMap:  make(map[string]string),
+	}
+	for _, m := range c.StringSlice("user-map") {
+		from, to, ok := strings.Cut(m, "=")
+		if !ok || from == "" || to == "" {
+			return fmt.Errorf("invalid user mapping %q, it must be old=new", m)
+		}
+		opts.UserMap[from] = to
+	}
+
+	file := c.String("file")
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var archive *migrations.IssueArchive
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		archive, err = migrations.ReadIssueArchive(f)
+	case ".csv":
+		archive, err = migrations.ReadIssuesCSV(f)
+	default:
+		return fmt.Errorf("unknown format of %q, the file must be a .json or .csv file", file)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := initDB(ctx); err != nil {
+		return err
+	}
+	// migrations.GiteaLocalUploader depends on git module
+	if err := git.InitSimple(); err != nil {
+		return err
+	}
+	repo, err := getRepositoryByFullName(ctx, c.String("repo"))
+	if err != nil {
+		return err
+	}
+	doer, err := user_model.GetUserByName(ctx, c.String("doer"))
+	if err != nil {
+		return err
+	}
+
+	n, err := migrations.ImportIssues(ctx, doer, repo, archive, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d issues have been imported into %s\n", n, repo.FullName())
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/git"
+	repo_service "code.gitea.io/gitea/services/repository"
+
+	"github.com/urfave/cli/v3"
+)
+
+var subcmdRepo = &cli.Command{
+	Name:  "repo",
+	Usage: "Modify repositories",
+	Commands: []*cli.Command{
+		microcmdRepoConvertObjectFormat,
+	},
+}
+
+var microcmdRepoConvertObjectFormat = &cli.Command{
+	Name:  "convert-object-format",
+	Usage: "Rewrite the history of a repository with another object format (sha1 or sha256)",
+	Description: "All commits and tags get new IDs and their signatures are stripped. " +
+		"The mapping from the old IDs to the new ones is stored, it is used to update the commit statuses and the commit references of issues and pull requests.",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "repo",
+			Aliases:  []string{"r"},
+			Usage:    "Full name of the repository to convert (owner/name)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "object-format",
+			Usage:    "The object format to convert the repository to",
+			Required: true,
+		},
+	},
+	Action: runRepoConvertObjectFormat,
+}
+
+func runRepoConvertObjectFormat(ctx context.Context, c *cli.Command) error {
+	ownerName, repoName, ok := strings.Cut(c.String("repo"), "/")
+	if !ok || ownerName == "" || repoName == "" {
+		return errors.New("the repository must be given as owner/name")
+	}
+
+	if err := initDB(ctx); err != nil {
+		return err
+	}
+	if err := git.InitSimple(); err != nil {
+		return err
+	}
+
+	repo, err := repo_model.GetRepositoryByOwnerAndName(ctx, ownerName, repoName)
+	if err != nil {
+		return err
+	}
+	if err := repo_service.ConvertObjectFormat(ctx, repo, c.String("object-format")); err != nil {
+		return err
+	}
+	fmt.Printf("Repository %s has been converted to %s\n", repo.FullName(), repo.ObjectFormatName)
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/glob"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ActionRequiredWorkflow represents a workflow which is required to run in the repositories of an organization.
+// The workflow file lives in the default branch of a designated repository of the organization,
+// and it runs in the targeted repositories for the events it is triggered by, even if they don't contain it.
+type ActionRequiredWorkflow struct {
+	ID           int64
+	OwnerID      int64                  `xorm:"UNIQUE(owner_repo_path) NOT NULL"`
+	RepoID       int64                  `xorm:"UNIQUE(owner_repo_path) NOT NULL"` // the designated repository storing the workflow file
+	Repo         *repo_model.Repository `xorm:"-"`
+	WorkflowPath string                 `xorm:"UNIQUE(owner_repo_path) NOT NULL"` // like ".gitea/workflows/security.yml"
+	// RepoPattern is a glob of the names of the targeted repositories, and Topics are the topics of them,
+	// a repository is targeted if it matches the pattern or has any of the topics, all repositories are targeted if both are empty.
+	RepoPattern string   `xorm:"NOT NULL DEFAULT ''"`
+	Topics      []string `xorm:"TEXT JSON"`
+	CreatedBy   int64    `xorm:"NOT NULL DEFAULT 0"`
+
+	// CommitSHA is the commit of the designated repository which the workflow file has last been read at,
+	// TriggeredByPullRequest is whether the workflow file of this commit is triggered by pull requests.
+	// They are updated by the pushes to the default branch, so the workflow file isn't read to check the pull requests.
+	CommitSHA              string `xorm:"VARCHAR(64) NOT NULL DEFAULT ''"`
+	TriggeredByPullRequest bool   `xorm:"NOT NULL DEFAULT false"`
+
+	Created timeutil.TimeStamp `xorm:"created"`
+	Updated timeutil.TimeStamp `xorm:"updated"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionRequiredWorkflow))
+}
+
+// LoadRepo loads the designated repository of the required workflow
+func (rw *ActionRequiredWorkflow) LoadRepo(ctx context.Context) error {
+	if rw.Repo != nil {
+		return nil
+	}
+	repo, err := repo_model.GetRepositoryByID(ctx, rw.RepoID)
+	if err != nil {
+		return err
+	}
+	rw.Repo = repo
+	return nil
+}
+
+// EntryName returns the name of the workflow file
+func (rw *ActionRequiredWorkflow) EntryName() string {
+	return path.Base(rw.WorkflowPath)
+}
+
+// WorkflowID returns the workflow ID of the runs of the required workflow, it is the designated repository and the path
+// of the workflow file, so the runs aren't mixed up with the ones of a workflow of the same name in the targeted repositories.
+// The repository should have been loaded.
+func (rw *ActionRequiredWorkflow) WorkflowID() string {
+	return fmt.Sprintf("%s/%s", rw.Repo.Name, rw.WorkflowPath)
+}
+
+// StatusContextName returns the name of the workflow in the contexts of the commit statuses created by its runs,
+// it contains the designated repository to be distinguished from the workflows of the targeted repositories.
+// The repository should have been loaded.
+func (rw *ActionRequiredWorkflow) StatusContextName() string {
+	return fmt.Sprintf("%s/%s", rw.Repo.Name, rw.EntryName())
+}
+
+// StatusContextPattern returns the glob pattern of the contexts of the commit statuses created by the jobs of its runs
+func (rw *ActionRequiredWorkflow) StatusContextPattern() string {
+	return glob.QuoteMeta(rw.StatusContextName()) + " / *"
+}
+
+// IsTargeting returns whether the repository is targeted by the required workflow
+func (rw *ActionRequiredWorkflow) IsTargeting(repo *repo_model.Repository) bool {
+	if repo.ID == rw.RepoID {
+		// the designated repository runs its own workflows
+		return false
+	}
+	if rw.RepoPattern == "" && len(rw.Topics) == 0 {
+		return true
+	}
+	if rw.RepoPattern != "" {
+		g, err := glob.Compile(strings.ToLower(rw.RepoPattern))
+		if err != nil {
+			log.Error("compile the repo pattern %q of required workflow %d: %v", rw.RepoPattern, rw.ID, err)
+		} else if g.Match(repo.LowerName) {
+			return true
+		}
+	}
+	for _, topic := range rw.Topics {
+		if slices.Contains(repo.Topics, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+type RequiredWorkflowList []*ActionRequiredWorkflow
+
+// LoadRepos loads the designated repositories of the required workflows
+func (rws RequiredWorkflowList) LoadRepos(ctx context.Context) error {
+	repoIDs := container.FilterSlice(rws, func(rw *ActionRequiredWorkflow) (int64, bool) {
+		return rw.RepoID, true
+	})
+	repos, err := repo_model.GetRepositoriesMapByIDs(ctx, repoIDs)
+	if err != nil {
+		return err
+	}
+	for _, rw := range rws {
+		rw.Repo = repos[rw.RepoID]
+	}
+	return nil
+}
+
+// FindRequiredWorkflowsOptions are the options to find the required workflows
+type FindRequiredWorkflowsOptions struct {
+	db.ListOptions
+	OwnerID int64
+	RepoID  int64 // the designated repository
+}
+
+func (opts FindRequiredWorkflowsOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.OwnerID > 0 {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	if opts.RepoID > 0 {
+		cond = cond.And(builder.Eq{"repo_id": opts.RepoID})
+	}
+	return cond
+}
+
+func (opts FindRequiredWorkflowsOptions) ToOrders() string {
+	return "id ASC"
+}
+
+// GetRequiredWorkflowByID returns the required workflow of the owner by its id
+func GetRequiredWorkflowByID(ctx context.Context, ownerID, id int64) (*ActionRequiredWorkflow, error) {
+	rw, has, err := db.Get[ActionRequiredWorkflow](ctx, builder.Eq{"id": id, "owner_id": ownerID})
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, util.NewNotExistErrorf("required workflow with id %d does not exist", id)
+	}
+	return rw, nil
+}
+
+// GetRequiredWorkflowsTargeting returns the required workflows of the owner of the repository which target the repository
+func GetRequiredWorkflowsTargeting(ctx context.Context, repo *repo_model.Repository) ([]*ActionRequiredWorkflow, error) {
+	rws, err := db.Find[ActionRequiredWorkflow](ctx, FindRequiredWorkflowsOptions{OwnerID: repo.OwnerID})
+	if err != nil {
+		return nil, err
+	}
+	targeting := make([]*ActionRequiredWorkflow, 0, len(rws))
+	for _, rw := range rws {
+		if rw.IsTargeting(repo) {
+			targeting = append(targeting, rw)
+		}
+	}
+	return targeting, nil
+}
+
+// UpdateRequiredWorkflowTrigger updates the commit which the workflow file has been read at and whether it is triggered by pull requests
+func UpdateRequiredWorkflowTrigger(ctx context.Context, rw *ActionRequiredWorkflow) error {
+	_, err := db.GetEngine(ctx).ID(rw.ID).Cols("commit_sha", "triggered_by_pull_request").Update(rw)
+	return err
+}
+
+// DeleteRequiredWorkflow deletes the required workflow of the owner, the runs created by it are kept
+func DeleteRequiredWorkflow(ctx context.Context, ownerID, id int64) error {
+	n, err := db.GetEngine(ctx).Where(builder.Eq{"id": id, "owner_id": ownerID}).Delete(&ActionRequiredWorkflow{})
+	if err != nil {
+		return err
+	} else if n == 0 {
+		return util.NewNotExistErrorf("required workflow with id %d does not exist", id)
+	}
+	return nil
+}
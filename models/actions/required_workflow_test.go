@@ -0,0 +1,85 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"testing"
+
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/glob"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionRequiredWorkflow_IsTargeting(t *testing.T) {
+	designated := &repo_model.Repository{ID: 1, Name: "ci", LowerName: "ci"}
+	service := &repo_model.Repository{ID: 2, Name: "Service-A", LowerName: "service-a"}
+	tool := &repo_model.Repository{ID: 3, Name: "tool", LowerName: "tool", Topics: []string{"go", "cli"}}
+
+	cases := []struct {
+		name     string
+		rw       *ActionRequiredWorkflow
+		repo     *repo_model.Repository
+		expected bool
+	}{
+		{"all repositories", &ActionRequiredWorkflow{RepoID: 1}, service, true},
+		{"designated repository", &ActionRequiredWorkflow{RepoID: 1}, designated, false},
+		{"pattern matches", &ActionRequiredWorkflow{RepoID: 1, RepoPattern: "Service-*"}, service, true},
+		{"pattern doesn't match", &ActionRequiredWorkflow{RepoID: 1, RepoPattern: "service-*"}, tool, false},
+		{"topic matches", &ActionRequiredWorkflow{RepoID: 1, Topics: []string{"web", "go"}}, tool, true},
+		{"topic doesn't match", &ActionRequiredWorkflow{RepoID: 1, Topics: []string{"go"}}, service, false},
+		{"pattern or topic", &ActionRequiredWorkflow{RepoID: 1, RepoPattern: "service-*", Topics: []string{"cli"}}, tool, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.rw.IsTargeting(c.repo))
+		})
+	}
+}
+
+func TestActionRequiredWorkflow_StatusContextPattern(t *testing.T) {
+	rw := &ActionRequiredWorkflow{
+		Repo:         &repo_model.Repository{Name: "ci"},
+		WorkflowPath: ".gitea/workflows/lint[1].yml",
+	}
+	assert.Equal(t, "ci/lint[1].yml", rw.StatusContextName())
+
+	g, err := glob.Compile(rw.StatusContextPattern())
+	require.NoError(t, err)
+	assert.True(t, g.Match("ci/lint[1].yml / check (pull_request)"))
+	assert.False(t, g.Match("ci/lint1.yml / check (pull_request)"))
+	assert.False(t, g.Match("lint[1].yml / check (pull_request)"))
+}
+
+func TestGetRequiredWorkflowsTargeting(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	repo3 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 3})
+	repo5 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 5})
+
+	rwAll := &ActionRequiredWorkflow{OwnerID: 3, RepoID: 32, WorkflowPath: ".gitea/workflows/all.yml"}
+	rwRepo5 := &ActionRequiredWorkflow{OwnerID: 3, RepoID: 32, WorkflowPath: ".gitea/workflows/repo5.yml", RepoPattern: "repo5"}
+	require.NoError(t, db.Insert(t.Context(), rwAll))
+	require.NoError(t, db.Insert(t.Context(), rwRepo5))
+
+	rws, err := GetRequiredWorkflowsTargeting(t.Context(), repo3)
+	require.NoError(t, err)
+	require.Len(t, rws, 1)
+	assert.Equal(t, rwAll.ID, rws[0].ID)
+
+	rws, err = GetRequiredWorkflowsTargeting(t.Context(), repo5)
+	require.NoError(t, err)
+	require.Len(t, rws, 2)
+	require.NoError(t, RequiredWorkflowList(rws).LoadRepos(t.Context()))
+	assert.Equal(t, "repo21", rws[1].Repo.Name)
+
+	require.NoError(t, DeleteRequiredWorkflow(t.Context(), 3, rwAll.ID))
+	_, err = GetRequiredWorkflowByID(t.Context(), 3, rwAll.ID)
+	assert.Error(t, err)
+	// the required workflow of another owner can't be deleted
+	assert.Error(t, DeleteRequiredWorkflow(t.Context(), 2, rwRepo5.ID))
+}
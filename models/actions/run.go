@@ -59,6 +59,9 @@ type ActionRun struct {
 	PreviousDuration time.Duration
 	Created          timeutil.TimeStamp `xorm:"created"`
 	Updated          timeutil.TimeStamp `xorm:"updated"`
+
+	// RequiredWorkflowID is the org required workflow which created the run, the workflow file isn't in the repository
+	RequiredWorkflowID int64 `xorm:"index NOT NULL DEFAULT 0"`
 }
 
 func init() {
@@ -0,0 +1,77 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// ActionApprovedContributor represents a contributor whose workflow runs have been approved in a repository,
+// the later runs of the contributor don't need approval with the "first time contributors" policy.
+type ActionApprovedContributor struct {
+	ID         int64
+	RepoID     int64              `xorm:"UNIQUE(repo_user) NOT NULL"`
+	UserID     int64              `xorm:"UNIQUE(repo_user) NOT NULL"`
+	User       *user_model.User   `xorm:"-"`
+	ApprovedBy int64              `xorm:"NOT NULL DEFAULT 0"`
+	Created    timeutil.TimeStamp `xorm:"created"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionApprovedContributor))
+}
+
+// IsContributorApproved returns whether the workflow runs of the user have been approved in the repository before
+func IsContributorApproved(ctx context.Context, repoID, userID int64) (bool, error) {
+	return db.GetEngine(ctx).Exist(&ActionApprovedContributor{RepoID: repoID, UserID: userID})
+}
+
+// ApproveContributor remembers the approval of the workflow runs of the user in the repository
+func ApproveContributor(ctx context.Context, repoID, userID, doerID int64) error {
+	if has, err := IsContributorApproved(ctx, repoID, userID); err != nil || has {
+		return err
+	}
+	return db.Insert(ctx, &ActionApprovedContributor{RepoID: repoID, UserID: userID, ApprovedBy: doerID})
+}
+
+// DeleteApprovedContributor forgets the approval of the user, the next runs of the user will need approval again
+func DeleteApprovedContributor(ctx context.Context, repoID, userID int64) error {
+	n, err := db.GetEngine(ctx).Delete(&ActionApprovedContributor{RepoID: repoID, UserID: userID})
+	if err != nil {
+		return err
+	} else if n == 0 {
+		return db.ErrNotExist{Resource: "approved_contributor", ID: userID}
+	}
+	return nil
+}
+
+// GetApprovedContributors returns the approved contributors of the repository with their users loaded
+func GetApprovedContributors(ctx context.Context, repoID int64) ([]*ActionApprovedContributor, error) {
+	contributors := make([]*ActionApprovedContributor, 0, 10)
+	if err := db.GetEngine(ctx).Where(builder.Eq{"repo_id": repoID}).OrderBy("id DESC").Find(&contributors); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(contributors))
+	for _, c := range contributors {
+		userIDs = append(userIDs, c.UserID)
+	}
+	users, err := user_model.GetUsersMapByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range contributors {
+		c.User = users[c.UserID]
+		if c.User == nil {
+			c.User = user_model.NewGhostUser()
+		}
+	}
+	return contributors, nil
+}
@@ -41,6 +41,12 @@ type ActionRunJob struct {
 	TaskID int64    // the latest task of the job
 	Status Status   `xorm:"index"`
 
+	// DynamicMatrix is not DynamicMatrixNone when the matrix of the job depends on the outputs of the needed jobs
+	DynamicMatrix DynamicMatrixState `xorm:"NOT NULL DEFAULT 0"`
+
+	// ErrorMessage tells the users why the job has failed before it could run on a runner
+	ErrorMessage string `xorm:"TEXT"`
+
 	RawConcurrency string // raw concurrency from job YAML's "concurrency" section
 
 	// IsConcurrencyEvaluated is only valid/needed when this job's RawConcurrency is not empty.
@@ -51,12 +57,28 @@ type ActionRunJob struct {
 	ConcurrencyGroup  string `xorm:"index(repo_concurrency) NOT NULL DEFAULT ''"` // evaluated concurrency.group
 	ConcurrencyCancel bool   `xorm:"NOT NULL DEFAULT FALSE"`                      // evaluated concurrency.cancel-in-progress
 
+	// Queued is the last time the job became waiting again, zero means it has been waiting since it was created
+	Queued  timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
 	Started timeutil.TimeStamp
 	Stopped timeutil.TimeStamp
 	Created timeutil.TimeStamp `xorm:"created"`
 	Updated timeutil.TimeStamp `xorm:"updated index"`
 }
 
+// DynamicMatrixState represents whether the matrix of a job is expanded when the run is created or later
+type DynamicMatrixState int
+
+const (
+	// DynamicMatrixNone means the matrix of the job, if any, has been expanded when the run was created
+	DynamicMatrixNone DynamicMatrixState = iota
+	// DynamicMatrixPending means the job is a placeholder which will be expanded to the jobs of the matrix
+	// once the needed jobs are done, because the matrix depends on their outputs
+	DynamicMatrixPending
+	// DynamicMatrixExpanded means the job is one of the combinations of a matrix expanded by the job emitter,
+	// the "max-parallel" and "fail-fast" of the strategy are applied to these jobs
+	DynamicMatrixExpanded
+)
+
 func init() {
 	db.RegisterModel(new(ActionRunJob))
 }
@@ -130,6 +152,18 @@ func GetRunJobByID(ctx context.Context, id int64) (*ActionRunJob, error) {
 	return &job, nil
 }
 
+// GetRunIDOfJob returns the id of the run of a job without loading the job
+func GetRunIDOfJob(ctx context.Context, jobID int64) (int64, error) {
+	var runID int64
+	has, err := db.GetEngine(ctx).Table("action_run_job").Where("id=?", jobID).Cols("run_id").Get(&runID)
+	if err != nil {
+		return 0, err
+	} else if !has {
+		return 0, fmt.Errorf("run job with id %d: %w", jobID, util.ErrNotExist)
+	}
+	return runID, nil
+}
+
 func GetRunJobsByRunID(ctx context.Context, runID int64) (ActionJobList, error) {
 	var jobs []*ActionRunJob
 	if err := db.GetEngine(ctx).Where("run_id=?", runID).OrderBy("id").Find(&jobs); err != nil {
@@ -160,6 +194,11 @@ func UpdateRunJob(ctx context.Context, job *ActionRunJob, cond builder.Cond, col
 	}
 
 	if slices.Contains(cols, "status") && job.Status.IsWaiting() {
+		// record when the job is queued again for the queue time of its next task
+		job.Queued = timeutil.TimeStampNow()
+		if _, err := e.ID(job.ID).Cols("queued").NoAutoTime().Update(&ActionRunJob{Queued: job.Queued}); err != nil {
+			return 0, err
+		}
 		// if the status of job changes to waiting again, increase tasks version.
 		if err := IncreaseTaskVersion(ctx, job.OwnerID, job.RepoID); err != nil {
 			return 0, err
@@ -71,6 +71,7 @@ type FindRunOptions struct {
 	TriggerUserID    int64
 	TriggerEvent     webhook_module.HookEventType
 	Approved         bool // not util.OptionalBool, it works only when it's true
+	NeedApproval     bool // not util.OptionalBool, it works only when it's true
 	Status           []Status
 	ConcurrencyGroup string
 	CommitSHA        string
@@ -90,6 +91,9 @@ func (opts FindRunOptions) ToConds() builder.Cond {
 	if opts.Approved {
 		cond = cond.And(builder.Gt{"`action_run`.approved_by": 0})
 	}
+	if opts.NeedApproval {
+		cond = cond.And(builder.Eq{"`action_run`.need_approval": true})
+	}
 	if len(opts.Status) > 0 {
 		cond = cond.And(builder.In("`action_run`.status", opts.Status))
 	}
@@ -62,6 +62,8 @@ type ActionRunner struct {
 	AgentLabels []string `xorm:"TEXT"`
 	// Store if this is a runner that only ever get one single job assigned
 	Ephemeral bool `xorm:"ephemeral NOT NULL DEFAULT false"`
+	// The time a pre-registered (just-in-time) runner expires if it has never connected, 0 means never
+	Expires timeutil.TimeStamp `xorm:"index NOT NULL DEFAULT 0"`
 
 	Created timeutil.TimeStamp `xorm:"created"`
 	Updated timeutil.TimeStamp `xorm:"updated"`
@@ -126,6 +128,11 @@ func (r *ActionRunner) IsOnline() bool {
 	return false
 }
 
+// IsExpired returns whether the runner is a pre-registered runner which hasn't connected in time
+func (r *ActionRunner) IsExpired() bool {
+	return r.Expires > 0 && r.LastOnline == 0 && r.Expires < timeutil.TimeStampNow()
+}
+
 // EditableInContext checks if the runner is editable by the "context" owner/repo
 // ownerID == 0 and repoID == 0 means "admin" context, any runner including global runners could be edited
 // ownerID == 0 and repoID != 0 means "repo" context, any runner belonging to the given repo could be edited
@@ -324,6 +331,14 @@ func DeleteEphemeralRunner(ctx context.Context, id int64) error {
 	return err
 }
 
+// DeleteExpiredRunners deletes the pre-registered runners which haven't connected before they expire
+func DeleteExpiredRunners(ctx context.Context) (int64, error) {
+	return db.GetEngine(ctx).
+		Where(builder.Gt{"expires": 0}.And(builder.Lt{"expires": timeutil.TimeStampNow()})).
+		And(builder.Eq{"last_online": 0}).
+		Delete(new(ActionRunner))
+}
+
 // CreateRunner creates new runner.
 func CreateRunner(ctx context.Context, t *ActionRunner) error {
 	if t.OwnerID != 0 && t.RepoID != 0 {
@@ -0,0 +1,115 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/translation"
+)
+
+// ActionScaledRunner represents a runner started by the runner scaler through a provider
+type ActionScaledRunner struct {
+	ID         int64
+	RunnerID   int64              `xorm:"UNIQUE NOT NULL"`
+	Provider   string             `xorm:"VARCHAR(64) NOT NULL"`
+	InstanceID string             `xorm:"VARCHAR(255)"` // the ID of the runner instance in the provider
+	Labels     []string           `xorm:"JSON TEXT"`
+	Created    timeutil.TimeStamp `xorm:"created"`
+}
+
+// ScaleDecisionType represents the type of a decision of the runner scaler
+type ScaleDecisionType int
+
+const (
+	ScaleDecisionStart        ScaleDecisionType = iota + 1 // runners have been started for waiting jobs
+	ScaleDecisionStopIdle                                  // runners have been stopped because they didn't pick a job
+	ScaleDecisionStopFinished                              // runners have been stopped because their job is done
+	ScaleDecisionError                                     // the provider failed to start or stop a runner
+)
+
+func (t ScaleDecisionType) String() string {
+	switch t {
+	case ScaleDecisionStart:
+		return "start"
+	case ScaleDecisionStopIdle:
+		return "stop_idle"
+	case ScaleDecisionStopFinished:
+		return "stop_finished"
+	case ScaleDecisionError:
+		return "error"
+	}
+	return "unknown"
+}
+
+func (t ScaleDecisionType) LocaleString(lang translation.Locale) string {
+	return lang.TrString("actions.runners.scaler.decision." + t.String())
+}
+
+// ActionScaleDecision represents a decision taken by the runner scaler, it's shown in the admin runners page
+type ActionScaleDecision struct {
+	ID          int64
+	Type        ScaleDecisionType  `xorm:"NOT NULL"`
+	Labels      []string           `xorm:"JSON TEXT"`
+	NumWaiting  int                `xorm:"NOT NULL DEFAULT 0"` // the number of waiting jobs when the decision was taken
+	NumRunners  int                `xorm:"NOT NULL DEFAULT 0"` // the number of started or stopped runners
+	Message     string             `xorm:"TEXT"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created INDEX"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionScaledRunner))
+	db.RegisterModel(new(ActionScaleDecision))
+}
+
+// CreateScaledRunner records a runner started by the runner scaler
+func CreateScaledRunner(ctx context.Context, r *ActionScaledRunner) error {
+	return db.Insert(ctx, r)
+}
+
+// GetScaledRunners returns all the runners started by the runner scaler
+func GetScaledRunners(ctx context.Context) ([]*ActionScaledRunner, error) {
+	var runners []*ActionScaledRunner
+	return runners, db.GetEngine(ctx).Asc("id").Find(&runners)
+}
+
+// DeleteScaledRunner deletes the record of a runner stopped by the runner scaler
+func DeleteScaledRunner(ctx context.Context, id int64) error {
+	_, err := db.DeleteByID[ActionScaledRunner](ctx, id)
+	return err
+}
+
+// GetWaitingJobsRunsOn returns the "runs-on" labels of all the jobs waiting for a runner
+func GetWaitingJobsRunsOn(ctx context.Context) ([][]string, error) {
+	var jobs []*ActionRunJob
+	if err := db.GetEngine(ctx).Cols("runs_on").
+		Where("task_id=? AND status=?", 0, StatusWaiting).
+		Find(&jobs); err != nil {
+		return nil, err
+	}
+	runsOn := make([][]string, 0, len(jobs))
+	for _, job := range jobs {
+		runsOn = append(runsOn, job.RunsOn)
+	}
+	return runsOn, nil
+}
+
+// InsertScaleDecision records a decision of the runner scaler
+func InsertScaleDecision(ctx context.Context, d *ActionScaleDecision) error {
+	return db.Insert(ctx, d)
+}
+
+// GetLatestScaleDecisions returns the latest decisions of the runner scaler
+func GetLatestScaleDecisions(ctx context.Context, limit int) ([]*ActionScaleDecision, error) {
+	decisions := make([]*ActionScaleDecision, 0, limit)
+	return decisions, db.GetEngine(ctx).Desc("id").Limit(limit).Find(&decisions)
+}
+
+// DeleteScaleDecisionsBefore deletes the decisions of the runner scaler taken before the given time
+func DeleteScaleDecisionsBefore(ctx context.Context, before timeutil.TimeStamp) error {
+	_, err := db.GetEngine(ctx).Where("created_unix < ?", before).Delete(new(ActionScaleDecision))
+	return err
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// durationBuckets are the upper bounds in seconds of the buckets of a DurationHistogram,
+// the last bucket of a histogram counts the durations longer than the last bound.
+var durationBuckets = []int64{
+	5, 10, 15, 20, 30, 45, 60, 90, 120, 180, 240, 300, 420, 600, 900,
+	1200, 1800, 2700, 3600, 5400, 7200, 10800, 14400, 21600, 43200, 86400,
+}
+
+// DurationHistogram counts durations in buckets, the histograms of several days can be merged to estimate percentiles
+type DurationHistogram []int64
+
+// Add counts a duration in seconds
+func (h *DurationHistogram) Add(seconds int64) {
+	if len(*h) == 0 {
+		*h = make(DurationHistogram, len(durationBuckets)+1)
+	}
+	i := 0
+	for i < len(durationBuckets) && seconds > durationBuckets[i] {
+		i++
+	}
+	(*h)[i]++
+}
+
+// Merge adds the counts of another histogram
+func (h *DurationHistogram) Merge(other DurationHistogram) {
+	if len(other) == 0 {
+		return
+	}
+	if len(*h) == 0 {
+		*h = make(DurationHistogram, len(durationBuckets)+1)
+	}
+	for i := range min(len(*h), len(other)) {
+		(*h)[i] += other[i]
+	}
+}
+
+// Percentile returns the upper bound in seconds of the bucket containing the percentile p (0-100)
+func (h DurationHistogram) Percentile(p int) int64 {
+	var total int64
+	for _, n := range h {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := (total*int64(p) + 99) / 100
+	var count int64
+	for i, n := range h {
+		count += n
+		if count >= rank && n > 0 {
+			if i < len(durationBuckets) {
+				return durationBuckets[i]
+			}
+			break
+		}
+	}
+	return durationBuckets[len(durationBuckets)-1]
+}
+
+// ActionJobStat is the daily rollup of the finished tasks of a job, or of a step of the job if StepName isn't empty
+type ActionJobStat struct {
+	ID      int64
+	RepoID  int64 `xorm:"INDEX(repo_day) NOT NULL"`
+	OwnerID int64 `xorm:"INDEX NOT NULL"`
+	// Day is the start of the day in the default timezone of the UI
+	Day        timeutil.TimeStamp `xorm:"INDEX(repo_day) INDEX NOT NULL"`
+	WorkflowID string             `xorm:"VARCHAR(255) NOT NULL"`
+	JobName    string             `xorm:"VARCHAR(255) NOT NULL"`
+	StepName   string             `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+
+	NumTasks     int64             `xorm:"NOT NULL DEFAULT 0"`
+	NumFailures  int64             `xorm:"NOT NULL DEFAULT 0"`
+	NumRetries   int64             `xorm:"NOT NULL DEFAULT 0"` // the tasks of a rerun attempt
+	NumFlaky     int64             `xorm:"NOT NULL DEFAULT 0"` // the tasks which succeeded in a rerun attempt
+	NumQueued    int64             `xorm:"NOT NULL DEFAULT 0"` // the tasks whose queue time is known
+	QueueSeconds int64             `xorm:"NOT NULL DEFAULT 0"`
+	RunSeconds   int64             `xorm:"NOT NULL DEFAULT 0"`
+	RunHistogram DurationHistogram `xorm:"JSON TEXT"`
+}
+
+// ActionRunnerLabelStat is the daily rollup of the time spent by the runners on the jobs requiring a label
+type ActionRunnerLabelStat struct {
+	ID          int64
+	RepoID      int64              `xorm:"INDEX(repo_day) NOT NULL"`
+	OwnerID     int64              `xorm:"INDEX NOT NULL"`
+	Day         timeutil.TimeStamp `xorm:"INDEX(repo_day) INDEX NOT NULL"`
+	Label       string             `xorm:"VARCHAR(255) NOT NULL"`
+	NumTasks    int64              `xorm:"NOT NULL DEFAULT 0"`
+	BusySeconds int64              `xorm:"NOT NULL DEFAULT 0"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionJobStat))
+	db.RegisterModel(new(ActionRunnerLabelStat))
+}
+
+// StatDay returns the start of the day of t in the default timezone of the UI
+func StatDay(t time.Time) time.Time {
+	t = t.In(setting.DefaultUILocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, setting.DefaultUILocation)
+}
+
+// GetLastStatDay returns the last day whose stats have been rolled up, zero if there is none
+func GetLastStatDay(ctx context.Context) (timeutil.TimeStamp, error) {
+	var day int64
+	if _, err := db.GetEngine(ctx).Table("action_job_stat").Select("MAX(day)").Get(&day); err != nil {
+		return 0, err
+	}
+	return timeutil.TimeStamp(day), nil
+}
+
+// SaveStats replaces the stats of a day
+func SaveStats(ctx context.Context, day timeutil.TimeStamp, jobStats []*ActionJobStat, labelStats []*ActionRunnerLabelStat) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("day=?", day).Delete(new(ActionJobStat)); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).Where("day=?", day).Delete(new(ActionRunnerLabelStat)); err != nil {
+			return err
+		}
+		if len(jobStats) > 0 {
+			if err := db.Insert(ctx, jobStats); err != nil {
+				return err
+			}
+		}
+		if len(labelStats) > 0 {
+			return db.Insert(ctx, labelStats)
+		}
+		return nil
+	})
+}
+
+// DeleteStatsBefore deletes the stats of the days before the given day
+func DeleteStatsBefore(ctx context.Context, day timeutil.TimeStamp) error {
+	if _, err := db.GetEngine(ctx).Where("day<?", day).Delete(new(ActionJobStat)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where("day<?", day).Delete(new(ActionRunnerLabelStat))
+	return err
+}
+
+// FindStatsOptions represents the conditions to find the stats of a repository or of the repositories of an owner
+type FindStatsOptions struct {
+	RepoID     int64
+	OwnerID    int64
+	WorkflowID string
+	Since      timeutil.TimeStamp
+}
+
+func (opts FindStatsOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.RepoID > 0 {
+		cond = cond.And(builder.Eq{"repo_id": opts.RepoID})
+	}
+	if opts.OwnerID > 0 {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	if opts.Since > 0 {
+		cond = cond.And(builder.Gte{"day": opts.Since})
+	}
+	return cond
+}
+
+// FindJobStats returns the job and step stats ordered by day
+func FindJobStats(ctx context.Context, opts FindStatsOptions) ([]*ActionJobStat, error) {
+	cond := opts.ToConds()
+	if opts.WorkflowID != "" {
+		cond = cond.And(builder.Eq{"workflow_id": opts.WorkflowID})
+	}
+	stats := make([]*ActionJobStat, 0, 50)
+	return stats, db.GetEngine(ctx).Where(cond).OrderBy("day, id").Find(&stats)
+}
+
+// FindRunnerLabelStats returns the runner label stats ordered by day
+func FindRunnerLabelStats(ctx context.Context, opts FindStatsOptions) ([]*ActionRunnerLabelStat, error) {
+	stats := make([]*ActionRunnerLabelStat, 0, 20)
+	return stats, db.GetEngine(ctx).Where(opts.ToConds()).OrderBy("day, id").Find(&stats)
+}
+
+// FindTasksStoppedBetween calls f with the done tasks stopped in [start, end) by batches
+func FindTasksStoppedBetween(ctx context.Context, start, end timeutil.TimeStamp, batchSize int, f func(tasks []*ActionTask) error) error {
+	var lastID int64
+	for {
+		tasks := make([]*ActionTask, 0, batchSize)
+		if err := db.GetEngine(ctx).
+			Where("stopped >= ? AND stopped < ? AND id > ?", start, end, lastID).
+			In("status", []Status{StatusSuccess, StatusFailure, StatusCancelled}).
+			Omit("log_indexes").
+			OrderBy("id").Limit(batchSize).Find(&tasks); err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+		if err := f(tasks); err != nil {
+			return err
+		}
+		if len(tasks) < batchSize {
+			return nil
+		}
+		lastID = tasks[len(tasks)-1].ID
+	}
+}
+
+// GetRunJobsMapByIDs returns the jobs of the given IDs
+func GetRunJobsMapByIDs(ctx context.Context, ids []int64) (map[int64]*ActionRunJob, error) {
+	jobs := make(map[int64]*ActionRunJob, len(ids))
+	if len(ids) == 0 {
+		return jobs, nil
+	}
+	return jobs, db.GetEngine(ctx).In("id", ids).Omit("workflow_payload").Find(&jobs)
+}
+
+// GetRunsMapByIDs returns the runs of the given IDs
+func GetRunsMapByIDs(ctx context.Context, ids []int64) (map[int64]*ActionRun, error) {
+	runs := make(map[int64]*ActionRun, len(ids))
+	if len(ids) == 0 {
+		return runs, nil
+	}
+	return runs, db.GetEngine(ctx).In("id", ids).Omit("event_payload").Find(&runs)
+}
+
+// GetTaskStepsByTaskIDs returns the steps of the given tasks
+func GetTaskStepsByTaskIDs(ctx context.Context, taskIDs []int64) ([]*ActionTaskStep, error) {
+	steps := make([]*ActionTaskStep, 0, len(taskIDs)*5)
+	if len(taskIDs) == 0 {
+		return steps, nil
+	}
+	return steps, db.GetEngine(ctx).In("task_id", taskIDs).OrderBy("task_id, `index`").Find(&steps)
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationHistogram(t *testing.T) {
+	var h DurationHistogram
+	assert.EqualValues(t, 0, h.Percentile(50))
+
+	for _, seconds := range []int64{1, 5, 6, 50, 50, 55, 100, 100, 600, 100000} {
+		h.Add(seconds)
+	}
+	assert.Len(t, h, len(durationBuckets)+1)
+	assert.EqualValues(t, 5, h.Percentile(10))
+	assert.EqualValues(t, 60, h.Percentile(50))
+	assert.EqualValues(t, 120, h.Percentile(80))
+	assert.EqualValues(t, 600, h.Percentile(90))
+	// the durations longer than the last bucket are counted in the last bucket
+	assert.EqualValues(t, 86400, h.Percentile(100))
+
+	var merged DurationHistogram
+	merged.Merge(nil)
+	assert.Empty(t, merged)
+	merged.Merge(h)
+	merged.Merge(h)
+	assert.EqualValues(t, 60, merged.Percentile(50))
+	assert.EqualValues(t, 4, merged[0])
+}
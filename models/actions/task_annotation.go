@@ -0,0 +1,182 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+const (
+	// MaxAnnotationsPerTask is the max number of annotations kept for a task, the later ones are dropped
+	MaxAnnotationsPerTask = 100
+	// MaxSummarySizePerTask is the max total size in bytes of the step summaries of a task, the later ones are dropped
+	MaxSummarySizePerTask = 1024 * 1024
+)
+
+// AnnotationLevel represents the level of an annotation
+type AnnotationLevel int
+
+const (
+	AnnotationLevelNotice AnnotationLevel = iota + 1
+	AnnotationLevelWarning
+	AnnotationLevelError
+)
+
+// ParseAnnotationLevel returns the level of the workflow command which creates an annotation, 0 if the command doesn't create one
+func ParseAnnotationLevel(command string) AnnotationLevel {
+	switch command {
+	case "notice":
+		return AnnotationLevelNotice
+	case "warning":
+		return AnnotationLevelWarning
+	case "error":
+		return AnnotationLevelError
+	}
+	return 0
+}
+
+func (l AnnotationLevel) String() string {
+	switch l {
+	case AnnotationLevelNotice:
+		return "notice"
+	case AnnotationLevelWarning:
+		return "warning"
+	case AnnotationLevelError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// ActionTaskAnnotation represents an annotation reported by a task with a workflow command like "::error file=app.go,line=1::message".
+// Like the outputs, the annotations are bound to a task, so the annotations of a rerun job are those of its new task.
+type ActionTaskAnnotation struct {
+	ID        int64
+	TaskID    int64  `xorm:"INDEX NOT NULL"`
+	RepoID    int64  `xorm:"INDEX(repo_commit) NOT NULL"`
+	CommitSHA string `xorm:"INDEX(repo_commit) VARCHAR(64) NOT NULL"`
+	// LogIndex is the index of the line reporting the annotation in the log of the task, to know which step reported it
+	LogIndex int64           `xorm:"NOT NULL DEFAULT 0"`
+	Level    AnnotationLevel `xorm:"NOT NULL"`
+	Title    string          `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	Message  string          `xorm:"TEXT"`
+	File     string          `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	Line     int             `xorm:"NOT NULL DEFAULT 0"`
+	EndLine  int             `xorm:"NOT NULL DEFAULT 0"`
+	Col      int             `xorm:"NOT NULL DEFAULT 0"`
+	EndCol   int             `xorm:"NOT NULL DEFAULT 0"`
+
+	Created timeutil.TimeStamp `xorm:"created"`
+}
+
+// ActionTaskSummary represents a part of the markdown summary of a step reported by a task,
+// the parts reported by the same step are concatenated in the order of LogIndex.
+type ActionTaskSummary struct {
+	ID     int64
+	TaskID int64 `xorm:"INDEX NOT NULL"`
+	RepoID int64 `xorm:"INDEX NOT NULL"`
+	// LogIndex is the index of the line reporting the summary in the log of the task, to know which step reported it
+	LogIndex int64  `xorm:"NOT NULL DEFAULT 0"`
+	Content  string `xorm:"MEDIUMTEXT"`
+
+	Created timeutil.TimeStamp `xorm:"created"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionTaskAnnotation))
+	db.RegisterModel(new(ActionTaskSummary))
+}
+
+func (a *ActionTaskAnnotation) isSameAs(other *ActionTaskAnnotation) bool {
+	return a.Level == other.Level && a.Title == other.Title && a.Message == other.Message &&
+		a.File == other.File && a.Line == other.Line && a.EndLine == other.EndLine && a.Col == other.Col && a.EndCol == other.EndCol
+}
+
+// InsertTaskAnnotations saves the annotations and the summaries reported by the task.
+// The annotations which have been reported are ignored since the runner could print a workflow command twice,
+// and the annotations and summaries beyond the limits of a task are dropped.
+func InsertTaskAnnotations(ctx context.Context, task *ActionTask, annotations []*ActionTaskAnnotation, summaries []*ActionTaskSummary) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if len(annotations) > 0 {
+			existing, err := GetTaskAnnotations(ctx, task.ID)
+			if err != nil {
+				return err
+			}
+			toInsert := make([]*ActionTaskAnnotation, 0, len(annotations))
+		loop:
+			for _, a := range annotations {
+				if len(existing) >= MaxAnnotationsPerTask {
+					break
+				}
+				for _, e := range existing {
+					if a.isSameAs(e) {
+						continue loop
+					}
+				}
+				a.TaskID = task.ID
+				a.RepoID = task.RepoID
+				a.CommitSHA = task.CommitSHA
+				existing = append(existing, a)
+				toInsert = append(toInsert, a)
+			}
+			if len(toInsert) > 0 {
+				if err := db.Insert(ctx, toInsert); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(summaries) > 0 {
+			var size int64
+			if _, err := db.GetEngine(ctx).Table("action_task_summary").Where("task_id=?", task.ID).
+				Select("COALESCE(SUM(LENGTH(content)), 0)").Get(&size); err != nil {
+				return err
+			}
+			toInsert := make([]*ActionTaskSummary, 0, len(summaries))
+			for _, s := range summaries {
+				size += int64(len(s.Content))
+				if size > MaxSummarySizePerTask {
+					break
+				}
+				s.TaskID = task.ID
+				s.RepoID = task.RepoID
+				toInsert = append(toInsert, s)
+			}
+			if len(toInsert) > 0 {
+				return db.Insert(ctx, toInsert)
+			}
+		}
+		return nil
+	})
+}
+
+// GetTaskAnnotations returns the annotations of a task in the order they were reported
+func GetTaskAnnotations(ctx context.Context, taskID int64) ([]*ActionTaskAnnotation, error) {
+	annotations := make([]*ActionTaskAnnotation, 0, 10)
+	return annotations, db.GetEngine(ctx).Where("task_id=?", taskID).OrderBy("log_index, id").Find(&annotations)
+}
+
+// GetTaskSummaries returns the summaries of a task in the order they were reported
+func GetTaskSummaries(ctx context.Context, taskID int64) ([]*ActionTaskSummary, error) {
+	summaries := make([]*ActionTaskSummary, 0, 5)
+	return summaries, db.GetEngine(ctx).Where("task_id=?", taskID).OrderBy("log_index, id").Find(&summaries)
+}
+
+// GetAnnotationsOfCommit returns the annotations on the files reported by the latest tasks of the jobs run on the commit
+func GetAnnotationsOfCommit(ctx context.Context, repoID int64, commitSHA string) ([]*ActionTaskAnnotation, error) {
+	annotations := make([]*ActionTaskAnnotation, 0, 10)
+	return annotations, db.GetEngine(ctx).
+		Join("INNER", "action_run_job", "action_run_job.task_id = action_task_annotation.task_id").
+		Where(builder.Eq{
+			"action_task_annotation.repo_id":    repoID,
+			"action_task_annotation.commit_sha": commitSHA,
+		}).
+		And(builder.Neq{"action_task_annotation.file": ""}).
+		OrderBy("action_task_annotation.file, action_task_annotation.line, action_task_annotation.id").
+		Find(&annotations)
+}
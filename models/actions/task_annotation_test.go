@@ -0,0 +1,78 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"strings"
+	"testing"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertTaskAnnotations(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	task := &ActionTask{ID: 47, RepoID: 4, CommitSHA: "c2d72f548424103f01ee1dc02889c1e2bff816b0"}
+
+	require.NoError(t, InsertTaskAnnotations(t.Context(), task, []*ActionTaskAnnotation{
+		{LogIndex: 1, Level: AnnotationLevelError, File: "main.go", Line: 2, Message: "syntax error"},
+		{LogIndex: 2, Level: AnnotationLevelWarning, Message: "deprecated"},
+	}, []*ActionTaskSummary{
+		{LogIndex: 3, Content: "# Summary"},
+	}))
+	// the same annotation printed twice is saved once
+	require.NoError(t, InsertTaskAnnotations(t.Context(), task, []*ActionTaskAnnotation{
+		{LogIndex: 4, Level: AnnotationLevelError, File: "main.go", Line: 2, Message: "syntax error"},
+	}, nil))
+
+	annotations, err := GetTaskAnnotations(t.Context(), task.ID)
+	require.NoError(t, err)
+	require.Len(t, annotations, 2)
+	assert.Equal(t, task.RepoID, annotations[0].RepoID)
+	assert.Equal(t, task.CommitSHA, annotations[0].CommitSHA)
+	assert.Equal(t, "syntax error", annotations[0].Message)
+
+	summaries, err := GetTaskSummaries(t.Context(), task.ID)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "# Summary", summaries[0].Content)
+
+	t.Run("Limits", func(t *testing.T) {
+		annotations := make([]*ActionTaskAnnotation, 0, MaxAnnotationsPerTask)
+		for i := range MaxAnnotationsPerTask {
+			annotations = append(annotations, &ActionTaskAnnotation{LogIndex: int64(10 + i), Level: AnnotationLevelNotice, Line: i})
+		}
+		require.NoError(t, InsertTaskAnnotations(t.Context(), task, annotations, []*ActionTaskSummary{
+			{LogIndex: 10, Content: strings.Repeat("a", MaxSummarySizePerTask-100)},
+			{LogIndex: 11, Content: strings.Repeat("b", 200)},
+		}))
+		unittest.AssertCount(t, &ActionTaskAnnotation{TaskID: task.ID}, MaxAnnotationsPerTask)
+		unittest.AssertCount(t, &ActionTaskSummary{TaskID: task.ID}, 2)
+	})
+}
+
+func TestGetAnnotationsOfCommit(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	// task 47 is the current task of its job, the task 46 of a previous attempt isn't
+	task := &ActionTask{ID: 47, RepoID: 4, CommitSHA: "c2d72f548424103f01ee1dc02889c1e2bff816b0"}
+	require.NoError(t, db.Insert(t.Context(), &ActionRunJob{RunID: 791, RepoID: 4, CommitSHA: task.CommitSHA, Name: "job", JobID: "job", TaskID: task.ID}))
+	require.NoError(t, InsertTaskAnnotations(t.Context(), task, []*ActionTaskAnnotation{
+		{Level: AnnotationLevelError, File: "main.go", Line: 2, Message: "current"},
+		{Level: AnnotationLevelError, Message: "no file"},
+	}, nil))
+	oldTask := &ActionTask{ID: 46, RepoID: task.RepoID, CommitSHA: task.CommitSHA}
+	require.NoError(t, InsertTaskAnnotations(t.Context(), oldTask, []*ActionTaskAnnotation{
+		{Level: AnnotationLevelError, File: "main.go", Line: 2, Message: "outdated"},
+	}, nil))
+
+	annotations, err := GetAnnotationsOfCommit(t.Context(), task.RepoID, task.CommitSHA)
+	require.NoError(t, err)
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "current", annotations[0].Message)
+}
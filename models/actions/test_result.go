@@ -0,0 +1,294 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/junit"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// TestReportArtifactName is the reserved name of the artifacts containing JUnit XML test reports,
+// the artifacts named with this prefix and a dash, e.g. "gitea-test-report-linux", are test reports too.
+const TestReportArtifactName = "gitea-test-report"
+
+// IsTestReportArtifactName returns whether the artifact contains test reports
+func IsTestReportArtifactName(name string) bool {
+	return name == TestReportArtifactName || strings.HasPrefix(name, TestReportArtifactName+"-") && len(name) > len(TestReportArtifactName)+1
+}
+
+// ActionTestResult represents the result of a test case of a test report uploaded by a job of a run
+type ActionTestResult struct {
+	ID         int64
+	RepoID     int64        `xorm:"INDEX NOT NULL"`
+	RunID      int64        `xorm:"INDEX(run_status) NOT NULL"`
+	JobID      int64        `xorm:"NOT NULL DEFAULT 0"`
+	ArtifactID int64        `xorm:"INDEX NOT NULL"`
+	Suite      string       `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	ClassName  string       `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	Name       string       `xorm:"TEXT NOT NULL"`
+	Status     junit.Status `xorm:"INDEX(run_status) NOT NULL"`
+	Duration   int64        `xorm:"NOT NULL DEFAULT 0"` // in milliseconds
+	Message    string       `xorm:"TEXT"`
+	Details    string       `xorm:"LONGTEXT"`
+
+	Created timeutil.TimeStamp `xorm:"created"`
+}
+
+// ActionTestCase is the history of a test case in the runs of the default branch of a repository,
+// a test is flaky if its status flips between passed and failed.
+type ActionTestCase struct {
+	ID          int64
+	RepoID      int64        `xorm:"UNIQUE(repo_key) NOT NULL"`
+	KeyHash     string       `xorm:"VARCHAR(64) UNIQUE(repo_key) NOT NULL"`
+	Suite       string       `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	ClassName   string       `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+	Name        string       `xorm:"TEXT NOT NULL"`
+	NumRuns     int64        `xorm:"NOT NULL DEFAULT 0"`
+	NumFailures int64        `xorm:"NOT NULL DEFAULT 0"`
+	NumFlips    int64        `xorm:"INDEX NOT NULL DEFAULT 0"`
+	LastStatus  junit.Status `xorm:"NOT NULL DEFAULT 0"`
+	LastRunID   int64        `xorm:"NOT NULL DEFAULT 0"`
+	LastTaskID  int64        `xorm:"NOT NULL DEFAULT 0"`
+
+	Created timeutil.TimeStamp `xorm:"created"`
+	Updated timeutil.TimeStamp `xorm:"updated INDEX"`
+}
+
+func init() {
+	db.RegisterModel(new(ActionTestResult))
+	db.RegisterModel(new(ActionTestCase))
+}
+
+// TestCaseKey returns the hash identifying a test case in a repository
+func TestCaseKey(suite, className, name string) string {
+	h := sha256.Sum256([]byte(suite + "\x00" + className + "\x00" + name))
+	return hex.EncodeToString(h[:])
+}
+
+// Key returns the hash identifying the test case of the result
+func (r *ActionTestResult) Key() string {
+	return TestCaseKey(r.Suite, r.ClassName, r.Name)
+}
+
+// FullName returns the class name and the name of the test
+func (r *ActionTestResult) FullName() string {
+	return testFullName(r.Suite, r.ClassName, r.Name)
+}
+
+// Elapsed returns the duration of the test
+func (r *ActionTestResult) Elapsed() time.Duration {
+	return time.Duration(r.Duration) * time.Millisecond
+}
+
+// FullName returns the class name and the name of the test
+func (c *ActionTestCase) FullName() string {
+	return testFullName(c.Suite, c.ClassName, c.Name)
+}
+
+func testFullName(suite, className, name string) string {
+	// many frameworks use the class name as the name of the suite
+	if className == "" || className == suite {
+		return name
+	}
+	return className + "." + name
+}
+
+// FlakyRate returns the percentage of the runs in which the status of the test flipped
+func (c *ActionTestCase) FlakyRate() float64 {
+	if c.NumRuns <= 1 {
+		return 0
+	}
+	return float64(c.NumFlips) * 100 / float64(c.NumRuns-1)
+}
+
+// NewTestResult returns the result of a test case of a report, the fields are truncated to fit the columns
+func NewTestResult(tc *junit.TestCase) *ActionTestResult {
+	return &ActionTestResult{
+		Suite:     util.TruncateRunes(tc.Suite, 255),
+		ClassName: util.TruncateRunes(tc.ClassName, 255),
+		Name:      tc.Name,
+		Status:    tc.Status,
+		Duration:  tc.Duration.Milliseconds(),
+		Message:   tc.Message,
+		Details:   tc.Details,
+	}
+}
+
+// ReplaceTestResultsOfArtifact replaces the results of the reports of an artifact, e.g. when it's uploaded again
+func ReplaceTestResultsOfArtifact(ctx context.Context, artifactID int64, results []*ActionTestResult) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("artifact_id=?", artifactID).Delete(new(ActionTestResult)); err != nil {
+			return err
+		}
+		for batch := range slices.Chunk(results, 100) {
+			if err := db.Insert(ctx, batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TestSummary is the number of the test results of each status
+type TestSummary struct {
+	Passed  int64 `json:"passed"`
+	Failed  int64 `json:"failed"`
+	Errored int64 `json:"errored"`
+	Skipped int64 `json:"skipped"`
+}
+
+// Total returns the number of the test results
+func (s *TestSummary) Total() int64 {
+	return s.Passed + s.Failed + s.Errored + s.Skipped
+}
+
+// NumFailures returns the number of the failed and errored tests
+func (s *TestSummary) NumFailures() int64 {
+	return s.Failed + s.Errored
+}
+
+// GetTestSummaryOfRun returns the summary of the test results of a run
+func GetTestSummaryOfRun(ctx context.Context, runID int64) (*TestSummary, error) {
+	var counts []struct {
+		Status junit.Status
+		Count  int64
+	}
+	if err := db.GetEngine(ctx).Table("action_test_result").Select("status, COUNT(*) AS count").
+		Where("run_id=?", runID).GroupBy("status").Find(&counts); err != nil {
+		return nil, err
+	}
+	summary := &TestSummary{}
+	for _, c := range counts {
+		switch c.Status {
+		case junit.StatusPassed:
+			summary.Passed = c.Count
+		case junit.StatusFailed:
+			summary.Failed = c.Count
+		case junit.StatusErrored:
+			summary.Errored = c.Count
+		case junit.StatusSkipped:
+			summary.Skipped = c.Count
+		}
+	}
+	return summary, nil
+}
+
+// FindTestResultsOptions represents the conditions to find the test results of a run
+type FindTestResultsOptions struct {
+	db.ListOptions
+	RunID    int64
+	Statuses []junit.Status
+}
+
+func (opts FindTestResultsOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.RunID > 0 {
+		cond = cond.And(builder.Eq{"run_id": opts.RunID})
+	}
+	if len(opts.Statuses) > 0 {
+		cond = cond.And(builder.In("status", opts.Statuses))
+	}
+	return cond
+}
+
+func (opts FindTestResultsOptions) ToOrders() string {
+	return "suite, class_name, id"
+}
+
+// UpdateTestCasesHistory counts the results of a task of a run of the default branch in the history of the test cases,
+// the results reported again by the same task, e.g. when the artifact is uploaded again, are ignored.
+func UpdateTestCasesHistory(ctx context.Context, repoID, runID, taskID int64, results []*ActionTestResult) error {
+	latest := make(map[string]*ActionTestResult, len(results))
+	flipped := make(map[string]bool)
+	for _, r := range results {
+		if r.Status == junit.StatusSkipped {
+			continue
+		}
+		key := r.Key()
+		if prev, ok := latest[key]; ok && prev.Status.IsFailure() != r.Status.IsFailure() {
+			// the test has been retried by the test framework in the same report
+			flipped[key] = true
+		}
+		latest[key] = r
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		keys := make([]string, 0, len(latest))
+		for key := range latest {
+			keys = append(keys, key)
+		}
+		existing, err := GetTestCasesByKeys(ctx, repoID, keys)
+		if err != nil {
+			return err
+		}
+
+		for key, r := range latest {
+			tc, ok := existing[key]
+			if !ok {
+				tc = &ActionTestCase{RepoID: repoID, KeyHash: key, Suite: r.Suite, ClassName: r.ClassName, Name: r.Name}
+			} else if tc.LastTaskID == taskID {
+				continue
+			}
+			if flipped[key] || tc.LastStatus > 0 && tc.LastStatus.IsFailure() != r.Status.IsFailure() {
+				tc.NumFlips++
+			}
+			tc.NumRuns++
+			if r.Status.IsFailure() {
+				tc.NumFailures++
+			}
+			tc.LastStatus = r.Status
+			tc.LastRunID = runID
+			tc.LastTaskID = taskID
+			if tc.ID == 0 {
+				err = db.Insert(ctx, tc)
+			} else {
+				_, err = db.GetEngine(ctx).ID(tc.ID).Cols("num_runs", "num_failures", "num_flips", "last_status", "last_run_id", "last_task_id").Update(tc)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetTestCasesByKeys returns the test cases of a repository by their keys
+func GetTestCasesByKeys(ctx context.Context, repoID int64, keys []string) (map[string]*ActionTestCase, error) {
+	testCases := make(map[string]*ActionTestCase, len(keys))
+	for batch := range slices.Chunk(keys, 500) {
+		var cases []*ActionTestCase
+		if err := db.GetEngine(ctx).Where("repo_id=?", repoID).In("key_hash", batch).Find(&cases); err != nil {
+			return nil, err
+		}
+		for _, tc := range cases {
+			testCases[tc.KeyHash] = tc
+		}
+	}
+	return testCases, nil
+}
+
+// FindFlakyTestCases returns the test cases of a repository whose status flipped, the flakiest first
+func FindFlakyTestCases(ctx context.Context, repoID int64, listOptions db.ListOptions) ([]*ActionTestCase, int64, error) {
+	sess := db.GetEngine(ctx).Where("repo_id=? AND num_flips>0", repoID)
+	if listOptions.Page > 0 {
+		sess = db.SetSessionPagination(sess, &listOptions)
+	}
+	cases := make([]*ActionTestCase, 0, listOptions.PageSize)
+	count, err := sess.OrderBy("num_flips DESC, updated DESC").FindAndCount(&cases)
+	return cases, count, err
+}
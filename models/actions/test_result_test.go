@@ -0,0 +1,94 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"testing"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/junit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTestReportArtifactName(t *testing.T) {
+	assert.True(t, IsTestReportArtifactName("gitea-test-report"))
+	assert.True(t, IsTestReportArtifactName("gitea-test-report-linux"))
+	assert.False(t, IsTestReportArtifactName("gitea-test-report-"))
+	assert.False(t, IsTestReportArtifactName("gitea-test-reports"))
+	assert.False(t, IsTestReportArtifactName("test-report"))
+}
+
+func TestUpdateTestCasesHistory(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	report := func(taskID int64, statuses ...junit.Status) {
+		results := make([]*ActionTestResult, 0, len(statuses))
+		for _, status := range statuses {
+			results = append(results, &ActionTestResult{Suite: "pkg", ClassName: "pkg", Name: "TestA", Status: status})
+		}
+		require.NoError(t, UpdateTestCasesHistory(t.Context(), 1, taskID, taskID, results))
+	}
+	getTestCase := func() *ActionTestCase {
+		return unittest.AssertExistsAndLoadBean(t, &ActionTestCase{RepoID: 1, KeyHash: TestCaseKey("pkg", "pkg", "TestA")})
+	}
+
+	report(1, junit.StatusPassed)
+	tc := getTestCase()
+	assert.EqualValues(t, 1, tc.NumRuns)
+	assert.Zero(t, tc.NumFlips)
+	assert.Equal(t, "TestA", tc.FullName())
+
+	// the same task is ignored
+	report(1, junit.StatusFailed)
+	assert.EqualValues(t, 1, getTestCase().NumRuns)
+
+	report(2, junit.StatusFailed)
+	report(3, junit.StatusErrored)
+	report(4, junit.StatusSkipped)
+	tc = getTestCase()
+	assert.EqualValues(t, 3, tc.NumRuns)
+	assert.EqualValues(t, 2, tc.NumFailures)
+	assert.EqualValues(t, 1, tc.NumFlips)
+	assert.Equal(t, junit.StatusErrored, tc.LastStatus)
+
+	// a test retried by the framework in the same report is flaky
+	report(5, junit.StatusFailed, junit.StatusPassed)
+	tc = getTestCase()
+	assert.EqualValues(t, 4, tc.NumRuns)
+	assert.EqualValues(t, 2, tc.NumFlips)
+	assert.InDelta(t, 66.67, tc.FlakyRate(), 0.01)
+
+	cases, total, err := FindFlakyTestCases(t.Context(), 1, db.ListOptions{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, total)
+	assert.Len(t, cases, 1)
+}
+
+func TestGetTestSummaryOfRun(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	require.NoError(t, ReplaceTestResultsOfArtifact(t.Context(), 1, []*ActionTestResult{
+		{RepoID: 1, RunID: 1, ArtifactID: 1, Name: "a", Status: junit.StatusPassed},
+		{RepoID: 1, RunID: 1, ArtifactID: 1, Name: "b", Status: junit.StatusFailed},
+		{RepoID: 1, RunID: 1, ArtifactID: 1, Name: "c", Status: junit.StatusErrored},
+	}))
+	require.NoError(t, ReplaceTestResultsOfArtifact(t.Context(), 2, []*ActionTestResult{
+		{RepoID: 1, RunID: 1, ArtifactID: 2, Name: "d", Status: junit.StatusSkipped},
+	}))
+
+	summary, err := GetTestSummaryOfRun(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, &TestSummary{Passed: 1, Failed: 1, Errored: 1, Skipped: 1}, summary)
+	assert.EqualValues(t, 4, summary.Total())
+	assert.EqualValues(t, 2, summary.NumFailures())
+
+	// the results of an artifact are replaced
+	require.NoError(t, ReplaceTestResultsOfArtifact(t.Context(), 1, nil))
+	summary, err = GetTestSummaryOfRun(t.Context(), 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, summary.Total())
+}
@@ -21,6 +21,7 @@ import (
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/container"
 	"code.gitea.io/gitea/modules/htmlutil"
+	"code.gitea.io/gitea/modules/json"
 	"code.gitea.io/gitea/modules/log"
 	"code.gitea.io/gitea/modules/optional"
 	"code.gitea.io/gitea/modules/references"
@@ -116,6 +117,13 @@ const (
 	CommentTypeUnpin // 37 unpin Issue/PullRequest
 
 	CommentTypeChangeTimeEstimate // 38 Change time estimate
+
+	CommentTypeAddSubIssue       // 39 Sub-issue added
+	CommentTypeRemoveSubIssue    // 40 Sub-issue removed
+	CommentTypeAddParentIssue    // 41 Parent issue added
+	CommentTypeRemoveParentIssue // 42 Parent issue removed
+
+	CommentTypeChangeIssueType // 43 Change issue type
 )
 
 var commentStrings = []string{
@@ -158,6 +166,11 @@ var commentStrings = []string{
 	"pin",
 	"unpin",
 	"change_time_estimate",
+	"add_sub_issue",
+	"remove_sub_issue",
+	"add_parent_issue",
+	"remove_parent_issue",
+	"change_issue_type",
 }
 
 func (t CommentType) String() string {
@@ -286,8 +299,9 @@ type Comment struct {
 	DependentIssue   *Issue `xorm:"-"`
 
 	CommitID        int64
-	Line            int64         // - previous line / + proposed line
-	TreePath        string        `xorm:"VARCHAR(4000)"` // SQLServer only supports up to 4000
+	Line            int64         // - previous line / + proposed line, zero for a comment on the whole file
+	StartLine       int64         `xorm:"NOT NULL DEFAULT 0"` // first line of a comment on a range of lines, with the sign of Line, zero for a single line
+	TreePath        string        `xorm:"VARCHAR(4000)"`      // SQLServer only supports up to 4000
 	Content         string        `xorm:"LONGTEXT"`
 	ContentVersion  int           `xorm:"NOT NULL DEFAULT 0"`
 	RenderedContent template.HTML `xorm:"-"`
@@ -756,6 +770,27 @@ func (c *Comment) UnsignedLine() uint64 {
 	return uint64(c.Line)
 }
 
+// UnsignedStartLine returns the first LOC of the code comment without + or -, it is the line of a single line comment
+func (c *Comment) UnsignedStartLine() uint64 {
+	if c.StartLine == 0 {
+		return c.UnsignedLine()
+	}
+	if c.StartLine < 0 {
+		return uint64(c.StartLine * -1)
+	}
+	return uint64(c.StartLine)
+}
+
+// IsMultiLine returns true if the code comment covers a range of lines
+func (c *Comment) IsMultiLine() bool {
+	return c.Type == CommentTypeCode && c.StartLine != 0 && c.StartLine != c.Line
+}
+
+// IsFileComment returns true if the code comment is on the whole file instead of some lines
+func (c *Comment) IsFileComment() bool {
+	return c.Type == CommentTypeCode && c.Line == 0
+}
+
 // CodeCommentLink returns the url to a comment in code
 func (c *Comment) CodeCommentLink(ctx context.Context) string {
 	err := c.LoadIssue(ctx)
@@ -841,6 +876,7 @@ func CreateComment(ctx context.Context, opts *CreateCommentOptions) (_ *Comment,
 			CommitID:         opts.CommitID,
 			CommitSHA:        opts.CommitSHA,
 			Line:             opts.LineNum,
+			StartLine:        opts.StartLineNum,
 			Content:          opts.Content,
 			OldTitle:         opts.OldTitle,
 			NewTitle:         opts.NewTitle,
@@ -1008,6 +1044,7 @@ type CreateCommentOptions struct {
 	CommitSHA          string
 	Patch              string
 	LineNum            int64
+	StartLineNum       int64
 	TreePath           string
 	ReviewID           int64
 	Content            string
@@ -1204,6 +1241,29 @@ func UpdateCommentsMigrationsByType(ctx context.Context, tp structs.GitServiceTy
 	return err
 }
 
+// UpdatePushCommentsCommitIDs rewrites the commit IDs recorded by the push comments of the repository's pull requests,
+// it is used after the repository has been converted to another object format
+func UpdatePushCommentsCommitIDs(ctx context.Context, repoID int64, convert func(commitID string) string) error {
+	cond := builder.Eq{"`comment`.type": CommentTypePullRequestPush}.
+		And(builder.In("`comment`.issue_id", builder.Select("id").From("issue").Where(builder.Eq{"repo_id": repoID})))
+	return db.Iterate(ctx, cond, func(ctx context.Context, comment *Comment) error {
+		var data PushActionContent
+		if err := json.Unmarshal([]byte(comment.Content), &data); err != nil {
+			return nil // the content of an unknown format is left as it is
+		}
+		for i, commitID := range data.CommitIDs {
+			data.CommitIDs[i] = convert(commitID)
+		}
+		content, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		comment.Content = string(content)
+		_, err = db.GetEngine(ctx).ID(comment.ID).Cols("content").NoAutoTime().Update(comment)
+		return err
+	})
+}
+
 // CreateAutoMergeComment is a internal function, only use it for CommentTypePRScheduledToAutoMerge and CommentTypePRUnScheduledToAutoMerge CommentTypes
 func CreateAutoMergeComment(ctx context.Context, typ CommentType, pr *PullRequest, doer *user_model.User) (comment *Comment, err error) {
 	if typ != CommentTypePRScheduledToAutoMerge && typ != CommentTypePRUnScheduledToAutoMerge {
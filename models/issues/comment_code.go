@@ -5,11 +5,14 @@ package issues
 
 import (
 	"context"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"code.gitea.io/gitea/models/db"
 	"code.gitea.io/gitea/models/renderhelper"
 	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/git"
 	"code.gitea.io/gitea/modules/markup/markdown"
 
 	"xorm.io/builder"
@@ -119,7 +122,7 @@ func findCodeComments(ctx context.Context, opts FindCommentsOptions, issue *Issu
 		rctx := renderhelper.NewRenderContextRepoComment(ctx, issue.Repo, renderhelper.RepoCommentOptions{
 			FootnoteContextID: strconv.FormatInt(comment.ID, 10),
 		})
-		if comment.RenderedContent, err = markdown.RenderString(rctx, comment.Content); err != nil {
+		if comment.RenderedContent, err = markdown.RenderString(rctx, comment.ContentWithSuggestionDiff()); err != nil {
 			return nil, err
 		}
 	}
@@ -136,3 +139,106 @@ func FetchCodeCommentsByLine(ctx context.Context, issue *Issue, currentUser *use
 	}
 	return findCodeComments(ctx, opts, issue, currentUser, nil, showOutdatedComments)
 }
+
+// CodeSuggestion is a change suggested by a code comment, the suggested lines replace the commented lines of the proposed file
+type CodeSuggestion struct {
+	StartLine      int64
+	EndLine        int64
+	OriginalLines  []string
+	SuggestedLines []string
+
+	// the lines of the fenced block in the comment content
+	blockStart, blockEnd int
+	fence                string
+}
+
+// codeSuggestionFenceRegex matches the opening fence of a suggestion block, e.g. "```suggestion"
+var codeSuggestionFenceRegex = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})[ \\t]*suggestion[ \\t]*$")
+
+// CodeSuggestion returns the first change suggested by a code comment on the proposed file,
+// nil is returned if there is no suggestion or the commented lines aren't in the patch of the comment
+func (c *Comment) CodeSuggestion() *CodeSuggestion {
+	if c.Type != CommentTypeCode || c.Line <= 0 {
+		return nil
+	}
+
+	lines := strings.Split(strings.ReplaceAll(c.Content, "\r\n", "\n"), "\n")
+	suggestion := &CodeSuggestion{StartLine: int64(c.UnsignedStartLine()), EndLine: c.Line, blockStart: -1}
+	for i, line := range lines {
+		if suggestion.blockStart == -1 {
+			if m := codeSuggestionFenceRegex.FindStringSubmatch(line); m != nil {
+				suggestion.blockStart, suggestion.fence = i, m[1]
+			}
+			continue
+		}
+		// the closing fence must use the same character and be at least as long as the opening fence
+		closing := strings.TrimSpace(line)
+		if strings.HasPrefix(closing, suggestion.fence) && strings.Trim(closing, suggestion.fence[:1]) == "" {
+			suggestion.blockEnd = i
+			break
+		}
+		suggestion.SuggestedLines = append(suggestion.SuggestedLines, line)
+	}
+	if suggestion.blockStart == -1 || suggestion.blockEnd == 0 {
+		return nil
+	}
+
+	proposed := patchProposedLines(c.Patch)
+	for line := suggestion.StartLine; line <= suggestion.EndLine; line++ {
+		content, ok := proposed[line]
+		if !ok {
+			return nil
+		}
+		suggestion.OriginalLines = append(suggestion.OriginalLines, content)
+	}
+	return suggestion
+}
+
+// ContentWithSuggestionDiff returns the content of a comment with its suggestion block replaced by a diff
+// against the commented lines, so the suggestion is rendered as a change
+func (c *Comment) ContentWithSuggestionDiff() string {
+	suggestion := c.CodeSuggestion()
+	if suggestion == nil {
+		return c.Content
+	}
+
+	lines := strings.Split(strings.ReplaceAll(c.Content, "\r\n", "\n"), "\n")
+	diff := make([]string, 0, len(lines)+len(suggestion.OriginalLines))
+	diff = append(diff, lines[:suggestion.blockStart]...)
+	diff = append(diff, suggestion.fence+"diff")
+	for _, line := range suggestion.OriginalLines {
+		diff = append(diff, "-"+line)
+	}
+	for _, line := range suggestion.SuggestedLines {
+		diff = append(diff, "+"+line)
+	}
+	diff = append(diff, lines[suggestion.blockEnd:]...)
+	return strings.Join(diff, "\n")
+}
+
+// patchProposedLines returns the lines of the proposed file in the patch of a code comment by their line number
+func patchProposedLines(patch string) map[int64]string {
+	lines := make(map[int64]string)
+	var line int64
+	inHunk := false
+	for lof := range strings.SplitSeq(patch, "\n") {
+		if strings.HasPrefix(lof, "@@") {
+			_, _, rightLine, _ := git.ParseDiffHunkString(lof)
+			line, inHunk = int64(rightLine), true
+			continue
+		}
+		if !inHunk || lof == "" {
+			continue
+		}
+		switch lof[0] {
+		case '+', ' ':
+			lines[line] = lof[1:]
+			line++
+		case '-', '\\':
+			// removed lines and "\ No newline at end of file" aren't in the proposed file
+		default:
+			inHunk = false
+		}
+	}
+	return lines
+}
@@ -124,3 +124,65 @@ func Test_UpdateIssueNumComments(t *testing.T) {
 	issue2 = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
 	assert.Equal(t, 1, issue2.NumComments)
 }
+
+func TestComment_CodeSuggestion(t *testing.T) {
+	patch := "diff --git a/README.md b/README.md\n--- a/README.md\n+++ b/README.md\n@@ -1,3 +1,3 @@\n # repo1\n-Description\n+Description for repo1\n \n"
+	comment := &issues_model.Comment{
+		Type:    issues_model.CommentTypeCode,
+		Line:    2,
+		Patch:   patch,
+		Content: "Better wording:\n```suggestion\nThe description of repo1\n```\nWhat do you think?",
+	}
+
+	suggestion := comment.CodeSuggestion()
+	if assert.NotNil(t, suggestion) {
+		assert.EqualValues(t, 2, suggestion.StartLine)
+		assert.EqualValues(t, 2, suggestion.EndLine)
+		assert.Equal(t, []string{"Description for repo1"}, suggestion.OriginalLines)
+		assert.Equal(t, []string{"The description of repo1"}, suggestion.SuggestedLines)
+	}
+	assert.Equal(t, "Better wording:\n```diff\n-Description for repo1\n+The description of repo1\n```\nWhat do you think?", comment.ContentWithSuggestionDiff())
+
+	// an empty suggestion removes the line
+	comment.Content = "~~~~suggestion\n~~~~"
+	suggestion = comment.CodeSuggestion()
+	if assert.NotNil(t, suggestion) {
+		assert.Empty(t, suggestion.SuggestedLines)
+	}
+
+	// comments on the previous file, on lines out of the patch or without suggestion have no suggestion
+	comment.Content = "```suggestion\nfoo\n```"
+	comment.Line = -2
+	assert.Nil(t, comment.CodeSuggestion())
+	comment.Line = 10
+	assert.Nil(t, comment.CodeSuggestion())
+	comment.Line = 2
+	comment.Content = "```go\nfoo\n```"
+	assert.Nil(t, comment.CodeSuggestion())
+	assert.Equal(t, comment.Content, comment.ContentWithSuggestionDiff())
+
+	// a comment on several lines suggests to replace all of them
+	comment.StartLine = 1
+	comment.Content = "```suggestion\n# repo\nDescription\n```"
+	suggestion = comment.CodeSuggestion()
+	if assert.NotNil(t, suggestion) {
+		assert.EqualValues(t, 1, suggestion.StartLine)
+		assert.EqualValues(t, 2, suggestion.EndLine)
+		assert.Equal(t, []string{"# repo1", "Description for repo1"}, suggestion.OriginalLines)
+	}
+}
+
+func TestComment_CodeCommentLines(t *testing.T) {
+	comment := &issues_model.Comment{Type: issues_model.CommentTypeCode, Line: -5}
+	assert.False(t, comment.IsMultiLine())
+	assert.False(t, comment.IsFileComment())
+	assert.EqualValues(t, 5, comment.UnsignedStartLine())
+
+	comment.StartLine = -3
+	assert.True(t, comment.IsMultiLine())
+	assert.EqualValues(t, 3, comment.UnsignedStartLine())
+
+	comment.StartLine, comment.Line = 0, 0
+	assert.True(t, comment.IsFileComment())
+	assert.False(t, comment.IsMultiLine())
+}
@@ -122,6 +122,11 @@ type Issue struct {
 
 	// Time estimate
 	TimeEstimate int64 `xorm:"NOT NULL DEFAULT 0"`
+
+	// the type defined by the owner of the repository
+	TypeID int64      `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Type   *IssueType `xorm:"-"`
+	SLA    *IssueSLA  `xorm:"-"`
 }
 
 var (
@@ -331,6 +336,10 @@ func (issue *Issue) LoadAttributes(ctx context.Context) (err error) {
 		return err
 	}
 
+	if err = issue.LoadType(ctx); err != nil {
+		return err
+	}
+
 	if err = issue.LoadAssignees(ctx); err != nil {
 		return err
 	}
@@ -0,0 +1,316 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// IssueFieldType is the type of the values of a custom field of an issue type
+type IssueFieldType uint8
+
+const (
+	IssueFieldTypeText IssueFieldType = iota + 1
+	IssueFieldTypeNumber
+	IssueFieldTypeDate
+	IssueFieldTypeSelect
+)
+
+// IssueFieldDateFormat is the format of the values of the date fields
+const IssueFieldDateFormat = "2006-01-02"
+
+// maxIssueFields max custom fields allowed for an issue type
+const maxIssueFields = 25
+
+var issueFieldTypeNames = map[IssueFieldType]string{
+	IssueFieldTypeText:   "text",
+	IssueFieldTypeNumber: "number",
+	IssueFieldTypeDate:   "date",
+	IssueFieldTypeSelect: "select",
+}
+
+func (t IssueFieldType) String() string {
+	return issueFieldTypeNames[t]
+}
+
+// IsValid checks if the field type is known
+func (t IssueFieldType) IsValid() bool {
+	_, ok := issueFieldTypeNames[t]
+	return ok
+}
+
+// IssueFieldTypes returns the known field types
+func IssueFieldTypes() []IssueFieldType {
+	return []IssueFieldType{IssueFieldTypeText, IssueFieldTypeNumber, IssueFieldTypeDate, IssueFieldTypeSelect}
+}
+
+// ParseIssueFieldType returns the field type of a name, 0 is returned if the name is unknown
+func ParseIssueFieldType(name string) IssueFieldType {
+	for t, n := range issueFieldTypeNames {
+		if n == name {
+			return t
+		}
+	}
+	return 0
+}
+
+// IssueField is a custom field of an issue type, every issue of the type can have a value for it
+type IssueField struct {
+	ID      int64          `xorm:"pk autoincr"`
+	OwnerID int64          `xorm:"INDEX NOT NULL"`
+	TypeID  int64          `xorm:"INDEX NOT NULL"`
+	Name    string         `xorm:"NOT NULL"`
+	Type    IssueFieldType `xorm:"NOT NULL"`
+	Sorting int            `xorm:"NOT NULL DEFAULT 0"`
+
+	// the options of a select field
+	Options []string `xorm:"JSON TEXT"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+// IssueFieldValue is the value of a custom field for an issue
+type IssueFieldValue struct {
+	ID      int64  `xorm:"pk autoincr"`
+	IssueID int64  `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	FieldID int64  `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Value   string `xorm:"TEXT"`
+
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueField))
+	db.RegisterModel(new(IssueFieldValue))
+}
+
+// ErrIssueFieldNotExist represents a "IssueFieldNotExist" kind of error.
+type ErrIssueFieldNotExist struct {
+	ID int64
+}
+
+// IsErrIssueFieldNotExist checks if an error is a ErrIssueFieldNotExist.
+func IsErrIssueFieldNotExist(err error) bool {
+	_, ok := err.(ErrIssueFieldNotExist)
+	return ok
+}
+
+func (err ErrIssueFieldNotExist) Error() string {
+	return fmt.Sprintf("issue field does not exist [id: %d]", err.ID)
+}
+
+func (err ErrIssueFieldNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// NormalizeValue checks a value for the field and returns it in the format it is stored
+func (f *IssueField) NormalizeValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	switch f.Type {
+	case IssueFieldTypeText:
+		return value, nil
+	case IssueFieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", util.NewInvalidArgumentErrorf("field %q requires a number", f.Name)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case IssueFieldTypeDate:
+		date, err := time.Parse(IssueFieldDateFormat, value)
+		if err != nil {
+			return "", util.NewInvalidArgumentErrorf("field %q requires a date like 2006-01-02", f.Name)
+		}
+		return date.Format(IssueFieldDateFormat), nil
+	case IssueFieldTypeSelect:
+		if !slices.Contains(f.Options, value) {
+			return "", util.NewInvalidArgumentErrorf("field %q has no option %q", f.Name, value)
+		}
+		return value, nil
+	}
+	return "", util.NewInvalidArgumentErrorf("field %q has an unknown type", f.Name)
+}
+
+func (f *IssueField) validate() error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" {
+		return util.NewInvalidArgumentErrorf("field name is required")
+	}
+	if !f.Type.IsValid() {
+		return util.NewInvalidArgumentErrorf("unknown field type %d", f.Type)
+	}
+	if f.Type != IssueFieldTypeSelect {
+		f.Options = nil
+		return nil
+	}
+
+	options := make([]string, 0, len(f.Options))
+	for _, option := range f.Options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		if slices.Contains(options, option) {
+			return util.NewInvalidArgumentErrorf("options of field %q must have unique names", f.Name)
+		}
+		options = append(options, option)
+	}
+	if len(options) == 0 {
+		return util.NewInvalidArgumentErrorf("select field %q requires options", f.Name)
+	}
+	f.Options = options
+	return nil
+}
+
+// IssueFieldList is a list of custom fields
+type IssueFieldList []*IssueField
+
+// GetFieldByName returns the field of the list with the name, the name is case-insensitive
+func (fields IssueFieldList) GetFieldByName(name string) *IssueField {
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, strings.TrimSpace(name)) {
+			return field
+		}
+	}
+	return nil
+}
+
+// GetIssueFieldsByTypeID returns the custom fields of an issue type
+func GetIssueFieldsByTypeID(ctx context.Context, typeID int64) (IssueFieldList, error) {
+	fields := make(IssueFieldList, 0, 5)
+	return fields, db.GetEngine(ctx).Where("type_id=?", typeID).OrderBy("sorting, id").Find(&fields)
+}
+
+// GetIssueFieldByID returns a custom field of an issue type
+func GetIssueFieldByID(ctx context.Context, typeID, id int64) (*IssueField, error) {
+	field := new(IssueField)
+	has, err := db.GetEngine(ctx).Where("type_id=? AND id=?", typeID, id).Get(field)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueFieldNotExist{ID: id}
+	}
+	return field, nil
+}
+
+func isIssueFieldNameUsed(ctx context.Context, field *IssueField) (bool, error) {
+	return db.GetEngine(ctx).Where("type_id=? AND id<>?", field.TypeID, field.ID).And("LOWER(name)=?", strings.ToLower(field.Name)).Exist(new(IssueField))
+}
+
+// NewIssueField adds a custom field to an issue type
+func NewIssueField(ctx context.Context, t *IssueType, field *IssueField) error {
+	field.OwnerID, field.TypeID = t.OwnerID, t.ID
+	if err := field.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueFieldNameUsed(ctx, field); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("field %q already exists", field.Name)
+		}
+		count, err := db.GetEngine(ctx).Where("type_id=?", t.ID).Count(new(IssueField))
+		if err != nil {
+			return err
+		}
+		if count >= maxIssueFields {
+			return util.NewInvalidArgumentErrorf("an issue type can have at most %d fields", maxIssueFields)
+		}
+		field.Sorting = int(count)
+		return db.Insert(ctx, field)
+	})
+}
+
+// UpdateIssueField updates the name and the options of a custom field, its type can't be changed.
+// The values of the removed options are removed.
+func UpdateIssueField(ctx context.Context, field *IssueField) error {
+	if err := field.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueFieldNameUsed(ctx, field); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("field %q already exists", field.Name)
+		}
+		if _, err := db.GetEngine(ctx).ID(field.ID).Cols("name", "options").Update(field); err != nil {
+			return err
+		}
+		if field.Type != IssueFieldTypeSelect {
+			return nil
+		}
+		_, err := db.GetEngine(ctx).Where(builder.Eq{"field_id": field.ID}.And(builder.NotIn("value", field.Options))).Delete(new(IssueFieldValue))
+		return err
+	})
+}
+
+// DeleteIssueField removes a custom field and its values
+func DeleteIssueField(ctx context.Context, field *IssueField) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("field_id=?", field.ID).Delete(new(IssueFieldValue)); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(field.ID).Delete(new(IssueField))
+		return err
+	})
+}
+
+// SetIssueFieldValue sets the value of a custom field of the type of an issue, an empty value removes it
+func SetIssueFieldValue(ctx context.Context, issue *Issue, field *IssueField, value string) error {
+	if field.TypeID != issue.TypeID {
+		return util.NewInvalidArgumentErrorf("field %q is not a field of the type of the issue", field.Name)
+	}
+	value, err := field.NormalizeValue(value)
+	if err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if value == "" {
+			_, err := db.GetEngine(ctx).Where("field_id=? AND issue_id=?", field.ID, issue.ID).Delete(new(IssueFieldValue))
+			return err
+		}
+		fieldValue := &IssueFieldValue{FieldID: field.ID, IssueID: issue.ID}
+		has, err := db.GetEngine(ctx).Get(fieldValue)
+		if err != nil {
+			return err
+		}
+		fieldValue.Value = value
+		if has {
+			_, err = db.GetEngine(ctx).ID(fieldValue.ID).Cols("value").Update(fieldValue)
+			return err
+		}
+		return db.Insert(ctx, fieldValue)
+	})
+}
+
+// GetIssueFieldValues returns the values of the custom fields of the issues, by issue id and field id
+func GetIssueFieldValues(ctx context.Context, issueIDs []int64) (map[int64]map[int64]string, error) {
+	values := make([]*IssueFieldValue, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).In("issue_id", issueIDs).Find(&values); err != nil {
+		return nil, err
+	}
+	result := make(map[int64]map[int64]string, len(issueIDs))
+	for _, value := range values {
+		if result[value.IssueID] == nil {
+			result[value.IssueID] = make(map[int64]string)
+		}
+		result[value.IssueID][value.FieldID] = value.Value
+	}
+	return result, nil
+}
@@ -534,6 +534,14 @@ func (issues IssueList) LoadAttributes(ctx context.Context) error {
 		return fmt.Errorf("issue.loadAttributes: loadProjects: %w", err)
 	}
 
+	if err := issues.LoadTypes(ctx); err != nil {
+		return fmt.Errorf("issue.loadAttributes: LoadTypes: %w", err)
+	}
+
+	if err := issues.LoadSLAs(ctx); err != nil {
+		return fmt.Errorf("issue.loadAttributes: LoadSLAs: %w", err)
+	}
+
 	if err := issues.LoadAssignees(ctx); err != nil {
 		return fmt.Errorf("issue.loadAttributes: loadAssignees: %w", err)
 	}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// maxIssueSavedSearches max saved searches allowed for a user
+const maxIssueSavedSearches = 50
+
+// IssueSavedSearch is a named issue search query of a user for the issues or the pull requests dashboard.
+// A pinned search is shown on the dashboard of its owner, a shared search can be run by every signed-in user
+// and the qualifiers like assignee:@me refer to the user running it.
+type IssueSavedSearch struct {
+	ID       int64  `xorm:"pk autoincr"`
+	OwnerID  int64  `xorm:"UNIQUE(s) NOT NULL"`
+	IsPull   bool   `xorm:"UNIQUE(s) NOT NULL DEFAULT false"`
+	Name     string `xorm:"UNIQUE(s) NOT NULL"`
+	Query    string `xorm:"TEXT NOT NULL"`
+	IsPinned bool   `xorm:"NOT NULL DEFAULT false"`
+	IsShared bool   `xorm:"NOT NULL DEFAULT false"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+
+	Owner *user_model.User `xorm:"-"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueSavedSearch))
+}
+
+// ErrIssueSavedSearchNotExist represents a "IssueSavedSearchNotExist" kind of error.
+type ErrIssueSavedSearchNotExist struct {
+	ID int64
+}
+
+// IsErrIssueSavedSearchNotExist checks if an error is a ErrIssueSavedSearchNotExist.
+func IsErrIssueSavedSearchNotExist(err error) bool {
+	_, ok := err.(ErrIssueSavedSearchNotExist)
+	return ok
+}
+
+func (err ErrIssueSavedSearchNotExist) Error() string {
+	return fmt.Sprintf("issue saved search does not exist [id: %d]", err.ID)
+}
+
+func (err ErrIssueSavedSearchNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+func (s *IssueSavedSearch) validate() error {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Query = strings.TrimSpace(s.Query)
+	if s.Name == "" {
+		return util.NewInvalidArgumentErrorf("saved search name is required")
+	}
+	if s.Query == "" {
+		return util.NewInvalidArgumentErrorf("saved search query is required")
+	}
+	return nil
+}
+
+// LoadOwner loads the owner of the saved search
+func (s *IssueSavedSearch) LoadOwner(ctx context.Context) (err error) {
+	if s.Owner == nil {
+		s.Owner, err = user_model.GetPossibleUserByID(ctx, s.OwnerID)
+	}
+	return err
+}
+
+// CanBeRunBy returns whether the user can run the saved search
+func (s *IssueSavedSearch) CanBeRunBy(doer *user_model.User) bool {
+	return doer != nil && (s.IsShared || s.OwnerID == doer.ID)
+}
+
+// FindIssueSavedSearchOptions represents the options to find the saved searches of a user
+type FindIssueSavedSearchOptions struct {
+	db.ListOptions
+	OwnerID  int64
+	IsPull   optional.Option[bool]
+	IsPinned optional.Option[bool]
+}
+
+func (opts FindIssueSavedSearchOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	if opts.OwnerID > 0 {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	if opts.IsPull.Has() {
+		cond = cond.And(builder.Eq{"is_pull": opts.IsPull.Value()})
+	}
+	if opts.IsPinned.Has() {
+		cond = cond.And(builder.Eq{"is_pinned": opts.IsPinned.Value()})
+	}
+	return cond
+}
+
+func (opts FindIssueSavedSearchOptions) ToOrders() string {
+	return "is_pinned DESC, name ASC"
+}
+
+// GetIssueSavedSearchByID returns a saved search by its ID
+func GetIssueSavedSearchByID(ctx context.Context, id int64) (*IssueSavedSearch, error) {
+	s := new(IssueSavedSearch)
+	has, err := db.GetEngine(ctx).ID(id).Get(s)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueSavedSearchNotExist{ID: id}
+	}
+	return s, nil
+}
+
+func isIssueSavedSearchNameUsed(ctx context.Context, s *IssueSavedSearch) (bool, error) {
+	return db.GetEngine(ctx).Where("owner_id=? AND is_pull=? AND id<>?", s.OwnerID, s.IsPull, s.ID).
+		And("LOWER(name)=?", strings.ToLower(s.Name)).Exist(new(IssueSavedSearch))
+}
+
+// NewIssueSavedSearch adds a saved search to a user, the query should have been checked by the caller
+func NewIssueSavedSearch(ctx context.Context, s *IssueSavedSearch) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueSavedSearchNameUsed(ctx, s); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("saved search %q already exists", s.Name)
+		}
+		count, err := db.GetEngine(ctx).Where("owner_id=?", s.OwnerID).Count(new(IssueSavedSearch))
+		if err != nil {
+			return err
+		}
+		if count >= maxIssueSavedSearches {
+			return util.NewInvalidArgumentErrorf("a user can have at most %d saved searches", maxIssueSavedSearches)
+		}
+		return db.Insert(ctx, s)
+	})
+}
+
+// UpdateIssueSavedSearch updates the name, the query and the flags of a saved search
+func UpdateIssueSavedSearch(ctx context.Context, s *IssueSavedSearch) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueSavedSearchNameUsed(ctx, s); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("saved search %q already exists", s.Name)
+		}
+		_, err := db.GetEngine(ctx).ID(s.ID).Cols("name", "query", "is_pinned", "is_shared").Update(s)
+		return err
+	})
+}
+
+// DeleteIssueSavedSearch removes a saved search of a user
+func DeleteIssueSavedSearch(ctx context.Context, ownerID, id int64) error {
+	deleted, err := db.GetEngine(ctx).Where("owner_id=? AND id=?", ownerID, id).Delete(new(IssueSavedSearch))
+	if err != nil {
+		return err
+	} else if deleted == 0 {
+		return ErrIssueSavedSearchNotExist{ID: id}
+	}
+	return nil
+}
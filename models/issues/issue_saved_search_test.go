@@ -0,0 +1,56 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSavedSearches(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	owner := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	other := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 4})
+
+	mine := &issues_model.IssueSavedSearch{OwnerID: owner.ID, Name: " Mine ", Query: "assignee:@me", IsPinned: true}
+	require.NoError(t, issues_model.NewIssueSavedSearch(t.Context(), mine))
+	assert.Equal(t, "Mine", mine.Name)
+	assert.ErrorIs(t, issues_model.NewIssueSavedSearch(t.Context(), &issues_model.IssueSavedSearch{OwnerID: owner.ID, Name: "mine", Query: "is:open"}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewIssueSavedSearch(t.Context(), &issues_model.IssueSavedSearch{OwnerID: owner.ID, Name: "Empty", Query: " "}), util.ErrInvalidArgument)
+	// the same name can be used for the pull requests dashboard
+	reviews := &issues_model.IssueSavedSearch{OwnerID: owner.ID, IsPull: true, Name: "Mine", Query: "review-requested:@me", IsShared: true}
+	require.NoError(t, issues_model.NewIssueSavedSearch(t.Context(), reviews))
+
+	assert.True(t, mine.CanBeRunBy(owner))
+	assert.False(t, mine.CanBeRunBy(other))
+	assert.True(t, reviews.CanBeRunBy(other))
+	assert.False(t, reviews.CanBeRunBy(nil))
+
+	searches, err := db.Find[issues_model.IssueSavedSearch](t.Context(), issues_model.FindIssueSavedSearchOptions{OwnerID: owner.ID, IsPull: optional.Some(false)})
+	require.NoError(t, err)
+	if assert.Len(t, searches, 1) {
+		assert.Equal(t, mine.ID, searches[0].ID)
+	}
+
+	reviews.Name = "mine"
+	require.NoError(t, issues_model.UpdateIssueSavedSearch(t.Context(), reviews))
+	mine.Name = "Reviews"
+	mine.IsPinned = false
+	require.NoError(t, issues_model.UpdateIssueSavedSearch(t.Context(), mine))
+	unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSavedSearch{ID: mine.ID, Name: "Reviews", IsPinned: false})
+
+	assert.True(t, issues_model.IsErrIssueSavedSearchNotExist(issues_model.DeleteIssueSavedSearch(t.Context(), other.ID, mine.ID)))
+	require.NoError(t, issues_model.DeleteIssueSavedSearch(t.Context(), owner.ID, mine.ID))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSavedSearch{ID: mine.ID})
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// maxIssueSchedules max issue schedules allowed for a repository
+const maxIssueSchedules = 25
+
+// IssueSchedule opens an issue from an issue template of the default branch of a repository on a cron schedule,
+// the issues are posted by the creator of the schedule with its assignees, labels and project column
+type IssueSchedule struct {
+	ID           int64  `xorm:"pk autoincr"`
+	RepoID       int64  `xorm:"INDEX NOT NULL"`
+	CreatorID    int64  `xorm:"NOT NULL"`
+	Name         string `xorm:"NOT NULL"`
+	TemplateFile string `xorm:"NOT NULL"`
+	Spec         string `xorm:"NOT NULL"`
+
+	AssigneeIDs     []int64 `xorm:"JSON TEXT"`
+	LabelIDs        []int64 `xorm:"JSON TEXT"`
+	ProjectColumnID int64   `xorm:"NOT NULL DEFAULT 0"`
+
+	// whether the open issue of the previous run is closed when a new one is opened
+	ClosePrevious bool  `xorm:"NOT NULL DEFAULT false"`
+	IsActive      bool  `xorm:"INDEX NOT NULL DEFAULT true"`
+	LastIssueID   int64 `xorm:"NOT NULL DEFAULT 0"`
+
+	// Next is the time of the next run, Prev the time of the last run or zero
+	Next timeutil.TimeStamp `xorm:"INDEX NOT NULL DEFAULT 0"`
+	Prev timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+
+	Repo    *repo_model.Repository `xorm:"-"`
+	Creator *user_model.User       `xorm:"-"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueSchedule))
+}
+
+// ErrIssueScheduleNotExist represents a "IssueScheduleNotExist" kind of error.
+type ErrIssueScheduleNotExist struct {
+	ID int64
+}
+
+// IsErrIssueScheduleNotExist checks if an error is a ErrIssueScheduleNotExist.
+func IsErrIssueScheduleNotExist(err error) bool {
+	_, ok := err.(ErrIssueScheduleNotExist)
+	return ok
+}
+
+func (err ErrIssueScheduleNotExist) Error() string {
+	return fmt.Sprintf("issue schedule does not exist [id: %d]", err.ID)
+}
+
+func (err ErrIssueScheduleNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+func (s *IssueSchedule) validate() error {
+	s.Name = strings.TrimSpace(s.Name)
+	s.TemplateFile = strings.TrimSpace(s.TemplateFile)
+	s.Spec = strings.TrimSpace(s.Spec)
+	if s.Name == "" {
+		return util.NewInvalidArgumentErrorf("issue schedule name is required")
+	}
+	if s.TemplateFile == "" {
+		return util.NewInvalidArgumentErrorf("issue schedule template is required")
+	}
+	if s.Spec == "" {
+		return util.NewInvalidArgumentErrorf("issue schedule spec is required")
+	}
+	return nil
+}
+
+// LoadRepo loads the repository of the schedule
+func (s *IssueSchedule) LoadRepo(ctx context.Context) (err error) {
+	if s.Repo == nil {
+		s.Repo, err = repo_model.GetRepositoryByID(ctx, s.RepoID)
+	}
+	return err
+}
+
+// LoadCreator loads the creator of the schedule, it's the poster of the issues
+func (s *IssueSchedule) LoadCreator(ctx context.Context) (err error) {
+	if s.Creator == nil {
+		s.Creator, err = user_model.GetPossibleUserByID(ctx, s.CreatorID)
+	}
+	return err
+}
+
+// GetIssueSchedulesByRepoID returns the issue schedules of a repository
+func GetIssueSchedulesByRepoID(ctx context.Context, repoID int64) ([]*IssueSchedule, error) {
+	schedules := make([]*IssueSchedule, 0, 5)
+	return schedules, db.GetEngine(ctx).Where("repo_id=?", repoID).OrderBy("name").Find(&schedules)
+}
+
+// GetIssueScheduleByID returns an issue schedule of a repository
+func GetIssueScheduleByID(ctx context.Context, repoID, id int64) (*IssueSchedule, error) {
+	s := new(IssueSchedule)
+	has, err := db.GetEngine(ctx).Where("repo_id=? AND id=?", repoID, id).Get(s)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueScheduleNotExist{ID: id}
+	}
+	return s, nil
+}
+
+// NewIssueSchedule adds an issue schedule to a repository, the spec and the next run should have been set by the caller
+func NewIssueSchedule(ctx context.Context, s *IssueSchedule) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		count, err := db.GetEngine(ctx).Where("repo_id=?", s.RepoID).Count(new(IssueSchedule))
+		if err != nil {
+			return err
+		}
+		if count >= maxIssueSchedules {
+			return util.NewInvalidArgumentErrorf("a repository can have at most %d issue schedules", maxIssueSchedules)
+		}
+		return db.Insert(ctx, s)
+	})
+}
+
+// UpdateIssueSchedule updates the settings and the next run of an issue schedule
+func UpdateIssueSchedule(ctx context.Context, s *IssueSchedule) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).ID(s.ID).Cols("name", "template_file", "spec", "assignee_i_ds", "label_i_ds",
+		"project_column_id", "close_previous", "is_active", "next").Update(s)
+	return err
+}
+
+// UpdateIssueScheduleRun records a run of an issue schedule
+func UpdateIssueScheduleRun(ctx context.Context, s *IssueSchedule) error {
+	_, err := db.GetEngine(ctx).ID(s.ID).Cols("last_issue_id", "next", "prev").NoAutoTime().Update(s)
+	return err
+}
+
+// DeleteIssueSchedule removes an issue schedule, the issues it opened are kept
+func DeleteIssueSchedule(ctx context.Context, repoID, id int64) error {
+	deleted, err := db.GetEngine(ctx).Where("repo_id=? AND id=?", repoID, id).Delete(new(IssueSchedule))
+	if err != nil {
+		return err
+	} else if deleted == 0 {
+		return ErrIssueScheduleNotExist{ID: id}
+	}
+	return nil
+}
+
+// FindDueIssueSchedules returns the active issue schedules whose next run is due
+func FindDueIssueSchedules(ctx context.Context, now timeutil.TimeStamp, limit int) ([]*IssueSchedule, error) {
+	schedules := make([]*IssueSchedule, 0, 10)
+	return schedules, db.GetEngine(ctx).Where(builder.Eq{"is_active": true}.And(builder.Gt{"next": 0}, builder.Lte{"next": now})).
+		OrderBy("next").Limit(limit).Find(&schedules)
+}
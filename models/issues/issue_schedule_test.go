@@ -0,0 +1,57 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSchedules(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	assert.ErrorIs(t, issues_model.NewIssueSchedule(t.Context(), &issues_model.IssueSchedule{RepoID: 1, Name: " ", TemplateFile: "a.md", Spec: "@daily"}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewIssueSchedule(t.Context(), &issues_model.IssueSchedule{RepoID: 1, Name: "No template", Spec: "@daily"}), util.ErrInvalidArgument)
+
+	weekly := &issues_model.IssueSchedule{RepoID: 1, CreatorID: 2, Name: "Weekly", TemplateFile: ".gitea/ISSUE_TEMPLATE/weekly.md", Spec: "0 9 * * 1", LabelIDs: []int64{1}, IsActive: true, Next: 100}
+	require.NoError(t, issues_model.NewIssueSchedule(t.Context(), weekly))
+	paused := &issues_model.IssueSchedule{RepoID: 1, CreatorID: 2, Name: "Paused", TemplateFile: ".gitea/ISSUE_TEMPLATE/weekly.md", Spec: "@daily", IsActive: false, Next: 100}
+	require.NoError(t, issues_model.NewIssueSchedule(t.Context(), paused))
+	later := &issues_model.IssueSchedule{RepoID: 1, CreatorID: 2, Name: "Later", TemplateFile: ".gitea/ISSUE_TEMPLATE/weekly.md", Spec: "@daily", IsActive: true, Next: 300}
+	require.NoError(t, issues_model.NewIssueSchedule(t.Context(), later))
+
+	schedules, err := issues_model.GetIssueSchedulesByRepoID(t.Context(), 1)
+	require.NoError(t, err)
+	require.Len(t, schedules, 3)
+	assert.Equal(t, "Later", schedules[0].Name)
+	s, err := issues_model.GetIssueScheduleByID(t.Context(), 1, weekly.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, s.LabelIDs)
+	_, err = issues_model.GetIssueScheduleByID(t.Context(), 2, weekly.ID)
+	assert.True(t, issues_model.IsErrIssueScheduleNotExist(err))
+
+	due, err := issues_model.FindDueIssueSchedules(t.Context(), timeutil.TimeStamp(200), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, weekly.ID, due[0].ID)
+
+	weekly.LastIssueID, weekly.Prev, weekly.Next = 1, 200, 400
+	require.NoError(t, issues_model.UpdateIssueScheduleRun(t.Context(), weekly))
+	due, err = issues_model.FindDueIssueSchedules(t.Context(), timeutil.TimeStamp(300), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, later.ID, due[0].ID)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{ID: weekly.ID, LastIssueID: 1, Prev: 200})
+
+	assert.True(t, issues_model.IsErrIssueScheduleNotExist(issues_model.DeleteIssueSchedule(t.Context(), 2, weekly.ID)))
+	require.NoError(t, issues_model.DeleteIssueSchedule(t.Context(), 1, weekly.ID))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSchedule{ID: weekly.ID})
+}
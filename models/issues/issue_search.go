@@ -38,9 +38,14 @@ type IssuesOptions struct { //nolint:revive // export stutter
 	MilestoneIDs       []int64
 	ProjectID          int64
 	ProjectColumnID    int64
+	ProjectFieldValues map[int64]string // values of the custom fields of the project by field ID, "(none)" for no value
+	ParentID           int64            // parent issue of the issues, db.NoConditionID for the issues without parent
+	TypeID             int64            // issue type of the issues, db.NoConditionID for the issues without type
+	IssueFieldValues   map[int64]string // values of the custom fields of the issue type by field ID
 	IsClosed           optional.Option[bool]
 	IsPull             optional.Option[bool]
 	LabelIDs           []int64
+	LabelIDGroups      [][]int64 // groups of labels, the issues have at least one label of every group
 	IncludedLabelNames []string
 	ExcludedLabelNames []string
 	IncludeMilestones  []string
@@ -173,6 +178,12 @@ func applyLabelsCondition(sess *xorm.Session, opts *IssuesOptions) {
 		}
 	}
 
+	if len(opts.LabelIDs) == 0 || opts.LabelIDs[0] != 0 {
+		for _, group := range opts.LabelIDGroups {
+			sess.In("issue.id", builder.Select("issue_id").From("issue_label").Where(builder.In("label_id", group)))
+		}
+	}
+
 	if len(opts.IncludedLabelNames) > 0 {
 		sess.In("issue.id", BuildLabelNamesIssueIDsCondition(opts.IncludedLabelNames))
 	}
@@ -218,6 +229,35 @@ func applyProjectColumnCondition(sess *xorm.Session, opts *IssuesOptions) {
 	}
 }
 
+func applyProjectFieldCondition(sess *xorm.Session, opts *IssuesOptions) {
+	for fieldID, value := range opts.ProjectFieldValues {
+		if value == "(none)" {
+			sess.NotIn("issue.id", builder.Select("issue_id").From("project_field_value").Where(builder.Eq{"field_id": fieldID}))
+		} else {
+			sess.In("issue.id", builder.Select("issue_id").From("project_field_value").Where(builder.Eq{"field_id": fieldID, "value": value}))
+		}
+	}
+}
+
+func applyParentCondition(sess *xorm.Session, opts *IssuesOptions) {
+	if opts.ParentID > 0 {
+		sess.In("issue.id", builder.Select("issue_id").From("sub_issue").Where(builder.Eq{"parent_id": opts.ParentID}))
+	} else if opts.ParentID == db.NoConditionID {
+		sess.NotIn("issue.id", builder.Select("issue_id").From("sub_issue"))
+	}
+}
+
+func applyTypeCondition(sess *xorm.Session, opts *IssuesOptions) {
+	if opts.TypeID > 0 {
+		sess.And("issue.type_id=?", opts.TypeID)
+	} else if opts.TypeID == db.NoConditionID {
+		sess.And("issue.type_id=0")
+	}
+	for fieldID, value := range opts.IssueFieldValues {
+		sess.In("issue.id", builder.Select("issue_id").From("issue_field_value").Where(builder.Eq{"field_id": fieldID, "value": value}))
+	}
+}
+
 func applyRepoConditions(sess *xorm.Session, opts *IssuesOptions) {
 	if len(opts.RepoIDs) == 1 {
 		opts.RepoCond = builder.Eq{"issue.repo_id": opts.RepoIDs[0]}
@@ -277,6 +317,9 @@ func applyConditions(sess *xorm.Session, opts *IssuesOptions) {
 	applyProjectCondition(sess, opts)
 
 	applyProjectColumnCondition(sess, opts)
+	applyProjectFieldCondition(sess, opts)
+	applyParentCondition(sess, opts)
+	applyTypeCondition(sess, opts)
 
 	if opts.IsPull.Has() {
 		sess.And("issue.is_pull=?", opts.IsPull.Value())
@@ -0,0 +1,491 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// maxIssueSLAPolicies max SLA policies allowed for a repository
+const maxIssueSLAPolicies = 25
+
+// maxIssueSLAHours max business hours of a target of an SLA policy
+const maxIssueSLAHours = 10000
+
+// IssueSLAPolicy is a service level agreement of a repository for the issues having a label and a type,
+// a policy without label or type applies to every issue. Its targets are measured in business hours
+// from the creation of the issues, a target of 0 hours is not tracked.
+type IssueSLAPolicy struct {
+	ID                 int64  `xorm:"pk autoincr"`
+	RepoID             int64  `xorm:"INDEX NOT NULL"`
+	Name               string `xorm:"NOT NULL"`
+	LabelID            int64  `xorm:"NOT NULL DEFAULT 0"`
+	TypeID             int64  `xorm:"NOT NULL DEFAULT 0"`
+	FirstResponseHours int    `xorm:"NOT NULL DEFAULT 0"`
+	ResolutionHours    int    `xorm:"NOT NULL DEFAULT 0"`
+
+	// the issues at risk are escalated to their assignees, the breached issues to the team too
+	EscalateToAssignees bool  `xorm:"NOT NULL DEFAULT false"`
+	EscalationTeamID    int64 `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+
+	Label *Label     `xorm:"-"`
+	Type  *IssueType `xorm:"-"`
+}
+
+// IssueSLAStatus is the status of a target of the SLA of an issue
+type IssueSLAStatus int
+
+// Enumerate all the statuses of a target, the order is used to find the pending escalations
+const (
+	IssueSLAStatusNone IssueSLAStatus = iota // the target is not tracked
+	IssueSLAStatusOnTrack
+	IssueSLAStatusAtRisk
+	IssueSLAStatusBreached
+	IssueSLAStatusMet
+)
+
+// String returns the name of the status used by the locales and the webhooks
+func (s IssueSLAStatus) String() string {
+	switch s {
+	case IssueSLAStatusOnTrack:
+		return "on_track"
+	case IssueSLAStatusAtRisk:
+		return "at_risk"
+	case IssueSLAStatusBreached:
+		return "breached"
+	case IssueSLAStatusMet:
+		return "met"
+	}
+	return "none"
+}
+
+// IssueSLATarget is a target of an SLA policy
+type IssueSLATarget string
+
+// Enumerate all the targets of an SLA policy
+const (
+	IssueSLATargetFirstResponse IssueSLATarget = "first_response"
+	IssueSLATargetResolution    IssueSLATarget = "resolution"
+)
+
+// IssueSLA is the state of the SLA policy applying to an issue: the deadlines of the targets,
+// when they have been met and the last status of a target which has been escalated
+type IssueSLA struct {
+	ID       int64 `xorm:"pk autoincr"`
+	IssueID  int64 `xorm:"UNIQUE NOT NULL"`
+	RepoID   int64 `xorm:"INDEX NOT NULL"`
+	PolicyID int64 `xorm:"INDEX NOT NULL"`
+
+	FirstResponseWarnUnix timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	FirstResponseDueUnix  timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	FirstRespondedUnix    timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	FirstResponseNotified IssueSLAStatus     `xorm:"NOT NULL DEFAULT 0"`
+
+	ResolutionWarnUnix timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	ResolutionDueUnix  timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	ResolvedUnix       timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	ResolutionNotified IssueSLAStatus     `xorm:"NOT NULL DEFAULT 0"`
+
+	Policy *IssueSLAPolicy `xorm:"-"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueSLAPolicy))
+	db.RegisterModel(new(IssueSLA))
+}
+
+// ErrIssueSLAPolicyNotExist represents a "IssueSLAPolicyNotExist" kind of error.
+type ErrIssueSLAPolicyNotExist struct {
+	ID int64
+}
+
+// IsErrIssueSLAPolicyNotExist checks if an error is a ErrIssueSLAPolicyNotExist.
+func IsErrIssueSLAPolicyNotExist(err error) bool {
+	_, ok := err.(ErrIssueSLAPolicyNotExist)
+	return ok
+}
+
+func (err ErrIssueSLAPolicyNotExist) Error() string {
+	return fmt.Sprintf("issue SLA policy does not exist [id: %d]", err.ID)
+}
+
+func (err ErrIssueSLAPolicyNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+func (p *IssueSLAPolicy) validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return util.NewInvalidArgumentErrorf("SLA policy name is required")
+	}
+	if p.FirstResponseHours < 0 || p.ResolutionHours < 0 || p.FirstResponseHours > maxIssueSLAHours || p.ResolutionHours > maxIssueSLAHours {
+		return util.NewInvalidArgumentErrorf("the targets of an SLA policy must be between 0 and %d hours", maxIssueSLAHours)
+	}
+	if p.FirstResponseHours == 0 && p.ResolutionHours == 0 {
+		return util.NewInvalidArgumentErrorf("an SLA policy needs a first response or a resolution target")
+	}
+	return nil
+}
+
+// Match returns whether the policy applies to the issue, the labels of the issue must be loaded
+func (p *IssueSLAPolicy) Match(issue *Issue) bool {
+	if issue.IsPull || p.TypeID > 0 && issue.TypeID != p.TypeID {
+		return false
+	}
+	if p.LabelID == 0 {
+		return true
+	}
+	for _, l := range issue.Labels {
+		if l.ID == p.LabelID {
+			return true
+		}
+	}
+	return false
+}
+
+// isStricterThan returns whether the policy has shorter targets than the other one, an untracked target is the longest
+func (p *IssueSLAPolicy) isStricterThan(other *IssueSLAPolicy) bool {
+	hours := func(h int) int { return util.Iif(h > 0, h, maxIssueSLAHours+1) }
+	if hours(p.FirstResponseHours) != hours(other.FirstResponseHours) {
+		return hours(p.FirstResponseHours) < hours(other.FirstResponseHours)
+	}
+	if hours(p.ResolutionHours) != hours(other.ResolutionHours) {
+		return hours(p.ResolutionHours) < hours(other.ResolutionHours)
+	}
+	return p.ID < other.ID
+}
+
+// IssueSLAPolicyList is a list of SLA policies
+type IssueSLAPolicyList []*IssueSLAPolicy
+
+// Match returns the strictest policy applying to the issue or nil, the labels of the issue must be loaded
+func (policies IssueSLAPolicyList) Match(issue *Issue) *IssueSLAPolicy {
+	var matched *IssueSLAPolicy
+	for _, p := range policies {
+		if p.Match(issue) && (matched == nil || p.isStricterThan(matched)) {
+			matched = p
+		}
+	}
+	return matched
+}
+
+// LoadAttributes loads the labels and the types of the policies
+func (policies IssueSLAPolicyList) LoadAttributes(ctx context.Context) error {
+	labelIDs := make(container.Set[int64])
+	typeIDs := make(container.Set[int64])
+	for _, p := range policies {
+		if p.LabelID > 0 {
+			labelIDs.Add(p.LabelID)
+		}
+		if p.TypeID > 0 {
+			typeIDs.Add(p.TypeID)
+		}
+	}
+	labels := make(map[int64]*Label, len(labelIDs))
+	if len(labelIDs) > 0 {
+		if err := db.GetEngine(ctx).In("id", labelIDs.Values()).Find(&labels); err != nil {
+			return err
+		}
+	}
+	types := make(map[int64]*IssueType, len(typeIDs))
+	if len(typeIDs) > 0 {
+		if err := db.GetEngine(ctx).In("id", typeIDs.Values()).Find(&types); err != nil {
+			return err
+		}
+	}
+	for _, p := range policies {
+		p.Label = labels[p.LabelID]
+		p.Type = types[p.TypeID]
+	}
+	return nil
+}
+
+// GetIssueSLAPoliciesByRepoID returns the SLA policies of a repository
+func GetIssueSLAPoliciesByRepoID(ctx context.Context, repoID int64) (IssueSLAPolicyList, error) {
+	policies := make(IssueSLAPolicyList, 0, 5)
+	return policies, db.GetEngine(ctx).Where("repo_id=?", repoID).OrderBy("id").Find(&policies)
+}
+
+// GetIssueSLAPolicyByID returns an SLA policy of a repository
+func GetIssueSLAPolicyByID(ctx context.Context, repoID, id int64) (*IssueSLAPolicy, error) {
+	p := new(IssueSLAPolicy)
+	has, err := db.GetEngine(ctx).Where("repo_id=? AND id=?", repoID, id).Get(p)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueSLAPolicyNotExist{ID: id}
+	}
+	return p, nil
+}
+
+// NewIssueSLAPolicy adds an SLA policy to a repository, the label and the type should have been checked by the caller
+func NewIssueSLAPolicy(ctx context.Context, p *IssueSLAPolicy) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		count, err := db.GetEngine(ctx).Where("repo_id=?", p.RepoID).Count(new(IssueSLAPolicy))
+		if err != nil {
+			return err
+		}
+		if count >= maxIssueSLAPolicies {
+			return util.NewInvalidArgumentErrorf("a repository can have at most %d SLA policies", maxIssueSLAPolicies)
+		}
+		return db.Insert(ctx, p)
+	})
+}
+
+// UpdateIssueSLAPolicy updates an SLA policy, the SLAs of the issues have to be refreshed by the caller
+func UpdateIssueSLAPolicy(ctx context.Context, p *IssueSLAPolicy) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).ID(p.ID).Cols("name", "label_id", "type_id", "first_response_hours", "resolution_hours",
+		"escalate_to_assignees", "escalation_team_id").Update(p)
+	return err
+}
+
+// DeleteIssueSLAPolicy removes an SLA policy and the SLAs of the issues it applies to
+func DeleteIssueSLAPolicy(ctx context.Context, p *IssueSLAPolicy) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("policy_id=?", p.ID).Delete(new(IssueSLA)); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(p.ID).Delete(new(IssueSLAPolicy))
+		return err
+	})
+}
+
+// DeleteOwnerIssueSLAPoliciesOfRepo removes the SLA policies of a repository which depend on its owner:
+// the policies of an issue type or of an organization label, the escalations to a team are removed
+func DeleteOwnerIssueSLAPoliciesOfRepo(ctx context.Context, repoID int64) error {
+	var policyIDs []int64
+	if err := db.GetEngine(ctx).Table("issue_sla_policy").Cols("id").Where(builder.Eq{"repo_id": repoID}.And(builder.Or(
+		builder.Gt{"type_id": 0},
+		builder.In("label_id", builder.Select("id").From("label").Where(builder.Gt{"org_id": 0})),
+	))).Find(&policyIDs); err != nil {
+		return err
+	}
+	if len(policyIDs) > 0 {
+		if _, err := db.GetEngine(ctx).In("policy_id", policyIDs).Delete(new(IssueSLA)); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).In("id", policyIDs).Delete(new(IssueSLAPolicy)); err != nil {
+			return err
+		}
+	}
+	_, err := db.GetEngine(ctx).Where("repo_id=?", repoID).Cols("escalation_team_id").Update(&IssueSLAPolicy{EscalationTeamID: 0})
+	return err
+}
+
+// issueSLABusinessHours returns the schedule of the business hours configured for the SLAs
+func issueSLABusinessHours() *timeutil.BusinessHours {
+	return &timeutil.BusinessHours{
+		Start:    setting.IssueSLA.WorkdayStart,
+		End:      setting.IssueSLA.WorkdayEnd,
+		Days:     setting.IssueSLA.Workdays,
+		Location: setting.IssueSLA.Location,
+	}
+}
+
+// NewIssueSLA returns the SLA of an issue under the policy, the targets start at the creation of the issue.
+// The targets which are already at risk or breached are not escalated.
+func (p *IssueSLAPolicy) NewIssueSLA(issue *Issue, now timeutil.TimeStamp) *IssueSLA {
+	hours := issueSLABusinessHours()
+	created := issue.CreatedUnix.AsTime()
+	deadlines := func(targetHours int) (warn, due timeutil.TimeStamp) {
+		if targetHours <= 0 {
+			return 0, 0
+		}
+		target := time.Duration(targetHours) * time.Hour
+		warn = timeutil.TimeStamp(hours.Add(created, target*time.Duration(setting.IssueSLA.WarningThreshold)/100).Unix())
+		due = timeutil.TimeStamp(hours.Add(created, target).Unix())
+		return warn, due
+	}
+	s := &IssueSLA{
+		IssueID:  issue.ID,
+		RepoID:   issue.RepoID,
+		PolicyID: p.ID,
+		Policy:   p,
+	}
+	s.FirstResponseWarnUnix, s.FirstResponseDueUnix = deadlines(p.FirstResponseHours)
+	s.ResolutionWarnUnix, s.ResolutionDueUnix = deadlines(p.ResolutionHours)
+	s.FirstResponseNotified = pendingIssueSLAStatus(s.FirstResponseStatusAt(now))
+	s.ResolutionNotified = pendingIssueSLAStatus(s.ResolutionStatusAt(now))
+	return s
+}
+
+// pendingIssueSLAStatus returns the status if it needs an escalation
+func pendingIssueSLAStatus(status IssueSLAStatus) IssueSLAStatus {
+	if status == IssueSLAStatusAtRisk || status == IssueSLAStatusBreached {
+		return status
+	}
+	return IssueSLAStatusNone
+}
+
+func issueSLATargetStatus(warn, due, done, now timeutil.TimeStamp) IssueSLAStatus {
+	switch {
+	case due == 0:
+		return IssueSLAStatusNone
+	case done > 0 && done <= due:
+		return IssueSLAStatusMet
+	case done > 0 || now >= due:
+		return IssueSLAStatusBreached
+	case now >= warn:
+		return IssueSLAStatusAtRisk
+	}
+	return IssueSLAStatusOnTrack
+}
+
+// FirstResponseStatusAt returns the status of the first response target at the given time
+func (s *IssueSLA) FirstResponseStatusAt(now timeutil.TimeStamp) IssueSLAStatus {
+	return issueSLATargetStatus(s.FirstResponseWarnUnix, s.FirstResponseDueUnix, s.FirstRespondedUnix, now)
+}
+
+// ResolutionStatusAt returns the status of the resolution target at the given time
+func (s *IssueSLA) ResolutionStatusAt(now timeutil.TimeStamp) IssueSLAStatus {
+	return issueSLATargetStatus(s.ResolutionWarnUnix, s.ResolutionDueUnix, s.ResolvedUnix, now)
+}
+
+// FirstResponseStatus returns the current status of the first response target
+func (s *IssueSLA) FirstResponseStatus() IssueSLAStatus {
+	return s.FirstResponseStatusAt(timeutil.TimeStampNow())
+}
+
+// ResolutionStatus returns the current status of the resolution target
+func (s *IssueSLA) ResolutionStatus() IssueSLAStatus {
+	return s.ResolutionStatusAt(timeutil.TimeStampNow())
+}
+
+// Status returns the worst current status of the targets: breached, at risk, on track or met
+func (s *IssueSLA) Status() IssueSLAStatus {
+	rank := map[IssueSLAStatus]int{IssueSLAStatusBreached: 4, IssueSLAStatusAtRisk: 3, IssueSLAStatusOnTrack: 2, IssueSLAStatusMet: 1}
+	first, resolution := s.FirstResponseStatus(), s.ResolutionStatus()
+	return util.Iif(rank[first] >= rank[resolution], first, resolution)
+}
+
+// LoadPolicy loads the policy of the SLA
+func (s *IssueSLA) LoadPolicy(ctx context.Context) (err error) {
+	if s.Policy == nil {
+		s.Policy, err = GetIssueSLAPolicyByID(ctx, s.RepoID, s.PolicyID)
+	}
+	return err
+}
+
+// LoadSLA loads the SLA of the issue, it is nil if no SLA policy applies to the issue
+func (issue *Issue) LoadSLA(ctx context.Context) error {
+	if issue.SLA != nil {
+		return nil
+	}
+	s := new(IssueSLA)
+	has, err := db.GetEngine(ctx).Where("issue_id=?", issue.ID).Get(s)
+	if err != nil {
+		return err
+	} else if has {
+		issue.SLA = s
+	}
+	return nil
+}
+
+// LoadSLAs loads the SLAs of the issues
+func (issues IssueList) LoadSLAs(ctx context.Context) error {
+	issueIDs := make([]int64, 0, len(issues))
+	for _, issue := range issues {
+		if !issue.IsPull {
+			issueIDs = append(issueIDs, issue.ID)
+		}
+	}
+	if len(issueIDs) == 0 {
+		return nil
+	}
+	slas := make([]*IssueSLA, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).In("issue_id", issueIDs).Find(&slas); err != nil {
+		return err
+	}
+	slaMap := make(map[int64]*IssueSLA, len(slas))
+	for _, s := range slas {
+		slaMap[s.IssueID] = s
+	}
+	for _, issue := range issues {
+		issue.SLA = slaMap[issue.ID]
+	}
+	return nil
+}
+
+// SaveIssueSLA inserts the SLA of an issue or replaces the existing one
+func SaveIssueSLA(ctx context.Context, s *IssueSLA) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		existing := new(IssueSLA)
+		has, err := db.GetEngine(ctx).Where("issue_id=?", s.IssueID).Get(existing)
+		if err != nil {
+			return err
+		} else if !has {
+			return db.Insert(ctx, s)
+		}
+		s.ID = existing.ID
+		_, err = db.GetEngine(ctx).ID(s.ID).AllCols().Update(s)
+		return err
+	})
+}
+
+// DeleteIssueSLA removes the SLA of an issue
+func DeleteIssueSLA(ctx context.Context, issueID int64) error {
+	_, err := db.GetEngine(ctx).Where("issue_id=?", issueID).Delete(new(IssueSLA))
+	return err
+}
+
+// SetIssueSLAResponded records the first response to an issue, the later responses are ignored
+func SetIssueSLAResponded(ctx context.Context, issueID int64, when timeutil.TimeStamp) error {
+	_, err := db.GetEngine(ctx).Where("issue_id=? AND first_responded_unix=0", issueID).
+		Cols("first_responded_unix").Update(&IssueSLA{FirstRespondedUnix: when})
+	return err
+}
+
+// SetIssueSLAResolved records the resolution of an issue, it's also its first response if there was none.
+// A zero time clears the resolution of a reopened issue.
+func SetIssueSLAResolved(ctx context.Context, issueID int64, when timeutil.TimeStamp) error {
+	if when > 0 {
+		if err := SetIssueSLAResponded(ctx, issueID, when); err != nil {
+			return err
+		}
+	}
+	_, err := db.GetEngine(ctx).Where("issue_id=?", issueID).Cols("resolved_unix").Update(&IssueSLA{ResolvedUnix: when})
+	return err
+}
+
+// UpdateIssueSLANotified updates the last escalated statuses of the targets of an SLA
+func UpdateIssueSLANotified(ctx context.Context, s *IssueSLA) error {
+	_, err := db.GetEngine(ctx).ID(s.ID).Cols("first_response_notified", "resolution_notified").Update(s)
+	return err
+}
+
+// FindIssueSLAsToEscalate returns the SLAs of the open issues having a target which became at risk or breached
+// since its last escalation
+func FindIssueSLAsToEscalate(ctx context.Context, now timeutil.TimeStamp, limit int) ([]*IssueSLA, error) {
+	pending := func(target, doneCol string) builder.Cond {
+		return builder.Eq{doneCol: 0}.And(builder.Gt{target + "_due_unix": 0}, builder.Or(
+			builder.Lte{target + "_warn_unix": now}.And(builder.Lt{target + "_notified": IssueSLAStatusAtRisk}),
+			builder.Lte{target + "_due_unix": now}.And(builder.Lt{target + "_notified": IssueSLAStatusBreached}),
+		))
+	}
+	slas := make([]*IssueSLA, 0, 10)
+	return slas, db.GetEngine(ctx).
+		Where(builder.Or(pending("first_response", "first_responded_unix"), pending("resolution", "resolved_unix"))).
+		And(builder.In("issue_id", builder.Select("id").From("issue").Where(builder.Eq{"is_closed": false}))).
+		OrderBy("id").Limit(limit).Find(&slas)
+}
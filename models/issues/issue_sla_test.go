@@ -0,0 +1,148 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSLAPolicies(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	assert.ErrorIs(t, issues_model.NewIssueSLAPolicy(t.Context(), &issues_model.IssueSLAPolicy{RepoID: 1, Name: " "}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewIssueSLAPolicy(t.Context(), &issues_model.IssueSLAPolicy{RepoID: 1, Name: "No target"}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewIssueSLAPolicy(t.Context(), &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Negative", ResolutionHours: -1}), util.ErrInvalidArgument)
+
+	all := &issues_model.IssueSLAPolicy{RepoID: 1, Name: "All", ResolutionHours: 40}
+	require.NoError(t, issues_model.NewIssueSLAPolicy(t.Context(), all))
+	bugs := &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Bugs", LabelID: 1, FirstResponseHours: 4, ResolutionHours: 80}
+	require.NoError(t, issues_model.NewIssueSLAPolicy(t.Context(), bugs))
+
+	policies, err := issues_model.GetIssueSLAPoliciesByRepoID(t.Context(), 1)
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	require.NoError(t, policies.LoadAttributes(t.Context()))
+	assert.Nil(t, policies[0].Label)
+	assert.Equal(t, "label1", policies[1].Label.Name)
+
+	// issue 1 has the label 1, the policy with a first response target is the strictest
+	issue1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	require.NoError(t, issue1.LoadLabels(t.Context()))
+	assert.Equal(t, bugs.ID, policies.Match(issue1).ID)
+	issue5 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 5})
+	require.NoError(t, issue5.LoadLabels(t.Context()))
+	assert.Equal(t, all.ID, policies.Match(issue5).ID)
+	// the pull requests have no SLA
+	pull := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2, IsPull: true})
+	assert.Nil(t, policies.Match(pull))
+
+	p, err := issues_model.GetIssueSLAPolicyByID(t.Context(), 1, bugs.ID)
+	require.NoError(t, err)
+	p.TypeID = 1
+	require.NoError(t, issues_model.UpdateIssueSLAPolicy(t.Context(), p))
+	_, err = issues_model.GetIssueSLAPolicyByID(t.Context(), 2, bugs.ID)
+	assert.True(t, issues_model.IsErrIssueSLAPolicyNotExist(err))
+
+	require.NoError(t, issues_model.DeleteIssueSLAPolicy(t.Context(), all))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSLAPolicy{ID: all.ID})
+}
+
+func TestIssueSLA(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	// every hour is a business hour
+	defer test.MockVariableValue(&setting.IssueSLA.WorkdayStart, 0)()
+	defer test.MockVariableValue(&setting.IssueSLA.WorkdayEnd, 0)()
+	defer test.MockVariableValue(&setting.IssueSLA.WarningThreshold, 50)()
+
+	policy := &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Triage", FirstResponseHours: 2, ResolutionHours: 10}
+	require.NoError(t, issues_model.NewIssueSLAPolicy(t.Context(), policy))
+
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+	created := issue.CreatedUnix
+	const hour = 3600
+
+	s := policy.NewIssueSLA(issue, created)
+	assert.Equal(t, created+hour, s.FirstResponseWarnUnix)
+	assert.Equal(t, created+2*hour, s.FirstResponseDueUnix)
+	assert.Equal(t, created+5*hour, s.ResolutionWarnUnix)
+	assert.Equal(t, created+10*hour, s.ResolutionDueUnix)
+	assert.Equal(t, issues_model.IssueSLAStatusNone, s.FirstResponseNotified)
+	assert.Equal(t, issues_model.IssueSLAStatusOnTrack, s.FirstResponseStatusAt(created))
+	assert.Equal(t, issues_model.IssueSLAStatusAtRisk, s.FirstResponseStatusAt(created+hour))
+	assert.Equal(t, issues_model.IssueSLAStatusBreached, s.FirstResponseStatusAt(created+2*hour))
+	assert.Equal(t, "breached", s.FirstResponseStatusAt(created+2*hour).String())
+
+	// the targets which are already late when the policy applies are not escalated
+	late := policy.NewIssueSLA(issue, created+3*hour)
+	assert.Equal(t, issues_model.IssueSLAStatusBreached, late.FirstResponseNotified)
+	assert.Equal(t, issues_model.IssueSLAStatusNone, late.ResolutionNotified)
+
+	require.NoError(t, issues_model.SaveIssueSLA(t.Context(), s))
+	toEscalate, err := issues_model.FindIssueSLAsToEscalate(t.Context(), created+hour/2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, toEscalate)
+	toEscalate, err = issues_model.FindIssueSLAsToEscalate(t.Context(), created+hour, 10)
+	require.NoError(t, err)
+	require.Len(t, toEscalate, 1)
+	assert.Equal(t, issue.ID, toEscalate[0].IssueID)
+
+	s.FirstResponseNotified = issues_model.IssueSLAStatusAtRisk
+	require.NoError(t, issues_model.UpdateIssueSLANotified(t.Context(), s))
+	toEscalate, err = issues_model.FindIssueSLAsToEscalate(t.Context(), created+hour, 10)
+	require.NoError(t, err)
+	assert.Empty(t, toEscalate)
+	toEscalate, err = issues_model.FindIssueSLAsToEscalate(t.Context(), created+2*hour, 10)
+	require.NoError(t, err)
+	assert.Len(t, toEscalate, 1)
+
+	// the first response meets the target, the resolution is still pending
+	require.NoError(t, issues_model.SetIssueSLAResponded(t.Context(), issue.ID, created+hour))
+	require.NoError(t, issues_model.SetIssueSLAResponded(t.Context(), issue.ID, created+3*hour))
+	issues := issues_model.IssueList{issue}
+	require.NoError(t, issues.LoadSLAs(t.Context()))
+	require.NotNil(t, issue.SLA)
+	assert.Equal(t, created+hour, issue.SLA.FirstRespondedUnix)
+	assert.Equal(t, issues_model.IssueSLAStatusMet, issue.SLA.FirstResponseStatusAt(created+3*hour))
+	assert.Equal(t, issues_model.IssueSLAStatusBreached, issue.SLA.Status())
+
+	require.NoError(t, issues_model.SetIssueSLAResolved(t.Context(), issue.ID, created+4*hour))
+	issue.SLA = nil
+	require.NoError(t, issue.LoadSLA(t.Context()))
+	assert.Equal(t, issues_model.IssueSLAStatusMet, issue.SLA.Status())
+	toEscalate, err = issues_model.FindIssueSLAsToEscalate(t.Context(), created+20*hour, 10)
+	require.NoError(t, err)
+	assert.Empty(t, toEscalate)
+
+	// reopening clears the resolution
+	require.NoError(t, issues_model.SetIssueSLAResolved(t.Context(), issue.ID, 0))
+	issue.SLA = nil
+	require.NoError(t, issue.LoadSLA(t.Context()))
+	assert.Equal(t, issues_model.IssueSLAStatusBreached, issue.SLA.Status())
+
+	require.NoError(t, issues_model.DeleteIssueSLAPolicy(t.Context(), policy))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSLA{IssueID: issue.ID})
+}
+
+func TestDeleteOwnerIssueSLAPoliciesOfRepo(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	kept := &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Repo label", LabelID: 1, ResolutionHours: 8, EscalationTeamID: 1}
+	require.NoError(t, issues_model.NewIssueSLAPolicy(t.Context(), kept))
+	typed := &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Typed", TypeID: 1, ResolutionHours: 8}
+	require.NoError(t, issues_model.NewIssueSLAPolicy(t.Context(), typed))
+
+	require.NoError(t, issues_model.DeleteOwnerIssueSLAPoliciesOfRepo(t.Context(), 1))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSLAPolicy{ID: typed.ID})
+	kept = unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSLAPolicy{ID: kept.ID})
+	assert.Zero(t, kept.EscalationTeamID)
+}
@@ -0,0 +1,332 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/label"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// maxIssueTypes max issue types allowed for an owner
+const maxIssueTypes = 25
+
+// IssueTypeIcons are the icons an issue type can have
+var IssueTypeIcons = []string{
+	"octicon-issue-opened",
+	"octicon-bug",
+	"octicon-light-bulb",
+	"octicon-tasklist",
+	"octicon-flame",
+	"octicon-alert",
+	"octicon-zap",
+	"octicon-rocket",
+	"octicon-shield",
+	"octicon-tools",
+	"octicon-beaker",
+	"octicon-book",
+	"octicon-question",
+	"octicon-milestone",
+}
+
+// IssueType is a type defined by an organization for the issues of its repositories, like bug, feature or task.
+// An issue type can have custom fields, the issues of the type have values for them.
+type IssueType struct {
+	ID          int64  `xorm:"pk autoincr"`
+	OwnerID     int64  `xorm:"UNIQUE(s) NOT NULL"`
+	Name        string `xorm:"UNIQUE(s) NOT NULL"`
+	Description string
+	Icon        string `xorm:"VARCHAR(50)"`
+	Color       string `xorm:"VARCHAR(7)"`
+	Sorting     int    `xorm:"NOT NULL DEFAULT 0"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+
+	Fields IssueFieldList `xorm:"-"`
+}
+
+func init() {
+	db.RegisterModel(new(IssueType))
+}
+
+// ErrIssueTypeNotExist represents a "IssueTypeNotExist" kind of error.
+type ErrIssueTypeNotExist struct {
+	ID   int64
+	Name string
+}
+
+// IsErrIssueTypeNotExist checks if an error is a ErrIssueTypeNotExist.
+func IsErrIssueTypeNotExist(err error) bool {
+	_, ok := err.(ErrIssueTypeNotExist)
+	return ok
+}
+
+func (err ErrIssueTypeNotExist) Error() string {
+	return fmt.Sprintf("issue type does not exist [id: %d, name: %s]", err.ID, err.Name)
+}
+
+func (err ErrIssueTypeNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// DefaultIssueTypes returns the issue types an organization can start with
+func DefaultIssueTypes() []*IssueType {
+	return []*IssueType{
+		{Name: "Bug", Description: "An unexpected problem or behavior", Icon: "octicon-bug", Color: "#d73a4a"},
+		{Name: "Feature", Description: "A request, idea, or new functionality", Icon: "octicon-light-bulb", Color: "#0075ca"},
+		{Name: "Task", Description: "A specific piece of work", Icon: "octicon-tasklist", Color: "#e4e669"},
+		{Name: "Incident", Description: "A disruption of a service which needs a response", Icon: "octicon-flame", Color: "#b60205"},
+	}
+}
+
+func (t *IssueType) validate() error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return util.NewInvalidArgumentErrorf("issue type name is required")
+	}
+	if t.Icon == "" {
+		t.Icon = IssueTypeIcons[0]
+	} else if !slices.Contains(IssueTypeIcons, t.Icon) {
+		return util.NewInvalidArgumentErrorf("unknown issue type icon %q", t.Icon)
+	}
+	if t.Color != "" {
+		color, err := label.NormalizeColor(t.Color)
+		if err != nil {
+			return util.NewInvalidArgumentErrorf("bad color code: %s", t.Color)
+		}
+		t.Color = color
+	}
+	return nil
+}
+
+// LoadFields loads the custom fields of the issue type
+func (t *IssueType) LoadFields(ctx context.Context) (err error) {
+	if t.Fields == nil {
+		t.Fields, err = GetIssueFieldsByTypeID(ctx, t.ID)
+	}
+	return err
+}
+
+// IssueTypeList is a list of issue types
+type IssueTypeList []*IssueType
+
+// LoadFields loads the custom fields of the issue types
+func (types IssueTypeList) LoadFields(ctx context.Context) error {
+	typeIDs := make([]int64, 0, len(types))
+	for _, t := range types {
+		typeIDs = append(typeIDs, t.ID)
+	}
+	fields := make(IssueFieldList, 0, len(types))
+	if err := db.GetEngine(ctx).In("type_id", typeIDs).OrderBy("sorting, id").Find(&fields); err != nil {
+		return err
+	}
+	for _, t := range types {
+		t.Fields = IssueFieldList{}
+		for _, field := range fields {
+			if field.TypeID == t.ID {
+				t.Fields = append(t.Fields, field)
+			}
+		}
+	}
+	return nil
+}
+
+// GetIssueTypesByOwnerID returns the issue types of an owner
+func GetIssueTypesByOwnerID(ctx context.Context, ownerID int64) (IssueTypeList, error) {
+	types := make(IssueTypeList, 0, 5)
+	return types, db.GetEngine(ctx).Where("owner_id=?", ownerID).OrderBy("sorting, id").Find(&types)
+}
+
+// GetIssueTypeByID returns an issue type of an owner
+func GetIssueTypeByID(ctx context.Context, ownerID, id int64) (*IssueType, error) {
+	t := new(IssueType)
+	has, err := db.GetEngine(ctx).Where("owner_id=? AND id=?", ownerID, id).Get(t)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueTypeNotExist{ID: id}
+	}
+	return t, nil
+}
+
+// GetIssueTypeByName returns an issue type of an owner by its name, the name is case-insensitive
+func GetIssueTypeByName(ctx context.Context, ownerID int64, name string) (*IssueType, error) {
+	t := new(IssueType)
+	has, err := db.GetEngine(ctx).Where("owner_id=?", ownerID).And("LOWER(name)=?", strings.ToLower(strings.TrimSpace(name))).Get(t)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrIssueTypeNotExist{Name: name}
+	}
+	return t, nil
+}
+
+func isIssueTypeNameUsed(ctx context.Context, t *IssueType) (bool, error) {
+	return db.GetEngine(ctx).Where("owner_id=? AND id<>?", t.OwnerID, t.ID).And("LOWER(name)=?", strings.ToLower(t.Name)).Exist(new(IssueType))
+}
+
+// NewIssueType adds an issue type to an owner
+func NewIssueType(ctx context.Context, t *IssueType) error {
+	if err := t.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueTypeNameUsed(ctx, t); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("issue type %q already exists", t.Name)
+		}
+		count, err := db.GetEngine(ctx).Where("owner_id=?", t.OwnerID).Count(new(IssueType))
+		if err != nil {
+			return err
+		}
+		if count >= maxIssueTypes {
+			return util.NewInvalidArgumentErrorf("an owner can have at most %d issue types", maxIssueTypes)
+		}
+		t.Sorting = int(count)
+		return db.Insert(ctx, t)
+	})
+}
+
+// UpdateIssueType updates the name, the description, the icon and the color of an issue type
+func UpdateIssueType(ctx context.Context, t *IssueType) error {
+	if err := t.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if used, err := isIssueTypeNameUsed(ctx, t); err != nil {
+			return err
+		} else if used {
+			return util.NewInvalidArgumentErrorf("issue type %q already exists", t.Name)
+		}
+		_, err := db.GetEngine(ctx).ID(t.ID).Cols("name", "description", "icon", "color").Update(t)
+		return err
+	})
+}
+
+// DeleteIssueType removes an issue type and its custom fields, the issues of the type get no type
+func DeleteIssueType(ctx context.Context, t *IssueType) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		fieldIDs := builder.Select("id").From("issue_field").Where(builder.Eq{"type_id": t.ID})
+		if _, err := db.GetEngine(ctx).Where(builder.In("field_id", fieldIDs)).Delete(new(IssueFieldValue)); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).Where("type_id=?", t.ID).Delete(new(IssueField)); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).Where("type_id=?", t.ID).Cols("type_id").NoAutoTime().Update(&Issue{TypeID: 0}); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(t.ID).Delete(new(IssueType))
+		return err
+	})
+}
+
+// LoadType loads the type of the issue
+func (issue *Issue) LoadType(ctx context.Context) (err error) {
+	if issue.TypeID == 0 || issue.Type != nil {
+		return nil
+	}
+	issue.Type = new(IssueType)
+	has, err := db.GetEngine(ctx).ID(issue.TypeID).Get(issue.Type)
+	if err != nil {
+		return err
+	} else if !has {
+		issue.Type = nil
+	}
+	return nil
+}
+
+// LoadTypes loads the types of the issues
+func (issues IssueList) LoadTypes(ctx context.Context) error {
+	typeIDs := make([]int64, 0, len(issues))
+	for _, issue := range issues {
+		if issue.TypeID > 0 && !slices.Contains(typeIDs, issue.TypeID) {
+			typeIDs = append(typeIDs, issue.TypeID)
+		}
+	}
+	if len(typeIDs) == 0 {
+		return nil
+	}
+	types := make(map[int64]*IssueType, len(typeIDs))
+	if err := db.GetEngine(ctx).In("id", typeIDs).Find(&types); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		issue.Type = types[issue.TypeID]
+	}
+	return nil
+}
+
+// ChangeIssueType changes the type of an issue, a nil type removes it. The values of the custom fields
+// of the old type are removed.
+func ChangeIssueType(ctx context.Context, doer *user_model.User, issue *Issue, t *IssueType) error {
+	var typeID int64
+	if t != nil {
+		typeID = t.ID
+	}
+	if issue.TypeID == typeID {
+		return nil
+	}
+	if t != nil && issue.IsPull {
+		return util.NewInvalidArgumentErrorf("pull requests can't have a type")
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if t != nil && t.OwnerID != issue.Repo.OwnerID {
+		return util.NewInvalidArgumentErrorf("issue type %q is not a type of %s", t.Name, issue.Repo.OwnerName)
+	}
+	if err := issue.LoadType(ctx); err != nil {
+		return err
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("issue_id=?", issue.ID).Delete(new(IssueFieldValue)); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).ID(issue.ID).Cols("type_id").Update(&Issue{TypeID: typeID}); err != nil {
+			return err
+		}
+
+		opts := &CreateCommentOptions{
+			Type:  CommentTypeChangeIssueType,
+			Doer:  doer,
+			Repo:  issue.Repo,
+			Issue: issue,
+		}
+		if issue.Type != nil {
+			opts.OldTitle = issue.Type.Name
+		}
+		if t != nil {
+			opts.NewTitle = t.Name
+		}
+		if _, err := CreateComment(ctx, opts); err != nil {
+			return err
+		}
+		issue.TypeID, issue.Type = typeID, t
+		return nil
+	})
+}
+
+// ClearIssueTypesOfRepo removes the types and the custom field values of the issues of a repository,
+// the types belong to the owner so they can't be kept when the repository is transferred
+func ClearIssueTypesOfRepo(ctx context.Context, repoID int64) error {
+	issueIDs := builder.Select("id").From("issue").Where(builder.Eq{"repo_id": repoID})
+	if _, err := db.GetEngine(ctx).Where(builder.In("issue_id", issueIDs)).Delete(new(IssueFieldValue)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where("repo_id=?", repoID).Cols("type_id").NoAutoTime().Update(&Issue{TypeID: 0})
+	return err
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueTypes(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 6}) // org3/repo3#1
+	pull := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
+
+	bug := &issues_model.IssueType{OwnerID: 3, Name: " Bug ", Icon: "octicon-bug", Color: "#d73a4a"}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), bug))
+	assert.Equal(t, "Bug", bug.Name)
+	assert.ErrorIs(t, issues_model.NewIssueType(t.Context(), &issues_model.IssueType{OwnerID: 3, Name: "bug"}), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.NewIssueType(t.Context(), &issues_model.IssueType{OwnerID: 3, Name: "Task", Icon: "octicon-unknown"}), util.ErrInvalidArgument)
+	otherBug := &issues_model.IssueType{OwnerID: 2, Name: "Bug"}
+	require.NoError(t, issues_model.NewIssueType(t.Context(), otherBug))
+
+	severity := &issues_model.IssueField{Name: "Severity", Type: issues_model.IssueFieldTypeSelect, Options: []string{"low", " high ", ""}}
+	require.NoError(t, issues_model.NewIssueField(t.Context(), bug, severity))
+	assert.Equal(t, []string{"low", "high"}, severity.Options)
+	points := &issues_model.IssueField{Name: "Points", Type: issues_model.IssueFieldTypeNumber}
+	require.NoError(t, issues_model.NewIssueField(t.Context(), bug, points))
+	assert.ErrorIs(t, issues_model.NewIssueField(t.Context(), bug, &issues_model.IssueField{Name: "Kind", Type: issues_model.IssueFieldTypeSelect}), util.ErrInvalidArgument)
+
+	for _, c := range []struct {
+		field           *issues_model.IssueField
+		value, expected string
+		valid           bool
+	}{
+		{points, "1.50", "1.5", true},
+		{points, "many", "", false},
+		{severity, "high", "high", true},
+		{severity, "urgent", "", false},
+		{&issues_model.IssueField{Type: issues_model.IssueFieldTypeDate}, "2026-01-02", "2026-01-02", true},
+		{&issues_model.IssueField{Type: issues_model.IssueFieldTypeDate}, "01/02/2026", "", false},
+	} {
+		value, err := c.field.NormalizeValue(c.value)
+		if c.valid {
+			assert.NoError(t, err, c.value)
+			assert.Equal(t, c.expected, value)
+		} else {
+			assert.ErrorIs(t, err, util.ErrInvalidArgument, c.value)
+		}
+	}
+
+	assert.ErrorIs(t, issues_model.ChangeIssueType(t.Context(), doer, issue, otherBug), util.ErrInvalidArgument)
+	assert.ErrorIs(t, issues_model.ChangeIssueType(t.Context(), doer, pull, bug), util.ErrInvalidArgument)
+	require.NoError(t, issues_model.ChangeIssueType(t.Context(), doer, issue, bug))
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{Type: issues_model.CommentTypeChangeIssueType, IssueID: issue.ID, NewTitle: "Bug"})
+
+	require.NoError(t, issues_model.SetIssueFieldValue(t.Context(), issue, severity, "high"))
+	require.NoError(t, issues_model.SetIssueFieldValue(t.Context(), issue, points, "3"))
+	values, err := issues_model.GetIssueFieldValues(t.Context(), []int64{issue.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{severity.ID: "high", points.ID: "3"}, values[issue.ID])
+
+	// the values of the removed options are removed
+	severity.Options = []string{"low"}
+	require.NoError(t, issues_model.UpdateIssueField(t.Context(), severity))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueFieldValue{IssueID: issue.ID, FieldID: severity.ID})
+
+	// an empty value removes the value
+	require.NoError(t, issues_model.SetIssueFieldValue(t.Context(), issue, points, ""))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueFieldValue{IssueID: issue.ID, FieldID: points.ID})
+
+	require.NoError(t, issues_model.SetIssueFieldValue(t.Context(), issue, points, "5"))
+	require.NoError(t, issues_model.DeleteIssueType(t.Context(), bug))
+	unittest.AssertNotExistsBean(t, &issues_model.IssueField{TypeID: bug.ID})
+	unittest.AssertNotExistsBean(t, &issues_model.IssueFieldValue{IssueID: issue.ID})
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID})
+	assert.Zero(t, issue.TypeID)
+}
@@ -269,6 +269,9 @@ func DeleteMilestoneByRepoID(ctx context.Context, repoID, id int64) error {
 		if _, err = db.DeleteByID[Milestone](ctx, m.ID); err != nil {
 			return err
 		}
+		if err = DeleteMilestoneSnapshots(ctx, m.ID); err != nil {
+			return err
+		}
 
 		numMilestones, err := db.Count[Milestone](ctx, FindMilestoneOptions{
 			RepoID: repo.ID,
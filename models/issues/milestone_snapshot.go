@@ -0,0 +1,283 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// MilestoneSnapshot is the state of the issues of a milestone at the end of a day,
+// the estimates are the sums of the time estimates of the issues in seconds
+type MilestoneSnapshot struct {
+	ID          int64 `xorm:"pk autoincr"`
+	RepoID      int64 `xorm:"INDEX NOT NULL"`
+	MilestoneID int64 `xorm:"UNIQUE(s) NOT NULL"`
+	// Day is the start of the day in the default timezone of the UI
+	Day timeutil.TimeStamp `xorm:"UNIQUE(s) NOT NULL"`
+
+	NumIssues      int   `xorm:"NOT NULL DEFAULT 0"`
+	NumOpenIssues  int   `xorm:"NOT NULL DEFAULT 0"`
+	OpenEstimate   int64 `xorm:"NOT NULL DEFAULT 0"`
+	ClosedEstimate int64 `xorm:"NOT NULL DEFAULT 0"`
+
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+func init() {
+	db.RegisterModel(new(MilestoneSnapshot))
+}
+
+// NumClosedIssues returns the number of the closed issues of the milestone at the end of the day
+func (s *MilestoneSnapshot) NumClosedIssues() int {
+	return s.NumIssues - s.NumOpenIssues
+}
+
+// MilestoneSnapshotDay returns the start of the day of t in the default timezone of the UI
+func MilestoneSnapshotDay(t time.Time) time.Time {
+	t = t.In(setting.DefaultUILocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, setting.DefaultUILocation)
+}
+
+// GetMilestoneSnapshots returns the snapshots of a milestone ordered by day
+func GetMilestoneSnapshots(ctx context.Context, milestoneID int64) ([]*MilestoneSnapshot, error) {
+	snapshots := make([]*MilestoneSnapshot, 0, 30)
+	return snapshots, db.GetEngine(ctx).Where("milestone_id=?", milestoneID).OrderBy("day").Find(&snapshots)
+}
+
+// HasMilestoneSnapshots returns whether the snapshots of a milestone have been collected
+func HasMilestoneSnapshots(ctx context.Context, milestoneID int64) (bool, error) {
+	return db.GetEngine(ctx).Where("milestone_id=?", milestoneID).Exist(new(MilestoneSnapshot))
+}
+
+// SaveMilestoneSnapshots replaces the snapshots of a milestone of the same days
+func SaveMilestoneSnapshots(ctx context.Context, milestoneID int64, snapshots []*MilestoneSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	days := make([]timeutil.TimeStamp, 0, len(snapshots))
+	for _, s := range snapshots {
+		days = append(days, s.Day)
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("milestone_id=?", milestoneID).In("day", days).Delete(new(MilestoneSnapshot)); err != nil {
+			return err
+		}
+		return db.Insert(ctx, snapshots)
+	})
+}
+
+// milestoneHistoryCommentTypes are the types of the comments recording the changes of the milestone,
+// the state and the time estimate of an issue
+var milestoneHistoryCommentTypes = []CommentType{
+	CommentTypeMilestone,
+	CommentTypeClose,
+	CommentTypeReopen,
+	CommentTypeMergePull,
+	CommentTypeChangeTimeEstimate,
+}
+
+// milestoneIssueHistory is an issue which is or was in a milestone with the comments recording its changes
+type milestoneIssueHistory struct {
+	issue      *Issue
+	milestones []*Comment
+	states     []*Comment
+	estimates  []*Comment
+}
+
+// lastBefore returns the last comment created before end and whether some comments are created later
+func lastBefore(comments []*Comment, end timeutil.TimeStamp) (last *Comment, hasLater bool) {
+	for _, c := range comments {
+		if c.CreatedUnix >= end {
+			return last, true
+		}
+		last = c
+	}
+	return last, false
+}
+
+// stateAt returns whether the issue was in the milestone before end, whether it was open and its time estimate,
+// the current values of the issue are used when no comments recorded the changes, e.g. for migrated issues
+func (h *milestoneIssueHistory) stateAt(milestoneID int64, end timeutil.TimeStamp) (inMilestone, isOpen bool, estimate int64) {
+	if h.issue.CreatedUnix >= end {
+		return false, false, 0
+	}
+
+	if last, _ := lastBefore(h.milestones, end); last != nil {
+		inMilestone = last.MilestoneID == milestoneID
+	} else if len(h.milestones) > 0 {
+		inMilestone = h.milestones[0].OldMilestoneID == milestoneID
+	} else {
+		inMilestone = h.issue.MilestoneID == milestoneID
+	}
+	if !inMilestone {
+		return false, false, 0
+	}
+
+	if last, hasLater := lastBefore(h.states, end); last != nil {
+		isOpen = last.Type == CommentTypeReopen
+	} else if hasLater {
+		isOpen = true
+	} else {
+		isOpen = !h.issue.IsClosed || h.issue.ClosedUnix >= end
+	}
+
+	if last, hasLater := lastBefore(h.estimates, end); last != nil {
+		estimate, _ = strconv.ParseInt(last.Content, 10, 64)
+	} else if !hasLater {
+		estimate = h.issue.TimeEstimate
+	}
+	return inMilestone, isOpen, estimate
+}
+
+// loadMilestoneIssueHistories loads the issues which are or were in a milestone with their changes
+func loadMilestoneIssueHistories(ctx context.Context, milestoneID int64) ([]*milestoneIssueHistory, error) {
+	issueIDs := make([]int64, 0, 50)
+	if err := db.GetEngine(ctx).Table("issue").Where("milestone_id=?", milestoneID).Cols("id").Find(&issueIDs); err != nil {
+		return nil, err
+	}
+	movedIssueIDs := make([]int64, 0, 10)
+	if err := db.GetEngine(ctx).Table("comment").Where("type=?", CommentTypeMilestone).
+		And(builder.Eq{"milestone_id": milestoneID}.Or(builder.Eq{"old_milestone_id": milestoneID})).
+		Distinct("issue_id").Cols("issue_id").Find(&movedIssueIDs); err != nil {
+		return nil, err
+	}
+	ids := container.SetOf(issueIDs...)
+	ids.AddMultiple(movedIssueIDs...)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	issues, err := GetIssuesByIDs(ctx, ids.Values())
+	if err != nil {
+		return nil, err
+	}
+	histories := make(map[int64]*milestoneIssueHistory, len(issues))
+	for _, issue := range issues {
+		histories[issue.ID] = &milestoneIssueHistory{issue: issue}
+	}
+
+	comments := make([]*Comment, 0, len(issues)*2)
+	if err := db.GetEngine(ctx).In("issue_id", ids.Values()).In("type", milestoneHistoryCommentTypes).
+		Cols("id", "issue_id", "type", "milestone_id", "old_milestone_id", "content", "created_unix").
+		OrderBy("created_unix, id").Find(&comments); err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		h := histories[c.IssueID]
+		if h == nil {
+			continue
+		}
+		switch c.Type {
+		case CommentTypeMilestone:
+			h.milestones = append(h.milestones, c)
+		case CommentTypeChangeTimeEstimate:
+			h.estimates = append(h.estimates, c)
+		default:
+			h.states = append(h.states, c)
+		}
+	}
+
+	ret := make([]*milestoneIssueHistory, 0, len(histories))
+	for _, issue := range issues {
+		ret = append(ret, histories[issue.ID])
+	}
+	return ret, nil
+}
+
+// ComputeMilestoneSnapshots computes the snapshots of a milestone at the end of the given days from the history of its issues,
+// the days must be the starts of days returned by MilestoneSnapshotDay
+func ComputeMilestoneSnapshots(ctx context.Context, m *Milestone, days []time.Time) ([]*MilestoneSnapshot, error) {
+	histories, err := loadMilestoneIssueHistories(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]*MilestoneSnapshot, 0, len(days))
+	for _, day := range days {
+		s := &MilestoneSnapshot{
+			RepoID:      m.RepoID,
+			MilestoneID: m.ID,
+			Day:         timeutil.TimeStamp(day.Unix()),
+		}
+		end := timeutil.TimeStamp(day.AddDate(0, 0, 1).Unix())
+		for _, h := range histories {
+			inMilestone, isOpen, estimate := h.stateAt(m.ID, end)
+			if !inMilestone {
+				continue
+			}
+			s.NumIssues++
+			if isOpen {
+				s.NumOpenIssues++
+				s.OpenEstimate += estimate
+			} else {
+				s.ClosedEstimate += estimate
+			}
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, nil
+}
+
+// DeleteMilestoneSnapshots removes the snapshots of a milestone
+func DeleteMilestoneSnapshots(ctx context.Context, milestoneID int64) error {
+	_, err := db.GetEngine(ctx).Where("milestone_id=?", milestoneID).Delete(new(MilestoneSnapshot))
+	return err
+}
+
+// MilestoneVelocity is the work done in a closed milestone
+type MilestoneVelocity struct {
+	Milestone       *Milestone
+	NumClosedIssues int64
+	// ClosedEstimate is the sum of the time estimates of the closed issues in seconds
+	ClosedEstimate int64
+}
+
+// GetMilestoneVelocities returns the work done in the last closed milestones of a repository ordered by their closed date
+func GetMilestoneVelocities(ctx context.Context, repoID int64, limit int) ([]*MilestoneVelocity, error) {
+	milestones := make(MilestoneList, 0, limit)
+	if err := db.GetEngine(ctx).Where("repo_id=? AND is_closed=?", repoID, true).
+		OrderBy("closed_date_unix DESC, id DESC").Limit(limit).Find(&milestones); err != nil {
+		return nil, err
+	}
+	if len(milestones) == 0 {
+		return nil, nil
+	}
+	slices.Reverse(milestones)
+
+	type milestoneWork struct {
+		MilestoneID int64
+		NumIssues   int64
+		Estimate    int64
+	}
+	works := make([]*milestoneWork, 0, len(milestones))
+	if err := db.GetEngine(ctx).Table("issue").
+		Select("milestone_id, COUNT(*) AS num_issues, SUM(time_estimate) AS estimate").
+		In("milestone_id", milestones.getMilestoneIDs()).And("is_closed=?", true).
+		GroupBy("milestone_id").Find(&works); err != nil {
+		return nil, err
+	}
+	workMap := make(map[int64]*milestoneWork, len(works))
+	for _, w := range works {
+		workMap[w.MilestoneID] = w
+	}
+
+	velocities := make([]*MilestoneVelocity, 0, len(milestones))
+	for _, m := range milestones {
+		v := &MilestoneVelocity{Milestone: m}
+		if w := workMap[m.ID]; w != nil {
+			v.NumClosedIssues, v.ClosedEstimate = w.NumIssues, w.Estimate
+		}
+		velocities = append(velocities, v)
+	}
+	return velocities, nil
+}
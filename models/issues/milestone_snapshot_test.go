@@ -0,0 +1,103 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeMilestoneSnapshots(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	milestone := unittest.AssertExistsAndLoadBean(t, &issues_model.Milestone{ID: 1})
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
+	created := issues_model.MilestoneSnapshotDay(issue.CreatedUnix.AsTime())
+	days := []time.Time{created.AddDate(0, 0, -1), created, created.AddDate(0, 0, 1), created.AddDate(0, 0, 2)}
+
+	// the issue was closed on the second day with an estimate of one hour and moved to another milestone on the third day
+	closedAt := timeutil.TimeStamp(created.AddDate(0, 0, 1).Unix() + 60)
+	_, err := db.GetEngine(t.Context()).NoAutoTime().Insert([]*issues_model.Comment{
+		{Type: issues_model.CommentTypeChangeTimeEstimate, IssueID: issue.ID, PosterID: 1, Content: "3600", CreatedUnix: closedAt - 30},
+		{Type: issues_model.CommentTypeClose, IssueID: issue.ID, PosterID: 1, CreatedUnix: closedAt},
+		{Type: issues_model.CommentTypeMilestone, IssueID: issue.ID, PosterID: 1, OldMilestoneID: 1, MilestoneID: 2, CreatedUnix: closedAt + 86400},
+	})
+	require.NoError(t, err)
+
+	snapshots, err := issues_model.ComputeMilestoneSnapshots(t.Context(), milestone, days)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 4)
+
+	assert.Equal(t, 0, snapshots[0].NumIssues)
+	assert.Equal(t, 1, snapshots[1].NumIssues)
+	assert.Equal(t, 1, snapshots[1].NumOpenIssues)
+	assert.EqualValues(t, 0, snapshots[1].OpenEstimate)
+	assert.Equal(t, 1, snapshots[2].NumIssues)
+	assert.Equal(t, 1, snapshots[2].NumClosedIssues())
+	assert.EqualValues(t, 3600, snapshots[2].ClosedEstimate)
+	assert.Equal(t, 0, snapshots[3].NumIssues)
+
+	// the issue is in the new milestone since the third day
+	moved, err := issues_model.ComputeMilestoneSnapshots(t.Context(), unittest.AssertExistsAndLoadBean(t, &issues_model.Milestone{ID: 2}), days)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 0, 0, 1}, []int{moved[0].NumIssues, moved[1].NumIssues, moved[2].NumIssues, moved[3].NumIssues})
+}
+
+func TestSaveMilestoneSnapshots(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	day := issues_model.MilestoneSnapshotDay(time.Now())
+	has, err := issues_model.HasMilestoneSnapshots(t.Context(), 1)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	require.NoError(t, issues_model.SaveMilestoneSnapshots(t.Context(), 1, []*issues_model.MilestoneSnapshot{
+		{RepoID: 1, MilestoneID: 1, Day: timeutil.TimeStamp(day.AddDate(0, 0, -1).Unix()), NumIssues: 2, NumOpenIssues: 2},
+		{RepoID: 1, MilestoneID: 1, Day: timeutil.TimeStamp(day.Unix()), NumIssues: 2, NumOpenIssues: 1},
+	}))
+	// the snapshot of the same day is replaced
+	require.NoError(t, issues_model.SaveMilestoneSnapshots(t.Context(), 1, []*issues_model.MilestoneSnapshot{
+		{RepoID: 1, MilestoneID: 1, Day: timeutil.TimeStamp(day.Unix()), NumIssues: 3, NumOpenIssues: 1},
+	}))
+
+	snapshots, err := issues_model.GetMilestoneSnapshots(t.Context(), 1)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, 2, snapshots[0].NumOpenIssues)
+	assert.Equal(t, 3, snapshots[1].NumIssues)
+	assert.Equal(t, 2, snapshots[1].NumClosedIssues())
+
+	require.NoError(t, issues_model.DeleteMilestoneSnapshots(t.Context(), 1))
+	unittest.AssertNotExistsBean(t, &issues_model.MilestoneSnapshot{MilestoneID: 1})
+}
+
+func TestGetMilestoneVelocities(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	velocities, err := issues_model.GetMilestoneVelocities(t.Context(), 1, 10)
+	require.NoError(t, err)
+	require.Len(t, velocities, 1)
+	assert.EqualValues(t, 3, velocities[0].Milestone.ID)
+	assert.EqualValues(t, 0, velocities[0].NumClosedIssues)
+
+	_, err = db.GetEngine(t.Context()).ID(3).Cols("is_closed", "time_estimate").Update(&issues_model.Issue{IsClosed: true, TimeEstimate: 7200})
+	require.NoError(t, err)
+	velocities, err = issues_model.GetMilestoneVelocities(t.Context(), 1, 10)
+	require.NoError(t, err)
+	require.Len(t, velocities, 1)
+	assert.EqualValues(t, 1, velocities[0].NumClosedIssues)
+	assert.EqualValues(t, 7200, velocities[0].ClosedEstimate)
+
+	velocities, err = issues_model.GetMilestoneVelocities(t.Context(), 2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, velocities)
+}
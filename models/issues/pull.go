@@ -174,6 +174,12 @@ func DeletePullsByBaseRepoID(ctx context.Context, repoID int64) error {
 		return err
 	}
 
+	// Delete stack links
+	if _, err := db.GetEngine(ctx).Where(builder.In("pull_id", deleteCond).Or(builder.In("parent_pull_id", deleteCond))).
+		Delete(&pull_model.Stack{}); err != nil {
+		return err
+	}
+
 	_, err := db.DeleteByBean(ctx, &PullRequest{BaseRepoID: repoID})
 	return err
 }
@@ -551,6 +551,22 @@ func GetReviewByIssueIDAndUserID(ctx context.Context, issueID, userID int64) (*R
 	return review, nil
 }
 
+// GetLastReviewedCommitID returns the head commit of the pull request when the user submitted their latest review,
+// an empty string is returned if the user hasn't reviewed the pull request
+func GetLastReviewedCommitID(ctx context.Context, issueID, userID int64) (string, error) {
+	review := new(Review)
+	has, err := db.GetEngine(ctx).Where(
+		builder.In("type", ReviewTypeApprove, ReviewTypeReject, ReviewTypeComment).
+			And(builder.Eq{"issue_id": issueID, "reviewer_id": userID, "original_author_id": 0}).
+			And(builder.Neq{"commit_id": ""})).
+		Desc("id").
+		Get(review)
+	if err != nil || !has {
+		return "", err
+	}
+	return review.CommitID, nil
+}
+
 // GetTeamReviewerByIssueIDAndTeamID get the latest review request of reviewer team for a pull request
 func GetTeamReviewerByIssueIDAndTeamID(ctx context.Context, issueID, teamID int64) (*Review, error) {
 	review := new(Review)
@@ -101,6 +101,19 @@ func TestGetCurrentReview(t *testing.T) {
 	assert.Nil(t, review2)
 }
 
+func TestGetLastReviewedCommitID(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	commitID, err := issues_model.GetLastReviewedCommitID(t.Context(), 3, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "8091a55037cd59e47293aca02981b5a67076b364", commitID)
+
+	// reviews without commit aren't taken into account
+	commitID, err = issues_model.GetLastReviewedCommitID(t.Context(), 3, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, commitID)
+}
+
 func TestCreateReview(t *testing.T) {
 	assert.NoError(t, unittest.PrepareTestDatabase())
 
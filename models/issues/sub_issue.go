@@ -0,0 +1,265 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+const (
+	// MaxSubIssues is the maximum number of sub-issues of an issue
+	MaxSubIssues = 100
+	// MaxSubIssueDepth is the maximum number of levels of a tree of sub-issues
+	MaxSubIssueDepth = 8
+)
+
+// SubIssue links an issue to its parent issue, an issue has at most one parent.
+// The parent can be in another repository of the same owner.
+type SubIssue struct {
+	ID          int64              `xorm:"pk autoincr"`
+	IssueID     int64              `xorm:"UNIQUE NOT NULL"`
+	ParentID    int64              `xorm:"INDEX NOT NULL"`
+	CreatorID   int64              `xorm:"NOT NULL"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+}
+
+func init() {
+	db.RegisterModel(new(SubIssue))
+}
+
+// SubIssueProgress is the number of sub-issues of an issue and of their own sub-issues
+type SubIssueProgress struct {
+	Total  int
+	Closed int
+}
+
+// Percent returns the percentage of the closed sub-issues
+func (p *SubIssueProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Closed * 100 / p.Total
+}
+
+// GetParentIssueID returns the ID of the parent of an issue, 0 is returned if the issue has no parent
+func GetParentIssueID(ctx context.Context, issueID int64) (int64, error) {
+	link := &SubIssue{}
+	has, err := db.GetEngine(ctx).Where("issue_id = ?", issueID).Get(link)
+	if err != nil || !has {
+		return 0, err
+	}
+	return link.ParentID, nil
+}
+
+// GetSubIssues returns the sub-issues of an issue in the order they were added
+func GetSubIssues(ctx context.Context, parentID int64) (IssueList, error) {
+	issues := make(IssueList, 0, 10)
+	return issues, db.GetEngine(ctx).
+		Join("INNER", "sub_issue", "sub_issue.issue_id = issue.id").
+		Where("sub_issue.parent_id = ?", parentID).
+		OrderBy("sub_issue.id").
+		Find(&issues)
+}
+
+// HasOpenSubIssues checks if an issue has sub-issues which are still open
+func HasOpenSubIssues(ctx context.Context, parentID int64) (bool, error) {
+	return db.GetEngine(ctx).
+		Join("INNER", "sub_issue", "sub_issue.issue_id = issue.id").
+		Where("sub_issue.parent_id = ?", parentID).
+		And("issue.is_closed = ?", false).
+		Exist(&Issue{})
+}
+
+// GetSubIssueProgress returns the progress of the sub-issues of the issues, the sub-issues of the sub-issues
+// are counted too. Issues without sub-issues are not in the map.
+func GetSubIssueProgress(ctx context.Context, parentIDs []int64) (map[int64]*SubIssueProgress, error) {
+	progress := make(map[int64]*SubIssueProgress, len(parentIDs))
+	// rootIDs maps the issues of the current level to the issue whose progress they count for
+	rootIDs := make(map[int64]int64, len(parentIDs))
+	for _, id := range parentIDs {
+		rootIDs[id] = id
+	}
+	for range MaxSubIssueDepth {
+		if len(rootIDs) == 0 {
+			break
+		}
+		type subIssueState struct {
+			IssueID  int64
+			ParentID int64
+			IsClosed bool
+		}
+		states := make([]*subIssueState, 0, len(rootIDs))
+		if err := db.GetEngine(ctx).Table("sub_issue").
+			Select("sub_issue.issue_id, sub_issue.parent_id, issue.is_closed").
+			Join("INNER", "issue", "issue.id = sub_issue.issue_id").
+			Where(builder.In("sub_issue.parent_id", util.KeysOfMap(rootIDs))).
+			Find(&states); err != nil {
+			return nil, err
+		}
+
+		next := make(map[int64]int64, len(states))
+		for _, state := range states {
+			rootID := rootIDs[state.ParentID]
+			p := progress[rootID]
+			if p == nil {
+				p = &SubIssueProgress{}
+				progress[rootID] = p
+			}
+			p.Total++
+			if state.IsClosed {
+				p.Closed++
+			}
+			next[state.IssueID] = rootID
+		}
+		rootIDs = next
+	}
+	return progress, nil
+}
+
+// subIssueTreeHeight returns the number of levels of the tree of sub-issues below an issue, the issue included
+func subIssueTreeHeight(ctx context.Context, issueID int64) (int, error) {
+	ids := []int64{issueID}
+	for height := 1; height <= MaxSubIssueDepth; height++ {
+		var children []int64
+		if err := db.GetEngine(ctx).Table("sub_issue").Where(builder.In("parent_id", ids)).Cols("issue_id").Find(&children); err != nil {
+			return 0, err
+		}
+		if len(children) == 0 {
+			return height, nil
+		}
+		ids = children
+	}
+	return MaxSubIssueDepth + 1, nil
+}
+
+// AddSubIssue makes an issue a sub-issue of the parent issue. The issue must not have a parent yet,
+// it must be in a repository of the owner of the parent and the parent must not be below the issue.
+func AddSubIssue(ctx context.Context, doer *user_model.User, parent, issue *Issue) error {
+	if err := parent.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+
+	switch {
+	case parent.ID == issue.ID:
+		return util.NewInvalidArgumentErrorf("issue can't be a sub-issue of itself")
+	case parent.IsPull || issue.IsPull:
+		return util.NewInvalidArgumentErrorf("pull requests can't have sub-issues or be sub-issues")
+	case parent.Repo.OwnerID != issue.Repo.OwnerID:
+		return util.NewInvalidArgumentErrorf("sub-issue must be in a repository of %s", parent.Repo.OwnerName)
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		parentID, err := GetParentIssueID(ctx, issue.ID)
+		if err != nil {
+			return err
+		}
+		if parentID != 0 {
+			return util.NewInvalidArgumentErrorf("issue #%d already has a parent issue", issue.Index)
+		}
+
+		count, err := db.GetEngine(ctx).Where("parent_id = ?", parent.ID).Count(&SubIssue{})
+		if err != nil {
+			return err
+		}
+		if count >= MaxSubIssues {
+			return util.NewInvalidArgumentErrorf("an issue can have at most %d sub-issues", MaxSubIssues)
+		}
+
+		// the parent must not be below the issue, and the tree must not become too deep
+		depth := 1
+		for ancestorID := parent.ID; ; depth++ {
+			if ancestorID == issue.ID {
+				return util.NewInvalidArgumentErrorf("issue #%d is above #%d in the tree of sub-issues", issue.Index, parent.Index)
+			}
+			if ancestorID, err = GetParentIssueID(ctx, ancestorID); err != nil {
+				return err
+			}
+			if ancestorID == 0 || depth > MaxSubIssueDepth {
+				break
+			}
+		}
+		height, err := subIssueTreeHeight(ctx, issue.ID)
+		if err != nil {
+			return err
+		}
+		if depth+height > MaxSubIssueDepth {
+			return util.NewInvalidArgumentErrorf("a tree of sub-issues can have at most %d levels", MaxSubIssueDepth)
+		}
+
+		if err := db.Insert(ctx, &SubIssue{
+			IssueID:   issue.ID,
+			ParentID:  parent.ID,
+			CreatorID: doer.ID,
+		}); err != nil {
+			return err
+		}
+		return createSubIssueComments(ctx, doer, parent, issue, true)
+	})
+}
+
+// RemoveSubIssue removes an issue from the sub-issues of its parent
+func RemoveSubIssue(ctx context.Context, doer *user_model.User, parent, issue *Issue) error {
+	if err := parent.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		affected, err := db.GetEngine(ctx).Where("issue_id = ? AND parent_id = ?", issue.ID, parent.ID).Delete(&SubIssue{})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return util.NewNotExistErrorf("issue #%d is not a sub-issue of #%d", issue.Index, parent.Index)
+		}
+		return createSubIssueComments(ctx, doer, parent, issue, false)
+	})
+}
+
+// createSubIssueComments adds a comment to the parent issue and to the sub-issue
+func createSubIssueComments(ctx context.Context, doer *user_model.User, parent, issue *Issue, add bool) error {
+	parentType, issueType := CommentTypeAddSubIssue, CommentTypeAddParentIssue
+	if !add {
+		parentType, issueType = CommentTypeRemoveSubIssue, CommentTypeRemoveParentIssue
+	}
+	if _, err := CreateComment(ctx, &CreateCommentOptions{
+		Type:             parentType,
+		Doer:             doer,
+		Repo:             parent.Repo,
+		Issue:            parent,
+		DependentIssueID: issue.ID,
+	}); err != nil {
+		return err
+	}
+	_, err := CreateComment(ctx, &CreateCommentOptions{
+		Type:             issueType,
+		Doer:             doer,
+		Repo:             issue.Repo,
+		Issue:            issue,
+		DependentIssueID: parent.ID,
+	})
+	return err
+}
+
+// DeleteSubIssueLinksOfRepo removes the links between the issues of a repository and the issues of other repositories
+func DeleteSubIssueLinksOfRepo(ctx context.Context, repoID int64) error {
+	repoIssueIDs := builder.Select("id").From("issue").Where(builder.Eq{"repo_id": repoID})
+	_, err := db.GetEngine(ctx).Where(builder.Or(
+		builder.In("issue_id", repoIssueIDs).And(builder.NotIn("parent_id", repoIssueIDs)),
+		builder.In("parent_id", repoIssueIDs).And(builder.NotIn("issue_id", repoIssueIDs)),
+	)).Delete(&SubIssue{})
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues_test
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubIssues(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	parent := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})     // user2/repo1#1
+	child := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 7})      // user2/repo2#2
+	grandchild := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 5}) // user2/repo1#4, closed
+	otherOwner := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 6}) // user3/repo3#1
+	pull := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 2})
+
+	assert.NoError(t, issues_model.AddSubIssue(t.Context(), doer, parent, child))
+	assert.NoError(t, issues_model.AddSubIssue(t.Context(), doer, child, grandchild))
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{Type: issues_model.CommentTypeAddSubIssue, IssueID: parent.ID, DependentIssueID: child.ID})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{Type: issues_model.CommentTypeAddParentIssue, IssueID: child.ID, DependentIssueID: parent.ID})
+
+	for _, c := range []struct {
+		name          string
+		parent, issue *issues_model.Issue
+	}{
+		{"itself", parent, parent},
+		{"pull request", parent, pull},
+		{"other owner", parent, otherOwner},
+		{"has parent", grandchild, child},
+		{"cycle", grandchild, parent},
+	} {
+		err := issues_model.AddSubIssue(t.Context(), doer, c.parent, c.issue)
+		assert.ErrorIs(t, err, util.ErrInvalidArgument, c.name)
+	}
+
+	parentID, err := issues_model.GetParentIssueID(t.Context(), grandchild.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, child.ID, parentID)
+
+	subIssues, err := issues_model.GetSubIssues(t.Context(), parent.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, subIssues, 1) {
+		assert.Equal(t, child.ID, subIssues[0].ID)
+	}
+
+	progress, err := issues_model.GetSubIssueProgress(t.Context(), []int64{parent.ID, child.ID, grandchild.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, &issues_model.SubIssueProgress{Total: 2, Closed: 1}, progress[parent.ID])
+	assert.Equal(t, &issues_model.SubIssueProgress{Total: 1, Closed: 1}, progress[child.ID])
+	assert.NotContains(t, progress, grandchild.ID)
+
+	hasOpen, err := issues_model.HasOpenSubIssues(t.Context(), child.ID)
+	assert.NoError(t, err)
+	assert.False(t, hasOpen)
+	hasOpen, err = issues_model.HasOpenSubIssues(t.Context(), parent.ID)
+	assert.NoError(t, err)
+	assert.True(t, hasOpen)
+
+	assert.NoError(t, issues_model.RemoveSubIssue(t.Context(), doer, child, grandchild))
+	assert.ErrorIs(t, issues_model.RemoveSubIssue(t.Context(), doer, child, grandchild), util.ErrNotExist)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{Type: issues_model.CommentTypeRemoveParentIssue, IssueID: grandchild.ID, DependentIssueID: child.ID})
+
+	// links to the issues of other repositories are removed when a repository is transferred
+	assert.NoError(t, issues_model.DeleteSubIssueLinksOfRepo(t.Context(), child.RepoID))
+	unittest.AssertNotExistsBean(t, &issues_model.SubIssue{IssueID: child.ID})
+}
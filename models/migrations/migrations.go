@@ -400,6 +400,29 @@ func prepareMigrationTasks() []*migration {
 		newMigration(323, "Add support for actions concurrency", v1_26.AddActionsConcurrency),
 		newMigration(324, "Fix closed milestone completeness for milestones with no issues", v1_26.FixClosedMilestoneCompleteness),
 		newMigration(325, "Fix missed repo_id when migrate attachments", v1_26.FixMissedRepoIDWhenMigrateAttachments),
+		newMigration(326, "Add repo_object_mapping table", v1_26.AddRepoObjectMappingTable),
+		newMigration(327, "Add pull_stack table", v1_26.AddPullStackTable),
+		newMigration(328, "Add start_line column to comment table", v1_26.AddStartLineToComment),
+		newMigration(329, "Add project field tables", v1_26.AddProjectFieldTables),
+		newMigration(330, "Add project_view table", v1_26.AddProjectViewTable),
+		newMigration(331, "Add sub_issue table", v1_26.AddSubIssueTable),
+		newMigration(332, "Add issue type tables", v1_26.AddIssueTypeTables),
+		newMigration(333, "Add issue_saved_search table", v1_26.AddIssueSavedSearchTable),
+		newMigration(334, "Add issue SLA tables", v1_26.AddIssueSLATables),
+		newMigration(335, "Add issue_schedule table", v1_26.AddIssueScheduleTable),
+		newMigration(336, "Add milestone_snapshot table", v1_26.AddMilestoneSnapshotTable),
+		newMigration(337, "Add action_scaled_runner and action_scale_decision tables", v1_26.AddActionsRunnerScalerTables),
+		newMigration(338, "Add expires to action_runner", v1_26.AddExpiresToActionRunner),
+		newMigration(339, "Add action_approved_contributor table", v1_26.AddActionApprovedContributorTable),
+		newMigration(340, "Add queued to action_run_job and the tables of actions stats", v1_26.AddActionsStatsTables),
+		newMigration(341, "Add the tables of actions test results", v1_26.AddActionTestResultTables),
+		newMigration(342, "Add the tables of actions task annotations and summaries", v1_26.AddActionTaskAnnotationTables),
+		newMigration(343, "Add dynamic matrix state to action run job", v1_26.AddDynamicMatrixToActionRunJob),
+		newMigration(344, "Add the table of actions required workflows", v1_26.AddActionRequiredWorkflowTable),
+		newMigration(345, "Add the table of the access logs of the external actions secrets", v1_26.AddSecretAccessLogTable),
+		newMigration(346, "Add the next option id to project fields", v1_26.AddNextOptionIDToProjectField),
+		newMigration(347, "Add the error message to action run jobs", v1_26.AddErrorMessageToActionRunJob),
+		newMigration(348, "Add whether the required workflows are triggered by pull requests", v1_26.AddTriggerToActionRequiredWorkflow),
 	}
 	return preparedMigrations
 }
@@ -0,0 +1,22 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddRepoObjectMappingTable(x *xorm.Engine) error {
+	type RepoObjectMapping struct {
+		ID          int64              `xorm:"pk autoincr"`
+		RepoID      int64              `xorm:"UNIQUE(s) NOT NULL"`
+		OldID       string             `xorm:"VARCHAR(64) UNIQUE(s) NOT NULL"`
+		NewID       string             `xorm:"VARCHAR(64) NOT NULL"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(RepoObjectMapping))
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddPullStackTable(x *xorm.Engine) error {
+	type PullStack struct {
+		ID           int64              `xorm:"pk autoincr"`
+		PullID       int64              `xorm:"UNIQUE"`
+		ParentPullID int64              `xorm:"INDEX NOT NULL"`
+		DoerID       int64              `xorm:"INDEX NOT NULL"`
+		AutoRebase   bool               `xorm:"NOT NULL DEFAULT false"`
+		CreatedUnix  timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(PullStack))
+}
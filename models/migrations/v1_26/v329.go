@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddProjectFieldTables(x *xorm.Engine) error {
+	type ProjectFieldOption struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	}
+
+	type ProjectField struct {
+		ID                 int64                 `xorm:"pk autoincr"`
+		ProjectID          int64                 `xorm:"INDEX NOT NULL"`
+		Name               string                `xorm:"NOT NULL"`
+		Type               uint8                 `xorm:"NOT NULL"`
+		Sorting            int                   `xorm:"NOT NULL DEFAULT 0"`
+		Options            []*ProjectFieldOption `xorm:"JSON TEXT"`
+		IterationStartDate string                `xorm:"VARCHAR(10)"`
+		IterationDuration  int                   `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix        timeutil.TimeStamp    `xorm:"created"`
+		UpdatedUnix        timeutil.TimeStamp    `xorm:"updated"`
+	}
+
+	type ProjectFieldValue struct {
+		ID          int64              `xorm:"pk autoincr"`
+		ProjectID   int64              `xorm:"INDEX NOT NULL"`
+		FieldID     int64              `xorm:"UNIQUE(s) NOT NULL"`
+		IssueID     int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		Value       string             `xorm:"TEXT"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	return x.Sync(new(ProjectField), new(ProjectFieldValue))
+}
@@ -0,0 +1,28 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddProjectViewTable(x *xorm.Engine) error {
+	type ProjectView struct {
+		ID          int64              `xorm:"pk autoincr"`
+		ProjectID   int64              `xorm:"INDEX NOT NULL"`
+		Name        string             `xorm:"NOT NULL"`
+		Type        uint8              `xorm:"NOT NULL"`
+		Sorting     int                `xorm:"NOT NULL DEFAULT 0"`
+		CreatorID   int64              `xorm:"NOT NULL"`
+		Filter      string             `xorm:"TEXT"`
+		SortType    string             `xorm:"VARCHAR(20)"`
+		GroupBy     string             `xorm:"VARCHAR(20)"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	return x.Sync(new(ProjectView))
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddSubIssueTable(x *xorm.Engine) error {
+	type SubIssue struct {
+		ID          int64              `xorm:"pk autoincr"`
+		IssueID     int64              `xorm:"UNIQUE NOT NULL"`
+		ParentID    int64              `xorm:"INDEX NOT NULL"`
+		CreatorID   int64              `xorm:"NOT NULL"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(SubIssue))
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddIssueTypeTables(x *xorm.Engine) error {
+	type IssueType struct {
+		ID          int64  `xorm:"pk autoincr"`
+		OwnerID     int64  `xorm:"UNIQUE(s) NOT NULL"`
+		Name        string `xorm:"UNIQUE(s) NOT NULL"`
+		Description string
+		Icon        string             `xorm:"VARCHAR(50)"`
+		Color       string             `xorm:"VARCHAR(7)"`
+		Sorting     int                `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	type IssueField struct {
+		ID          int64              `xorm:"pk autoincr"`
+		OwnerID     int64              `xorm:"INDEX NOT NULL"`
+		TypeID      int64              `xorm:"INDEX NOT NULL"`
+		Name        string             `xorm:"NOT NULL"`
+		Type        uint8              `xorm:"NOT NULL"`
+		Sorting     int                `xorm:"NOT NULL DEFAULT 0"`
+		Options     []string           `xorm:"JSON TEXT"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	type IssueFieldValue struct {
+		ID          int64              `xorm:"pk autoincr"`
+		IssueID     int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		FieldID     int64              `xorm:"UNIQUE(s) INDEX NOT NULL"`
+		Value       string             `xorm:"TEXT"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	type Issue struct {
+		TypeID int64 `xorm:"INDEX NOT NULL DEFAULT 0"`
+	}
+
+	return x.Sync(new(IssueType), new(IssueField), new(IssueFieldValue), new(Issue))
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddIssueSavedSearchTable(x *xorm.Engine) error {
+	type IssueSavedSearch struct {
+		ID          int64              `xorm:"pk autoincr"`
+		OwnerID     int64              `xorm:"UNIQUE(s) NOT NULL"`
+		IsPull      bool               `xorm:"UNIQUE(s) NOT NULL DEFAULT false"`
+		Name        string             `xorm:"UNIQUE(s) NOT NULL"`
+		Query       string             `xorm:"TEXT NOT NULL"`
+		IsPinned    bool               `xorm:"NOT NULL DEFAULT false"`
+		IsShared    bool               `xorm:"NOT NULL DEFAULT false"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	return x.Sync(new(IssueSavedSearch))
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddIssueSLATables(x *xorm.Engine) error {
+	type IssueSLAPolicy struct {
+		ID                  int64              `xorm:"pk autoincr"`
+		RepoID              int64              `xorm:"INDEX NOT NULL"`
+		Name                string             `xorm:"NOT NULL"`
+		LabelID             int64              `xorm:"NOT NULL DEFAULT 0"`
+		TypeID              int64              `xorm:"NOT NULL DEFAULT 0"`
+		FirstResponseHours  int                `xorm:"NOT NULL DEFAULT 0"`
+		ResolutionHours     int                `xorm:"NOT NULL DEFAULT 0"`
+		EscalateToAssignees bool               `xorm:"NOT NULL DEFAULT false"`
+		EscalationTeamID    int64              `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix         timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix         timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	type IssueSLA struct {
+		ID                    int64              `xorm:"pk autoincr"`
+		IssueID               int64              `xorm:"UNIQUE NOT NULL"`
+		RepoID                int64              `xorm:"INDEX NOT NULL"`
+		PolicyID              int64              `xorm:"INDEX NOT NULL"`
+		FirstResponseWarnUnix timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		FirstResponseDueUnix  timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		FirstRespondedUnix    timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		FirstResponseNotified int                `xorm:"NOT NULL DEFAULT 0"`
+		ResolutionWarnUnix    timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		ResolutionDueUnix     timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		ResolvedUnix          timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		ResolutionNotified    int                `xorm:"NOT NULL DEFAULT 0"`
+	}
+
+	return x.Sync(new(IssueSLAPolicy), new(IssueSLA))
+}
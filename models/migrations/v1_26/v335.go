@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddIssueScheduleTable(x *xorm.Engine) error {
+	type IssueSchedule struct {
+		ID              int64              `xorm:"pk autoincr"`
+		RepoID          int64              `xorm:"INDEX NOT NULL"`
+		CreatorID       int64              `xorm:"NOT NULL"`
+		Name            string             `xorm:"NOT NULL"`
+		TemplateFile    string             `xorm:"NOT NULL"`
+		Spec            string             `xorm:"NOT NULL"`
+		AssigneeIDs     []int64            `xorm:"JSON TEXT"`
+		LabelIDs        []int64            `xorm:"JSON TEXT"`
+		ProjectColumnID int64              `xorm:"NOT NULL DEFAULT 0"`
+		ClosePrevious   bool               `xorm:"NOT NULL DEFAULT false"`
+		IsActive        bool               `xorm:"INDEX NOT NULL DEFAULT true"`
+		LastIssueID     int64              `xorm:"NOT NULL DEFAULT 0"`
+		Next            timeutil.TimeStamp `xorm:"INDEX NOT NULL DEFAULT 0"`
+		Prev            timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+		CreatedUnix     timeutil.TimeStamp `xorm:"created"`
+		UpdatedUnix     timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	return x.Sync(new(IssueSchedule))
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddMilestoneSnapshotTable(x *xorm.Engine) error {
+	type MilestoneSnapshot struct {
+		ID             int64              `xorm:"pk autoincr"`
+		RepoID         int64              `xorm:"INDEX NOT NULL"`
+		MilestoneID    int64              `xorm:"UNIQUE(s) NOT NULL"`
+		Day            timeutil.TimeStamp `xorm:"UNIQUE(s) NOT NULL"`
+		NumIssues      int                `xorm:"NOT NULL DEFAULT 0"`
+		NumOpenIssues  int                `xorm:"NOT NULL DEFAULT 0"`
+		OpenEstimate   int64              `xorm:"NOT NULL DEFAULT 0"`
+		ClosedEstimate int64              `xorm:"NOT NULL DEFAULT 0"`
+		UpdatedUnix    timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	return x.Sync(new(MilestoneSnapshot))
+}
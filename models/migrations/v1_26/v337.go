@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionsRunnerScalerTables(x *xorm.Engine) error {
+	type ActionScaledRunner struct {
+		ID         int64              `xorm:"pk autoincr"`
+		RunnerID   int64              `xorm:"UNIQUE NOT NULL"`
+		Provider   string             `xorm:"VARCHAR(64) NOT NULL"`
+		InstanceID string             `xorm:"VARCHAR(255)"`
+		Labels     []string           `xorm:"JSON TEXT"`
+		Created    timeutil.TimeStamp `xorm:"created"`
+	}
+
+	type ActionScaleDecision struct {
+		ID          int64              `xorm:"pk autoincr"`
+		Type        int                `xorm:"NOT NULL"`
+		Labels      []string           `xorm:"JSON TEXT"`
+		NumWaiting  int                `xorm:"NOT NULL DEFAULT 0"`
+		NumRunners  int                `xorm:"NOT NULL DEFAULT 0"`
+		Message     string             `xorm:"TEXT"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created INDEX"`
+	}
+
+	return x.Sync(new(ActionScaledRunner), new(ActionScaleDecision))
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionApprovedContributorTable(x *xorm.Engine) error {
+	type ActionApprovedContributor struct {
+		ID         int64
+		RepoID     int64              `xorm:"UNIQUE(repo_user) NOT NULL"`
+		UserID     int64              `xorm:"UNIQUE(repo_user) NOT NULL"`
+		ApprovedBy int64              `xorm:"NOT NULL DEFAULT 0"`
+		Created    timeutil.TimeStamp `xorm:"created"`
+	}
+
+	if err := x.Sync(new(ActionApprovedContributor)); err != nil {
+		return err
+	}
+
+	// the approvals were remembered by the approved runs before, keep them
+	type approvedRun struct {
+		RepoID        int64
+		TriggerUserID int64
+		ApprovedBy    int64
+	}
+	var runs []approvedRun
+	if err := x.Table("action_run").Select("repo_id, trigger_user_id, MAX(approved_by) AS approved_by").
+		Where("approved_by > 0").GroupBy("repo_id, trigger_user_id").Find(&runs); err != nil {
+		return err
+	}
+	for _, run := range runs {
+		if _, err := x.Insert(&ActionApprovedContributor{
+			RepoID:     run.RepoID,
+			UserID:     run.TriggerUserID,
+			ApprovedBy: run.ApprovedBy,
+			Created:    timeutil.TimeStampNow(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
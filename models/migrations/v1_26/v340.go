@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionsStatsTables(x *xorm.Engine) error {
+	type ActionRunJob struct {
+		Queued timeutil.TimeStamp `xorm:"NOT NULL DEFAULT 0"`
+	}
+
+	type ActionJobStat struct {
+		ID           int64
+		RepoID       int64              `xorm:"INDEX(repo_day) NOT NULL"`
+		OwnerID      int64              `xorm:"INDEX NOT NULL"`
+		Day          timeutil.TimeStamp `xorm:"INDEX(repo_day) INDEX NOT NULL"`
+		WorkflowID   string             `xorm:"VARCHAR(255) NOT NULL"`
+		JobName      string             `xorm:"VARCHAR(255) NOT NULL"`
+		StepName     string             `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		NumTasks     int64              `xorm:"NOT NULL DEFAULT 0"`
+		NumFailures  int64              `xorm:"NOT NULL DEFAULT 0"`
+		NumRetries   int64              `xorm:"NOT NULL DEFAULT 0"`
+		NumFlaky     int64              `xorm:"NOT NULL DEFAULT 0"`
+		NumQueued    int64              `xorm:"NOT NULL DEFAULT 0"`
+		QueueSeconds int64              `xorm:"NOT NULL DEFAULT 0"`
+		RunSeconds   int64              `xorm:"NOT NULL DEFAULT 0"`
+		RunHistogram []int64            `xorm:"JSON TEXT"`
+	}
+
+	type ActionRunnerLabelStat struct {
+		ID          int64
+		RepoID      int64              `xorm:"INDEX(repo_day) NOT NULL"`
+		OwnerID     int64              `xorm:"INDEX NOT NULL"`
+		Day         timeutil.TimeStamp `xorm:"INDEX(repo_day) INDEX NOT NULL"`
+		Label       string             `xorm:"VARCHAR(255) NOT NULL"`
+		NumTasks    int64              `xorm:"NOT NULL DEFAULT 0"`
+		BusySeconds int64              `xorm:"NOT NULL DEFAULT 0"`
+	}
+
+	if _, err := x.SyncWithOptions(xorm.SyncOptions{IgnoreDropIndices: true}, new(ActionRunJob)); err != nil {
+		return err
+	}
+	return x.Sync(new(ActionJobStat), new(ActionRunnerLabelStat))
+}
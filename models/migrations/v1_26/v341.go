@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionTestResultTables(x *xorm.Engine) error {
+	type ActionTestResult struct {
+		ID         int64
+		RepoID     int64  `xorm:"INDEX NOT NULL"`
+		RunID      int64  `xorm:"INDEX(run_status) NOT NULL"`
+		JobID      int64  `xorm:"NOT NULL DEFAULT 0"`
+		ArtifactID int64  `xorm:"INDEX NOT NULL"`
+		Suite      string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		ClassName  string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		Name       string `xorm:"TEXT NOT NULL"`
+		Status     int    `xorm:"INDEX(run_status) NOT NULL"`
+		Duration   int64  `xorm:"NOT NULL DEFAULT 0"`
+		Message    string `xorm:"TEXT"`
+		Details    string `xorm:"LONGTEXT"`
+
+		Created timeutil.TimeStamp `xorm:"created"`
+	}
+
+	type ActionTestCase struct {
+		ID          int64
+		RepoID      int64  `xorm:"UNIQUE(repo_key) NOT NULL"`
+		KeyHash     string `xorm:"VARCHAR(64) UNIQUE(repo_key) NOT NULL"`
+		Suite       string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		ClassName   string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		Name        string `xorm:"TEXT NOT NULL"`
+		NumRuns     int64  `xorm:"NOT NULL DEFAULT 0"`
+		NumFailures int64  `xorm:"NOT NULL DEFAULT 0"`
+		NumFlips    int64  `xorm:"INDEX NOT NULL DEFAULT 0"`
+		LastStatus  int    `xorm:"NOT NULL DEFAULT 0"`
+		LastRunID   int64  `xorm:"NOT NULL DEFAULT 0"`
+		LastTaskID  int64  `xorm:"NOT NULL DEFAULT 0"`
+
+		Created timeutil.TimeStamp `xorm:"created"`
+		Updated timeutil.TimeStamp `xorm:"updated INDEX"`
+	}
+
+	return x.Sync(new(ActionTestResult), new(ActionTestCase))
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionTaskAnnotationTables(x *xorm.Engine) error {
+	type ActionTaskAnnotation struct {
+		ID        int64
+		TaskID    int64  `xorm:"INDEX NOT NULL"`
+		RepoID    int64  `xorm:"INDEX(repo_commit) NOT NULL"`
+		CommitSHA string `xorm:"INDEX(repo_commit) VARCHAR(64) NOT NULL"`
+		LogIndex  int64  `xorm:"NOT NULL DEFAULT 0"`
+		Level     int    `xorm:"NOT NULL"`
+		Title     string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		Message   string `xorm:"TEXT"`
+		File      string `xorm:"VARCHAR(255) NOT NULL DEFAULT ''"`
+		Line      int    `xorm:"NOT NULL DEFAULT 0"`
+		EndLine   int    `xorm:"NOT NULL DEFAULT 0"`
+		Col       int    `xorm:"NOT NULL DEFAULT 0"`
+		EndCol    int    `xorm:"NOT NULL DEFAULT 0"`
+
+		Created timeutil.TimeStamp `xorm:"created"`
+	}
+
+	type ActionTaskSummary struct {
+		ID       int64
+		TaskID   int64  `xorm:"INDEX NOT NULL"`
+		RepoID   int64  `xorm:"INDEX NOT NULL"`
+		LogIndex int64  `xorm:"NOT NULL DEFAULT 0"`
+		Content  string `xorm:"MEDIUMTEXT"`
+
+		Created timeutil.TimeStamp `xorm:"created"`
+	}
+
+	return x.Sync(new(ActionTaskAnnotation), new(ActionTaskSummary))
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddActionRequiredWorkflowTable(x *xorm.Engine) error {
+	type ActionRequiredWorkflow struct {
+		ID           int64
+		OwnerID      int64              `xorm:"UNIQUE(owner_repo_path) NOT NULL"`
+		RepoID       int64              `xorm:"UNIQUE(owner_repo_path) NOT NULL"`
+		WorkflowPath string             `xorm:"UNIQUE(owner_repo_path) NOT NULL"`
+		RepoPattern  string             `xorm:"NOT NULL DEFAULT ''"`
+		Topics       []string           `xorm:"TEXT JSON"`
+		CreatedBy    int64              `xorm:"NOT NULL DEFAULT 0"`
+		Created      timeutil.TimeStamp `xorm:"created"`
+		Updated      timeutil.TimeStamp `xorm:"updated"`
+	}
+
+	type ActionRun struct {
+		RequiredWorkflowID int64 `xorm:"index NOT NULL DEFAULT 0"`
+	}
+
+	if err := x.Sync(new(ActionRequiredWorkflow)); err != nil {
+		return err
+	}
+	_, err := x.SyncWithOptions(xorm.SyncOptions{
+		IgnoreDropIndices: true,
+	}, new(ActionRun))
+	return err
+}
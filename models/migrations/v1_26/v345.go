@@ -0,0 +1,28 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/xorm"
+)
+
+func AddSecretAccessLogTable(x *xorm.Engine) error {
+	type SecretAccessLog struct {
+		ID          int64
+		SecretID    int64              `xorm:"INDEX NOT NULL"`
+		OwnerID     int64              `xorm:"INDEX NOT NULL DEFAULT 0"`
+		RepoID      int64              `xorm:"INDEX NOT NULL DEFAULT 0"`
+		SecretName  string             `xorm:"NOT NULL"`
+		Reference   string             `xorm:"TEXT"`
+		TaskID      int64              `xorm:"INDEX NOT NULL DEFAULT 0"`
+		TaskRepoID  int64              `xorm:"NOT NULL DEFAULT 0"`
+		Cached      bool               `xorm:"NOT NULL DEFAULT false"`
+		Error       string             `xorm:"TEXT"`
+		CreatedUnix timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+	}
+
+	return x.Sync(new(SecretAccessLog))
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"xorm.io/xorm"
+)
+
+func AddNextOptionIDToProjectField(x *xorm.Engine) error {
+	type FieldOption struct {
+		ID int64 `json:"id"`
+	}
+	type ProjectField struct {
+		ID           int64
+		Type         uint8
+		Options      []*FieldOption `xorm:"JSON TEXT"`
+		NextOptionID int64          `xorm:"NOT NULL DEFAULT 1"`
+	}
+	if _, err := x.SyncWithOptions(xorm.SyncOptions{
+		IgnoreDropIndices: true,
+	}, new(ProjectField)); err != nil {
+		return err
+	}
+
+	// the options of the single select fields have been numbered from 1 by their highest id
+	const fieldTypeSingleSelect = 4
+	fields := make([]*ProjectField, 0, 10)
+	if err := x.Where("type = ?", fieldTypeSingleSelect).Find(&fields); err != nil {
+		return err
+	}
+	for _, field := range fields {
+		nextID := int64(1)
+		for _, option := range field.Options {
+			nextID = max(nextID, option.ID+1)
+		}
+		if _, err := x.ID(field.ID).Cols("next_option_id").Update(&ProjectField{NextOptionID: nextID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
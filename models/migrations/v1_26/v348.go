@@ -0,0 +1,20 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package v1_26
+
+import (
+	"xorm.io/xorm"
+)
+
+func AddTriggerToActionRequiredWorkflow(x *xorm.Engine) error {
+	// the columns are filled when the status contexts of the required workflows are needed the first time
+	type ActionRequiredWorkflow struct {
+		CommitSHA              string `xorm:"VARCHAR(64) NOT NULL DEFAULT ''"`
+		TriggeredByPullRequest bool   `xorm:"NOT NULL DEFAULT false"`
+	}
+	_, err := x.SyncWithOptions(xorm.SyncOptions{
+		IgnoreDropIndices: true,
+	}, new(ActionRequiredWorkflow))
+	return err
+}
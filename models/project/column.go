@@ -135,7 +135,7 @@ const maxProjectColumns = 20
 // NewColumn adds a new project column to a given project
 func NewColumn(ctx context.Context, column *Column) error {
 	if len(column.Color) != 0 && !ColumnColorPattern.MatchString(column.Color) {
-		return fmt.Errorf("bad color code: %s", column.Color)
+		return util.NewInvalidArgumentErrorf("bad color code: %s", column.Color)
 	}
 
 	res := struct {
@@ -147,7 +147,7 @@ func NewColumn(ctx context.Context, column *Column) error {
 		return err
 	}
 	if res.ColumnCount >= maxProjectColumns {
-		return errors.New("NewBoard: maximum number of columns reached")
+		return util.NewInvalidArgumentErrorf("NewBoard: maximum number of columns reached")
 	}
 	column.Sorting = int8(util.Iif(res.ColumnCount > 0, res.MaxSorting+1, 0))
 	_, err := db.GetEngine(ctx).Insert(column)
@@ -172,7 +172,7 @@ func deleteColumnByID(ctx context.Context, columnID int64) error {
 	}
 
 	if column.Default {
-		return errors.New("deleteColumnByID: cannot delete default column")
+		return util.NewInvalidArgumentErrorf("deleteColumnByID: cannot delete default column")
 	}
 
 	// move all issues to the default column
@@ -238,7 +238,7 @@ func UpdateColumn(ctx context.Context, column *Column) error {
 	}
 
 	if len(column.Color) != 0 && !ColumnColorPattern.MatchString(column.Color) {
-		return fmt.Errorf("bad color code: %s", column.Color)
+		return util.NewInvalidArgumentErrorf("bad color code: %s", column.Color)
 	}
 	fieldToUpdate = append(fieldToUpdate, "color")
 
@@ -0,0 +1,481 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// FieldType is the type of the values of a custom field of a project
+type FieldType uint8
+
+const (
+	FieldTypeText FieldType = iota + 1
+	FieldTypeNumber
+	FieldTypeDate
+	FieldTypeSingleSelect
+	FieldTypeIteration
+)
+
+// FieldDateFormat is the format of the values of the date fields
+const FieldDateFormat = "2006-01-02"
+
+// maxProjectFields max custom fields allowed in a project
+const maxProjectFields = 50
+
+var fieldTypeNames = map[FieldType]string{
+	FieldTypeText:         "text",
+	FieldTypeNumber:       "number",
+	FieldTypeDate:         "date",
+	FieldTypeSingleSelect: "single_select",
+	FieldTypeIteration:    "iteration",
+}
+
+func (t FieldType) String() string {
+	return fieldTypeNames[t]
+}
+
+// IsValid checks if the field type is known
+func (t FieldType) IsValid() bool {
+	_, ok := fieldTypeNames[t]
+	return ok
+}
+
+// ParseFieldType returns the field type of a name, 0 is returned if the name is unknown
+func ParseFieldType(name string) FieldType {
+	for t, n := range fieldTypeNames {
+		if n == name {
+			return t
+		}
+	}
+	return 0
+}
+
+// FieldOption is an option of a single select field
+type FieldOption struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Iteration is a period of an iteration field, the iterations follow each other from the start date of the field
+type Iteration struct {
+	Number    int
+	StartDate time.Time
+	EndDate   time.Time // the last day of the iteration
+}
+
+// Title returns the title of the iteration
+func (it *Iteration) Title() string {
+	return fmt.Sprintf("Iteration %d", it.Number)
+}
+
+// Field is a custom field of a project, every issue of the project can have a value for it
+type Field struct {
+	ID        int64     `xorm:"pk autoincr"`
+	ProjectID int64     `xorm:"INDEX NOT NULL"`
+	Name      string    `xorm:"NOT NULL"`
+	Type      FieldType `xorm:"NOT NULL"`
+	Sorting   int       `xorm:"NOT NULL DEFAULT 0"`
+
+	// the options of a single select field
+	Options []*FieldOption `xorm:"JSON TEXT"`
+	// the id of the next new option, the ids of the removed options are never reused
+	NextOptionID int64 `xorm:"NOT NULL DEFAULT 1"`
+
+	// the cadence of an iteration field
+	IterationStartDate string `xorm:"VARCHAR(10)"`
+	IterationDuration  int    `xorm:"NOT NULL DEFAULT 0"` // in days
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+// TableName return the real table name
+func (Field) TableName() string {
+	return "project_field"
+}
+
+// FieldValue is the value of a custom field of a project for an issue of the project
+type FieldValue struct {
+	ID        int64  `xorm:"pk autoincr"`
+	ProjectID int64  `xorm:"INDEX NOT NULL"`
+	FieldID   int64  `xorm:"UNIQUE(s) NOT NULL"`
+	IssueID   int64  `xorm:"UNIQUE(s) INDEX NOT NULL"`
+	Value     string `xorm:"TEXT"`
+
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+// TableName return the real table name
+func (FieldValue) TableName() string {
+	return "project_field_value"
+}
+
+func init() {
+	db.RegisterModel(new(Field))
+	db.RegisterModel(new(FieldValue))
+}
+
+// ErrProjectFieldNotExist represents a "ProjectFieldNotExist" kind of error.
+type ErrProjectFieldNotExist struct {
+	FieldID int64
+}
+
+// IsErrProjectFieldNotExist checks if an error is a ErrProjectFieldNotExist
+func IsErrProjectFieldNotExist(err error) bool {
+	_, ok := err.(ErrProjectFieldNotExist)
+	return ok
+}
+
+func (err ErrProjectFieldNotExist) Error() string {
+	return fmt.Sprintf("project field does not exist [id: %d]", err.FieldID)
+}
+
+func (err ErrProjectFieldNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// GetOption returns the option of a single select field
+func (f *Field) GetOption(id int64) *FieldOption {
+	for _, option := range f.Options {
+		if option.ID == id {
+			return option
+		}
+	}
+	return nil
+}
+
+// ValueOption returns the option of a value of a single select field
+func (f *Field) ValueOption(value string) *FieldOption {
+	if f.Type != FieldTypeSingleSelect {
+		return nil
+	}
+	id, _ := strconv.ParseInt(value, 10, 64)
+	return f.GetOption(id)
+}
+
+// Iteration returns the iteration of an iteration field by its number starting at 1
+func (f *Field) Iteration(number int) *Iteration {
+	start, err := time.Parse(FieldDateFormat, f.IterationStartDate)
+	if err != nil || f.IterationDuration <= 0 || number <= 0 {
+		return nil
+	}
+	start = start.AddDate(0, 0, (number-1)*f.IterationDuration)
+	return &Iteration{
+		Number:    number,
+		StartDate: start,
+		EndDate:   start.AddDate(0, 0, f.IterationDuration-1),
+	}
+}
+
+// IterationAt returns the iteration of an iteration field which contains the date,
+// nil is returned if the date is before the first iteration
+func (f *Field) IterationAt(date time.Time) *Iteration {
+	first := f.Iteration(1)
+	if first == nil {
+		return nil
+	}
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Before(first.StartDate) {
+		return nil
+	}
+	days := int(day.Sub(first.StartDate).Hours() / 24)
+	return f.Iteration(days/f.IterationDuration + 1)
+}
+
+// Iterations returns the iterations of an iteration field from the previous iteration of the current one,
+// it gives a reasonable list of iterations to choose from
+func (f *Field) Iterations(count int) []*Iteration {
+	current := f.IterationAt(time.Now())
+	number := 1
+	if current != nil && current.Number > 1 {
+		number = current.Number - 1
+	}
+	iterations := make([]*Iteration, 0, count)
+	for i := range count {
+		if it := f.Iteration(number + i); it != nil {
+			iterations = append(iterations, it)
+		}
+	}
+	return iterations
+}
+
+// IterationChoices returns the iterations which can be chosen for an iteration field,
+// the iteration of the current value is kept even if it is an old one
+func (f *Field) IterationChoices(value string) []*Iteration {
+	iterations := f.Iterations(6)
+	number, _ := strconv.Atoi(value)
+	current := f.Iteration(number)
+	if current == nil || (len(iterations) > 0 && current.Number >= iterations[0].Number) {
+		return iterations
+	}
+	return append([]*Iteration{current}, iterations...)
+}
+
+// NormalizeValue checks a value for the field and returns it in the format it is stored
+func (f *Field) NormalizeValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	switch f.Type {
+	case FieldTypeText:
+		return value, nil
+	case FieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", util.NewInvalidArgumentErrorf("field %q requires a number", f.Name)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case FieldTypeDate:
+		date, err := time.Parse(FieldDateFormat, value)
+		if err != nil {
+			return "", util.NewInvalidArgumentErrorf("field %q requires a date like 2006-01-02", f.Name)
+		}
+		return date.Format(FieldDateFormat), nil
+	case FieldTypeSingleSelect:
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || f.GetOption(id) == nil {
+			return "", util.NewInvalidArgumentErrorf("field %q has no option %q", f.Name, value)
+		}
+		return strconv.FormatInt(id, 10), nil
+	case FieldTypeIteration:
+		number, err := strconv.Atoi(value)
+		if err != nil || f.Iteration(number) == nil {
+			return "", util.NewInvalidArgumentErrorf("field %q has no iteration %q", f.Name, value)
+		}
+		return strconv.Itoa(number), nil
+	}
+	return "", util.NewInvalidArgumentErrorf("field %q has an unknown type", f.Name)
+}
+
+// DisplayValue returns the text shown for a value of the field
+func (f *Field) DisplayValue(value string) string {
+	switch f.Type {
+	case FieldTypeSingleSelect:
+		if option := f.ValueOption(value); option != nil {
+			return option.Name
+		}
+	case FieldTypeIteration:
+		number, _ := strconv.Atoi(value)
+		if it := f.Iteration(number); it != nil {
+			return it.Title()
+		}
+	}
+	return value
+}
+
+// validate checks the field and gives ids to its new options, existing is the stored field when it is updated
+func (f *Field) validate(existing *Field) error {
+	f.Name = strings.TrimSpace(f.Name)
+	if f.Name == "" {
+		return util.NewInvalidArgumentErrorf("field name is required")
+	}
+	if !f.Type.IsValid() {
+		return util.NewInvalidArgumentErrorf("unknown field type %d", f.Type)
+	}
+
+	switch f.Type {
+	case FieldTypeSingleSelect:
+		if len(f.Options) == 0 {
+			return util.NewInvalidArgumentErrorf("single select field %q requires options", f.Name)
+		}
+		existingIDs := make(container.Set[int64])
+		nextID := int64(1)
+		if existing != nil {
+			for _, option := range existing.Options {
+				existingIDs.Add(option.ID)
+				nextID = max(nextID, option.ID+1)
+			}
+			nextID = max(nextID, existing.NextOptionID)
+		}
+		names := make(map[string]bool, len(f.Options))
+		ids := make(container.Set[int64], len(f.Options))
+		for _, option := range f.Options {
+			option.Name = strings.TrimSpace(option.Name)
+			if option.Name == "" || names[option.Name] {
+				return util.NewInvalidArgumentErrorf("options of field %q must have unique names", f.Name)
+			}
+			if option.Color != "" && !ColumnColorPattern.MatchString(option.Color) {
+				return util.NewInvalidArgumentErrorf("bad color code: %s", option.Color)
+			}
+			names[option.Name] = true
+			// the new options get the next ids, the others must keep the ids of the stored options
+			if option.ID <= 0 {
+				option.ID = nextID
+				nextID++
+			} else if !existingIDs.Contains(option.ID) || !ids.Add(option.ID) {
+				return util.NewInvalidArgumentErrorf("option %d doesn't exist in field %q", option.ID, f.Name)
+			}
+		}
+		f.NextOptionID = nextID
+	case FieldTypeIteration:
+		if _, err := time.Parse(FieldDateFormat, f.IterationStartDate); err != nil {
+			return util.NewInvalidArgumentErrorf("iteration field %q requires a start date like 2006-01-02", f.Name)
+		}
+		if f.IterationDuration <= 0 {
+			return util.NewInvalidArgumentErrorf("iteration field %q requires a duration", f.Name)
+		}
+	}
+	if f.Type != FieldTypeSingleSelect {
+		f.Options = nil
+	}
+	if f.Type != FieldTypeIteration {
+		f.IterationStartDate, f.IterationDuration = "", 0
+	}
+	return nil
+}
+
+// FieldList is a list of custom fields
+type FieldList []*Field
+
+// GetFieldByID returns a custom field of a project
+func GetFieldByID(ctx context.Context, projectID, fieldID int64) (*Field, error) {
+	field := new(Field)
+	has, err := db.GetEngine(ctx).Where("project_id=? AND id=?", projectID, fieldID).Get(field)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrProjectFieldNotExist{FieldID: fieldID}
+	}
+	return field, nil
+}
+
+// GetFields returns the custom fields of a project
+func GetFields(ctx context.Context, projectID int64) (FieldList, error) {
+	fields := make(FieldList, 0, 5)
+	return fields, db.GetEngine(ctx).Where("project_id=?", projectID).OrderBy("sorting, id").Find(&fields)
+}
+
+// NewField adds a custom field to a project
+func NewField(ctx context.Context, field *Field) error {
+	if err := field.validate(nil); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		count, err := db.GetEngine(ctx).Where("project_id=?", field.ProjectID).Count(new(Field))
+		if err != nil {
+			return err
+		}
+		if count >= maxProjectFields {
+			return util.NewInvalidArgumentErrorf("a project can have at most %d fields", maxProjectFields)
+		}
+		field.Sorting = int(count)
+		return db.Insert(ctx, field)
+	})
+}
+
+// UpdateField updates the name, the options and the cadence of a custom field, its type can't be changed.
+// The values which refer to a removed option are removed.
+func UpdateField(ctx context.Context, field *Field) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		existing, err := GetFieldByID(ctx, field.ProjectID, field.ID)
+		if err != nil {
+			return err
+		}
+		if err := field.validate(existing); err != nil {
+			return err
+		}
+		if _, err := db.GetEngine(ctx).ID(field.ID).Cols("name", "options", "next_option_id", "iteration_start_date", "iteration_duration").Update(field); err != nil {
+			return err
+		}
+		if field.Type != FieldTypeSingleSelect {
+			return nil
+		}
+		optionIDs := make([]string, 0, len(field.Options))
+		for _, option := range field.Options {
+			optionIDs = append(optionIDs, strconv.FormatInt(option.ID, 10))
+		}
+		_, err = db.GetEngine(ctx).Where(builder.Eq{"field_id": field.ID}.And(builder.NotIn("value", optionIDs))).Delete(new(FieldValue))
+		return err
+	})
+}
+
+// DeleteField removes a custom field and its values
+func DeleteField(ctx context.Context, field *Field) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("field_id=?", field.ID).Delete(new(FieldValue)); err != nil {
+			return err
+		}
+		_, err := db.GetEngine(ctx).ID(field.ID).Delete(new(Field))
+		return err
+	})
+}
+
+func deleteFieldsByProjectID(ctx context.Context, projectID int64) error {
+	if _, err := db.GetEngine(ctx).Where("project_id=?", projectID).Delete(new(FieldValue)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where("project_id=?", projectID).Delete(new(Field))
+	return err
+}
+
+func deleteFieldsByRepoID(ctx context.Context, repoID int64) error {
+	projectIDs := builder.Select("id").From("project").Where(builder.Eq{"repo_id": repoID})
+	if _, err := db.GetEngine(ctx).Where(builder.In("project_id", projectIDs)).Delete(new(FieldValue)); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where(builder.In("project_id", projectIDs)).Delete(new(Field))
+	return err
+}
+
+// SetFieldValue sets the value of a custom field for an issue of the project, an empty value removes it
+func SetFieldValue(ctx context.Context, field *Field, issueID int64, value string) error {
+	value, err := field.NormalizeValue(value)
+	if err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if value == "" {
+			_, err := db.GetEngine(ctx).Where("field_id=? AND issue_id=?", field.ID, issueID).Delete(new(FieldValue))
+			return err
+		}
+		fieldValue := &FieldValue{ProjectID: field.ProjectID, FieldID: field.ID, IssueID: issueID}
+		has, err := db.GetEngine(ctx).Get(fieldValue)
+		if err != nil {
+			return err
+		}
+		fieldValue.Value = value
+		if has {
+			_, err = db.GetEngine(ctx).ID(fieldValue.ID).Cols("value").Update(fieldValue)
+			return err
+		}
+		return db.Insert(ctx, fieldValue)
+	})
+}
+
+// GetFieldValues returns the values of the custom fields of a project for the issues, by issue id and field id
+func GetFieldValues(ctx context.Context, projectID int64, issueIDs []int64) (map[int64]map[int64]string, error) {
+	values := make([]*FieldValue, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).Where("project_id=?", projectID).In("issue_id", issueIDs).Find(&values); err != nil {
+		return nil, err
+	}
+	result := make(map[int64]map[int64]string, len(issueIDs))
+	for _, value := range values {
+		if result[value.IssueID] == nil {
+			result[value.IssueID] = make(map[int64]string)
+		}
+		result[value.IssueID][value.FieldID] = value.Value
+	}
+	return result, nil
+}
+
+// DeleteIssueFieldValues removes the values of the custom fields of a project for an issue
+func DeleteIssueFieldValues(ctx context.Context, projectID, issueID int64) error {
+	_, err := db.GetEngine(ctx).Where("project_id=? AND issue_id=?", projectID, issueID).Delete(new(FieldValue))
+	return err
+}
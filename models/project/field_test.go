@@ -0,0 +1,128 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestField_NormalizeValue(t *testing.T) {
+	cases := []struct {
+		field    *Field
+		value    string
+		expected string
+		valid    bool
+	}{
+		{&Field{Type: FieldTypeText}, " some text ", "some text", true},
+		{&Field{Type: FieldTypeText}, "", "", true},
+		{&Field{Type: FieldTypeNumber}, "3.50", "3.5", true},
+		{&Field{Type: FieldTypeNumber}, "three", "", false},
+		{&Field{Type: FieldTypeDate}, "2026-02-01", "2026-02-01", true},
+		{&Field{Type: FieldTypeDate}, "01/02/2026", "", false},
+		{&Field{Type: FieldTypeSingleSelect, Options: []*FieldOption{{ID: 1, Name: "Todo"}}}, "1", "1", true},
+		{&Field{Type: FieldTypeSingleSelect, Options: []*FieldOption{{ID: 1, Name: "Todo"}}}, "2", "", false},
+		{&Field{Type: FieldTypeIteration, IterationStartDate: "2026-01-05", IterationDuration: 14}, "3", "3", true},
+		{&Field{Type: FieldTypeIteration, IterationStartDate: "2026-01-05", IterationDuration: 14}, "0", "", false},
+	}
+	for _, c := range cases {
+		value, err := c.field.NormalizeValue(c.value)
+		if c.valid {
+			assert.NoError(t, err, "value %q of %s field", c.value, c.field.Type)
+			assert.Equal(t, c.expected, value)
+		} else {
+			assert.Error(t, err, "value %q of %s field", c.value, c.field.Type)
+		}
+	}
+}
+
+func TestField_Iteration(t *testing.T) {
+	field := &Field{Type: FieldTypeIteration, IterationStartDate: "2026-01-05", IterationDuration: 14}
+
+	it := field.Iteration(2)
+	require.NotNil(t, it)
+	assert.Equal(t, "2026-01-19", it.StartDate.Format(FieldDateFormat))
+	assert.Equal(t, "2026-02-01", it.EndDate.Format(FieldDateFormat))
+	assert.Equal(t, "Iteration 2", field.DisplayValue("2"))
+
+	assert.Nil(t, field.IterationAt(time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 1, field.IterationAt(time.Date(2026, 1, 18, 23, 0, 0, 0, time.UTC)).Number)
+	assert.Equal(t, 2, field.IterationAt(time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)).Number)
+}
+
+func TestFieldValues(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	field := &Field{
+		ProjectID: 1,
+		Name:      "Status",
+		Type:      FieldTypeSingleSelect,
+		Options:   []*FieldOption{{Name: "Todo"}, {Name: "Done", Color: "#2ea44f"}},
+	}
+	require.NoError(t, NewField(t.Context(), field))
+	assert.Equal(t, int64(1), field.Options[0].ID)
+	assert.Equal(t, int64(2), field.Options[1].ID)
+
+	assert.Error(t, NewField(t.Context(), &Field{ProjectID: 1, Name: "Estimate"}))
+	estimate := &Field{ProjectID: 1, Name: "Estimate", Type: FieldTypeNumber}
+	require.NoError(t, NewField(t.Context(), estimate))
+
+	fields, err := GetFields(t.Context(), 1)
+	require.NoError(t, err)
+	assert.Len(t, fields, 2)
+
+	require.NoError(t, SetFieldValue(t.Context(), field, 1, "2"))
+	require.NoError(t, SetFieldValue(t.Context(), field, 2, "1"))
+	require.NoError(t, SetFieldValue(t.Context(), estimate, 1, "5"))
+	require.NoError(t, SetFieldValue(t.Context(), estimate, 1, "8"))
+	assert.Error(t, SetFieldValue(t.Context(), estimate, 2, "many"))
+
+	values, err := GetFieldValues(t.Context(), 1, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]map[int64]string{
+		1: {field.ID: "2", estimate.ID: "8"},
+		2: {field.ID: "1"},
+	}, values)
+
+	// removing an option clears the values referring to it
+	field.Options = field.Options[1:]
+	require.NoError(t, UpdateField(t.Context(), field))
+	values, err = GetFieldValues(t.Context(), 1, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]map[int64]string{1: {field.ID: "2", estimate.ID: "8"}}, values)
+
+	// the id of a removed option isn't given to a new option
+	field.Options = []*FieldOption{{Name: "Todo"}}
+	require.NoError(t, UpdateField(t.Context(), field))
+	assert.Equal(t, int64(3), field.Options[0].ID)
+	values, err = GetFieldValues(t.Context(), 1, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Empty(t, values[1][field.ID])
+
+	// the options can't be given ids which don't exist
+	field.Options = []*FieldOption{{ID: 3, Name: "Todo"}, {ID: 4, Name: "Done"}}
+	assert.ErrorIs(t, UpdateField(t.Context(), field), util.ErrInvalidArgument)
+	field.Options = []*FieldOption{{ID: 3, Name: "Todo"}, {ID: 3, Name: "Done"}}
+	assert.ErrorIs(t, UpdateField(t.Context(), field), util.ErrInvalidArgument)
+	assert.ErrorIs(t, NewField(t.Context(), &Field{
+		ProjectID: 1,
+		Name:      "Priority",
+		Type:      FieldTypeSingleSelect,
+		Options:   []*FieldOption{{ID: 7, Name: "High"}},
+	}), util.ErrInvalidArgument)
+
+	// an empty value removes the value
+	require.NoError(t, SetFieldValue(t.Context(), estimate, 1, ""))
+	require.NoError(t, DeleteField(t.Context(), field))
+	values, err = GetFieldValues(t.Context(), 1, []int64{1, 2})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+	unittest.AssertNotExistsBean(t, &Field{ID: field.ID})
+}
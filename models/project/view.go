@@ -0,0 +1,202 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ViewType is the layout of a saved view of a project, the board is the default view of every project
+type ViewType uint8
+
+const (
+	ViewTypeTable ViewType = iota + 1
+	ViewTypeRoadmap
+)
+
+var viewTypeNames = map[ViewType]string{
+	ViewTypeTable:   "table",
+	ViewTypeRoadmap: "roadmap",
+}
+
+func (t ViewType) String() string {
+	return viewTypeNames[t]
+}
+
+// IsValid checks if the view type is known
+func (t ViewType) IsValid() bool {
+	_, ok := viewTypeNames[t]
+	return ok
+}
+
+// ParseViewType returns the view type of a name, 0 is returned if the name is unknown
+func ParseViewType(name string) ViewType {
+	for t, n := range viewTypeNames {
+		if n == name {
+			return t
+		}
+	}
+	return 0
+}
+
+// ViewSortTypes are the orders of the issues of a view, a "-" prefix reverses the order
+var ViewSortTypes = []string{"column", "title", "assignee", "milestone", "deadline", "created", "updated"}
+
+// ViewGroupBys are the attributes the issues of a view can be grouped by
+var ViewGroupBys = []string{"assignee", "label", "milestone", "column"}
+
+// maxProjectViews max views allowed in a project
+const maxProjectViews = 20
+
+// View is a saved view of a project with its filter, sort and grouping
+type View struct {
+	ID        int64    `xorm:"pk autoincr"`
+	ProjectID int64    `xorm:"INDEX NOT NULL"`
+	Name      string   `xorm:"NOT NULL"`
+	Type      ViewType `xorm:"NOT NULL"`
+	Sorting   int      `xorm:"NOT NULL DEFAULT 0"`
+	CreatorID int64    `xorm:"NOT NULL"`
+
+	// Filter is the query string of the filters of the board, like "labels=1,2&assignee=3&field_4=1"
+	Filter   string `xorm:"TEXT"`
+	SortType string `xorm:"VARCHAR(20)"`
+	GroupBy  string `xorm:"VARCHAR(20)"`
+
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+	UpdatedUnix timeutil.TimeStamp `xorm:"updated"`
+}
+
+// TableName return the real table name
+func (View) TableName() string {
+	return "project_view"
+}
+
+func init() {
+	db.RegisterModel(new(View))
+}
+
+// ErrProjectViewNotExist represents a "ProjectViewNotExist" kind of error.
+type ErrProjectViewNotExist struct {
+	ViewID int64
+}
+
+// IsErrProjectViewNotExist checks if an error is a ErrProjectViewNotExist
+func IsErrProjectViewNotExist(err error) bool {
+	_, ok := err.(ErrProjectViewNotExist)
+	return ok
+}
+
+func (err ErrProjectViewNotExist) Error() string {
+	return fmt.Sprintf("project view does not exist [id: %d]", err.ViewID)
+}
+
+func (err ErrProjectViewNotExist) Unwrap() error {
+	return util.ErrNotExist
+}
+
+// IsValidViewSortType checks if a sort type is one of ViewSortTypes, optionally reversed
+func IsValidViewSortType(sortType string) bool {
+	return slices.Contains(ViewSortTypes, strings.TrimPrefix(sortType, "-"))
+}
+
+// Query returns the query string of the saved filter, sort and grouping of the view
+func (v *View) Query() string {
+	query, _ := url.ParseQuery(v.Filter)
+	if v.SortType != "" {
+		query.Set("sort", v.SortType)
+	}
+	if v.GroupBy != "" {
+		query.Set("group", v.GroupBy)
+	}
+	return query.Encode()
+}
+
+func (v *View) validate() error {
+	v.Name = strings.TrimSpace(v.Name)
+	if v.Name == "" {
+		return util.NewInvalidArgumentErrorf("view name is required")
+	}
+	if !v.Type.IsValid() {
+		return util.NewInvalidArgumentErrorf("unknown view type %d", v.Type)
+	}
+	if v.SortType != "" && !IsValidViewSortType(v.SortType) {
+		return util.NewInvalidArgumentErrorf("unknown sort type %q", v.SortType)
+	}
+	if v.GroupBy != "" && !slices.Contains(ViewGroupBys, v.GroupBy) {
+		return util.NewInvalidArgumentErrorf("unknown group %q", v.GroupBy)
+	}
+	return nil
+}
+
+// GetViewByID returns a saved view of a project
+func GetViewByID(ctx context.Context, projectID, viewID int64) (*View, error) {
+	view := new(View)
+	has, err := db.GetEngine(ctx).Where("project_id=? AND id=?", projectID, viewID).Get(view)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, ErrProjectViewNotExist{ViewID: viewID}
+	}
+	return view, nil
+}
+
+// GetViews returns the saved views of a project
+func GetViews(ctx context.Context, projectID int64) ([]*View, error) {
+	views := make([]*View, 0, 5)
+	return views, db.GetEngine(ctx).Where("project_id=?", projectID).OrderBy("sorting, id").Find(&views)
+}
+
+// NewView adds a saved view to a project
+func NewView(ctx context.Context, view *View) error {
+	if err := view.validate(); err != nil {
+		return err
+	}
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		count, err := db.GetEngine(ctx).Where("project_id=?", view.ProjectID).Count(new(View))
+		if err != nil {
+			return err
+		}
+		if count >= maxProjectViews {
+			return util.NewInvalidArgumentErrorf("a project can have at most %d views", maxProjectViews)
+		}
+		view.Sorting = int(count)
+		return db.Insert(ctx, view)
+	})
+}
+
+// UpdateView updates the name, the filter, the sort and the grouping of a saved view
+func UpdateView(ctx context.Context, view *View) error {
+	if err := view.validate(); err != nil {
+		return err
+	}
+	_, err := db.GetEngine(ctx).ID(view.ID).Cols("name", "filter", "sort_type", "group_by").Update(view)
+	return err
+}
+
+// DeleteView removes a saved view of a project
+func DeleteView(ctx context.Context, view *View) error {
+	_, err := db.GetEngine(ctx).ID(view.ID).Delete(new(View))
+	return err
+}
+
+func deleteViewsByProjectID(ctx context.Context, projectID int64) error {
+	_, err := db.GetEngine(ctx).Where("project_id=?", projectID).Delete(new(View))
+	return err
+}
+
+func deleteViewsByRepoID(ctx context.Context, repoID int64) error {
+	projectIDs := builder.Select("id").From("project").Where(builder.Eq{"repo_id": repoID})
+	_, err := db.GetEngine(ctx).Where(builder.In("project_id", projectIDs)).Delete(new(View))
+	return err
+}
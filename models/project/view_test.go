@@ -0,0 +1,57 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"testing"
+
+	"code.gitea.io/gitea/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestView_Query(t *testing.T) {
+	view := &View{Filter: "assignee=2&labels=1%2C3", SortType: "-deadline", GroupBy: "label"}
+	assert.Equal(t, "assignee=2&group=label&labels=1%2C3&sort=-deadline", view.Query())
+	assert.Empty(t, (&View{}).Query())
+}
+
+func TestViews(t *testing.T) {
+	assert.NoError(t, unittest.PrepareTestDatabase())
+
+	view := &View{ProjectID: 1, Name: " Planning ", Type: ViewTypeTable, Filter: "assignee=2", CreatorID: 2}
+	require.NoError(t, NewView(t.Context(), view))
+	assert.Equal(t, "Planning", view.Name)
+
+	roadmap := &View{ProjectID: 1, Name: "Roadmap", Type: ViewTypeRoadmap, CreatorID: 2}
+	require.NoError(t, NewView(t.Context(), roadmap))
+	assert.Equal(t, 1, roadmap.Sorting)
+
+	assert.Error(t, NewView(t.Context(), &View{ProjectID: 1, Name: "Unknown", CreatorID: 2}))
+	assert.Error(t, NewView(t.Context(), &View{ProjectID: 1, Name: "Sorted", Type: ViewTypeTable, SortType: "votes"}))
+
+	views, err := GetViews(t.Context(), 1)
+	require.NoError(t, err)
+	if assert.Len(t, views, 2) {
+		assert.Equal(t, view.ID, views[0].ID)
+		assert.Equal(t, roadmap.ID, views[1].ID)
+	}
+
+	view.SortType, view.GroupBy = "-title", "milestone"
+	require.NoError(t, UpdateView(t.Context(), view))
+	view, err = GetViewByID(t.Context(), 1, view.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "-title", view.SortType)
+	assert.Equal(t, "milestone", view.GroupBy)
+	view.GroupBy = "poster"
+	assert.Error(t, UpdateView(t.Context(), view))
+
+	_, err = GetViewByID(t.Context(), 2, view.ID)
+	assert.True(t, IsErrProjectViewNotExist(err))
+
+	require.NoError(t, DeleteView(t.Context(), roadmap))
+	require.NoError(t, DeleteProjectByID(t.Context(), 1))
+	unittest.AssertNotExistsBean(t, &View{ProjectID: 1})
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pull
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+)
+
+// Stack links a pull request to the parent pull request it is stacked on.
+// The base branch of a stacked pull request is the head branch of its parent,
+// it is retargeted to the base branch of the parent when the parent is merged.
+type Stack struct {
+	ID           int64              `xorm:"pk autoincr"`
+	PullID       int64              `xorm:"UNIQUE"`
+	ParentPullID int64              `xorm:"INDEX NOT NULL"`
+	DoerID       int64              `xorm:"INDEX NOT NULL"`
+	AutoRebase   bool               `xorm:"NOT NULL DEFAULT false"`
+	CreatedUnix  timeutil.TimeStamp `xorm:"created"`
+}
+
+// TableName return database table name for xorm
+func (Stack) TableName() string {
+	return "pull_stack"
+}
+
+func init() {
+	db.RegisterModel(new(Stack))
+}
+
+// SetStackParent stacks a pull request on the parent pull request, an existing parent is replaced
+func SetStackParent(ctx context.Context, doerID, pullID, parentPullID int64, autoRebase bool) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := db.GetEngine(ctx).Where("pull_id = ?", pullID).Delete(&Stack{}); err != nil {
+			return err
+		}
+		return db.Insert(ctx, &Stack{
+			PullID:       pullID,
+			ParentPullID: parentPullID,
+			DoerID:       doerID,
+			AutoRebase:   autoRebase,
+		})
+	})
+}
+
+// GetStackParent returns the link of a pull request to its parent pull request
+func GetStackParent(ctx context.Context, pullID int64) (bool, *Stack, error) {
+	stack := &Stack{}
+	exists, err := db.GetEngine(ctx).Where("pull_id = ?", pullID).Get(stack)
+	if err != nil || !exists {
+		return false, nil, err
+	}
+	return true, stack, nil
+}
+
+// GetStackChildren returns the links of the pull requests which are stacked on the parent pull request
+func GetStackChildren(ctx context.Context, parentPullID int64) ([]*Stack, error) {
+	stacks := make([]*Stack, 0, 2)
+	return stacks, db.GetEngine(ctx).Where("parent_pull_id = ?", parentPullID).OrderBy("pull_id").Find(&stacks)
+}
+
+// RemoveStackParent unstacks a pull request from its parent
+func RemoveStackParent(ctx context.Context, pullID int64) error {
+	_, err := db.GetEngine(ctx).Where("pull_id = ?", pullID).Delete(&Stack{})
+	return err
+}
+
+// ReplaceStackParent moves the children of a parent pull request to a new parent,
+// they become the bottom of their stacks if the new parent ID is zero
+func ReplaceStackParent(ctx context.Context, parentPullID, newParentPullID int64) error {
+	if newParentPullID == 0 {
+		_, err := db.GetEngine(ctx).Where("parent_pull_id = ?", parentPullID).Delete(&Stack{})
+		return err
+	}
+	_, err := db.GetEngine(ctx).Where("parent_pull_id = ?", parentPullID).Cols("parent_pull_id").Update(&Stack{ParentPullID: newParentPullID})
+	return err
+}
@@ -58,3 +58,12 @@ func (repo *Repository) IsDependenciesEnabled(ctx context.Context) bool {
 	}
 	return u.IssuesConfig().EnableDependencies
 }
+
+// CloseParentWhenSubIssuesClosed returns if the issues of the repository are closed when all their sub-issues are closed
+func (repo *Repository) CloseParentWhenSubIssuesClosed(ctx context.Context) bool {
+	u, err := repo.GetUnit(ctx, unit.TypeIssues)
+	if err != nil {
+		return false
+	}
+	return u.IssuesConfig().CloseParentWhenSubIssuesClosed
+}
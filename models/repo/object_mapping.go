@@ -0,0 +1,102 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"xorm.io/builder"
+)
+
+// ObjectMapping records the new ID of a commit or a tag after the repository has been converted to another object format
+type ObjectMapping struct {
+	ID          int64              `xorm:"pk autoincr"`
+	RepoID      int64              `xorm:"UNIQUE(s) NOT NULL"`
+	OldID       string             `xorm:"VARCHAR(64) UNIQUE(s) NOT NULL"`
+	NewID       string             `xorm:"VARCHAR(64) NOT NULL"`
+	CreatedUnix timeutil.TimeStamp `xorm:"created"`
+}
+
+// TableName represents real table name in database
+func (ObjectMapping) TableName() string {
+	return "repo_object_mapping"
+}
+
+func init() {
+	db.RegisterModel(new(ObjectMapping))
+}
+
+// InsertObjectMappings stores the old to new object IDs of a converted repository.
+// Mappings which were recorded by a previous conversion for the same old IDs are replaced.
+func InsertObjectMappings(ctx context.Context, repoID int64, mapping map[string]string) error {
+	const batchSize = 100
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		oldIDs := make([]string, 0, batchSize)
+		beans := make([]*ObjectMapping, 0, batchSize)
+		flush := func() error {
+			if len(beans) == 0 {
+				return nil
+			}
+			if _, err := db.GetEngine(ctx).Where("repo_id = ?", repoID).In("old_id", oldIDs).Delete(&ObjectMapping{}); err != nil {
+				return err
+			}
+			if _, err := db.GetEngine(ctx).Insert(beans); err != nil {
+				return err
+			}
+			oldIDs, beans = oldIDs[:0], beans[:0]
+			return nil
+		}
+		for oldID, newID := range mapping {
+			oldIDs = append(oldIDs, oldID)
+			beans = append(beans, &ObjectMapping{RepoID: repoID, OldID: oldID, NewID: newID})
+			if len(beans) == batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return flush()
+	})
+}
+
+// GetConvertedObjectID returns the ID which the given commit or tag ID got when the repository was converted to its current object format.
+// The ID can be abbreviated, mappings of repeated conversions are followed until an ID of the wanted length is found.
+func GetConvertedObjectID(ctx context.Context, repoID int64, oldID string, newIDLength int) (string, error) {
+	id := oldID
+	for range 3 {
+		mappings := make([]*ObjectMapping, 0, 2)
+		sess := db.GetEngine(ctx).Where("repo_id = ?", repoID)
+		if len(id) < 40 { // shorter than any full object ID, so it is abbreviated
+			sess.And(builder.Like{"old_id", id + "%"})
+		} else {
+			sess.And("old_id = ?", id)
+		}
+		if err := sess.Limit(2).Find(&mappings); err != nil {
+			return "", err
+		}
+		if len(mappings) != 1 {
+			break
+		}
+		id = mappings[0].NewID
+		if len(id) == newIDLength {
+			return id, nil
+		}
+	}
+	return "", util.NewNotExistErrorf("no converted object for %s in repository %d", oldID, repoID)
+}
+
+// UpdateObjectIDsByMapping replaces the old object IDs in a column with the new ones recorded for the repository.
+// The scope condition selects the rows which belong to the repository and gets the repository ID as its only argument.
+func UpdateObjectIDsByMapping(ctx context.Context, repoID int64, table, column, scopeCond string) error {
+	_, err := db.GetEngine(ctx).Exec(fmt.Sprintf("UPDATE `%[1]s` SET `%[2]s` = "+
+		"(SELECT new_id FROM repo_object_mapping WHERE repo_object_mapping.repo_id = ? AND repo_object_mapping.old_id = `%[1]s`.`%[2]s`) "+
+		"WHERE %[3]s AND `%[2]s` IN (SELECT old_id FROM repo_object_mapping WHERE repo_object_mapping.repo_id = ?)", table, column, scopeCond),
+		repoID, repoID, repoID)
+	return err
+}
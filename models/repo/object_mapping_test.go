@@ -0,0 +1,39 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo_test
+
+import (
+	"testing"
+
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConvertedObjectID(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	sha1ID := "65f1bf27bc3bf70f64657658635e66094edbcb4d"
+	sha256ID := "4ce2f8b3e1f7e0ac1e5d1e9c3a3f0d1d4ff4d7e0c9b1a8e2f3d4c5b6a7980f1e"
+	require.NoError(t, repo_model.InsertObjectMappings(t.Context(), 1, map[string]string{sha1ID: sha256ID}))
+
+	newID, err := repo_model.GetConvertedObjectID(t.Context(), 1, sha1ID, 64)
+	require.NoError(t, err)
+	assert.Equal(t, sha256ID, newID)
+
+	newID, err = repo_model.GetConvertedObjectID(t.Context(), 1, sha1ID[:10], 64)
+	require.NoError(t, err)
+	assert.Equal(t, sha256ID, newID)
+
+	_, err = repo_model.GetConvertedObjectID(t.Context(), 2, sha1ID, 64)
+	assert.Error(t, err)
+
+	// converting back records a new mapping for the same repository
+	require.NoError(t, repo_model.InsertObjectMappings(t.Context(), 1, map[string]string{sha256ID: sha1ID}))
+	newID, err = repo_model.GetConvertedObjectID(t.Context(), 1, sha256ID, 40)
+	require.NoError(t, err)
+	assert.Equal(t, sha1ID, newID)
+}
@@ -105,6 +105,7 @@ type IssuesConfig struct {
 	EnableTimetracker                bool
 	AllowOnlyContributorsToTrackTime bool
 	EnableDependencies               bool
+	CloseParentWhenSubIssuesClosed   bool
 }
 
 // FromDB fills up a IssuesConfig from serialized format.
@@ -168,11 +169,42 @@ func (cfg *PullRequestsConfig) GetDefaultMergeStyle() MergeStyle {
 	return MergeStyleMerge
 }
 
+// ActionsApprovalPolicy represents which workflow runs need the approval of a maintainer before running
+type ActionsApprovalPolicy string
+
+const (
+	// ActionsApprovalFirstTimeContributors requires approval for fork pull requests of contributors who haven't been approved before
+	ActionsApprovalFirstTimeContributors ActionsApprovalPolicy = "first_time_contributors"
+	// ActionsApprovalForks requires approval for all fork pull requests of users without write access
+	ActionsApprovalForks ActionsApprovalPolicy = "forks"
+	// ActionsApprovalOutsideCollaborators requires approval for all runs triggered by users without write access
+	ActionsApprovalOutsideCollaborators ActionsApprovalPolicy = "outside_collaborators"
+)
+
+// IsValid returns whether the policy is a known one
+func (p ActionsApprovalPolicy) IsValid() bool {
+	switch p {
+	case ActionsApprovalFirstTimeContributors, ActionsApprovalForks, ActionsApprovalOutsideCollaborators:
+		return true
+	}
+	return false
+}
+
 type ActionsConfig struct {
 	DisabledWorkflows []string
 	// CollaborativeOwnerIDs is a list of owner IDs used to share actions from private repos.
 	// Only workflows from the private repos whose owners are in CollaborativeOwnerIDs can access the current repo's actions.
 	CollaborativeOwnerIDs []int64
+	// ApprovalPolicy is empty for the repositories which haven't changed it, use GetApprovalPolicy to read it
+	ApprovalPolicy ActionsApprovalPolicy `json:",omitempty"`
+}
+
+// GetApprovalPolicy returns the approval policy of workflow runs, defaults to ActionsApprovalFirstTimeContributors
+func (cfg *ActionsConfig) GetApprovalPolicy() ActionsApprovalPolicy {
+	if cfg.ApprovalPolicy.IsValid() {
+		return cfg.ApprovalPolicy
+	}
+	return ActionsApprovalFirstTimeContributors
 }
 
 func (cfg *ActionsConfig) EnableWorkflow(file string) {
@@ -0,0 +1,69 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package secret
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"xorm.io/builder"
+)
+
+// AccessLog records an access of an actions task to a secret referencing an external secret manager.
+// The values of the secrets are never recorded.
+type AccessLog struct {
+	ID          int64
+	SecretID    int64              `xorm:"INDEX NOT NULL"`
+	OwnerID     int64              `xorm:"INDEX NOT NULL DEFAULT 0"` // the owner of the secret, see Secret
+	RepoID      int64              `xorm:"INDEX NOT NULL DEFAULT 0"` // the repository of the secret, see Secret
+	SecretName  string             `xorm:"NOT NULL"`
+	Reference   string             `xorm:"TEXT"` // like "vault://path#key"
+	TaskID      int64              `xorm:"INDEX NOT NULL DEFAULT 0"`
+	TaskRepoID  int64              `xorm:"NOT NULL DEFAULT 0"` // the repository running the task
+	Cached      bool               `xorm:"NOT NULL DEFAULT false"`
+	Error       string             `xorm:"TEXT"` // empty if the reference has been resolved
+	CreatedUnix timeutil.TimeStamp `xorm:"created INDEX NOT NULL"`
+}
+
+func (AccessLog) TableName() string {
+	return "secret_access_log"
+}
+
+func init() {
+	db.RegisterModel(new(AccessLog))
+}
+
+// InsertAccessLog records an access to a secret
+func InsertAccessLog(ctx context.Context, l *AccessLog) error {
+	return db.Insert(ctx, l)
+}
+
+// FindAccessLogsOptions are the options to find the access logs of the secrets of an owner or a repository
+type FindAccessLogsOptions struct {
+	db.ListOptions
+	RepoID  int64
+	OwnerID int64 // it will be ignored if RepoID is set
+}
+
+func (opts FindAccessLogsOptions) ToConds() builder.Cond {
+	cond := builder.NewCond()
+	cond = cond.And(builder.Eq{"repo_id": opts.RepoID})
+	if opts.RepoID != 0 {
+		cond = cond.And(builder.Eq{"owner_id": 0})
+	} else {
+		cond = cond.And(builder.Eq{"owner_id": opts.OwnerID})
+	}
+	return cond
+}
+
+func (opts FindAccessLogsOptions) ToOrders() string {
+	return "id DESC"
+}
+
+// DeleteAccessLogsBefore deletes the access logs recorded before the given time
+func DeleteAccessLogsBefore(ctx context.Context, before timeutil.TimeStamp) (int64, error) {
+	return db.GetEngine(ctx).Where(builder.Lt{"created_unix": before}).Delete(new(AccessLog))
+}
@@ -62,6 +62,20 @@ func (err ErrSecretNotFound) Unwrap() error {
 	return util.ErrNotExist
 }
 
+// ErrSecretNotResolved represents a secret of a task whose value can't be resolved
+type ErrSecretNotResolved struct {
+	Name string
+	Err  error
+}
+
+func (err ErrSecretNotResolved) Error() string {
+	return fmt.Sprintf("secret can't be resolved [name: %s]: %v", err.Name, err.Err)
+}
+
+func (err ErrSecretNotResolved) Unwrap() error {
+	return err.Err
+}
+
 // InsertEncryptedSecret Creates, encrypts, and validates a new secret with yet unencrypted data and insert into database
 func InsertEncryptedSecret(ctx context.Context, ownerID, repoID int64, name, data, description string) (*Secret, error) {
 	if ownerID != 0 && repoID != 0 {
@@ -151,7 +165,13 @@ func UpdateSecret(ctx context.Context, secretID int64, data, description string)
 	return err
 }
 
-func GetSecretsOfTask(ctx context.Context, task *actions_model.ActionTask) (map[string]string, error) {
+// Resolver resolves the decrypted value of a secret which is going to be used by a task,
+// e.g. the value of a secret can reference a secret of an external secret manager
+type Resolver func(ctx context.Context, secret *Secret, value string) (string, error)
+
+// GetSecretsOfTask returns the secrets the task can access, the values are resolved by the resolver if it isn't nil.
+// It returns an ErrSecretNotResolved if a secret can't be resolved, the task shouldn't run without it.
+func GetSecretsOfTask(ctx context.Context, task *actions_model.ActionTask, resolve Resolver) (map[string]string, error) {
 	secrets := map[string]string{}
 
 	secrets["GITHUB_TOKEN"] = task.Token
@@ -181,6 +201,11 @@ func GetSecretsOfTask(ctx context.Context, task *actions_model.ActionTask) (map[
 			log.Error("Unable to decrypt Actions secret %v %q, maybe SECRET_KEY is wrong: %v", secret.ID, secret.Name, err)
 			continue
 		}
+		if resolve != nil {
+			if v, err = resolve(ctx, secret, v); err != nil {
+				return nil, ErrSecretNotResolved{Name: secret.Name, Err: err}
+			}
+		}
 		secrets[secret.Name] = v
 	}
 
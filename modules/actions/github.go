@@ -23,6 +23,8 @@ const (
 	GithubEventPullRequestComment       = "pull_request_comment"
 	GithubEventGollum                   = "gollum"
 	GithubEventSchedule                 = "schedule"
+	GithubEventProject                  = "project"
+	GithubEventProjectCard              = "project_card"
 )
 
 // IsDefaultBranchWorkflow returns true if the event only triggers workflows on the default branch
@@ -59,6 +61,11 @@ func IsDefaultBranchWorkflow(triggedEvent webhook_module.HookEventType) bool {
 		// Github "issues" event
 		// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#issues
 		return true
+	case webhook_module.HookEventProject,
+		webhook_module.HookEventProjectCard:
+		// GitHub "project" and "project_card" events
+		// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#project
+		return true
 	}
 
 	return false
@@ -114,6 +121,12 @@ func canGithubEventMatch(eventName string, triggedEvent webhook_module.HookEvent
 	case GithubEventSchedule:
 		return triggedEvent == webhook_module.HookEventSchedule
 
+	case GithubEventProject:
+		return triggedEvent == webhook_module.HookEventProject
+
+	case GithubEventProjectCard:
+		return triggedEvent == webhook_module.HookEventProjectCard
+
 	case GithubEventIssueComment:
 		// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#pull_request_comment-use-issue_comment
 		return triggedEvent == webhook_module.HookEventIssueComment ||
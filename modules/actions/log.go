@@ -202,6 +202,41 @@ func OpenLogs(ctx context.Context, inStorage bool, filename string) (io.ReadSeek
 	return reader, nil
 }
 
+// LogMatch is a line of a log matching a search
+type LogMatch struct {
+	Index   int64 // index of the line in the log, starting at 0
+	Content string
+}
+
+// SearchLogs returns the lines of the log containing the keyword case-insensitively, at most limit lines.
+// It also returns whether there are more matches than the limit.
+func SearchLogs(r io.Reader, keyword string, limit int) ([]*LogMatch, bool, error) {
+	keyword = strings.ToLower(keyword)
+	scanner := bufio.NewScanner(r)
+	maxLineSize := len(timeFormat) + MaxLineSize + 1
+	scanner.Buffer(make([]byte, maxLineSize), maxLineSize)
+
+	var matches []*LogMatch
+	for index := int64(0); scanner.Scan(); index++ {
+		_, content, err := ParseLog(scanner.Text())
+		if err != nil {
+			// a broken line shouldn't hide the matches of the other lines
+			continue
+		}
+		if !strings.Contains(strings.ToLower(content), keyword) {
+			continue
+		}
+		if len(matches) >= limit {
+			return matches, true, nil
+		}
+		matches = append(matches, &LogMatch{Index: index, Content: content})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("SearchLogs scan: %w", err)
+	}
+	return matches, false, nil
+}
+
 func FormatLog(timestamp time.Time, content string) string {
 	// Content shouldn't contain new line, it will break log indexes, other control chars are safe.
 	content = strings.ReplaceAll(content, "\n", `\n`)
@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchLogs(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var sb strings.Builder
+	for _, line := range []string{"go build ./...", "ERROR: build failed", "go test ./...", "--- FAIL: TestFoo", "exit status 1: error"} {
+		sb.WriteString(FormatLog(now, line) + "\n")
+	}
+
+	matches, more, err := SearchLogs(strings.NewReader(sb.String()), "Error", 10)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, []*LogMatch{
+		{Index: 1, Content: "ERROR: build failed"},
+		{Index: 4, Content: "exit status 1: error"},
+	}, matches)
+
+	matches, more, err = SearchLogs(strings.NewReader(sb.String()), "go ", 1)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, []*LogMatch{{Index: 0, Content: "go build ./..."}}, matches)
+
+	matches, more, err = SearchLogs(strings.NewReader(sb.String()), "nothing", 10)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Empty(t, matches)
+
+	// the lines which can't be parsed are skipped, but they still count for the indexes
+	matches, more, err = SearchLogs(strings.NewReader("invalid error\n"+FormatLog(now, "error")+"\n"), "error", 10)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, []*LogMatch{{Index: 1, Content: "error"}}, matches)
+}
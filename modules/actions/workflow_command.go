@@ -0,0 +1,107 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"strconv"
+	"strings"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/modules/util"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+)
+
+// StepSummaryCommand is the workflow command used by the runners to report the markdown written to $GITHUB_STEP_SUMMARY by a step,
+// the data is escaped like the data of the other workflow commands and the summary could be reported in several lines.
+const StepSummaryCommand = "step-summary"
+
+// WorkflowCommand represents a workflow command printed in a log line, like "::error file=app.go,line=1::message"
+type WorkflowCommand struct {
+	Name       string
+	Properties map[string]string
+	Data       string
+}
+
+var (
+	dataUnescaper     = strings.NewReplacer("%0D", "\r", "%0A", "\n", "%25", "%")
+	propertyUnescaper = strings.NewReplacer("%0D", "\r", "%0A", "\n", "%3A", ":", "%2C", ",", "%25", "%")
+)
+
+// ParseWorkflowCommand parses a log line printing a workflow command, the properties and the data are unescaped
+func ParseWorkflowCommand(line string) (*WorkflowCommand, bool) {
+	line = strings.TrimRight(line, "\r\n")
+	rest, ok := strings.CutPrefix(line, "::")
+	if !ok {
+		return nil, false
+	}
+	command, data, ok := strings.Cut(rest, "::")
+	if !ok || command == "" {
+		return nil, false
+	}
+	name, properties, _ := strings.Cut(command, " ")
+	if name == "" {
+		return nil, false
+	}
+
+	cmd := &WorkflowCommand{
+		Name:       name,
+		Properties: map[string]string{},
+		Data:       dataUnescaper.Replace(data),
+	}
+	for property := range strings.SplitSeq(properties, ",") {
+		key, value, ok := strings.Cut(property, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			continue
+		}
+		cmd.Properties[strings.TrimSpace(key)] = propertyUnescaper.Replace(value)
+	}
+	return cmd, true
+}
+
+// ParseAnnotations returns the annotations and the step summaries reported by the workflow commands in the log rows,
+// startIndex is the index of the first row in the log of the task.
+func ParseAnnotations(rows []*runnerv1.LogRow, startIndex int64) (annotations []*actions_model.ActionTaskAnnotation, summaries []*actions_model.ActionTaskSummary) {
+	for i, row := range rows {
+		cmd, ok := ParseWorkflowCommand(row.Content)
+		if !ok {
+			continue
+		}
+		logIndex := startIndex + int64(i)
+		if cmd.Name == StepSummaryCommand {
+			if cmd.Data != "" {
+				summaries = append(summaries, &actions_model.ActionTaskSummary{LogIndex: logIndex, Content: cmd.Data})
+			}
+			continue
+		}
+		level := actions_model.ParseAnnotationLevel(cmd.Name)
+		if level == 0 {
+			continue
+		}
+		annotation := &actions_model.ActionTaskAnnotation{
+			LogIndex: logIndex,
+			Level:    level,
+			Title:    util.TruncateRunes(cmd.Properties["title"], 255),
+			Message:  cmd.Data,
+			File:     util.TruncateRunes(strings.TrimPrefix(cmd.Properties["file"], "./"), 255),
+			Line:     atoi(cmd.Properties["line"]),
+			EndLine:  atoi(cmd.Properties["endLine"]),
+			Col:      atoi(cmd.Properties["col"]),
+			EndCol:   atoi(cmd.Properties["endColumn"]),
+		}
+		if annotation.EndLine < annotation.Line {
+			annotation.EndLine = annotation.Line
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations, summaries
+}
+
+func atoi(s string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
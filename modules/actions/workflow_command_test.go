@@ -0,0 +1,66 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWorkflowCommand(t *testing.T) {
+	cases := []struct {
+		line string
+		cmd  *WorkflowCommand
+	}{
+		{
+			line: "::error file=app.go,line=10,col=5,title=Build%3A failed::undefined: foo%0Asecond line",
+			cmd: &WorkflowCommand{
+				Name:       "error",
+				Properties: map[string]string{"file": "app.go", "line": "10", "col": "5", "title": "Build: failed"},
+				Data:       "undefined: foo\nsecond line",
+			},
+		},
+		{
+			line: "::warning::100%25 deprecated\r\n",
+			cmd:  &WorkflowCommand{Name: "warning", Properties: map[string]string{}, Data: "100% deprecated"},
+		},
+		{
+			line: "::step-summary::# Title%0A%0A| a | b |",
+			cmd:  &WorkflowCommand{Name: "step-summary", Properties: map[string]string{}, Data: "# Title\n\n| a | b |"},
+		},
+		{line: "error::not a command"},
+		{line: "::::empty"},
+		{line: "::error without data"},
+		{line: "  ::error::indented"},
+	}
+	for _, c := range cases {
+		cmd, ok := ParseWorkflowCommand(c.line)
+		assert.Equal(t, c.cmd != nil, ok, c.line)
+		assert.Equal(t, c.cmd, cmd, c.line)
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	rows := []*runnerv1.LogRow{
+		{Content: "building"},
+		{Content: "::error file=./cmd/main.go,line=3,endLine=1::syntax error"},
+		{Content: "::group::tests"},
+		{Content: "::notice title=Coverage::80%25"},
+		{Content: "::step-summary::## Result"},
+		{Content: "::step-summary::"},
+		{Content: "::debug::hidden"},
+	}
+	annotations, summaries := ParseAnnotations(rows, 10)
+	assert.Equal(t, []*actions_model.ActionTaskAnnotation{
+		{LogIndex: 11, Level: actions_model.AnnotationLevelError, Message: "syntax error", File: "cmd/main.go", Line: 3, EndLine: 3},
+		{LogIndex: 13, Level: actions_model.AnnotationLevelNotice, Title: "Coverage", Message: "80%"},
+	}, annotations)
+	assert.Equal(t, []*actions_model.ActionTaskSummary{
+		{LogIndex: 14, Content: "## Result"},
+	}, summaries)
+}
@@ -25,6 +25,8 @@ type DetectedWorkflow struct {
 	EntryName    string
 	TriggerEvent *jobparser.Event
 	Content      []byte
+	// RequiredWorkflowID is the org required workflow which the workflow comes from, it is 0 for the workflows of the repository
+	RequiredWorkflowID int64
 }
 
 func init() {
@@ -149,6 +151,34 @@ func DetectWorkflows(
 	return workflows, schedules, nil
 }
 
+// DetectWorkflowFromContent detects the events of the workflow content matching the triggered event,
+// it is used for the workflows which aren't in the commit, like the required workflows of an organization.
+// The schedules of the workflow are ignored.
+func DetectWorkflowFromContent(
+	gitRepo *git.Repository,
+	commit *git.Commit,
+	entryName string,
+	content []byte,
+	triggedEvent webhook_module.HookEventType,
+	payload api.Payloader,
+) ([]*DetectedWorkflow, error) {
+	events, err := GetEventsFromContent(content)
+	if err != nil {
+		return nil, err
+	}
+	var workflows []*DetectedWorkflow
+	for _, evt := range events {
+		if !evt.IsSchedule() && detectMatched(gitRepo, commit, triggedEvent, payload, evt) {
+			workflows = append(workflows, &DetectedWorkflow{
+				EntryName:    entryName,
+				TriggerEvent: evt,
+				Content:      content,
+			})
+		}
+	}
+	return workflows, nil
+}
+
 func DetectScheduledWorkflows(gitRepo *git.Repository, commit *git.Commit) ([]*DetectedWorkflow, error) {
 	_, entries, err := ListWorkflows(commit)
 	if err != nil {
@@ -250,6 +280,14 @@ func detectMatched(gitRepo *git.Repository, commit *git.Commit, triggedEvent web
 		webhook_module.HookEventWorkflowRun:
 		return matchWorkflowRunEvent(payload.(*api.WorkflowRunPayload), evt)
 
+	case // project
+		webhook_module.HookEventProject:
+		return matchProjectEvent(string(payload.(*api.ProjectPayload).Action), evt)
+
+	case // project_card
+		webhook_module.HookEventProjectCard:
+		return matchProjectEvent(string(payload.(*api.ProjectCardPayload).Action), evt)
+
 	default:
 		log.Warn("unsupported event %q", triggedEvent)
 		return false
@@ -704,6 +742,34 @@ func matchPackageEvent(payload *api.PackagePayload, evt *jobparser.Event) bool {
 	return matchTimes == len(evt.Acts())
 }
 
+func matchProjectEvent(action string, evt *jobparser.Event) bool {
+	// with no special filter parameters
+	if len(evt.Acts()) == 0 {
+		return true
+	}
+
+	matchTimes := 0
+	// all acts conditions should be satisfied
+	for cond, vals := range evt.Acts() {
+		switch cond {
+		case "types":
+			// See https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#project
+			// and https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#project_card
+			// Unsupported activity types:
+			// converted (project_card)
+			for _, val := range vals {
+				if glob.MustCompile(val, '/').Match(action) {
+					matchTimes++
+					break
+				}
+			}
+		default:
+			log.Warn("project event unsupported condition %q", cond)
+		}
+	}
+	return matchTimes == len(evt.Acts())
+}
+
 func matchWorkflowRunEvent(payload *api.WorkflowRunPayload, evt *jobparser.Event) bool {
 	// with no special filter parameters
 	if len(evt.Acts()) == 0 {
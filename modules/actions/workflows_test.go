@@ -111,6 +111,20 @@ func TestDetectMatched(t *testing.T) {
 			yamlOn:       "on:\n  registry_package:\n    types: [updated]",
 			expected:     false,
 		},
+		{
+			desc:         "HookEventProject(project) `closed` action matches GithubEventProject(project) with `closed` activity type",
+			triggedEvent: webhook_module.HookEventProject,
+			payload:      &api.ProjectPayload{Action: api.HookProjectClosed},
+			yamlOn:       "on:\n  project:\n    types: [closed]",
+			expected:     true,
+		},
+		{
+			desc:         "HookEventProjectCard(project_card) `moved` action doesn't match GithubEventProjectCard(project_card) with `created` activity type",
+			triggedEvent: webhook_module.HookEventProjectCard,
+			payload:      &api.ProjectCardPayload{Action: api.HookProjectCardMoved},
+			yamlOn:       "on:\n  project_card:\n    types: [created]",
+			expected:     false,
+		},
 		{
 			desc:         "HookEventWiki(wiki) matches GithubEventGollum(gollum)",
 			triggedEvent: webhook_module.HookEventWiki,
@@ -13,6 +13,9 @@ type Manager struct {
 
 	messengers map[int64]*Messenger
 	connection chan struct{}
+
+	// topics are the messengers of the listeners interested in a topic rather than a user, like the logs of an actions run
+	topics map[string]*Messenger
 }
 
 var manager *Manager
@@ -21,6 +24,7 @@ func init() {
 	manager = &Manager{
 		messengers: make(map[int64]*Messenger),
 		connection: make(chan struct{}, 1),
+		topics:     make(map[string]*Messenger),
 	}
 }
 
@@ -66,6 +70,10 @@ func (m *Manager) UnregisterAll() {
 		messenger.UnregisterAll()
 	}
 	m.messengers = map[int64]*Messenger{}
+	for _, messenger := range m.topics {
+		messenger.UnregisterAll()
+	}
+	m.topics = map[string]*Messenger{}
 }
 
 // SendMessage sends a message to a particular user
@@ -87,3 +95,38 @@ func (m *Manager) SendMessageBlocking(uid int64, message *Event) {
 		messenger.SendMessageBlocking(message)
 	}
 }
+
+// RegisterTopic registers a message channel for a topic
+func (m *Manager) RegisterTopic(topic string) <-chan *Event {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	messenger, ok := m.topics[topic]
+	if !ok {
+		messenger = NewMessenger(0)
+		m.topics[topic] = messenger
+	}
+	return messenger.Register()
+}
+
+// UnregisterTopic unregisters a message channel of a topic
+func (m *Manager) UnregisterTopic(topic string, channel <-chan *Event) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	messenger, ok := m.topics[topic]
+	if !ok {
+		return
+	}
+	if messenger.Unregister(channel) {
+		delete(m.topics, topic)
+	}
+}
+
+// SendTopicMessage sends a message to the listeners of a topic, the message is dropped for the listeners which haven't received the previous one
+func (m *Manager) SendTopicMessage(topic string, message *Event) {
+	m.mutex.Lock()
+	messenger, ok := m.topics[topic]
+	m.mutex.Unlock()
+	if ok {
+		messenger.SendMessage(message)
+	}
+}
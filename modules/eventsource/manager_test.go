@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package eventsource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagerTopic(t *testing.T) {
+	m := &Manager{
+		messengers: make(map[int64]*Messenger),
+		connection: make(chan struct{}, 1),
+		topics:     make(map[string]*Messenger),
+	}
+
+	ch1 := m.RegisterTopic("topic-1")
+	ch2 := m.RegisterTopic("topic-1")
+	ch3 := m.RegisterTopic("topic-2")
+
+	m.SendTopicMessage("topic-1", &Event{Name: "update"})
+	assert.Equal(t, "update", (<-ch1).Name)
+	assert.Equal(t, "update", (<-ch2).Name)
+	assert.Empty(t, ch3)
+
+	// the message is dropped if the previous one hasn't been received
+	m.SendTopicMessage("topic-2", &Event{Name: "first"})
+	m.SendTopicMessage("topic-2", &Event{Name: "second"})
+	assert.Equal(t, "first", (<-ch3).Name)
+	assert.Empty(t, ch3)
+
+	m.UnregisterTopic("topic-1", ch1)
+	_, ok := <-ch1
+	assert.False(t, ok)
+	assert.Contains(t, m.topics, "topic-1")
+	m.UnregisterTopic("topic-1", ch2)
+	assert.NotContains(t, m.topics, "topic-1")
+
+	m.UnregisterAll()
+	_, ok = <-ch3
+	assert.False(t, ok)
+	assert.Empty(t, m.topics)
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/git/gitcmd"
+)
+
+// ConvertRepositoryObjectFormat rewrites all refs of the bare repository at srcPath into a new bare repository
+// at dstPath which uses the target object format. The history is replayed with "git fast-export | git fast-import",
+// so commit and tag signatures can't survive the rewrite and are stripped. Notes are re-attached to the rewritten objects.
+// It returns the mapping from the old commit and tag IDs to the new ones.
+func ConvertRepositoryObjectFormat(ctx context.Context, srcPath, dstPath string, target ObjectFormat) (map[string]string, error) {
+	if err := InitRepository(ctx, dstPath, true, target.Name()); err != nil {
+		return nil, fmt.Errorf("InitRepository: %w", err)
+	}
+
+	exportMarks := filepath.Join(dstPath, "gitea-export-marks")
+	importMarks := filepath.Join(dstPath, "gitea-import-marks")
+	defer func() {
+		_ = os.Remove(exportMarks)
+		_ = os.Remove(importMarks)
+	}()
+
+	objects, err := replayRepository(ctx, srcPath, dstPath, exportMarks, importMarks)
+	if err != nil {
+		return nil, err
+	}
+	if err := finishConvertedRepository(ctx, srcPath, dstPath, objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// SyncConvertedRepository replays the objects of the bare repository at srcPath which haven't been replayed yet
+// into the bare repository at dstPath, which uses another object format. The marks of the replayed objects are kept
+// in both repositories, so only the new commits are replayed. The refs of dstPath are updated to the ones of srcPath,
+// including forced updates and deletions. It returns the mapping of the commits and tags replayed by this call.
+func SyncConvertedRepository(ctx context.Context, srcPath, dstPath string) (map[string]string, error) {
+	exportMarks := filepath.Join(srcPath, "gitea-export-marks")
+	importMarks := filepath.Join(dstPath, "gitea-import-marks")
+
+	replayed, err := readFastExportMarks(exportMarks)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	objects, err := replayRepository(ctx, srcPath, dstPath, exportMarks, importMarks)
+	if err != nil {
+		return nil, err
+	}
+	if err := pruneConvertedRefs(ctx, srcPath, dstPath); err != nil {
+		return nil, err
+	}
+	if err := finishConvertedRepository(ctx, srcPath, dstPath, objects); err != nil {
+		return nil, err
+	}
+
+	oldIDs := make(container.Set[string], len(replayed))
+	for _, oldID := range replayed {
+		oldIDs.Add(oldID)
+	}
+	newObjects := make(map[string]string)
+	for oldID, newID := range objects {
+		if !oldIDs.Contains(oldID) {
+			newObjects[oldID] = newID
+		}
+	}
+	return newObjects, nil
+}
+
+// replayRepository pipes "git fast-export" of srcPath into "git fast-import" of dstPath. The marks files are read
+// if they exist and are written back, so the objects which have been replayed before are skipped.
+// It returns the mapping of all the commits and tags recorded in the marks files.
+func replayRepository(ctx context.Context, srcPath, dstPath, exportMarks, importMarks string) (map[string]string, error) {
+	exportCmd := gitcmd.NewCommand("fast-export", "--all", "--signed-tags=strip", "--reencode=no", "--mark-tags").
+		AddOptionFormat("--import-marks-if-exists=%s", exportMarks).
+		AddOptionFormat("--export-marks=%s", exportMarks)
+	stdoutReader, stdoutReaderClose := exportCmd.MakeStdoutPipe()
+	defer stdoutReaderClose()
+	if err := exportCmd.WithDir(srcPath).
+		WithPipelineFunc(func(ctx gitcmd.Context) error {
+			// the refs can be rewound by forced updates of the source repository
+			err := gitcmd.NewCommand("fast-import", "--quiet", "--force").
+				AddOptionFormat("--import-marks-if-exists=%s", importMarks).
+				AddOptionFormat("--export-marks=%s", importMarks).
+				WithDir(dstPath).
+				WithStdinCopy(stdoutReader).
+				RunWithStderr(ctx)
+			if err != nil {
+				// make sure the exporter is not blocked by a reader which has gone away
+				_, _ = io.Copy(io.Discard, stdoutReader)
+				return fmt.Errorf("fast-import: %w", err)
+			}
+			return nil
+		}).
+		RunWithStderr(ctx); err != nil {
+		return nil, fmt.Errorf("fast-export: %w", err)
+	}
+
+	oldMarks, err := readFastExportMarks(exportMarks)
+	if err != nil {
+		return nil, err
+	}
+	newMarks, err := readFastExportMarks(importMarks)
+	if err != nil {
+		return nil, err
+	}
+	// fast-export only writes the marks of commits, so there are no blobs or trees in the mapping,
+	// the marks of the tags are only written by fast-import and are replaced by the next commits
+	objects := make(map[string]string, len(oldMarks))
+	for mark, oldID := range oldMarks {
+		if newID, ok := newMarks[mark]; ok {
+			objects[oldID] = newID
+		}
+	}
+	return objects, nil
+}
+
+// finishConvertedRepository re-attaches the notes and points HEAD to the same ref as the source repository
+func finishConvertedRepository(ctx context.Context, srcPath, dstPath string, objects map[string]string) error {
+	if err := rewriteRepositoryNotes(ctx, srcPath, dstPath, objects); err != nil {
+		return err
+	}
+
+	// fast-import doesn't know which ref HEAD of the source repository pointed to
+	headRef, _, err := gitcmd.NewCommand("symbolic-ref", "HEAD").WithDir(srcPath).RunStdString(ctx)
+	if err == nil && strings.TrimSpace(headRef) != "" {
+		if _, _, err := gitcmd.NewCommand("symbolic-ref", "HEAD").AddDynamicArguments(strings.TrimSpace(headRef)).WithDir(dstPath).RunStdString(ctx); err != nil {
+			return fmt.Errorf("symbolic-ref: %w", err)
+		}
+	}
+	return nil
+}
+
+// pruneConvertedRefs deletes the refs of dstPath which don't exist in srcPath anymore, fast-export doesn't export deletions
+func pruneConvertedRefs(ctx context.Context, srcPath, dstPath string) error {
+	srcRefs, _, err := gitcmd.NewCommand("for-each-ref", "--format=%(refname)").WithDir(srcPath).RunStdString(ctx)
+	if err != nil {
+		return fmt.Errorf("for-each-ref: %w", err)
+	}
+	dstRefs, _, err := gitcmd.NewCommand("for-each-ref", "--format=%(refname)").WithDir(dstPath).RunStdString(ctx)
+	if err != nil {
+		return fmt.Errorf("for-each-ref: %w", err)
+	}
+	existing := container.SetOf(strings.Fields(srcRefs)...)
+	for ref := range strings.FieldsSeq(dstRefs) {
+		if existing.Contains(ref) {
+			continue
+		}
+		if _, _, err := gitcmd.NewCommand("update-ref", "-d").AddDynamicArguments(ref).WithDir(dstPath).RunStdString(ctx); err != nil {
+			return fmt.Errorf("update-ref -d %s: %w", ref, err)
+		}
+	}
+	return nil
+}
+
+// readFastExportMarks parses a marks file written by "--export-marks", every line is ":<mark> <object id>"
+func readFastExportMarks(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	marks := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		mark, objectID, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		marks[mark] = objectID
+	}
+	return marks, scanner.Err()
+}
+
+// rewriteRepositoryNotes re-creates the notes of the source repository in the destination repository.
+// The fast-import stream keeps the notes trees as they were, which are keyed by the old object IDs,
+// so every note has to be attached again to the rewritten object.
+func rewriteRepositoryNotes(ctx context.Context, srcPath, dstPath string, objects map[string]string) error {
+	stdout, _, err := gitcmd.NewCommand("for-each-ref", "--format=%(refname)", "refs/notes/").WithDir(srcPath).RunStdString(ctx)
+	if err != nil {
+		return fmt.Errorf("for-each-ref: %w", err)
+	}
+	for notesRef := range strings.FieldsSeq(stdout) {
+		notes, _, err := gitcmd.NewCommand("notes").AddOptionFormat("--ref=%s", notesRef).AddArguments("list").WithDir(srcPath).RunStdString(ctx)
+		if err != nil {
+			return fmt.Errorf("notes list %s: %w", notesRef, err)
+		}
+		if _, _, err := gitcmd.NewCommand("update-ref", "-d").AddDynamicArguments(notesRef).WithDir(dstPath).RunStdString(ctx); err != nil {
+			return fmt.Errorf("update-ref -d %s: %w", notesRef, err)
+		}
+		for line := range strings.Lines(notes) {
+			fields := strings.Fields(line)
+			if len(fields) != 2 {
+				continue
+			}
+			newObject := objects[fields[1]]
+			if newObject == "" {
+				continue
+			}
+			// there is no mark for the note blob, so its content is hashed again with the new object format
+			content, _, err := gitcmd.NewCommand("cat-file", "blob").AddDynamicArguments(fields[0]).WithDir(srcPath).RunStdBytes(ctx)
+			if err != nil {
+				return fmt.Errorf("cat-file %s: %w", fields[0], err)
+			}
+			newBlob, _, err := gitcmd.NewCommand("hash-object", "-w", "--stdin").WithStdinBytes(content).WithDir(dstPath).RunStdString(ctx)
+			if err != nil {
+				return fmt.Errorf("hash-object: %w", err)
+			}
+			if _, _, err := gitcmd.NewCommand("notes").AddOptionFormat("--ref=%s", notesRef).
+				AddArguments("add", "-f", "-C").AddDynamicArguments(strings.TrimSpace(newBlob), newObject).
+				WithDir(dstPath).RunStdString(ctx); err != nil {
+				return fmt.Errorf("notes add %s: %w", notesRef, err)
+			}
+		}
+	}
+	return nil
+}
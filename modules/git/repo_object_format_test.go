@@ -0,0 +1,84 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"path/filepath"
+	"testing"
+
+	"code.gitea.io/gitea/modules/git/gitcmd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertRepositoryObjectFormat(t *testing.T) {
+	if !DefaultFeatures().SupportHashSha256 {
+		t.Skip("skipping because installed Git version doesn't support SHA256")
+	}
+
+	srcPath := filepath.Join(testReposDir, "repo3_notes")
+	dstPath := filepath.Join(t.TempDir(), "repo3_notes_sha256.git")
+
+	mapping, err := ConvertRepositoryObjectFormat(t.Context(), srcPath, dstPath, Sha256ObjectFormat)
+	require.NoError(t, err)
+
+	newID, ok := mapping["3e668dbfac39cbc80a9ff9c61eb565d944453ba4"]
+	require.True(t, ok)
+	assert.Len(t, newID, Sha256ObjectFormat.FullLength())
+
+	repo, err := OpenRepository(t.Context(), dstPath)
+	require.NoError(t, err)
+	defer repo.Close()
+
+	objectFormat, err := repo.GetObjectFormat()
+	require.NoError(t, err)
+	assert.Equal(t, Sha256ObjectFormat, objectFormat)
+
+	commit, err := repo.GetCommit(newID)
+	require.NoError(t, err)
+	assert.Equal(t, newID, commit.ID.String())
+
+	note := Note{}
+	require.NoError(t, GetNote(t.Context(), repo, newID, &note))
+	assert.Equal(t, []byte("Note 2"), note.Message)
+}
+
+func TestSyncConvertedRepository(t *testing.T) {
+	if !DefaultFeatures().SupportHashSha256 {
+		t.Skip("skipping because installed Git version doesn't support SHA256")
+	}
+
+	srcPath := filepath.Join(t.TempDir(), "repo1_bare.git")
+	dstPath := filepath.Join(t.TempDir(), "repo1_bare_sha256.git")
+	var err error
+	_, _, err = gitcmd.NewCommand("clone", "--mirror").AddDynamicArguments(filepath.Join(testReposDir, "repo1_bare"), srcPath).RunStdString(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, InitRepository(t.Context(), dstPath, true, Sha256ObjectFormat.Name()))
+
+	mapping, err := SyncConvertedRepository(t.Context(), srcPath, dstPath)
+	require.NoError(t, err)
+	branch1ID, ok := mapping["2839944139e0de9737a044f78b0e4b40d989a9e3"]
+	require.True(t, ok)
+	assert.Len(t, branch1ID, Sha256ObjectFormat.FullLength())
+
+	// nothing is replayed again
+	mapping, err = SyncConvertedRepository(t.Context(), srcPath, dstPath)
+	require.NoError(t, err)
+	assert.Empty(t, mapping)
+
+	// a branch is deleted and another one is rewound upstream
+	_, _, err = gitcmd.NewCommand("update-ref", "-d", "refs/heads/branch2").WithDir(srcPath).RunStdString(t.Context())
+	require.NoError(t, err)
+	_, _, err = gitcmd.NewCommand("update-ref", "refs/heads/master", "2839944139e0de9737a044f78b0e4b40d989a9e3").WithDir(srcPath).RunStdString(t.Context())
+	require.NoError(t, err)
+	mapping, err = SyncConvertedRepository(t.Context(), srcPath, dstPath)
+	require.NoError(t, err)
+	assert.Empty(t, mapping)
+
+	var refs string
+	refs, _, err = gitcmd.NewCommand("for-each-ref", "--format=%(objectname) %(refname)", "refs/heads/").WithDir(dstPath).RunStdString(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, branch1ID+" refs/heads/branch1\n"+branch1ID+" refs/heads/master\n", refs)
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/gitea/modules/git/gitcmd"
+)
+
+// RangeDiffRelation is how a commit of the old series relates to a commit of the new series
+type RangeDiffRelation string
+
+const (
+	RangeDiffUnchanged RangeDiffRelation = "=" // the commit has the same changes in both series
+	RangeDiffModified  RangeDiffRelation = "!" // the commit has different changes in the new series
+	RangeDiffRemoved   RangeDiffRelation = "<" // the commit is only in the old series
+	RangeDiffAdded     RangeDiffRelation = ">" // the commit is only in the new series
+)
+
+// RangeDiffEntry is a commit pair of the range-diff between two commit series
+type RangeDiffEntry struct {
+	Relation RangeDiffRelation
+	// the position of the commit in the old series starting at 1, 0 if the commit was added
+	OldIndex    int
+	OldCommitID string
+	// the position of the commit in the new series starting at 1, 0 if the commit was removed
+	NewIndex    int
+	NewCommitID string
+	Subject     string
+	// the diff between the patches of the old and the new commit, only for modified commits
+	Interdiff string
+}
+
+// rangeDiffHeaderRegex matches the header of a commit pair, e.g. "1:  1234abc ! 1:  5678def subject"
+var rangeDiffHeaderRegex = regexp.MustCompile(`^(-|\d+):\s+(-+|[0-9a-f]+) ([=!<>]) +(-|\d+):\s+(-+|[0-9a-f]+) ?(.*)$`)
+
+// RangeDiff compares the commits from oldBase to oldHead with the commits from newBase to newHead,
+// the commits of both series are paired and the changes between the patches of the paired commits are returned
+func (repo *Repository) RangeDiff(oldBase, oldHead, newBase, newHead string) ([]*RangeDiffEntry, error) {
+	stdout, _, err := gitcmd.NewCommand("range-diff", "--no-color").
+		AddConfig("core.abbrev", "no").
+		AddDynamicArguments(oldBase+".."+oldHead, newBase+".."+newHead).
+		WithDir(repo.Path).
+		RunStdString(repo.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	return parseRangeDiff(strings.NewReader(stdout))
+}
+
+func parseRangeDiff(r io.Reader) ([]*RangeDiffEntry, error) {
+	var (
+		entries   []*RangeDiffEntry
+		interdiff strings.Builder
+	)
+	flush := func() {
+		if len(entries) > 0 {
+			entries[len(entries)-1].Interdiff = interdiff.String()
+		}
+		interdiff.Reset()
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		m := rangeDiffHeaderRegex.FindStringSubmatch(line)
+		if m == nil {
+			// the interdiff of a commit pair is indented by 4 spaces
+			if len(entries) > 0 {
+				interdiff.WriteString(strings.TrimPrefix(line, "    "))
+				interdiff.WriteByte('\n')
+			}
+			continue
+		}
+
+		flush()
+		entry := &RangeDiffEntry{Relation: RangeDiffRelation(m[3]), Subject: m[6]}
+		if m[1] != "-" {
+			entry.OldIndex, _ = strconv.Atoi(m[1])
+			entry.OldCommitID = m[2]
+		}
+		if m[4] != "-" {
+			entry.NewIndex, _ = strconv.Atoi(m[4])
+			entry.NewCommitID = m[5]
+		}
+		entries = append(entries, entry)
+	}
+	flush()
+	return entries, scanner.Err()
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package git
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRangeDiff(t *testing.T) {
+	output := `1:  b73786361d3910bb9bb5697c40f42c1e8de97cae ! 1:  cc596653ca60a940ce5ad5c0538c2b2d9c4596bd add g
+    @@ Commit message
+      ## g (new) ##
+     @@
+     +x
+    ++z
+2:  81ced1dd5d90db3ac3ae2c81771ce65400c890e6 < -:  ---------------------------------------- more g
+-:  ---------------------------------------- > 2:  7b24c2a9b04d24543adfa02745917e7b6aa557ca add h
+`
+	entries, err := parseRangeDiff(strings.NewReader(output))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, &RangeDiffEntry{
+		Relation:    RangeDiffModified,
+		OldIndex:    1,
+		OldCommitID: "b73786361d3910bb9bb5697c40f42c1e8de97cae",
+		NewIndex:    1,
+		NewCommitID: "cc596653ca60a940ce5ad5c0538c2b2d9c4596bd",
+		Subject:     "add g",
+		Interdiff:   "@@ Commit message\n  ## g (new) ##\n @@\n +x\n++z\n",
+	}, entries[0])
+	assert.Equal(t, &RangeDiffEntry{
+		Relation:    RangeDiffRemoved,
+		OldIndex:    2,
+		OldCommitID: "81ced1dd5d90db3ac3ae2c81771ce65400c890e6",
+		Subject:     "more g",
+	}, entries[1])
+	assert.Equal(t, &RangeDiffEntry{
+		Relation:    RangeDiffAdded,
+		NewIndex:    2,
+		NewCommitID: "7b24c2a9b04d24543adfa02745917e7b6aa557ca",
+		Subject:     "add h",
+	}, entries[2])
+}
+
+func TestRepository_RangeDiff(t *testing.T) {
+	repo, err := OpenRepository(t.Context(), filepath.Join(testReposDir, "repo1_bare"))
+	require.NoError(t, err)
+	defer repo.Close()
+
+	entries, err := repo.RangeDiff("6fbd69e9823458e6c4a2fc5c0f6bc022b2f2acd1", "ce064814f4a0d337b333e646ece456cd39fab612",
+		"8006ff9adbf0cb94da7dad9e537e53817f9fa5c0", "37991dec2c8e592043f47155ce4808d4580f9123")
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, RangeDiffAdded, entries[0].Relation)
+	assert.Equal(t, "6fbd69e9823458e6c4a2fc5c0f6bc022b2f2acd1", entries[0].NewCommitID)
+	assert.Equal(t, "Added broken links", entries[0].Subject)
+	assert.Equal(t, RangeDiffUnchanged, entries[1].Relation)
+	assert.Equal(t, 1, entries[1].OldIndex)
+	assert.Equal(t, 2, entries[1].NewIndex)
+	assert.Equal(t, RangeDiffRemoved, entries[2].Relation)
+	assert.Equal(t, RangeDiffRemoved, entries[3].Relation)
+	assert.Equal(t, "ce064814f4a0d337b333e646ece456cd39fab612", entries[3].OldCommitID)
+}
@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"slices"
+	"strings"
 
 	"code.gitea.io/gitea/modules/git"
 	"code.gitea.io/gitea/modules/git/gitcmd"
@@ -23,6 +25,31 @@ func LineBlame(ctx context.Context, repo Repository, revision, file string, line
 	return stdout, err
 }
 
+// LinesBlame returns the unique commit IDs which last changed the lines from startLine to endLine of the file
+func LinesBlame(ctx context.Context, repo Repository, revision, file string, startLine, endLine uint) ([]string, error) {
+	stdout, _, err := RunCmdString(ctx, repo,
+		gitcmd.NewCommand("blame").
+			AddOptionFormat("-L %d,%d", startLine, endLine).
+			AddOptionValues("--porcelain", revision).
+			AddDashesAndList(file))
+	if err != nil {
+		return nil, err
+	}
+
+	var shas []string
+	for line := range strings.SplitSeq(stdout, "\n") {
+		// the content lines start with a tab, the header of every line starts with its commit ID
+		if line == "" || line[0] == '\t' {
+			continue
+		}
+		sha, _, _ := strings.Cut(line, " ")
+		if git.IsStringLikelyCommitID(nil, sha, 40) && !slices.Contains(shas, sha) {
+			shas = append(shas, sha)
+		}
+	}
+	return shas, nil
+}
+
 // BlamePart represents block of blame - continuous lines with one sha
 type BlamePart struct {
 	Sha          string
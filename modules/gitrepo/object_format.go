@@ -0,0 +1,200 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gitrepo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/git/gitcmd"
+	"code.gitea.io/gitea/modules/globallock"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// convertedUpstreamDir is the directory in a converted mirror which keeps the upstream repository with its own object format
+const convertedUpstreamDir = "gitea-upstream.git"
+
+type upstreamRepo string
+
+func (r upstreamRepo) RelativePath() string {
+	return string(r)
+}
+
+// ConvertedMirrorUpstream returns the repository which keeps the upstream of a converted mirror,
+// the remote of the mirror is fetched into it and its new objects are replayed into the mirror
+func ConvertedMirrorUpstream(repo Repository) Repository {
+	return upstreamRepo(path.Join(repo.RelativePath(), convertedUpstreamDir))
+}
+
+// IsConvertedMirror returns whether the repository is a mirror which uses another object format than its upstream
+func IsConvertedMirror(repo Repository) bool {
+	isDir, _ := util.IsDir(repoPath(ConvertedMirrorUpstream(repo)))
+	return isDir
+}
+
+// ConvertObjectFormat rewrites the repository with the given object format and replaces the repository on disk
+// with the converted one, the server-side hooks are kept. The mapping from the old commit and tag IDs to the new ones
+// is passed to afterSwap, which is called when the converted repository has replaced the original one.
+// If afterSwap fails, the original repository is restored.
+func ConvertObjectFormat(ctx context.Context, repo Repository, objectFormat git.ObjectFormat, afterSwap func(ctx context.Context, mapping map[string]string) error) error {
+	return globallock.LockAndDo(ctx, getRepoWriteLockKey(repo.RelativePath()), func(ctx context.Context) error {
+		srcPath := repoPath(repo)
+		convertedPath := srcPath + ".convert-" + objectFormat.Name()
+		backupPath := srcPath + ".convert-backup"
+		if err := util.RemoveAll(convertedPath); err != nil {
+			return err
+		}
+		defer func() {
+			_ = util.RemoveAll(convertedPath)
+		}()
+
+		mapping, err := git.ConvertRepositoryObjectFormat(ctx, srcPath, convertedPath, objectFormat)
+		if err != nil {
+			return err
+		}
+
+		if err := swapConvertedRepository(srcPath, convertedPath, backupPath); err != nil {
+			return err
+		}
+		if afterSwap != nil {
+			if err := afterSwap(ctx, mapping); err != nil {
+				if errRestore := swapConvertedRepository(srcPath, backupPath, convertedPath); errRestore != nil {
+					return fmt.Errorf("%w, unable to restore the original repository from %s: %v", err, backupPath, errRestore)
+				}
+				return err
+			}
+		}
+		return util.RemoveAll(backupPath)
+	})
+}
+
+// swapConvertedRepository moves the repository at srcPath to backupPath and the one at replacementPath to srcPath,
+// the hooks (including the custom ones) are moved too, they don't depend on the object format
+func swapConvertedRepository(srcPath, replacementPath, backupPath string) error {
+	if hasHooks, _ := util.IsDir(filepath.Join(srcPath, "hooks")); hasHooks {
+		if err := util.RemoveAll(filepath.Join(replacementPath, "hooks")); err != nil {
+			return err
+		}
+		if err := util.Rename(filepath.Join(srcPath, "hooks"), filepath.Join(replacementPath, "hooks")); err != nil {
+			return fmt.Errorf("move hooks: %w", err)
+		}
+	}
+
+	if err := util.Rename(srcPath, backupPath); err != nil {
+		_ = util.Rename(filepath.Join(replacementPath, "hooks"), filepath.Join(srcPath, "hooks"))
+		return fmt.Errorf("rename repository to backup: %w", err)
+	}
+	if err := util.Rename(replacementPath, srcPath); err != nil {
+		if errRestore := util.Rename(backupPath, srcPath); errRestore != nil {
+			return fmt.Errorf("rename converted repository: %w, unable to restore the backup %s: %v", err, backupPath, errRestore)
+		}
+		_ = util.Rename(filepath.Join(replacementPath, "hooks"), filepath.Join(srcPath, "hooks"))
+		return fmt.Errorf("rename converted repository: %w", err)
+	}
+	return nil
+}
+
+// ConvertMirrorObjectFormat turns a mirror into a converted mirror with the given object format. The original repository,
+// including its remotes, becomes the upstream of the converted mirror, see ConvertedMirrorUpstream. The mapping from
+// the upstream commit and tag IDs to the converted ones is passed to afterSwap like ConvertObjectFormat.
+func ConvertMirrorObjectFormat(ctx context.Context, repo Repository, objectFormat git.ObjectFormat, afterSwap func(ctx context.Context, mapping map[string]string) error) error {
+	return globallock.LockAndDo(ctx, getRepoWriteLockKey(repo.RelativePath()), func(ctx context.Context) error {
+		srcPath := repoPath(repo)
+		convertedPath := srcPath + ".convert-" + objectFormat.Name()
+		backupPath := srcPath + ".convert-backup"
+		// the marks of the upstream only make sense with the mirror which they have been replayed into
+		exportMarks := filepath.Join(srcPath, "gitea-export-marks")
+		if err := util.Remove(exportMarks); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := util.RemoveAll(convertedPath); err != nil {
+			return err
+		}
+		defer func() {
+			_ = util.RemoveAll(convertedPath)
+		}()
+
+		if err := git.InitRepository(ctx, convertedPath, true, objectFormat.Name()); err != nil {
+			return fmt.Errorf("InitRepository: %w", err)
+		}
+		mapping, err := git.SyncConvertedRepository(ctx, srcPath, convertedPath)
+		if err == nil {
+			err = copyRemotes(ctx, srcPath, convertedPath)
+		}
+		if err != nil {
+			_ = util.Remove(exportMarks)
+			return err
+		}
+
+		if err := swapConvertedRepository(srcPath, convertedPath, backupPath); err != nil {
+			_ = util.Remove(exportMarks)
+			return err
+		}
+		upstreamPath := repoPath(ConvertedMirrorUpstream(repo))
+		err = util.Rename(backupPath, upstreamPath)
+		if err == nil && afterSwap != nil {
+			if err = afterSwap(ctx, mapping); err != nil {
+				if errRestore := util.Rename(upstreamPath, backupPath); errRestore != nil {
+					return fmt.Errorf("%w, unable to restore the original repository from %s: %v", err, upstreamPath, errRestore)
+				}
+			}
+		}
+		if err != nil {
+			if errRestore := swapConvertedRepository(srcPath, backupPath, convertedPath); errRestore != nil {
+				return fmt.Errorf("%w, unable to restore the original repository from %s: %v", err, backupPath, errRestore)
+			}
+			_ = util.Remove(exportMarks)
+			return err
+		}
+		return nil
+	})
+}
+
+// copyRemotes copies the remotes of the repository at srcPath to the one at dstPath, so the addresses of
+// a converted mirror are still found in the mirror, they are only fetched by its upstream
+func copyRemotes(ctx context.Context, srcPath, dstPath string) error {
+	stdout, _, err := gitcmd.NewCommand("config", "--get-regexp", `^remote\.`).WithDir(srcPath).RunStdString(ctx)
+	if err != nil {
+		if gitcmd.IsErrorExitCode(err, 1) { // no remote
+			return nil
+		}
+		return fmt.Errorf("config --get-regexp: %w", err)
+	}
+	for line := range strings.Lines(stdout) {
+		key, value, _ := strings.Cut(strings.TrimSuffix(line, "\n"), " ")
+		if _, _, err := gitcmd.NewCommand("config", "--add").AddDynamicArguments(key, value).WithDir(dstPath).RunStdString(ctx); err != nil {
+			return fmt.Errorf("config --add %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// RemoveConvertedMirrorUpstream removes the upstream of a converted mirror and the marks of the objects replayed
+// from it, so the repository is no longer a converted mirror. It does nothing if the repository isn't one.
+func RemoveConvertedMirrorUpstream(ctx context.Context, repo Repository) error {
+	return globallock.LockAndDo(ctx, getRepoWriteLockKey(repo.RelativePath()), func(ctx context.Context) error {
+		if err := util.RemoveAll(repoPath(ConvertedMirrorUpstream(repo))); err != nil {
+			return err
+		}
+		if err := util.Remove(filepath.Join(repoPath(repo), "gitea-import-marks")); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// SyncConvertedMirror replays the objects fetched into the upstream of the converted mirror into the mirror.
+// It returns the mapping of the commits and tags which have been replayed.
+func SyncConvertedMirror(ctx context.Context, repo Repository) (mapping map[string]string, err error) {
+	err = globallock.LockAndDo(ctx, getRepoWriteLockKey(repo.RelativePath()), func(ctx context.Context) error {
+		mapping, err = git.SyncConvertedRepository(ctx, repoPath(ConvertedMirrorUpstream(repo)), repoPath(repo))
+		return err
+	})
+	return mapping, err
+}
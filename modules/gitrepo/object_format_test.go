@@ -0,0 +1,78 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package gitrepo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/git/gitcmd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cloneTestMirror clones repo1_bare as a mirror with a marker file to tell whether the original repository is kept
+func cloneTestMirror(t *testing.T) *mockRepository {
+	repo := &mockRepository{path: filepath.Join(t.TempDir(), "repo1.git")}
+	_, _, err := gitcmd.NewCommand("clone", "--mirror").AddDynamicArguments(repoPath(&mockRepository{path: "repo1_bare"}), repo.path).RunStdString(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(repo.path, "gitea-test-marker"), nil, 0o644))
+	return repo
+}
+
+func TestConvertObjectFormatRestore(t *testing.T) {
+	// the object format is kept, the objects are rewritten like a conversion anyway
+	repo := cloneTestMirror(t)
+	errDatabase := errors.New("database error")
+	err := ConvertObjectFormat(t.Context(), repo, git.Sha1ObjectFormat, func(ctx context.Context, mapping map[string]string) error {
+		assert.NotEmpty(t, mapping)
+		assert.NoFileExists(t, filepath.Join(repo.path, "gitea-test-marker"))
+		return errDatabase
+	})
+	assert.ErrorIs(t, err, errDatabase)
+	assert.FileExists(t, filepath.Join(repo.path, "gitea-test-marker"))
+	assert.NoDirExists(t, repo.path+".convert-backup")
+
+	require.NoError(t, ConvertObjectFormat(t.Context(), repo, git.Sha1ObjectFormat, nil))
+	assert.NoFileExists(t, filepath.Join(repo.path, "gitea-test-marker"))
+	assert.NoDirExists(t, repo.path+".convert-backup")
+}
+
+func TestConvertMirrorObjectFormat(t *testing.T) {
+	repo := cloneTestMirror(t)
+	errDatabase := errors.New("database error")
+	err := ConvertMirrorObjectFormat(t.Context(), repo, git.Sha1ObjectFormat, func(ctx context.Context, mapping map[string]string) error {
+		assert.NotEmpty(t, mapping)
+		assert.True(t, IsConvertedMirror(repo))
+		return errDatabase
+	})
+	assert.ErrorIs(t, err, errDatabase)
+	assert.FileExists(t, filepath.Join(repo.path, "gitea-test-marker"))
+	assert.NoFileExists(t, filepath.Join(repo.path, "gitea-export-marks"))
+	assert.False(t, IsConvertedMirror(repo))
+
+	require.NoError(t, ConvertMirrorObjectFormat(t.Context(), repo, git.Sha1ObjectFormat, nil))
+	assert.True(t, IsConvertedMirror(repo))
+	assert.FileExists(t, filepath.Join(repoPath(ConvertedMirrorUpstream(repo)), "gitea-test-marker"))
+	// the remote is kept in the mirror, it is fetched by the upstream
+	for _, r := range []Repository{repo, ConvertedMirrorUpstream(repo)} {
+		u, err := GitRemoteGetURL(t.Context(), r, "origin")
+		require.NoError(t, err)
+		assert.Contains(t, u.String(), "repo1_bare")
+	}
+
+	mapping, err := SyncConvertedMirror(t.Context(), repo)
+	require.NoError(t, err)
+	assert.Empty(t, mapping)
+
+	require.NoError(t, RemoveConvertedMirrorUpstream(t.Context(), repo))
+	assert.False(t, IsConvertedMirror(repo))
+	assert.NoFileExists(t, filepath.Join(repo.path, "gitea-import-marks"))
+	require.NoError(t, RemoveConvertedMirrorUpstream(t.Context(), repo))
+}
@@ -38,6 +38,13 @@ func MatchAndQuery(matchPhrase, field, analyzer string, fuzziness int) *query.Ma
 	return q
 }
 
+// TermQuery generates a term query for the given term and field, the term is not analyzed
+func TermQuery(term, field string) *query.TermQuery {
+	q := bleve.NewTermQuery(term)
+	q.SetField(field)
+	return q
+}
+
 // BoolFieldQuery generates a bool field query for the given value and field
 func BoolFieldQuery(value bool, field string) *query.BoolFieldQuery {
 	q := bleve.NewBoolFieldQuery(value)
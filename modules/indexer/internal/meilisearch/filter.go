@@ -80,6 +80,12 @@ func NewFilterEq[T bool | int64](field string, value T) FilterEq {
 	return FilterEq(fmt.Sprintf("%s = %v", field, value))
 }
 
+// NewFilterEqString creates a new FilterEq for a string value, the value is quoted and escaped.
+func NewFilterEqString(field, value string) FilterEq {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return FilterEq(fmt.Sprintf(`%s = "%s"`, field, value))
+}
+
 func (f FilterEq) Statement() string {
 	return string(f)
 }
@@ -27,7 +27,7 @@ import (
 const (
 	issueIndexerAnalyzer      = "issueIndexer"
 	issueIndexerDocType       = "issueIndexerDocType"
-	issueIndexerLatestVersion = 5
+	issueIndexerLatestVersion = 7
 )
 
 const unicodeNormalizeName = "unicodeNormalize"
@@ -71,6 +71,10 @@ func generateIssueIndexMapping() (mapping.IndexMapping, error) {
 	numberFieldMapping.Store = false
 	numberFieldMapping.IncludeInAll = false
 
+	keywordFieldMapping := bleve.NewKeywordFieldMapping()
+	keywordFieldMapping.Store = false
+	keywordFieldMapping.IncludeInAll = false
+
 	docMapping.AddFieldMappingsAt("is_public", boolFieldMapping)
 
 	docMapping.AddFieldMappingsAt("title", textFieldMapping)
@@ -85,6 +89,9 @@ func generateIssueIndexMapping() (mapping.IndexMapping, error) {
 	docMapping.AddFieldMappingsAt("milestone_id", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("project_id", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("project_board_id", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("parent_id", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("type_id", numberFieldMapping)
+	docMapping.AddFieldMappingsAt("issue_field_values", keywordFieldMapping)
 	docMapping.AddFieldMappingsAt("poster_id", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("assignee_id", numberFieldMapping)
 	docMapping.AddFieldMappingsAt("mention_ids", numberFieldMapping)
@@ -222,6 +229,13 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 			}
 			queries = append(queries, bleve.NewDisjunctionQuery(includeQueries...))
 		}
+		for _, group := range options.IncludedLabelIDGroups {
+			var groupQueries []query.Query
+			for _, labelID := range group {
+				groupQueries = append(groupQueries, inner_bleve.NumericEqualityQuery(labelID, "label_ids"))
+			}
+			queries = append(queries, bleve.NewDisjunctionQuery(groupQueries...))
+		}
 		if len(options.ExcludedLabelIDs) > 0 {
 			var excludeQueries []query.Query
 			for _, labelID := range options.ExcludedLabelIDs {
@@ -247,6 +261,15 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 	if options.ProjectColumnID.Has() {
 		queries = append(queries, inner_bleve.NumericEqualityQuery(options.ProjectColumnID.Value(), "project_board_id"))
 	}
+	if options.ParentID.Has() {
+		queries = append(queries, inner_bleve.NumericEqualityQuery(options.ParentID.Value(), "parent_id"))
+	}
+	if options.TypeID.Has() {
+		queries = append(queries, inner_bleve.NumericEqualityQuery(options.TypeID.Value(), "type_id"))
+	}
+	for fieldID, value := range options.IssueFieldValues {
+		queries = append(queries, inner_bleve.TermQuery(internal.IssueFieldValueTerm(fieldID, value), "issue_field_values"))
+	}
 
 	if options.PosterID != "" {
 		// "(none)" becomes 0, it means no poster
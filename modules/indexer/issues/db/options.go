@@ -67,6 +67,9 @@ func ToDBOptions(ctx context.Context, options *internal.SearchOptions) (*issue_m
 		SubscriberID:       convertID(options.SubscriberID),
 		ProjectID:          convertID(options.ProjectID),
 		ProjectColumnID:    convertID(options.ProjectColumnID),
+		ParentID:           convertID(options.ParentID),
+		TypeID:             convertID(options.TypeID),
+		IssueFieldValues:   options.IssueFieldValues,
 		IsClosed:           options.IsClosed,
 		IsPull:             options.IsPull,
 		IncludedLabelNames: nil,
@@ -91,6 +94,7 @@ func ToDBOptions(ctx context.Context, options *internal.SearchOptions) (*issue_m
 	if options.NoLabelOnly {
 		opts.LabelIDs = []int64{0} // Be careful, it's zero, not db.NoConditionID
 	} else {
+		opts.LabelIDGroups = options.IncludedLabelIDGroups
 		opts.LabelIDs = make([]int64, 0, len(options.IncludedLabelIDs)+len(options.ExcludedLabelIDs))
 		opts.LabelIDs = append(opts.LabelIDs, options.IncludedLabelIDs...)
 		for _, id := range options.ExcludedLabelIDs {
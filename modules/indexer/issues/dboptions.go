@@ -36,6 +36,7 @@ func ToSearchOptions(keyword string, opts *issues_model.IssuesOptions) *SearchOp
 				searchOpt.ExcludedLabelIDs = append(searchOpt.ExcludedLabelIDs, -labelID)
 			}
 		}
+		searchOpt.IncludedLabelIDGroups = opts.LabelIDGroups
 		// opts.IncludedLabelNames and opts.ExcludedLabelNames are not supported here.
 		// It's not a TO DO, it's just unnecessary.
 	}
@@ -52,6 +53,19 @@ func ToSearchOptions(keyword string, opts *issues_model.IssuesOptions) *SearchOp
 		searchOpt.ProjectID = optional.Some[int64](0) // Those issues with no project(projectid==0)
 	}
 
+	if opts.ParentID > 0 {
+		searchOpt.ParentID = optional.Some(opts.ParentID)
+	} else if opts.ParentID == db.NoConditionID {
+		searchOpt.ParentID = optional.Some[int64](0) // Those issues without parent
+	}
+
+	if opts.TypeID > 0 {
+		searchOpt.TypeID = optional.Some(opts.TypeID)
+	} else if opts.TypeID == db.NoConditionID {
+		searchOpt.TypeID = optional.Some[int64](0) // Those issues without type
+	}
+	searchOpt.IssueFieldValues = opts.IssueFieldValues
+
 	searchOpt.AssigneeID = opts.AssigneeID
 
 	// See the comment of issues_model.SearchOptions for the reason why we need to convert
@@ -19,7 +19,7 @@ import (
 )
 
 const (
-	issueIndexerLatestVersion = 2
+	issueIndexerLatestVersion = 4
 	// multi-match-types, currently only 2 types are used
 	// Reference: https://www.elastic.co/guide/en/elasticsearch/reference/7.0/query-dsl-multi-match-query.html#multi-match-types
 	esMultiMatchTypeBestFields   = "best_fields"
@@ -70,6 +70,9 @@ const (
 			"milestone_id": { "type": "integer", "index": true },
 			"project_id": { "type": "integer", "index": true },
 			"project_board_id": { "type": "integer", "index": true },
+			"parent_id": { "type": "integer", "index": true },
+			"type_id": { "type": "integer", "index": true },
+			"issue_field_values": { "type": "keyword", "index": true },
 			"poster_id": { "type": "integer", "index": true },
 			"assignee_id": { "type": "integer", "index": true },
 			"mention_ids": { "type": "integer", "index": true },
@@ -191,6 +194,9 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		} else if len(options.IncludedAnyLabelIDs) > 0 {
 			query.Must(elastic.NewTermsQuery("label_ids", toAnySlice(options.IncludedAnyLabelIDs)...))
 		}
+		for _, group := range options.IncludedLabelIDGroups {
+			query.Must(elastic.NewTermsQuery("label_ids", toAnySlice(group)...))
+		}
 		if len(options.ExcludedLabelIDs) > 0 {
 			q := elastic.NewBoolQuery()
 			for _, labelID := range options.ExcludedLabelIDs {
@@ -210,6 +216,15 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 	if options.ProjectColumnID.Has() {
 		query.Must(elastic.NewTermQuery("project_board_id", options.ProjectColumnID.Value()))
 	}
+	if options.ParentID.Has() {
+		query.Must(elastic.NewTermQuery("parent_id", options.ParentID.Value()))
+	}
+	if options.TypeID.Has() {
+		query.Must(elastic.NewTermQuery("type_id", options.TypeID.Value()))
+	}
+	for fieldID, value := range options.IssueFieldValues {
+		query.Must(elastic.NewTermQuery("issue_field_values", internal.IssueFieldValueTerm(fieldID, value)))
+	}
 
 	if options.PosterID != "" {
 		// "(none)" becomes 0, it means no poster
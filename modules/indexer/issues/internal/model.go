@@ -32,6 +32,9 @@ type IndexerData struct {
 	MilestoneID        int64              `json:"milestone_id"`
 	ProjectID          int64              `json:"project_id"`
 	ProjectColumnID    int64              `json:"project_board_id"` // the key should be kept as project_board_id to keep compatible
+	ParentID           int64              `json:"parent_id"`
+	TypeID             int64              `json:"type_id"`
+	IssueFieldValues   []string           `json:"issue_field_values"` // terms of the values of the custom fields, see IssueFieldValueTerm
 	PosterID           int64              `json:"poster_id"`
 	AssigneeID         int64              `json:"assignee_id"`
 	MentionIDs         []int64            `json:"mention_ids"`
@@ -48,6 +51,11 @@ type IndexerData struct {
 	CommentCount int64              `json:"comment_count"`
 }
 
+// IssueFieldValueTerm returns the term of the value of a custom field of an issue type, it's indexed as a keyword
+func IssueFieldValueTerm(fieldID int64, value string) string {
+	return strconv.FormatInt(fieldID, 10) + "=" + value
+}
+
 // Match represents on search result
 type Match struct {
 	ID    int64   `json:"id"`
@@ -87,16 +95,22 @@ type SearchOptions struct {
 	IsClosed   optional.Option[bool] // if the issues is closed
 	IsArchived optional.Option[bool] // if the repo is archived
 
-	IncludedLabelIDs    []int64 // labels the issues have
-	ExcludedLabelIDs    []int64 // labels the issues don't have
-	IncludedAnyLabelIDs []int64 // labels the issues have at least one. It will be ignored if IncludedLabelIDs is not empty. It's an uncommon filter, but it has been supported accidentally by issues.IssuesOptions.IncludedLabelNames.
-	NoLabelOnly         bool    // if the issues have no label, if true, IncludedLabelIDs and ExcludedLabelIDs, IncludedAnyLabelIDs will be ignored
+	IncludedLabelIDs      []int64   // labels the issues have
+	ExcludedLabelIDs      []int64   // labels the issues don't have
+	IncludedAnyLabelIDs   []int64   // labels the issues have at least one. It will be ignored if IncludedLabelIDs is not empty. It's an uncommon filter, but it has been supported accidentally by issues.IssuesOptions.IncludedLabelNames.
+	IncludedLabelIDGroups [][]int64 // groups of labels, the issues have at least one label of every group. It's used to search labels by name across repositories.
+	NoLabelOnly           bool      // if the issues have no label, if true, IncludedLabelIDs and ExcludedLabelIDs, IncludedAnyLabelIDs, IncludedLabelIDGroups will be ignored
 
 	MilestoneIDs []int64 // milestones the issues have
 
 	ProjectID       optional.Option[int64] // project the issues belong to
 	ProjectColumnID optional.Option[int64] // project column the issues belong to
 
+	ParentID optional.Option[int64] // parent issue of the issues, 0 for the issues without parent
+
+	TypeID           optional.Option[int64] // issue type of the issues, 0 for the issues without type
+	IssueFieldValues map[int64]string       // values of the custom fields of the issue type the issues have, by field ID
+
 	PosterID   string // poster of the issues, "(none)" or "(any)" or a user ID
 	AssigneeID string // assignee of the issues, "(none)" or "(any)" or a user ID
 
@@ -264,6 +264,23 @@ var cases = []*testIndexerCase{
 		ExpectedIDs:   []int64{1003, 1001, 1000},
 		ExpectedTotal: 3,
 	},
+	{
+		Name: "label groups",
+		ExtraData: []*internal.IndexerData{
+			{ID: 1000, Title: "hello a", LabelIDs: []int64{2000, 2002}},
+			{ID: 1001, Title: "hello b", LabelIDs: []int64{2001, 2002}},
+			{ID: 1002, Title: "hello c", LabelIDs: []int64{2001, 2002, 2003}},
+			{ID: 1003, Title: "hello d", LabelIDs: []int64{2002}},
+			{ID: 1004, Title: "hello e", LabelIDs: []int64{}},
+		},
+		SearchOptions: &internal.SearchOptions{
+			Keyword:               "hello",
+			IncludedLabelIDGroups: [][]int64{{2000, 2001}, {2002}},
+			ExcludedLabelIDs:      []int64{2003},
+		},
+		ExpectedIDs:   []int64{1001, 1000},
+		ExpectedTotal: 2,
+	},
 	{
 		Name: "MilestoneIDs",
 		SearchOptions: &internal.SearchOptions{
@@ -372,6 +389,98 @@ var cases = []*testIndexerCase{
 			}), result.Total)
 		},
 	},
+	{
+		Name: "ParentID",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			ParentID: optional.Some(int64(1)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(1), data[v.ID].ParentID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.ParentID == 1
+			}), result.Total)
+		},
+	},
+	{
+		Name: "no ParentID",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			ParentID: optional.Some(int64(0)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(0), data[v.ID].ParentID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.ParentID == 0
+			}), result.Total)
+		},
+	},
+	{
+		Name: "TypeID",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			TypeID: optional.Some(int64(1)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(1), data[v.ID].TypeID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.TypeID == 1
+			}), result.Total)
+		},
+	},
+	{
+		Name: "no TypeID",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			TypeID: optional.Some(int64(0)),
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Equal(t, int64(0), data[v.ID].TypeID)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return v.TypeID == 0
+			}), result.Total)
+		},
+	},
+	{
+		Name: "IssueFieldValues",
+		SearchOptions: &internal.SearchOptions{
+			Paginator: &db.ListOptions{
+				PageSize: 5,
+			},
+			TypeID:           optional.Some(int64(2)),
+			IssueFieldValues: map[int64]string{2: `value "1"`},
+		},
+		Expected: func(t *testing.T, data map[int64]*internal.IndexerData, result *internal.SearchResult) {
+			term := internal.IssueFieldValueTerm(2, `value "1"`)
+			assert.Len(t, result.Hits, 5)
+			for _, v := range result.Hits {
+				assert.Contains(t, data[v.ID].IssueFieldValues, term)
+			}
+			assert.Equal(t, countIndexerData(data, func(v *internal.IndexerData) bool {
+				return slices.Contains(v.IssueFieldValues, term)
+			}), result.Total)
+		},
+	},
 	{
 		Name: "PosterID",
 		SearchOptions: &internal.SearchOptions{
@@ -706,6 +815,10 @@ func generateDefaultIndexerData() []*internal.IndexerData {
 			for i := range subscriberIDs {
 				subscriberIDs[i] = int64(i) + 1 // SubscriberID should not be 0
 			}
+			var issueFieldValues []string
+			if issueIndex%3 > 0 {
+				issueFieldValues = append(issueFieldValues, internal.IssueFieldValueTerm(issueIndex%3, fmt.Sprintf(`value "%d"`, issueIndex%2)))
+			}
 
 			data = append(data, &internal.IndexerData{
 				ID:                 id,
@@ -721,6 +834,9 @@ func generateDefaultIndexerData() []*internal.IndexerData {
 				MilestoneID:        issueIndex % 4,
 				ProjectID:          issueIndex % 5,
 				ProjectColumnID:    issueIndex % 6,
+				ParentID:           issueIndex % 7,
+				TypeID:             issueIndex % 3,
+				IssueFieldValues:   issueFieldValues,
 				PosterID:           id%10 + 1, // PosterID should not be 0
 				AssigneeID:         issueIndex % 10,
 				MentionIDs:         mentionIDs,
@@ -20,7 +20,7 @@ import (
 )
 
 const (
-	issueIndexerLatestVersion = 4
+	issueIndexerLatestVersion = 6
 
 	// TODO: make this configurable if necessary
 	maxTotalHits = 10000
@@ -73,6 +73,9 @@ func NewIndexer(url, apiKey, indexerName string) *Indexer {
 			"milestone_id",
 			"project_id",
 			"project_board_id",
+			"parent_id",
+			"type_id",
+			"issue_field_values",
 			"poster_id",
 			"assignee_id",
 			"mention_ids",
@@ -169,6 +172,9 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 		} else if len(options.IncludedAnyLabelIDs) > 0 {
 			query.And(inner_meilisearch.NewFilterIn("label_ids", options.IncludedAnyLabelIDs...))
 		}
+		for _, group := range options.IncludedLabelIDGroups {
+			query.And(inner_meilisearch.NewFilterIn("label_ids", group...))
+		}
 		if len(options.ExcludedLabelIDs) > 0 {
 			q := &inner_meilisearch.FilterAnd{}
 			for _, labelID := range options.ExcludedLabelIDs {
@@ -188,6 +194,15 @@ func (b *Indexer) Search(ctx context.Context, options *internal.SearchOptions) (
 	if options.ProjectColumnID.Has() {
 		query.And(inner_meilisearch.NewFilterEq("project_board_id", options.ProjectColumnID.Value()))
 	}
+	if options.ParentID.Has() {
+		query.And(inner_meilisearch.NewFilterEq("parent_id", options.ParentID.Value()))
+	}
+	if options.TypeID.Has() {
+		query.And(inner_meilisearch.NewFilterEq("type_id", options.TypeID.Value()))
+	}
+	for fieldID, value := range options.IssueFieldValues {
+		query.And(inner_meilisearch.NewFilterEqString("issue_field_values", internal.IssueFieldValueTerm(fieldID, value)))
+	}
 
 	if options.PosterID != "" {
 		// "(none)" becomes 0, it means no poster
@@ -0,0 +1,443 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/indexer/issues/internal"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// QueryUserMe is the user name which stands for the user running a query
+const QueryUserMe = "@me"
+
+// Query is an issue search query like `is:open label:bug repo:org/* assignee:@me -label:wontfix updated:>7d`.
+// The qualifiers refer to repositories, labels, milestones and users by name, they have to be resolved
+// to IDs to build the SearchOptions. The words which are not qualifiers are the keyword.
+type Query struct {
+	Keyword string
+
+	IsPull   optional.Option[bool] // is:pr, is:issue
+	IsClosed optional.Option[bool] // is:open, is:closed
+
+	Repos []string // repo:owner/name, the name can have wildcards like repo:owner/*, an issue matches any of them
+
+	Labels         [][]string // label:a,b, an issue must match every group and matches a group if it has any of its labels
+	ExcludedLabels []string   // -label:name
+	NoLabel        bool       // no:label
+
+	Milestones  []string // milestone:name, an issue matches any of them
+	NoMilestone bool     // no:milestone
+
+	Author          string // author:name
+	Assignee        string // assignee:name
+	NoAssignee      bool   // no:assignee
+	Mentions        string // mentions:name
+	ReviewRequested string // review-requested:name
+	ReviewedBy      string // reviewed-by:name
+
+	UpdatedAfter  optional.Option[int64] // updated:>7d, updated:>2006-01-02
+	UpdatedBefore optional.Option[int64] // updated:<7d, updated:<2006-01-02
+
+	SortBy internal.SortBy // sort:updated-desc
+}
+
+var (
+	queryTokenPattern    = regexp.MustCompile(`(-?[\w-]+:)?((?:"[^"]*"?|[^\s"]+)*)`)
+	queryDurationPattern = regexp.MustCompile(`^(\d+)([hdwmy])$`)
+)
+
+var queryQualifiers = container.SetOf(
+	"is", "state", "repo", "org", "user", "label", "milestone", "no",
+	"author", "assignee", "mentions", "review-requested", "reviewed-by", "updated", "sort",
+)
+
+var querySortBys = map[string]internal.SortBy{
+	"created":       SortByCreatedDesc,
+	"created-desc":  SortByCreatedDesc,
+	"created-asc":   SortByCreatedAsc,
+	"updated":       SortByUpdatedDesc,
+	"updated-desc":  SortByUpdatedDesc,
+	"updated-asc":   SortByUpdatedAsc,
+	"comments":      SortByCommentsDesc,
+	"comments-desc": SortByCommentsDesc,
+	"comments-asc":  SortByCommentsAsc,
+	"due":           SortByDeadlineAsc,
+	"due-asc":       SortByDeadlineAsc,
+	"due-desc":      SortByDeadlineDesc,
+}
+
+// ParseQuery parses an issue search query, the unknown qualifiers are kept in the keyword
+func ParseQuery(query string) (*Query, error) {
+	return parseQuery(query, time.Now())
+}
+
+func parseQuery(query string, now time.Time) (*Query, error) {
+	q := &Query{}
+	var keywords []string
+	for _, match := range queryTokenPattern.FindAllStringSubmatch(query, -1) {
+		token, key, value := match[0], strings.TrimSuffix(match[1], ":"), unquoteQueryValue(match[2])
+		negated := strings.HasPrefix(key, "-")
+		key = strings.ToLower(strings.TrimPrefix(key, "-"))
+		if key == "" {
+			if value != "" {
+				keywords = append(keywords, value)
+			}
+			continue
+		}
+
+		known, err := q.setQualifier(key, value, negated, now)
+		if err != nil {
+			return nil, err
+		} else if !known {
+			keywords = append(keywords, token)
+		}
+	}
+	q.Keyword = strings.Join(keywords, " ")
+	return q, nil
+}
+
+// unquoteQueryValue removes the quotes of a value like "good first issue",help
+func unquoteQueryValue(value string) string {
+	return strings.TrimSpace(strings.ReplaceAll(value, `"`, ""))
+}
+
+// setQualifier sets the qualifier of the key, it returns false if the key is not a known qualifier
+func (q *Query) setQualifier(key, value string, negated bool, now time.Time) (bool, error) {
+	if !queryQualifiers.Contains(key) {
+		return false, nil
+	}
+	if value == "" {
+		return true, util.NewInvalidArgumentErrorf("qualifier %q requires a value", key)
+	}
+	if negated && key != "label" {
+		return true, util.NewInvalidArgumentErrorf("qualifier %q can't be negated", key)
+	}
+
+	setUser := func(name *string) error {
+		if *name != "" {
+			return util.NewInvalidArgumentErrorf("qualifier %q can only be used once", key)
+		}
+		*name = value
+		return nil
+	}
+
+	switch key {
+	case "is", "state":
+		switch strings.ToLower(value) {
+		case "open":
+			q.IsClosed = optional.Some(false)
+		case "closed":
+			q.IsClosed = optional.Some(true)
+		case "issue":
+			q.IsPull = optional.Some(false)
+		case "pr", "pull":
+			q.IsPull = optional.Some(true)
+		default:
+			return true, util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+	case "repo":
+		if !strings.Contains(value, "/") {
+			return true, util.NewInvalidArgumentErrorf("qualifier %q requires a value like owner/name", key)
+		}
+		q.Repos = append(q.Repos, value)
+	case "org", "user":
+		q.Repos = append(q.Repos, value+"/*")
+	case "label":
+		names := splitQueryValues(value)
+		if negated {
+			q.ExcludedLabels = append(q.ExcludedLabels, names...)
+		} else {
+			q.Labels = append(q.Labels, names)
+		}
+	case "milestone":
+		q.Milestones = append(q.Milestones, value)
+	case "no":
+		switch strings.ToLower(value) {
+		case "label":
+			q.NoLabel = true
+		case "milestone":
+			q.NoMilestone = true
+		case "assignee":
+			q.NoAssignee = true
+		default:
+			return true, util.NewInvalidArgumentErrorf("unknown value %q of qualifier %q", value, key)
+		}
+	case "author":
+		return true, setUser(&q.Author)
+	case "assignee":
+		return true, setUser(&q.Assignee)
+	case "mentions":
+		return true, setUser(&q.Mentions)
+	case "review-requested":
+		return true, setUser(&q.ReviewRequested)
+	case "reviewed-by":
+		return true, setUser(&q.ReviewedBy)
+	case "updated":
+		return true, q.setUpdated(value, now)
+	case "sort":
+		sortBy, ok := querySortBys[strings.ToLower(value)]
+		if !ok {
+			return true, util.NewInvalidArgumentErrorf("unknown sort %q", value)
+		}
+		q.SortBy = sortBy
+	}
+	return true, nil
+}
+
+func splitQueryValues(value string) []string {
+	var values []string
+	for v := range strings.SplitSeq(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// setUpdated sets the range of the update time of a value like ">7d", "<=2006-01-02" or "2006-01-02..2006-02-01",
+// a duration is relative to now so ">7d" means updated in the last seven days
+func (q *Query) setUpdated(value string, now time.Time) error {
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		fromTime, err := parseQueryTime(from, now)
+		if err != nil {
+			return err
+		}
+		toTime, err := parseQueryTime(to, now)
+		if err != nil {
+			return err
+		}
+		q.UpdatedAfter = optional.Some(fromTime.Unix())
+		q.UpdatedBefore = optional.Some(toTime.AddDate(0, 0, 1).Unix())
+		return nil
+	}
+
+	operator := value[:len(value)-len(strings.TrimLeft(value, "<>="))]
+	t, err := parseQueryTime(value[len(operator):], now)
+	if err != nil {
+		return err
+	}
+	// a date stands for the whole day
+	isDuration := queryDurationPattern.MatchString(value[len(operator):])
+	endOfDay := t.AddDate(0, 0, 1)
+	if isDuration {
+		endOfDay = t
+	}
+	switch operator {
+	case ">":
+		q.UpdatedAfter = optional.Some(endOfDay.Unix())
+	case ">=":
+		q.UpdatedAfter = optional.Some(t.Unix())
+	case "<":
+		q.UpdatedBefore = optional.Some(t.Unix())
+	case "<=":
+		q.UpdatedBefore = optional.Some(endOfDay.Unix())
+	case "":
+		if isDuration {
+			return util.NewInvalidArgumentErrorf("updated:%s requires > or <", value)
+		}
+		q.UpdatedAfter = optional.Some(t.Unix())
+		q.UpdatedBefore = optional.Some(t.AddDate(0, 0, 1).Unix())
+	default:
+		return util.NewInvalidArgumentErrorf("unknown operator %q of qualifier updated", operator)
+	}
+	return nil
+}
+
+// parseQueryTime parses a date like 2006-01-02 or a duration before now like 7d, 12h, 2w, 3m or 1y
+func parseQueryTime(value string, now time.Time) (time.Time, error) {
+	if m := queryDurationPattern.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "h":
+			return now.Add(-time.Duration(n) * time.Hour), nil
+		case "d":
+			return now.AddDate(0, 0, -n), nil
+		case "w":
+			return now.AddDate(0, 0, -7*n), nil
+		case "m":
+			return now.AddDate(0, -n, 0), nil
+		default:
+			return now.AddDate(-n, 0, 0), nil
+		}
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, now.Location())
+	if err != nil {
+		return time.Time{}, util.NewInvalidArgumentErrorf("%q is neither a date like 2006-01-02 nor a duration like 7d", value)
+	}
+	return t, nil
+}
+
+// ToSearchOptions resolves the names of the query and returns a copy of the options with the filters of the query.
+// The repositories of the options are the scope of the query, the repo qualifiers can only narrow it down.
+// The names which don't exist or are not visible to the doer make the search return nothing.
+func (q *Query) ToSearchOptions(ctx context.Context, doer *user_model.User, opts *SearchOptions) (*SearchOptions, error) {
+	result := opts.Copy(func(o *SearchOptions) {
+		o.Keyword = q.Keyword
+	})
+	matchNothing := func() (*SearchOptions, error) {
+		result.RepoIDs = []int64{0}
+		result.AllPublic = false
+		return result, nil
+	}
+
+	if q.IsPull.Has() {
+		result.IsPull = q.IsPull
+	}
+	if q.IsClosed.Has() {
+		result.IsClosed = q.IsClosed
+	}
+
+	if len(q.Repos) > 0 {
+		repoIDs, err := q.resolveRepoIDs(ctx, doer, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(repoIDs) == 0 {
+			return matchNothing()
+		}
+		result.RepoIDs = repoIDs
+		result.AllPublic = false
+	}
+
+	if q.NoLabel {
+		result.NoLabelOnly = true
+	} else {
+		for _, names := range q.Labels {
+			labelIDs, err := issues_model.GetLabelIDsByNames(ctx, names)
+			if err != nil {
+				return nil, err
+			}
+			if len(labelIDs) == 0 {
+				return matchNothing()
+			}
+			result.IncludedLabelIDGroups = append(slices.Clip(result.IncludedLabelIDGroups), labelIDs)
+		}
+		if len(q.ExcludedLabels) > 0 {
+			labelIDs, err := issues_model.GetLabelIDsByNames(ctx, q.ExcludedLabels)
+			if err != nil {
+				return nil, err
+			}
+			result.ExcludedLabelIDs = append(slices.Clip(result.ExcludedLabelIDs), labelIDs...)
+		}
+	}
+
+	if q.NoMilestone {
+		result.MilestoneIDs = []int64{0}
+	} else if len(q.Milestones) > 0 {
+		milestoneIDs, err := issues_model.GetMilestoneIDsByNames(ctx, q.Milestones)
+		if err != nil {
+			return nil, err
+		}
+		if len(milestoneIDs) == 0 {
+			return matchNothing()
+		}
+		result.MilestoneIDs = milestoneIDs
+	}
+
+	users := []struct {
+		name string
+		set  func(id int64)
+	}{
+		{q.Author, func(id int64) { result.PosterID = strconv.FormatInt(id, 10) }},
+		{q.Assignee, func(id int64) { result.AssigneeID = strconv.FormatInt(id, 10) }},
+		{q.Mentions, func(id int64) { result.MentionID = optional.Some(id) }},
+		{q.ReviewRequested, func(id int64) { result.ReviewRequestedID = optional.Some(id) }},
+		{q.ReviewedBy, func(id int64) { result.ReviewedID = optional.Some(id) }},
+	}
+	for _, u := range users {
+		if u.name == "" {
+			continue
+		}
+		userID, err := resolveQueryUserID(ctx, doer, u.name)
+		if err != nil {
+			return nil, err
+		}
+		if userID == 0 {
+			return matchNothing()
+		}
+		u.set(userID)
+	}
+	if q.NoAssignee {
+		result.AssigneeID = "(none)"
+	}
+
+	if q.UpdatedAfter.Has() {
+		result.UpdatedAfterUnix = q.UpdatedAfter
+	}
+	if q.UpdatedBefore.Has() {
+		result.UpdatedBeforeUnix = q.UpdatedBefore
+	}
+	if q.SortBy != "" {
+		result.SortBy = q.SortBy
+	}
+	return result, nil
+}
+
+// resolveRepoIDs returns the IDs of the repositories matching the repo qualifiers which are visible to the doer
+// and in the scope of the options: the repositories of the options and all public repositories if AllPublic is set
+func (q *Query) resolveRepoIDs(ctx context.Context, doer *user_model.User, opts *SearchOptions) ([]int64, error) {
+	scope := container.SetOf(opts.RepoIDs...)
+	inScope := func(repo *repo_model.Repository) bool {
+		return len(opts.RepoIDs) == 0 && !opts.AllPublic || scope.Contains(repo.ID) || opts.AllPublic && !repo.IsPrivate
+	}
+	repoIDs := make(container.Set[int64])
+	for _, pattern := range q.Repos {
+		ownerName, namePattern, _ := strings.Cut(strings.ToLower(pattern), "/")
+		owner, err := user_model.GetUserByName(ctx, ownerName)
+		if err != nil {
+			if user_model.IsErrUserNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		repos, _, err := repo_model.SearchRepository(ctx, repo_model.SearchRepoOptions{
+			ListOptions: db.ListOptionsAll,
+			Actor:       doer,
+			OwnerID:     owner.ID,
+			Private:     doer != nil,
+			Collaborate: optional.Some(false),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			if ok, _ := path.Match(namePattern, repo.LowerName); ok && inScope(repo) {
+				repoIDs.Add(repo.ID)
+			}
+		}
+	}
+	return repoIDs.Values(), nil
+}
+
+// resolveQueryUserID returns the ID of the user of the name, it returns 0 if the user doesn't exist
+func resolveQueryUserID(ctx context.Context, doer *user_model.User, name string) (int64, error) {
+	if name == QueryUserMe {
+		if doer == nil {
+			return 0, util.NewInvalidArgumentErrorf("%s requires a signed-in user", QueryUserMe)
+		}
+		return doer.ID, nil
+	}
+	u, err := user_model.GetUserByName(ctx, name)
+	if err != nil {
+		if user_model.IsErrUserNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return u.ID, nil
+}
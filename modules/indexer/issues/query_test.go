@@ -0,0 +1,162 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issues
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuery(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	day := func(year int, month time.Month, d int) int64 {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC).Unix()
+	}
+
+	q, err := parseQuery(`is:open label:bug repo:org/* assignee:@me -label:wontfix updated:>7d crash "on start"`, now)
+	require.NoError(t, err)
+	assert.Equal(t, &Query{
+		Keyword:        "crash on start",
+		IsClosed:       optional.Some(false),
+		Repos:          []string{"org/*"},
+		Labels:         [][]string{{"bug"}},
+		ExcludedLabels: []string{"wontfix"},
+		Assignee:       QueryUserMe,
+		UpdatedAfter:   optional.Some(now.AddDate(0, 0, -7).Unix()),
+	}, q)
+
+	q, err = parseQuery(`is:pr is:closed label:"good first issue",help org:gitea user:lunny milestone:v1.0 no:assignee sort:comments-asc`, now)
+	require.NoError(t, err)
+	assert.Equal(t, &Query{
+		IsPull:     optional.Some(true),
+		IsClosed:   optional.Some(true),
+		Repos:      []string{"gitea/*", "lunny/*"},
+		Labels:     [][]string{{"good first issue", "help"}},
+		Milestones: []string{"v1.0"},
+		NoAssignee: true,
+		SortBy:     SortByCommentsAsc,
+	}, q)
+
+	q, err = parseQuery(`author:user1 review-requested:@me reviewed-by:user2 mentions:user3 no:label no:milestone`, now)
+	require.NoError(t, err)
+	assert.Equal(t, &Query{
+		Author:          "user1",
+		Mentions:        "user3",
+		ReviewRequested: QueryUserMe,
+		ReviewedBy:      "user2",
+		NoLabel:         true,
+		NoMilestone:     true,
+	}, q)
+
+	// the unknown qualifiers are kept in the keyword
+	q, err = parseQuery(`fix:crash http://example.com`, now)
+	require.NoError(t, err)
+	assert.Equal(t, "fix:crash http://example.com", q.Keyword)
+
+	for _, c := range []struct {
+		query         string
+		after, before optional.Option[int64]
+	}{
+		{"updated:>2026-03-01", optional.Some(day(2026, 3, 2)), nil},
+		{"updated:>=2026-03-01", optional.Some(day(2026, 3, 1)), nil},
+		{"updated:<2026-03-01", nil, optional.Some(day(2026, 3, 1))},
+		{"updated:<=2026-03-01", nil, optional.Some(day(2026, 3, 2))},
+		{"updated:2026-03-01", optional.Some(day(2026, 3, 1)), optional.Some(day(2026, 3, 2))},
+		{"updated:2026-03-01..2026-03-10", optional.Some(day(2026, 3, 1)), optional.Some(day(2026, 3, 11))},
+		{"updated:<2w", nil, optional.Some(now.AddDate(0, 0, -14).Unix())},
+		{"updated:>12h", optional.Some(now.Add(-12 * time.Hour).Unix()), nil},
+	} {
+		q, err := parseQuery(c.query, now)
+		require.NoError(t, err, c.query)
+		assert.Equal(t, c.after, q.UpdatedAfter, c.query)
+		assert.Equal(t, c.before, q.UpdatedBefore, c.query)
+	}
+
+	for _, query := range []string{
+		"is:draft",
+		"repo:gitea",
+		"no:project",
+		"-author:user1",
+		"author:user1 author:user2",
+		"label:",
+		"updated:7d",
+		"updated:>yesterday",
+		"sort:stars",
+	} {
+		_, err := parseQuery(query, now)
+		assert.ErrorIs(t, err, util.ErrInvalidArgument, query)
+	}
+}
+
+func TestQueryToSearchOptions(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	user5 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 5})
+	scope := &SearchOptions{AllPublic: true, SortBy: SortByUpdatedDesc}
+
+	q, err := ParseQuery(`is:issue repo:user2/repo1 label:label1,label2 -label:orglabel3 milestone:milestone1 assignee:@me author:user1 sort:created-asc first`)
+	require.NoError(t, err)
+	opts, err := q.ToSearchOptions(t.Context(), user2, scope)
+	require.NoError(t, err)
+	assert.Equal(t, "first", opts.Keyword)
+	assert.Equal(t, optional.Some(false), opts.IsPull)
+	assert.Equal(t, []int64{1}, opts.RepoIDs)
+	assert.False(t, opts.AllPublic)
+	assert.Equal(t, [][]int64{{1, 2}}, opts.IncludedLabelIDGroups)
+	assert.Equal(t, []int64{3}, opts.ExcludedLabelIDs)
+	assert.Equal(t, []int64{1}, opts.MilestoneIDs)
+	assert.Equal(t, "2", opts.AssigneeID)
+	assert.Equal(t, "1", opts.PosterID)
+	assert.Equal(t, SortByCreatedAsc, opts.SortBy)
+	// the scope is not changed
+	assert.True(t, scope.AllPublic)
+	assert.Empty(t, scope.RepoIDs)
+
+	// the private repositories are only visible to the users who can access them
+	// and they must be in the repositories of the scope
+	q, err = ParseQuery(`repo:user2/repo*`)
+	require.NoError(t, err)
+	privateScope := &SearchOptions{RepoIDs: []int64{2}, AllPublic: true}
+	opts, err = q.ToSearchOptions(t.Context(), user2, privateScope)
+	require.NoError(t, err)
+	assert.Contains(t, opts.RepoIDs, int64(1))
+	assert.Contains(t, opts.RepoIDs, int64(2))
+	opts, err = q.ToSearchOptions(t.Context(), user5, privateScope)
+	require.NoError(t, err)
+	assert.Contains(t, opts.RepoIDs, int64(1))
+	assert.NotContains(t, opts.RepoIDs, int64(2))
+	opts, err = q.ToSearchOptions(t.Context(), user2, scope)
+	require.NoError(t, err)
+	assert.Contains(t, opts.RepoIDs, int64(1))
+	assert.NotContains(t, opts.RepoIDs, int64(2))
+
+	// the repo qualifiers can't search out of the scope
+	opts, err = q.ToSearchOptions(t.Context(), user2, &SearchOptions{RepoIDs: []int64{2, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, opts.RepoIDs)
+
+	// unknown names match nothing
+	for _, query := range []string{"repo:user2/unknown", "label:unknown", "milestone:unknown", "author:unknown"} {
+		q, err := ParseQuery(query)
+		require.NoError(t, err)
+		opts, err := q.ToSearchOptions(t.Context(), user2, scope)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{0}, opts.RepoIDs, query)
+		assert.False(t, opts.AllPublic, query)
+	}
+
+	q, err = ParseQuery(`assignee:@me`)
+	require.NoError(t, err)
+	_, err = q.ToSearchOptions(t.Context(), nil, scope)
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
@@ -97,6 +97,20 @@ func getIssueIndexerData(ctx context.Context, issueID int64) (*internal.IndexerD
 		return nil, false, err
 	}
 
+	parentID, err := issue_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	fieldValues, err := issue_model.GetIssueFieldValues(ctx, []int64{issue.ID})
+	if err != nil {
+		return nil, false, err
+	}
+	issueFieldValues := make([]string, 0, len(fieldValues[issue.ID]))
+	for fieldID, value := range fieldValues[issue.ID] {
+		issueFieldValues = append(issueFieldValues, internal.IssueFieldValueTerm(fieldID, value))
+	}
+
 	if err := issue.Repo.LoadOwner(ctx); err != nil {
 		return nil, false, fmt.Errorf("issue.Repo.LoadOwner: %w", err)
 	}
@@ -116,6 +130,9 @@ func getIssueIndexerData(ctx context.Context, issueID int64) (*internal.IndexerD
 		MilestoneID:        issue.MilestoneID,
 		ProjectID:          projectID,
 		ProjectColumnID:    projectColumnID,
+		ParentID:           parentID,
+		TypeID:             issue.TypeID,
+		IssueFieldValues:   issueFieldValues,
 		PosterID:           issue.PosterID,
 		AssigneeID:         issue.AssigneeID,
 		MentionIDs:         mentionIDs,
@@ -38,6 +38,14 @@ func validateMetadata(template *api.IssueTemplate) error {
 	if strings.TrimSpace(template.About) == "" {
 		return errors.New("'about' is required")
 	}
+	if len(template.IssueFields) > 0 && strings.TrimSpace(template.IssueType) == "" {
+		return errors.New("'issue_fields' requires 'type'")
+	}
+	for name := range template.IssueFields {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("'issue_fields' must have field names")
+		}
+	}
 	return nil
 }
 
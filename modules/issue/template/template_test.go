@@ -42,6 +42,20 @@ about: "this is about"
 `,
 			wantErr: "'body' is required",
 		},
+		{
+			name: "issue fields without type",
+			content: `
+name: "test"
+about: "this is about"
+issue_fields:
+  Severity: "high"
+body:
+  - type: "markdown"
+    attributes:
+      value: "Bug report"
+`,
+			wantErr: "'issue_fields' requires 'type'",
+		},
 		{
 			name: "markdown miss value",
 			content: `
@@ -748,6 +762,28 @@ Content
 			},
 			wantErr: "",
 		},
+		{
+			name:     "issue type in markdown",
+			filename: "test.md",
+			content: `---
+name: Name
+about: About
+type: Bug
+issue_fields:
+  Severity: High
+---
+Content
+`,
+			want: &api.IssueTemplate{
+				Name:        "Name",
+				About:       "About",
+				IssueType:   "Bug",
+				IssueFields: map[string]string{"Severity": "High"},
+				Content:     "Content\n",
+				FileName:    "test.md",
+			},
+			wantErr: "",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,175 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+// Package junit parses the JUnit XML test reports, the de facto standard format produced by most test frameworks.
+package junit
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/modules/util"
+)
+
+const (
+	// MaxMessageLength is the max length of the message of a test case
+	MaxMessageLength = 1024
+	// MaxDetailsLength is the max length of the details (stack trace, output) of a test case
+	MaxDetailsLength = 64 * 1024
+)
+
+// ErrNotJUnit is returned when the root element of the document is neither <testsuites> nor <testsuite>
+var ErrNotJUnit = errors.New("not a JUnit XML report")
+
+// Status represents the result of a test case
+type Status int
+
+const (
+	StatusPassed  Status = iota + 1 // 1
+	StatusFailed                    // 2 the assertions of the test failed
+	StatusErrored                   // 3 the test couldn't complete because of an unexpected error
+	StatusSkipped                   // 4
+)
+
+// String returns the name of the status
+func (s Status) String() string {
+	switch s {
+	case StatusPassed:
+		return "passed"
+	case StatusFailed:
+		return "failed"
+	case StatusErrored:
+		return "errored"
+	case StatusSkipped:
+		return "skipped"
+	}
+	return "unknown"
+}
+
+// IsFailure returns whether the test failed or errored
+func (s Status) IsFailure() bool {
+	return s == StatusFailed || s == StatusErrored
+}
+
+// TestCase is the result of a test case of a report
+type TestCase struct {
+	Suite     string
+	ClassName string
+	Name      string
+	File      string
+	Status    Status
+	Duration  time.Duration
+	Message   string
+	Details   string
+}
+
+type problem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type testCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	File      string    `xml:"file,attr"`
+	Time      string    `xml:"time,attr"`
+	Failures  []problem `xml:"failure"`
+	Errors    []problem `xml:"error"`
+	Skipped   *problem  `xml:"skipped"`
+	SystemOut string    `xml:"system-out"`
+	SystemErr string    `xml:"system-err"`
+}
+
+type testSuite struct {
+	XMLName xml.Name
+	Name    string      `xml:"name,attr"`
+	Suites  []testSuite `xml:"testsuite"`
+	Cases   []testCase  `xml:"testcase"`
+}
+
+// Parse parses a JUnit XML report, the root element can be <testsuites> or a single <testsuite>
+func Parse(r io.Reader) ([]*TestCase, error) {
+	var root testSuite
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+	switch root.XMLName.Local {
+	case "testsuite":
+	case "testsuites":
+		// the name of <testsuites> is the name of the whole report, not of a suite
+		root.Name = ""
+	default:
+		return nil, ErrNotJUnit
+	}
+
+	var cases []*TestCase
+	collect(&root, "", &cases)
+	return cases, nil
+}
+
+func collect(suite *testSuite, parentName string, cases *[]*TestCase) {
+	name := suite.Name
+	if name == "" {
+		name = parentName
+	}
+	for i := range suite.Suites {
+		collect(&suite.Suites[i], name, cases)
+	}
+	for _, c := range suite.Cases {
+		*cases = append(*cases, toTestCase(name, &c))
+	}
+}
+
+func toTestCase(suite string, c *testCase) *TestCase {
+	tc := &TestCase{
+		Suite:     strings.TrimSpace(suite),
+		ClassName: strings.TrimSpace(c.ClassName),
+		Name:      strings.TrimSpace(c.Name),
+		File:      strings.TrimSpace(c.File),
+		Status:    StatusPassed,
+		Duration:  parseSeconds(c.Time),
+	}
+
+	var p *problem
+	switch {
+	case len(c.Errors) > 0:
+		tc.Status, p = StatusErrored, &c.Errors[0]
+	case len(c.Failures) > 0:
+		tc.Status, p = StatusFailed, &c.Failures[0]
+	case c.Skipped != nil:
+		tc.Status, p = StatusSkipped, c.Skipped
+	}
+	if p != nil {
+		tc.Message = strings.TrimSpace(p.Message)
+		if tc.Message == "" {
+			tc.Message = strings.TrimSpace(p.Type)
+		}
+		tc.Details = strings.TrimSpace(p.Text)
+	}
+	if tc.Status.IsFailure() && tc.Details == "" {
+		// some frameworks only write the output of the failed tests
+		tc.Details = strings.TrimSpace(c.SystemErr + "\n" + c.SystemOut)
+	}
+	tc.Message = util.EllipsisDisplayString(firstLine(tc.Message), MaxMessageLength)
+	tc.Details = util.EllipsisDisplayString(tc.Details, MaxDetailsLength)
+	return tc
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
+
+func parseSeconds(s string) time.Duration {
+	// some frameworks write thousands separators, e.g. "1,234.5"
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
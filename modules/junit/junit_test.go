@@ -0,0 +1,59 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package junit
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("TestSuites", func(t *testing.T) {
+		cases, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="all" tests="5">
+	<testsuite name="pkg/a" time="1.5">
+		<testcase classname="pkg/a" name="TestPass" time="0.25"/>
+		<testcase classname="pkg/a" name="TestFail" time="1,000.5">
+			<failure message="expected 1&#10;actual 2" type="AssertionError">a_test.go:12: expected 1
+actual 2</failure>
+		</testcase>
+		<testsuite>
+			<testcase classname="pkg/a/nested" name="TestError"><error type="panic"/><system-err>panic: boom</system-err></testcase>
+		</testsuite>
+	</testsuite>
+	<testsuite name="pkg/b">
+		<testcase name="TestSkip"><skipped message="not on windows"/></testcase>
+	</testsuite>
+</testsuites>`))
+		require.NoError(t, err)
+		require.Len(t, cases, 4)
+
+		assert.Equal(t, &TestCase{Suite: "pkg/a", ClassName: "pkg/a/nested", Name: "TestError", Status: StatusErrored, Message: "panic", Details: "panic: boom"}, cases[0])
+		assert.Equal(t, &TestCase{Suite: "pkg/a", ClassName: "pkg/a", Name: "TestPass", Status: StatusPassed, Duration: 250 * time.Millisecond}, cases[1])
+		assert.Equal(t, StatusFailed, cases[2].Status)
+		assert.Equal(t, "expected 1", cases[2].Message)
+		assert.Equal(t, "a_test.go:12: expected 1\nactual 2", cases[2].Details)
+		assert.Equal(t, 1000500*time.Millisecond, cases[2].Duration)
+		assert.Equal(t, &TestCase{Suite: "pkg/b", Name: "TestSkip", Status: StatusSkipped, Message: "not on windows"}, cases[3])
+	})
+
+	t.Run("TestSuite", func(t *testing.T) {
+		cases, err := Parse(strings.NewReader(`<testsuite name="single"><testcase name="a" time="abc"/></testsuite>`))
+		require.NoError(t, err)
+		require.Len(t, cases, 1)
+		assert.Equal(t, "single", cases[0].Suite)
+		assert.Zero(t, cases[0].Duration)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Parse(strings.NewReader(`<project></project>`))
+		assert.ErrorIs(t, err, ErrNotJUnit)
+		_, err = Parse(strings.NewReader(`not xml`))
+		assert.Error(t, err)
+	})
+}
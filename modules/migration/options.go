@@ -38,6 +38,8 @@ type MigrateOptions struct {
 	ReleaseAssets   bool
 	MigrateToRepoID int64
 	MirrorInterval  string `json:"mirror_interval"`
+	// ObjectFormatName converts the cloned repository to this object format if it is not empty
+	ObjectFormatName string `json:"object_format_name,omitempty"`
 
 	AWSAccessKeyID     string
 	AWSSecretAccessKey string
@@ -4,11 +4,14 @@
 package setting
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"code.gitea.io/gitea/modules/log"
+
+	"github.com/kballard/go-shellquote"
 )
 
 // Actions settings
@@ -24,14 +27,66 @@ var (
 		ZombieTaskTimeout     time.Duration     `ini:"ZOMBIE_TASK_TIMEOUT"`
 		EndlessTaskTimeout    time.Duration     `ini:"ENDLESS_TASK_TIMEOUT"`
 		AbandonedJobTimeout   time.Duration     `ini:"ABANDONED_JOB_TIMEOUT"`
+		JITRunnerTimeout      time.Duration     `ini:"JIT_RUNNER_TIMEOUT"`
 		SkipWorkflowStrings   []string          `ini:"SKIP_WORKFLOW_STRINGS"`
+		Scaler                ActionsScaler     `ini:"-"`
+
+		ExternalSecrets ActionsExternalSecrets `ini:"-"`
 	}{
 		Enabled:             true,
 		DefaultActionsURL:   defaultActionsURLGitHub,
 		SkipWorkflowStrings: []string{"[skip ci]", "[ci skip]", "[no ci]", "[skip actions]", "[actions skip]"},
+		Scaler: ActionsScaler{
+			Provider:    "exec",
+			MaxRunners:  10,
+			IdleTimeout: 10 * time.Minute,
+			ExecTimeout: time.Minute,
+		},
+		ExternalSecrets: ActionsExternalSecrets{
+			Provider:          "vault",
+			CacheTTL:          5 * time.Minute,
+			Timeout:           10 * time.Second,
+			AllowedPaths:      []string{"{owner}/{repo}/*"},
+			OwnerAllowedPaths: []string{"{owner}/*"},
+			VaultMount:        "secret",
+		},
 	}
 )
 
+// ActionsScaler represents the settings of the runner scaler which starts ephemeral runners for the waiting jobs
+type ActionsScaler struct {
+	Enabled          bool
+	Provider         string
+	Labels           []string // the labels the provider can start runners for, empty means any
+	MaxRunners       int
+	IdleTimeout      time.Duration
+	ExecStartCommand string
+	ExecStartArgs    []string `ini:"-"`
+	ExecStopCommand  string
+	ExecStopArgs     []string `ini:"-"`
+	ExecTimeout      time.Duration
+}
+
+// ActionsExternalSecrets represents the settings of the external secret manager which the actions secrets can reference.
+// A secret whose value is like "vault://path#key" gets the value of the key of the secret at the path when a task is dispatched.
+type ActionsExternalSecrets struct {
+	Enabled  bool
+	Provider string // it's also the scheme of the references
+	CacheTTL time.Duration
+	Timeout  time.Duration
+	// AllowedPaths are the glob patterns of the paths which can be referenced by the repository secrets,
+	// "{owner}" and "{repo}" are replaced by the names of the owner and the repository of a secret
+	AllowedPaths []string
+	// OwnerAllowedPaths are the glob patterns of the paths which can be referenced by the secrets of the users and the organizations,
+	// "{owner}" is replaced by the name of the owner of a secret
+	OwnerAllowedPaths []string
+
+	VaultAddress   string
+	VaultToken     string `ini:"-"`
+	VaultMount     string // the mount path of the KV version 2 secrets engine
+	VaultNamespace string
+}
+
 type defaultActionsURL string
 
 func (url defaultActionsURL) URL() string {
@@ -114,6 +169,14 @@ func loadActionsFrom(rootCfg ConfigProvider) error {
 	Actions.ZombieTaskTimeout = sec.Key("ZOMBIE_TASK_TIMEOUT").MustDuration(10 * time.Minute)
 	Actions.EndlessTaskTimeout = sec.Key("ENDLESS_TASK_TIMEOUT").MustDuration(3 * time.Hour)
 	Actions.AbandonedJobTimeout = sec.Key("ABANDONED_JOB_TIMEOUT").MustDuration(24 * time.Hour)
+	Actions.JITRunnerTimeout = sec.Key("JIT_RUNNER_TIMEOUT").MustDuration(time.Hour)
+
+	if err := loadActionsScalerFrom(rootCfg); err != nil {
+		return err
+	}
+	if err := loadActionsExternalSecretsFrom(rootCfg); err != nil {
+		return err
+	}
 
 	if !Actions.LogCompression.IsValid() {
 		return fmt.Errorf("invalid [actions] LOG_COMPRESSION: %q", Actions.LogCompression)
@@ -121,3 +184,72 @@ func loadActionsFrom(rootCfg ConfigProvider) error {
 
 	return nil
 }
+
+func loadActionsScalerFrom(rootCfg ConfigProvider) error {
+	sec := rootCfg.Section("actions.scaler")
+	if err := sec.MapTo(&Actions.Scaler); err != nil {
+		return fmt.Errorf("failed to map [actions.scaler] settings: %v", err)
+	}
+	// don't inherit ENABLED from [actions]
+	Actions.Scaler.Enabled = ConfigSectionKeyBool(sec, "ENABLED")
+	Actions.Scaler.IdleTimeout = sec.Key("IDLE_TIMEOUT").MustDuration(10 * time.Minute)
+	Actions.Scaler.ExecTimeout = sec.Key("EXEC_TIMEOUT").MustDuration(time.Minute)
+	if Actions.Scaler.MaxRunners <= 0 {
+		Actions.Scaler.MaxRunners = 10
+	}
+	if !Actions.Scaler.Enabled || Actions.Scaler.Provider != "exec" {
+		return nil
+	}
+
+	startArgs, err := shellquote.Split(sec.Key("EXEC_START_COMMAND").String())
+	if err != nil {
+		return fmt.Errorf("invalid [actions.scaler] EXEC_START_COMMAND: %v", err)
+	}
+	if len(startArgs) == 0 {
+		return errors.New("[actions.scaler] EXEC_START_COMMAND is required by the exec provider")
+	}
+	Actions.Scaler.ExecStartCommand, Actions.Scaler.ExecStartArgs = startArgs[0], startArgs[1:]
+
+	stopArgs, err := shellquote.Split(sec.Key("EXEC_STOP_COMMAND").String())
+	if err != nil {
+		return fmt.Errorf("invalid [actions.scaler] EXEC_STOP_COMMAND: %v", err)
+	}
+	if len(stopArgs) > 0 {
+		Actions.Scaler.ExecStopCommand, Actions.Scaler.ExecStopArgs = stopArgs[0], stopArgs[1:]
+	} else {
+		Actions.Scaler.ExecStopCommand, Actions.Scaler.ExecStopArgs = "", nil
+	}
+	return nil
+}
+
+func loadActionsExternalSecretsFrom(rootCfg ConfigProvider) error {
+	sec := rootCfg.Section("actions.external_secrets")
+	if err := sec.MapTo(&Actions.ExternalSecrets); err != nil {
+		return fmt.Errorf("failed to map [actions.external_secrets] settings: %v", err)
+	}
+	// don't inherit ENABLED from [actions]
+	Actions.ExternalSecrets.Enabled = ConfigSectionKeyBool(sec, "ENABLED")
+	Actions.ExternalSecrets.CacheTTL = sec.Key("CACHE_TTL").MustDuration(5 * time.Minute)
+	Actions.ExternalSecrets.Timeout = sec.Key("TIMEOUT").MustDuration(10 * time.Second)
+	Actions.ExternalSecrets.AllowedPaths = sec.Key("ALLOWED_PATHS").Strings(",")
+	if len(Actions.ExternalSecrets.AllowedPaths) == 0 {
+		Actions.ExternalSecrets.AllowedPaths = []string{"{owner}/{repo}/*"}
+	}
+	Actions.ExternalSecrets.OwnerAllowedPaths = sec.Key("OWNER_ALLOWED_PATHS").Strings(",")
+	if len(Actions.ExternalSecrets.OwnerAllowedPaths) == 0 {
+		Actions.ExternalSecrets.OwnerAllowedPaths = []string{"{owner}/*"}
+	}
+	if !Actions.ExternalSecrets.Enabled || Actions.ExternalSecrets.Provider != "vault" {
+		return nil
+	}
+
+	Actions.ExternalSecrets.VaultAddress = sec.Key("VAULT_ADDRESS").String()
+	if Actions.ExternalSecrets.VaultAddress == "" {
+		return errors.New("[actions.external_secrets] VAULT_ADDRESS is required by the vault provider")
+	}
+	Actions.ExternalSecrets.VaultToken = loadSecret(sec, "VAULT_TOKEN_URI", "VAULT_TOKEN")
+	if Actions.ExternalSecrets.VaultMount == "" {
+		Actions.ExternalSecrets.VaultMount = "secret"
+	}
+	return nil
+}
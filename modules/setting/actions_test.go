@@ -6,6 +6,7 @@ package setting
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -179,3 +180,74 @@ DEFAULT_ACTIONS_URL = gitea
 		})
 	}
 }
+
+func Test_loadActionsScalerFrom(t *testing.T) {
+	cfg, err := NewConfigProviderFromData(`
+[actions.scaler]
+ENABLED = true
+LABELS = ubuntu-latest,linux
+MAX_RUNNERS = 3
+IDLE_TIMEOUT = 5m
+EXEC_START_COMMAND = /usr/local/bin/start-runner --pool "small pool"
+EXEC_STOP_COMMAND = /usr/local/bin/stop-runner
+`)
+	require.NoError(t, err)
+	require.NoError(t, loadActionsFrom(cfg))
+	assert.True(t, Actions.Scaler.Enabled)
+	assert.Equal(t, "exec", Actions.Scaler.Provider)
+	assert.Equal(t, []string{"ubuntu-latest", "linux"}, Actions.Scaler.Labels)
+	assert.Equal(t, 3, Actions.Scaler.MaxRunners)
+	assert.Equal(t, 5*time.Minute, Actions.Scaler.IdleTimeout)
+	assert.Equal(t, time.Minute, Actions.Scaler.ExecTimeout)
+	assert.Equal(t, "/usr/local/bin/start-runner", Actions.Scaler.ExecStartCommand)
+	assert.Equal(t, []string{"--pool", "small pool"}, Actions.Scaler.ExecStartArgs)
+	assert.Equal(t, "/usr/local/bin/stop-runner", Actions.Scaler.ExecStopCommand)
+	assert.Empty(t, Actions.Scaler.ExecStopArgs)
+
+	cfg, err = NewConfigProviderFromData(`
+[actions.scaler]
+ENABLED = true
+`)
+	require.NoError(t, err)
+	assert.Error(t, loadActionsFrom(cfg))
+}
+
+func Test_loadActionsExternalSecretsFrom(t *testing.T) {
+	cfg, err := NewConfigProviderFromData(`
+[actions.external_secrets]
+ENABLED = true
+VAULT_ADDRESS = http://127.0.0.1:8200
+VAULT_TOKEN = root
+ALLOWED_PATHS = ci/{owner}/*,shared/*
+CACHE_TTL = 1m
+`)
+	require.NoError(t, err)
+	require.NoError(t, loadActionsFrom(cfg))
+	assert.True(t, Actions.ExternalSecrets.Enabled)
+	assert.Equal(t, "vault", Actions.ExternalSecrets.Provider)
+	assert.Equal(t, "http://127.0.0.1:8200", Actions.ExternalSecrets.VaultAddress)
+	assert.Equal(t, "root", Actions.ExternalSecrets.VaultToken)
+	assert.Equal(t, "secret", Actions.ExternalSecrets.VaultMount)
+	assert.Equal(t, []string{"ci/{owner}/*", "shared/*"}, Actions.ExternalSecrets.AllowedPaths)
+	assert.Equal(t, []string{"{owner}/*"}, Actions.ExternalSecrets.OwnerAllowedPaths)
+	assert.Equal(t, time.Minute, Actions.ExternalSecrets.CacheTTL)
+	assert.Equal(t, 10*time.Second, Actions.ExternalSecrets.Timeout)
+
+	// by default, the repository secrets can only reference the paths of their repositories
+	cfg, err = NewConfigProviderFromData(`
+[actions.external_secrets]
+ENABLED = true
+VAULT_ADDRESS = http://127.0.0.1:8200
+`)
+	require.NoError(t, err)
+	require.NoError(t, loadActionsFrom(cfg))
+	assert.Equal(t, []string{"{owner}/{repo}/*"}, Actions.ExternalSecrets.AllowedPaths)
+	assert.Equal(t, []string{"{owner}/*"}, Actions.ExternalSecrets.OwnerAllowedPaths)
+
+	cfg, err = NewConfigProviderFromData(`
+[actions.external_secrets]
+ENABLED = true
+`)
+	require.NoError(t, err)
+	assert.Error(t, loadActionsFrom(cfg))
+}
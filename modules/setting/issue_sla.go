@@ -0,0 +1,80 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/modules/log"
+)
+
+// IssueSLA settings, the targets of the SLA policies of the repositories are measured in business hours
+var IssueSLA = struct {
+	BusinessHours    string
+	BusinessDays     []string
+	Timezone         string
+	WarningThreshold int
+
+	WorkdayStart time.Duration  `ini:"-"`
+	WorkdayEnd   time.Duration  `ini:"-"`
+	Workdays     [7]bool        `ini:"-"`
+	Location     *time.Location `ini:"-"`
+}{
+	BusinessHours:    "09:00-17:00",
+	BusinessDays:     []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+	WarningThreshold: 75,
+	Location:         time.Local,
+}
+
+func parseWorkdayTime(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func loadIssueSLAFrom(rootCfg ConfigProvider) {
+	mustMapSetting(rootCfg, "issue_sla", &IssueSLA)
+
+	IssueSLA.WorkdayStart, IssueSLA.WorkdayEnd = 0, 0
+	if IssueSLA.BusinessHours != "" {
+		start, end, _ := strings.Cut(IssueSLA.BusinessHours, "-")
+		var err1, err2 error
+		IssueSLA.WorkdayStart, err1 = parseWorkdayTime(start)
+		IssueSLA.WorkdayEnd, err2 = parseWorkdayTime(end)
+		if err1 != nil || err2 != nil || IssueSLA.WorkdayStart >= IssueSLA.WorkdayEnd {
+			log.Fatal("Invalid [issue_sla] BUSINESS_HOURS %q, it should be like 09:00-17:00", IssueSLA.BusinessHours)
+		}
+	}
+
+	IssueSLA.Workdays = [7]bool{}
+	for _, day := range IssueSLA.BusinessDays {
+		if strings.TrimSpace(day) == "" {
+			continue
+		}
+		found := false
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if strings.EqualFold(strings.TrimSpace(day), weekday.String()[:3]) {
+				IssueSLA.Workdays[weekday], found = true, true
+			}
+		}
+		if !found {
+			log.Fatal("Invalid [issue_sla] BUSINESS_DAYS %q, the days should be like Mon, Tue", day)
+		}
+	}
+
+	IssueSLA.Location = DefaultUILocation
+	if IssueSLA.Timezone != "" {
+		var err error
+		if IssueSLA.Location, err = time.LoadLocation(IssueSLA.Timezone); err != nil {
+			log.Fatal("Invalid [issue_sla] TIMEZONE %q: %v", IssueSLA.Timezone, err)
+		}
+	}
+
+	if IssueSLA.WarningThreshold <= 0 || IssueSLA.WarningThreshold >= 100 {
+		log.Fatal("Invalid [issue_sla] WARNING_THRESHOLD %d, it should be a percentage between 1 and 99", IssueSLA.WarningThreshold)
+	}
+}
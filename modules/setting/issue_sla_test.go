@@ -0,0 +1,33 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/gitea/modules/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadIssueSLA(t *testing.T) {
+	defer test.MockVariableValue(&IssueSLA)()
+
+	cfg, err := NewConfigProviderFromData(`
+[issue_sla]
+BUSINESS_HOURS = 08:30-18:00
+BUSINESS_DAYS = sun, Mon
+TIMEZONE = Asia/Tokyo
+`)
+	require.NoError(t, err)
+	loadIssueSLAFrom(cfg)
+
+	assert.Equal(t, 8*time.Hour+30*time.Minute, IssueSLA.WorkdayStart)
+	assert.Equal(t, 18*time.Hour, IssueSLA.WorkdayEnd)
+	assert.Equal(t, [7]bool{time.Sunday: true, time.Monday: true}, IssueSLA.Workdays)
+	assert.Equal(t, "Asia/Tokyo", IssueSLA.Location.String())
+	assert.Equal(t, 75, IssueSLA.WarningThreshold)
+}
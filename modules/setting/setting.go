@@ -215,6 +215,7 @@ func LoadSettings() {
 	loadTaskFrom(CfgProvider)
 	LoadQueueSettings()
 	loadProjectFrom(CfgProvider)
+	loadIssueSLAFrom(CfgProvider)
 	loadMimeTypeMapFrom(CfgProvider)
 	loadFederationFrom(CfgProvider)
 }
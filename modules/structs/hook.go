@@ -379,6 +379,10 @@ const (
 	HookIssueReviewRequested HookIssueAction = "review_requested"
 	// HookIssueReviewRequestRemoved is an issue action for removing a review request to someone on a pull request.
 	HookIssueReviewRequestRemoved HookIssueAction = "review_request_removed"
+	// HookIssueSLAAtRisk is an issue action for when a target of the SLA of an issue is at risk.
+	HookIssueSLAAtRisk HookIssueAction = "sla_at_risk"
+	// HookIssueSLABreached is an issue action for when a target of the SLA of an issue is breached.
+	HookIssueSLABreached HookIssueAction = "sla_breached"
 )
 
 // IssuePayload represents the payload information that is sent along with an issue event.
@@ -397,6 +401,8 @@ type IssuePayload struct {
 	Sender *User `json:"sender"`
 	// The commit ID related to the issue action
 	CommitID string `json:"commit_id"`
+	// The escalated SLA target (for SLA actions)
+	SLA *IssueSLA `json:"sla,omitempty"`
 }
 
 // JSONPayload encodes the IssuePayload to JSON, with an indentation of two spaces.
@@ -642,3 +648,73 @@ type WorkflowJobPayload struct {
 func (p *WorkflowJobPayload) JSONPayload() ([]byte, error) {
 	return json.MarshalIndent(p, "", "  ")
 }
+
+// HookProjectAction an action that happens to a project
+type HookProjectAction string
+
+const (
+	// HookProjectCreated created
+	HookProjectCreated HookProjectAction = "created"
+	// HookProjectEdited edited
+	HookProjectEdited HookProjectAction = "edited"
+	// HookProjectClosed closed
+	HookProjectClosed HookProjectAction = "closed"
+	// HookProjectReopened reopened
+	HookProjectReopened HookProjectAction = "reopened"
+	// HookProjectDeleted deleted
+	HookProjectDeleted HookProjectAction = "deleted"
+)
+
+// ProjectPayload represents the payload information that is sent along with a project event
+type ProjectPayload struct {
+	// The action performed on the project
+	Action HookProjectAction `json:"action"`
+	// The project that was acted upon
+	Project *Project `json:"project"`
+	// The repository of the project, empty for the projects of users and organizations
+	Repository *Repository `json:"repository,omitempty"`
+	// The organization that owns the project (if applicable)
+	Organization *Organization `json:"organization,omitempty"`
+	// The user who performed the action
+	Sender *User `json:"sender"`
+}
+
+// JSONPayload implements Payload
+func (p *ProjectPayload) JSONPayload() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
+
+// HookProjectCardAction an action that happens to an issue on a project board
+type HookProjectCardAction string
+
+const (
+	// HookProjectCardCreated the issue was added to the project
+	HookProjectCardCreated HookProjectCardAction = "created"
+	// HookProjectCardMoved the issue was moved to another column of the project
+	HookProjectCardMoved HookProjectCardAction = "moved"
+	// HookProjectCardDeleted the issue was removed from the project
+	HookProjectCardDeleted HookProjectCardAction = "deleted"
+)
+
+// ProjectCardPayload represents the payload information that is sent along with a project card event
+type ProjectCardPayload struct {
+	// The action performed on the card
+	Action HookProjectCardAction `json:"action"`
+	// The project of the card
+	Project *Project `json:"project"`
+	// The column the issue is in, empty when the issue was removed from the project
+	Column *ProjectColumn `json:"column,omitempty"`
+	// The column the issue was in before it was moved or removed
+	OldColumn *ProjectColumn `json:"old_column,omitempty"`
+	// The issue of the card
+	Issue *Issue `json:"issue"`
+	// The repository of the issue
+	Repository *Repository `json:"repository"`
+	// The user who performed the action
+	Sender *User `json:"sender"`
+}
+
+// JSONPayload implements Payload
+func (p *ProjectCardPayload) JSONPayload() ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
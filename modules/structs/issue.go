@@ -82,6 +82,11 @@ type Issue struct {
 	Repo        *RepositoryMeta  `json:"repository"`
 
 	PinOrder int `json:"pin_order"`
+
+	// Type is the issue type of the issue
+	Type *IssueType `json:"type"`
+	// Fields are the values of the custom fields of the type of the issue by field name
+	Fields map[string]string `json:"fields"`
 }
 
 // CreateIssueOption options to create one issue
@@ -100,6 +105,10 @@ type CreateIssueOption struct {
 	// list of label ids
 	Labels []int64 `json:"labels"`
 	Closed bool    `json:"closed"`
+	// name of the issue type of the organization
+	Type string `json:"type"`
+	// values of the custom fields of the issue type by field name
+	Fields map[string]string `json:"fields"`
 }
 
 // EditIssueOption options for editing an issue
@@ -115,6 +124,10 @@ type EditIssueOption struct {
 	// swagger:strfmt date-time
 	Deadline       *time.Time `json:"due_date"`
 	RemoveDeadline *bool      `json:"unset_due_date"`
+	// name of the issue type of the organization, an empty name removes the type
+	Type *string `json:"type"`
+	// values of the custom fields of the issue type by field name, an empty value removes it
+	Fields map[string]string `json:"fields"`
 }
 
 // EditDeadlineOption options for creating a deadline
@@ -188,6 +201,10 @@ type IssueTemplate struct {
 	Content   string                   `json:"content" yaml:"-"`
 	Fields    []*IssueFormField        `json:"body" yaml:"body"`
 	FileName  string                   `json:"file_name" yaml:"-"`
+	// the name of an issue type of the owner of the repository
+	IssueType string `json:"issue_type" yaml:"type"`
+	// the values of custom fields of the issue type by field name
+	IssueFields map[string]string `json:"issue_fields" yaml:"issue_fields"`
 }
 
 type IssueTemplateStringSlice []string
@@ -274,3 +291,9 @@ type IssueMeta struct {
 type LockIssueOption struct {
 	Reason string `json:"lock_reason"`
 }
+
+// IssueImportResult is the result of an import of issues
+type IssueImportResult struct {
+	// number of the imported issues
+	Imported int `json:"imported"`
+}
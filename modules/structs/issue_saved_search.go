@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import "time"
+
+// IssueSavedSearch represents a named issue search query of a user
+// swagger:model
+type IssueSavedSearch struct {
+	// ID is the unique identifier for the saved search
+	ID int64 `json:"id"`
+	// Name is the display name of the saved search
+	Name string `json:"name"`
+	// Query is the search query like "is:open label:bug assignee:@me"
+	Query string `json:"query"`
+	// Type tells whether the search is run against issues or pull requests
+	// enum: issues,pulls
+	Type string `json:"type"`
+	// Pinned tells whether the search is shown on the dashboard of its owner
+	Pinned bool `json:"pinned"`
+	// Shared tells whether every signed-in user can run the search
+	Shared bool  `json:"shared"`
+	Owner  *User `json:"owner"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateIssueSavedSearchOption options for creating a saved issue search
+type CreateIssueSavedSearchOption struct {
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(100)"`
+	// required: true
+	Query string `json:"query" binding:"Required;MaxSize(1024)"`
+	// enum: issues,pulls
+	Type   string `json:"type"`
+	Pinned bool   `json:"pinned"`
+	Shared bool   `json:"shared"`
+}
+
+// EditIssueSavedSearchOption options for editing a saved issue search
+type EditIssueSavedSearchOption struct {
+	Name   *string `json:"name" binding:"MaxSize(100)"`
+	Query  *string `json:"query" binding:"MaxSize(1024)"`
+	Pinned *bool   `json:"pinned"`
+	Shared *bool   `json:"shared"`
+}
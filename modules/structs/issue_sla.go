@@ -0,0 +1,20 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import "time"
+
+// IssueSLA represents a target of the SLA policy of an issue which has been escalated
+type IssueSLA struct {
+	// Policy is the name of the SLA policy applying to the issue
+	Policy string `json:"policy"`
+	// Target is the escalated target of the policy
+	// enum: first_response,resolution
+	Target string `json:"target"`
+	// Status is the status of the target
+	// enum: at_risk,breached
+	Status string `json:"status"`
+	// swagger:strfmt date-time
+	Due time.Time `json:"due"`
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+// IssueType represents an issue type of an organization like bug or feature
+// swagger:model
+type IssueType struct {
+	// ID is the unique identifier for the issue type
+	ID int64 `json:"id"`
+	// Name is the display name of the issue type
+	Name string `json:"name"`
+	// Description provides additional context about the issue type's purpose
+	Description string `json:"description"`
+	// Icon is the name of the octicon of the issue type like "octicon-bug"
+	Icon string `json:"icon"`
+	// example: #d73a4a
+	Color string `json:"color"`
+	// Fields are the custom fields of the issues of the type
+	Fields []*IssueField `json:"fields"`
+}
+
+// IssueField represents a custom field of an issue type
+type IssueField struct {
+	// ID is the unique identifier for the field
+	ID int64 `json:"id"`
+	// Name is the name of the field
+	Name string `json:"name"`
+	// Type is the type of the values of the field
+	// enum: text,number,date,select
+	Type string `json:"type"`
+	// Options are the options of a select field
+	Options []string `json:"options"`
+}
+
+// CreateIssueTypeOption options for creating an issue type
+type CreateIssueTypeOption struct {
+	// required: true
+	Name        string `json:"name" binding:"Required;MaxSize(100)"`
+	Description string `json:"description" binding:"MaxSize(255)"`
+	// Icon is the name of the octicon of the issue type like "octicon-bug"
+	Icon string `json:"icon"`
+	// example: #d73a4a
+	Color string `json:"color" binding:"MaxSize(7)"`
+}
+
+// EditIssueTypeOption options for editing an issue type
+type EditIssueTypeOption struct {
+	Name        *string `json:"name" binding:"MaxSize(100)"`
+	Description *string `json:"description" binding:"MaxSize(255)"`
+	Icon        *string `json:"icon"`
+	// example: #d73a4a
+	Color *string `json:"color" binding:"MaxSize(7)"`
+}
+
+// CreateIssueFieldOption options for creating a custom field of an issue type
+type CreateIssueFieldOption struct {
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(100)"`
+	// required: true
+	// enum: text,number,date,select
+	Type string `json:"type" binding:"Required;In(text,number,date,select)"`
+	// Options are the options of a select field
+	Options []string `json:"options"`
+}
+
+// EditIssueFieldOption options for editing a custom field of an issue type, its type can't be changed
+type EditIssueFieldOption struct {
+	Name *string `json:"name" binding:"MaxSize(100)"`
+	// Options replace the options of a select field, the values of the removed options are cleared
+	Options []string `json:"options"`
+}
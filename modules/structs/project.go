@@ -0,0 +1,212 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package structs
+
+import (
+	"time"
+)
+
+// Project represents a project of a repository, an organization or a user
+type Project struct {
+	// ID is the unique identifier for the project
+	ID int64 `json:"id"`
+	// Title is the title of the project
+	Title string `json:"title"`
+	// Description provides details about the project
+	Description string `json:"description"`
+	// OwnerID is the user or organization owning the project, 0 for the projects of repositories
+	OwnerID int64 `json:"owner_id"`
+	// RepoID is the repository of the project, 0 for the projects of users and organizations
+	RepoID int64 `json:"repo_id"`
+	// State indicates if the project is open or closed
+	State StateType `json:"state"`
+	// CardType is the content shown on the cards of the board
+	// enum: text_only,images_and_text
+	CardType string `json:"card_type"`
+	// OpenIssues is the number of open issues in this project
+	OpenIssues int64 `json:"open_issues"`
+	// ClosedIssues is the number of closed issues in this project
+	ClosedIssues int64 `json:"closed_issues"`
+	// HTMLURL is the web page of the project
+	HTMLURL string `json:"html_url"`
+	Creator *User  `json:"creator"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+	// swagger:strfmt date-time
+	Closed *time.Time `json:"closed_at"`
+}
+
+// CreateProjectOption options for creating a project
+type CreateProjectOption struct {
+	// required: true
+	Title       string `json:"title" binding:"Required;MaxSize(255)"`
+	Description string `json:"description"`
+	// Template is the set of columns the project starts with
+	// enum: none,basic_kanban,bug_triage
+	Template string `json:"template" binding:"OmitEmpty;In(none,basic_kanban,bug_triage)"`
+	// enum: text_only,images_and_text
+	CardType string `json:"card_type" binding:"OmitEmpty;In(text_only,images_and_text)"`
+}
+
+// EditProjectOption options for editing a project
+type EditProjectOption struct {
+	Title       *string `json:"title" binding:"OmitEmpty;MaxSize(255)"`
+	Description *string `json:"description"`
+	// enum: text_only,images_and_text
+	CardType *string `json:"card_type" binding:"OmitEmpty;In(text_only,images_and_text)"`
+	// enum: open,closed
+	State *string `json:"state" binding:"OmitEmpty;In(open,closed)"`
+}
+
+// ProjectColumn represents a column of the board of a project
+type ProjectColumn struct {
+	// ID is the unique identifier for the column
+	ID int64 `json:"id"`
+	// ProjectID is the project the column belongs to
+	ProjectID int64 `json:"project_id"`
+	// Title is the title of the column
+	Title string `json:"title"`
+	// Color is the color of the column like "#2ea44f"
+	Color string `json:"color"`
+	// Default indicates if the issues added to the project without column go to this column
+	Default bool `json:"default"`
+	// Sorting is the position of the column on the board
+	Sorting int `json:"sorting"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateProjectColumnOption options for creating a column of a project
+type CreateProjectColumnOption struct {
+	// required: true
+	Title string `json:"title" binding:"Required;MaxSize(100)"`
+	// Color is the color of the column like "#2ea44f"
+	Color string `json:"color" binding:"MaxSize(7)"`
+}
+
+// EditProjectColumnOption options for editing a column of a project
+type EditProjectColumnOption struct {
+	Title *string `json:"title" binding:"OmitEmpty;MaxSize(100)"`
+	Color *string `json:"color" binding:"OmitEmpty;MaxSize(7)"`
+	// Sorting moves the column to this position on the board
+	Sorting *int `json:"sorting"`
+	// Default makes the column the default column of the project, it can only be set to true
+	Default *bool `json:"default"`
+}
+
+// ProjectIssue represents an issue on the board of a project
+type ProjectIssue struct {
+	// ProjectID is the project of the card
+	ProjectID int64 `json:"project_id"`
+	// ColumnID is the column the issue is in
+	ColumnID int64 `json:"column_id"`
+	// Position is the position of the issue in its column, starting at 0
+	Position int    `json:"position"`
+	Issue    *Issue `json:"issue"`
+}
+
+// AddProjectIssueOption options for adding an issue to a project, an issue is in at most one project
+// so it is removed from its previous project
+type AddProjectIssueOption struct {
+	// IssueID is the ID of the issue, not its index in the repository
+	// required: true
+	IssueID int64 `json:"issue_id" binding:"Required"`
+	// ColumnID is the column to put the issue in, the default column of the project is used if empty
+	ColumnID int64 `json:"column_id"`
+	// Position is the position of the issue in the column, the issue is put at the end if empty
+	Position *int `json:"position"`
+}
+
+// MoveProjectIssueOption options for moving an issue on the board of a project
+type MoveProjectIssueOption struct {
+	// ColumnID is the column to move the issue to
+	// required: true
+	ColumnID int64 `json:"column_id" binding:"Required"`
+	// Position is the position of the issue in the column, the issue is put at the end if empty
+	Position *int `json:"position"`
+}
+
+// ProjectFieldOption represents an option of a single select project field
+type ProjectFieldOption struct {
+	// ID is the identifier of the option in its field, it is assigned when the option is created
+	ID int64 `json:"id"`
+	// Name is the name of the option
+	Name string `json:"name"`
+	// Color is the color of the option like "#2ea44f"
+	Color string `json:"color"`
+}
+
+// ProjectField represents a custom field of a project
+type ProjectField struct {
+	// ID is the unique identifier for the field
+	ID int64 `json:"id"`
+	// ProjectID is the project the field belongs to
+	ProjectID int64 `json:"project_id"`
+	// Name is the name of the field
+	Name string `json:"name"`
+	// Type is the type of the values of the field
+	// enum: text,number,date,single_select,iteration
+	Type string `json:"type"`
+	// Options are the options of a single select field
+	Options []*ProjectFieldOption `json:"options"`
+	// IterationStartDate is the start date of the first iteration of an iteration field like "2006-01-02"
+	IterationStartDate string `json:"iteration_start_date,omitempty"`
+	// IterationDuration is the duration in days of the iterations of an iteration field
+	IterationDuration int `json:"iteration_duration,omitempty"`
+	// swagger:strfmt date-time
+	Created time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	Updated time.Time `json:"updated_at"`
+}
+
+// CreateProjectFieldOption options for creating a custom field of a project
+type CreateProjectFieldOption struct {
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(100)"`
+	// required: true
+	// enum: text,number,date,single_select,iteration
+	Type string `json:"type" binding:"Required;In(text,number,date,single_select,iteration)"`
+	// Options are the options of a single select field, their IDs are assigned by the server
+	Options []*ProjectFieldOption `json:"options"`
+	// IterationStartDate is the start date of the first iteration of an iteration field like "2006-01-02"
+	IterationStartDate string `json:"iteration_start_date"`
+	// IterationDuration is the duration in days of the iterations of an iteration field
+	IterationDuration int `json:"iteration_duration"`
+}
+
+// EditProjectFieldOption options for editing a custom field of a project, its type can't be changed
+type EditProjectFieldOption struct {
+	Name *string `json:"name" binding:"MaxSize(100)"`
+	// Options replace the options of a single select field, the options without ID are added
+	// and the values of the removed options are cleared
+	Options            []*ProjectFieldOption `json:"options"`
+	IterationStartDate *string               `json:"iteration_start_date"`
+	IterationDuration  *int                  `json:"iteration_duration"`
+}
+
+// ProjectFieldValue represents the value of a custom field of a project for an issue
+type ProjectFieldValue struct {
+	FieldID int64 `json:"field_id"`
+	// FieldName is the name of the field
+	FieldName string `json:"field_name"`
+	// enum: text,number,date,single_select,iteration
+	Type string `json:"type"`
+	// Value is the stored value: the text, the number, the date like "2006-01-02",
+	// the option ID of a single select field or the iteration number of an iteration field
+	Value string `json:"value"`
+	// DisplayValue is the text shown for the value, like the name of the option
+	DisplayValue string `json:"display_value"`
+}
+
+// SetProjectFieldValueOption options for setting the value of a custom field of a project for an issue
+type SetProjectFieldValueOption struct {
+	// Value is the text, the number, the date like "2006-01-02",
+	// the option ID of a single select field or the iteration number of an iteration field
+	// required: true
+	Value string `json:"value" binding:"Required"`
+}
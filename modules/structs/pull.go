@@ -191,3 +191,36 @@ type ChangedFile struct {
 	// The raw URL to download the file
 	RawURL string `json:"raw_url,omitempty"`
 }
+
+// PullRequestStackEntry represents a pull request of a stack of pull requests
+type PullRequestStackEntry struct {
+	// The pull request number
+	Number int64 `json:"number"`
+	// The title of the pull request
+	Title string `json:"title"`
+	// The state of the pull request
+	State StateType `json:"state"`
+	// Whether the pull request has been merged
+	HasMerged bool `json:"merged"`
+	// The base branch of the pull request
+	Base string `json:"base"`
+	// The head branch of the pull request
+	Head string `json:"head"`
+	// The number of the pull request it is stacked on, zero for the bottom of the stack
+	ParentNumber int64 `json:"parent_number"`
+	// Whether the pull request is rebased when its parent is merged
+	AutoRebase bool `json:"auto_rebase"`
+	// The depth of the pull request in the stack, zero for the bottom of the stack
+	Depth int `json:"depth"`
+	// The HTML URL of the pull request
+	HTMLURL string `json:"html_url"`
+}
+
+// SetPullRequestStackParentOption options for stacking a pull request on another one
+type SetPullRequestStackParentOption struct {
+	// The number of the parent pull request, its head branch must be the base branch of the pull request
+	// required: true
+	ParentNumber int64 `json:"parent_number" binding:"Required"`
+	// Whether the pull request is rebased onto the new base branch when the parent is merged
+	AutoRebase bool `json:"auto_rebase"`
+}
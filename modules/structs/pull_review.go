@@ -67,6 +67,12 @@ type PullReviewComment struct {
 	DiffHunk     string `json:"diff_hunk"`
 	LineNum      uint64 `json:"position"`
 	OldLineNum   uint64 `json:"original_position"`
+	// first new file line of a comment on several lines or 0
+	StartLineNum uint64 `json:"start_position"`
+	// first old file line of a comment on several lines or 0
+	OldStartLineNum uint64 `json:"original_start_position"`
+	// "line" for a comment on lines or "file" for a comment on the whole file
+	SubjectType string `json:"subject_type"`
 
 	HTMLURL     string `json:"html_url"`
 	HTMLPullURL string `json:"pull_request_url"`
@@ -89,6 +95,9 @@ type CreatePullReviewComment struct {
 	OldLineNum int64 `json:"old_position"`
 	// if comment to new file line or 0
 	NewLineNum int64 `json:"new_position"`
+	// first line of a comment on several lines, on the same side as the line, or 0.
+	// The comment is on the whole file if both the old and the new line are 0
+	StartLineNum int64 `json:"start_position"`
 }
 
 // SubmitPullReviewOptions are options to submit a pending pull request review
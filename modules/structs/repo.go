@@ -24,6 +24,8 @@ type InternalTracker struct {
 	AllowOnlyContributorsToTrackTime bool `json:"allow_only_contributors_to_track_time"`
 	// Enable dependencies for issues and pull requests (Built-in issue tracker)
 	EnableIssueDependencies bool `json:"enable_issue_dependencies"`
+	// Close the issues when all their sub-issues are closed (Built-in issue tracker)
+	CloseParentWhenSubIssuesClosed bool `json:"close_parent_when_sub_issues_closed"`
 }
 
 // ExternalTracker represents settings for external tracker
@@ -394,6 +396,10 @@ type MigrateRepoOptions struct {
 	PullRequests   bool   `json:"pull_requests"`
 	Releases       bool   `json:"releases"`
 	MirrorInterval string `json:"mirror_interval"`
+	// ObjectFormatName of the migrated git repository, the history is converted when it differs from the source repository.
+	// Empty keeps the object format of the source repository, the new commits of mirrors are converted when they are synchronized
+	// enum: sha1,sha256
+	ObjectFormatName string `json:"object_format_name" binding:"MaxSize(6)"`
 
 	AWSAccessKeyID     string `json:"aws_access_key_id"`
 	AWSSecretAccessKey string `json:"aws_secret_access_key"`
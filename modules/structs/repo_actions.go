@@ -205,3 +205,55 @@ type ActionRunnersResponse struct {
 	Entries    []*ActionRunner `json:"runners"`
 	TotalCount int64           `json:"total_count"`
 }
+
+// GenerateRunnerJITConfigOption options to create a just-in-time runner
+type GenerateRunnerJITConfigOption struct {
+	// name of the runner
+	//
+	// required: true
+	Name string `json:"name" binding:"Required;MaxSize(255)"`
+	// labels of the runner, it only runs the jobs whose `runs-on` labels are all in them
+	//
+	// required: true
+	Labels []string `json:"labels" binding:"Required"`
+}
+
+// ActionRunnerJITConfig represents the config of a just-in-time runner
+type ActionRunnerJITConfig struct {
+	Runner *ActionRunner `json:"runner"`
+	// the base64 encoded state file (.runner) of act_runner, it contains the token of the runner
+	EncodedJITConfig string `json:"encoded_jit_config"`
+}
+
+// ActionRequiredWorkflow represents a workflow required by an organization to run in its repositories
+type ActionRequiredWorkflow struct {
+	ID int64 `json:"id"`
+	// the name of the designated repository of the organization which contains the workflow file
+	RepoName string `json:"repo_name"`
+	// the path of the workflow file in the designated repository
+	WorkflowPath string `json:"workflow_path"`
+	// the glob pattern of the names of the targeted repositories, empty to target all repositories
+	RepoPattern string `json:"repo_pattern"`
+	// the topics of the targeted repositories, a repository is targeted if it has any of them
+	Topics []string `json:"topics"`
+	// swagger:strfmt date-time
+	CreatedAt time.Time `json:"created_at"`
+	// swagger:strfmt date-time
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateActionRequiredWorkflowOption options to create a required workflow of an organization
+type CreateActionRequiredWorkflowOption struct {
+	// the name of the designated repository of the organization which contains the workflow file
+	//
+	// required: true
+	RepoName string `json:"repo_name" binding:"Required"`
+	// the path of the workflow file in the designated repository, e.g. `.gitea/workflows/lint.yml`
+	//
+	// required: true
+	WorkflowPath string `json:"workflow_path" binding:"Required;MaxSize(255)"`
+	// the glob pattern of the names of the targeted repositories, empty to target all repositories
+	RepoPattern string `json:"repo_pattern" binding:"MaxSize(255)"`
+	// the topics of the targeted repositories, a repository is targeted if it has any of them
+	Topics []string `json:"topics"`
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package timeutil
+
+import "time"
+
+// BusinessHours is a weekly schedule of working time: the same hours on every working day of the week.
+// A schedule without working time counts every hour as a working hour.
+type BusinessHours struct {
+	// Start and End are the offsets from the midnight of a working day
+	Start, End time.Duration
+	// Days are the working days indexed by time.Weekday
+	Days     [7]bool
+	Location *time.Location
+}
+
+func (b *BusinessHours) hasWorkingTime() bool {
+	if b.Start >= b.End {
+		return false
+	}
+	for _, working := range b.Days {
+		if working {
+			return true
+		}
+	}
+	return false
+}
+
+// Add returns the time after the given duration of working time from t
+func (b *BusinessHours) Add(t time.Time, d time.Duration) time.Time {
+	if d <= 0 || !b.hasWorkingTime() {
+		return t.Add(d)
+	}
+	loc := b.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	t = t.In(loc)
+	for {
+		year, month, day := t.Date()
+		midnight := time.Date(year, month, day, 0, 0, 0, 0, loc)
+		if b.Days[t.Weekday()] {
+			open, closing := midnight.Add(b.Start), midnight.Add(b.End)
+			if t.Before(open) {
+				t = open
+			}
+			if t.Before(closing) {
+				available := closing.Sub(t)
+				if d <= available {
+					return t.Add(d)
+				}
+				d -= available
+			}
+		}
+		t = time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusinessHoursAdd(t *testing.T) {
+	b := &BusinessHours{
+		Start:    9 * time.Hour,
+		End:      17 * time.Hour,
+		Days:     [7]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true},
+		Location: time.UTC,
+	}
+	date := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.March, day, hour, minute, 0, 0, time.UTC) // 2026-03-02 is a Monday
+	}
+
+	for _, c := range []struct {
+		from     time.Time
+		d        time.Duration
+		expected time.Time
+	}{
+		{date(2, 10, 0), 4 * time.Hour, date(2, 14, 0)},
+		{date(2, 15, 0), 4 * time.Hour, date(3, 11, 0)},
+		{date(2, 7, 30), time.Hour, date(2, 10, 0)},
+		{date(2, 20, 0), 8 * time.Hour, date(3, 17, 0)},
+		{date(6, 16, 0), 2 * time.Hour, date(9, 10, 0)}, // over the weekend
+		{date(7, 12, 0), 30 * time.Minute, date(9, 9, 30)},
+		{date(2, 10, 0), 0, date(2, 10, 0)},
+	} {
+		assert.Equal(t, c.expected, b.Add(c.from, c.d), "%v + %v", c.from, c.d)
+	}
+
+	// without working time every hour counts
+	always := &BusinessHours{Location: time.UTC}
+	assert.Equal(t, date(7, 16, 0), always.Add(date(7, 12, 0), 4*time.Hour))
+}
@@ -32,6 +32,8 @@ const (
 	HookEventRelease                   HookEventType = "release"
 	HookEventPackage                   HookEventType = "package"
 	HookEventStatus                    HookEventType = "status"
+	HookEventProject                   HookEventType = "project"
+	HookEventProjectCard               HookEventType = "project_card"
 	// once a new event added here, please also added to AllEvents() function
 
 	// FIXME: This event should be a group of pull_request_review_xxx events
@@ -68,6 +70,8 @@ func AllEvents() []HookEventType {
 		HookEventRelease,
 		HookEventPackage,
 		HookEventStatus,
+		HookEventProject,
+		HookEventProjectCard,
 		HookEventWorkflowRun,
 		HookEventWorkflowJob,
 	}
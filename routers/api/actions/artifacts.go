@@ -300,7 +300,7 @@ func (ar artifactRoutes) uploadArtifact(ctx *ArtifactContext) {
 // comfirmUploadArtifact confirm upload artifact.
 // if all chunks are uploaded, merge them to one file.
 func (ar artifactRoutes) comfirmUploadArtifact(ctx *ArtifactContext) {
-	_, runID, ok := validateRunID(ctx)
+	task, runID, ok := validateRunID(ctx)
 	if !ok {
 		return
 	}
@@ -315,6 +315,10 @@ func (ar artifactRoutes) comfirmUploadArtifact(ctx *ArtifactContext) {
 		ctx.HTTPError(http.StatusInternalServerError, "Error merge chunks")
 		return
 	}
+	if err := actions_service.QueueTestReports(task, artifactName); err != nil {
+		// the artifact has been uploaded, so don't fail the upload because of the test reports
+		log.Error("Error queue test reports: %v", err)
+	}
 	ctx.JSON(http.StatusOK, map[string]string{
 		"message": "success",
 	})
@@ -44,6 +44,9 @@ var withRunner = connect.WithInterceptors(connect.UnaryInterceptorFunc(func(unar
 		if subtle.ConstantTimeCompare([]byte(runner.TokenHash), []byte(auth_model.HashToken(token, runner.TokenSalt))) != 1 {
 			return nil, status.Error(codes.Unauthenticated, "unregistered runner")
 		}
+		if runner.IsExpired() {
+			return nil, status.Error(codes.Unauthenticated, "runner registration has expired")
+		}
 
 		cols := []string{"last_online"}
 		runner.LastOnline = timeutil.TimeStampNow()
@@ -218,6 +218,7 @@ func (s *Service) UpdateTask(
 	}
 
 	actions_service.CreateCommitStatusForRunJobs(ctx, task.Job.Run, task.Job)
+	actions_service.NotifyRunUpdated(task.Job.RunID)
 
 	if task.Status.IsDone() {
 		notify_service.WorkflowJobStatusUpdate(ctx, task.Job.Run.Repo, task.Job.Run.TriggerUser, task.Job, task)
@@ -272,6 +273,12 @@ func (s *Service) UpdateLog(
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "write logs: %v", err)
 	}
+	// the annotations are extras of the log, so failing to save them shouldn't fail the upload
+	if annotations, summaries := actions.ParseAnnotations(rows, task.LogLength); len(annotations) > 0 || len(summaries) > 0 {
+		if err := actions_model.InsertTaskAnnotations(ctx, task, annotations, summaries); err != nil {
+			log.Error("InsertTaskAnnotations for task %d: %v", task.ID, err)
+		}
+	}
 	task.LogLength += int64(len(rows))
 	for _, n := range ns {
 		task.LogIndexes = append(task.LogIndexes, task.LogSize)
@@ -296,5 +303,11 @@ func (s *Service) UpdateLog(
 		remove()
 	}
 
+	if runID, err := actions_model.GetRunIDOfJob(ctx, task.JobID); err != nil {
+		log.Error("GetRunIDOfJob for task %d: %v", task.ID, err)
+	} else {
+		actions_service.NotifyRunUpdated(runID)
+	}
+
 	return res, nil
 }
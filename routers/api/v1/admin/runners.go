@@ -40,6 +40,32 @@ func CreateRegistrationToken(ctx *context.APIContext) {
 	shared.GetRegistrationToken(ctx, 0, 0)
 }
 
+// GenerateRunnerJITConfig creates a global just-in-time runner
+func GenerateRunnerJITConfig(ctx *context.APIContext) {
+	// swagger:operation POST /admin/actions/runners/generate-jitconfig admin adminGenerateRunnerJITConfig
+	// ---
+	// summary: Create a global just-in-time runner
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/GenerateRunnerJITConfigOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/RunnerJITConfig"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	shared.GenerateRunnerJITConfig(ctx, 0, 0)
+}
+
 // ListRunners get all runners
 func ListRunners(ctx *context.APIContext) {
 	// swagger:operation GET /admin/actions/runners admin getAdminRunners
@@ -525,6 +525,25 @@ func reqTeamMembership() func(ctx *context.APIContext) {
 	}
 }
 
+// reqOwnerProjectsAccess user should have the access mode to the projects of the user or the organization, or be a site admin
+// only the user itself can write to the projects of a user
+func reqOwnerProjectsAccess(mode perm.AccessMode) func(ctx *context.APIContext) {
+	return func(ctx *context.APIContext) {
+		if ctx.IsUserSiteAdmin() {
+			return
+		}
+		if ctx.ContextUser.IsOrganization() {
+			if organization.OrgFromUser(ctx.ContextUser).UnitPermission(ctx, ctx.Doer, unit.TypeProjects) < mode {
+				ctx.APIError(http.StatusForbidden, "user should have a permission to access the projects of the organization")
+			}
+			return
+		}
+		if mode > perm.AccessModeRead && (ctx.Doer == nil || ctx.Doer.ID != ctx.ContextUser.ID) {
+			ctx.APIError(http.StatusForbidden, "only the user can change the projects of the user")
+		}
+	}
+}
+
 // reqOrgMembership user should be an organization member, or a site admin
 func reqOrgMembership() func(ctx *context.APIContext) {
 	return func(ctx *context.APIContext) {
@@ -919,6 +938,7 @@ func Routes() *web.Router {
 				m.Get("", reqToken(), reqChecker, act.ListRunners)
 				m.Get("/registration-token", reqToken(), reqChecker, act.GetRegistrationToken)
 				m.Post("/registration-token", reqToken(), reqChecker, act.CreateRegistrationToken)
+				m.Post("/generate-jitconfig", reqToken(), reqChecker, bind(api.GenerateRunnerJITConfigOption{}), act.GenerateRunnerJITConfig)
 				m.Get("/{runner_id}", reqToken(), reqChecker, act.GetRunner)
 				m.Delete("/{runner_id}", reqToken(), reqChecker, act.DeleteRunner)
 			})
@@ -1003,6 +1023,31 @@ func Routes() *web.Router {
 				}, reqSelfOrAdmin(), reqBasicOrRevProxyAuth())
 
 				m.Get("/activities/feeds", user.ListUserActivityFeeds)
+
+				m.Group("/projects", func() {
+					m.Combo("").Get(user.ListProjects).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectOption{}), user.CreateProject)
+					m.Group("/{id}", func() {
+						m.Combo("").Get(user.GetProject).
+							Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectOption{}), user.EditProject).
+							Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), user.DeleteProject)
+						m.Combo("/columns").Get(user.ListProjectColumns).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectColumnOption{}), user.CreateProjectColumn)
+						m.Combo("/columns/{column_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+							Patch(bind(api.EditProjectColumnOption{}), user.EditProjectColumn).
+							Delete(user.DeleteProjectColumn)
+						m.Combo("/fields").Get(user.ListProjectFields).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectFieldOption{}), user.CreateProjectField)
+						m.Combo("/fields/{field_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+							Patch(bind(api.EditProjectFieldOption{}), user.EditProjectField).
+							Delete(user.DeleteProjectField)
+						m.Combo("/issues").Get(user.ListProjectIssues).
+							Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.AddProjectIssueOption{}), user.AddProjectIssue)
+						m.Combo("/issues/{issue_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+							Patch(bind(api.MoveProjectIssueOption{}), user.MoveProjectIssue).
+							Delete(user.RemoveProjectIssue)
+					})
+				}, reqOwnerProjectsAccess(perm.AccessModeRead))
 			}, context.UserAssignmentAPI(), checkTokenPublicOnly(), individualPermsChecker)
 		}, tokenRequiresScopes(auth_model.AccessTokenScopeCategoryUser))
 
@@ -1057,6 +1102,7 @@ func Routes() *web.Router {
 					m.Get("", reqToken(), user.ListRunners)
 					m.Get("/registration-token", reqToken(), user.GetRegistrationToken)
 					m.Post("/registration-token", reqToken(), user.CreateRegistrationToken)
+					m.Post("/generate-jitconfig", reqToken(), bind(api.GenerateRunnerJITConfigOption{}), user.GenerateRunnerJITConfig)
 					m.Get("/{runner_id}", reqToken(), user.GetRunner)
 					m.Delete("/{runner_id}", reqToken(), user.DeleteRunner)
 				})
@@ -1134,6 +1180,16 @@ func Routes() *web.Router {
 				m.Delete("", user.DeleteAvatar)
 			})
 
+			m.Group("/issue_saved_searches", func() {
+				m.Combo("").Get(user.ListIssueSavedSearches).
+					Post(bind(api.CreateIssueSavedSearchOption{}), user.CreateIssueSavedSearch)
+				m.Combo("/{id}").Get(user.GetIssueSavedSearch).
+					Patch(bind(api.EditIssueSavedSearchOption{}), user.EditIssueSavedSearch).
+					Delete(user.DeleteIssueSavedSearch)
+				// (issue scope)
+				m.Get("/{id}/issues", tokenRequiresScopes(auth_model.AccessTokenScopeCategoryIssue), user.RunIssueSavedSearch)
+			})
+
 			m.Group("/blocks", func() {
 				m.Get("", user.ListBlocks)
 				m.Group("/{username}", func() {
@@ -1271,8 +1327,11 @@ func Routes() *web.Router {
 						m.Group("/{run}", func() {
 							m.Get("", repo.GetWorkflowRun)
 							m.Delete("", reqToken(), reqRepoWriter(unit.TypeActions), repo.DeleteActionRun)
+							m.Post("/approve", reqToken(), reqRepoWriter(unit.TypeActions), repo.ApproveWorkflowRun)
+							m.Post("/reject", reqToken(), reqRepoWriter(unit.TypeActions), repo.RejectWorkflowRun)
 							m.Get("/jobs", repo.ListWorkflowRunJobs)
 							m.Get("/artifacts", repo.GetArtifactsOfRun)
+							m.Get("/logs", repo.DownloadActionsRunLogs)
 						})
 					})
 					m.Get("/artifacts", repo.GetArtifacts)
@@ -1357,6 +1416,12 @@ func Routes() *web.Router {
 						m.Post("/update", reqToken(), repo.UpdatePullRequest)
 						m.Get("/commits", repo.GetPullRequestCommits)
 						m.Get("/files", repo.GetPullRequestFiles)
+						m.Group("/stack", func() {
+							m.Get("", repo.GetPullRequestStack)
+							m.Combo("/parent", reqToken(), mustNotBeArchived).
+								Put(bind(api.SetPullRequestStackParentOption{}), repo.SetPullRequestStackParent).
+								Delete(repo.RemovePullRequestStackParent)
+						})
 						m.Combo("/merge").Get(repo.IsPullRequestMerged).
 							Post(reqToken(), mustNotBeArchived, bind(forms.MergePullRequestForm{}), repo.MergePullRequest).
 							Delete(reqToken(), mustNotBeArchived, repo.CancelScheduledAutoMerge)
@@ -1476,6 +1541,8 @@ func Routes() *web.Router {
 					m.Combo("").Get(repo.ListIssues).
 						Post(reqToken(), mustNotBeArchived, bind(api.CreateIssueOption{}), reqRepoReader(unit.TypeIssues), repo.CreateIssue)
 					m.Get("/pinned", reqRepoReader(unit.TypeIssues), repo.ListPinnedIssues)
+					m.Get("/export", reqRepoReader(unit.TypeIssues), repo.ExportIssues)
+					m.Post("/import", reqToken(), reqAdmin(), mustNotBeArchived, repo.ImportIssues)
 					m.Group("/comments", func() {
 						m.Get("", repo.ListRepoIssueComments)
 						m.Group("/{id}", func() {
@@ -1556,6 +1623,11 @@ func Routes() *web.Router {
 							Get(repo.GetIssueBlocks).
 							Post(reqToken(), bind(api.IssueMeta{}), repo.CreateIssueBlocking).
 							Delete(reqToken(), bind(api.IssueMeta{}), repo.RemoveIssueBlocking)
+						m.Combo("/sub_issues").
+							Get(repo.ListSubIssues).
+							Post(reqToken(), mustNotBeArchived, bind(api.IssueMeta{}), repo.AddSubIssue).
+							Delete(reqToken(), mustNotBeArchived, bind(api.IssueMeta{}), repo.RemoveSubIssue)
+						m.Get("/parent", repo.GetParentIssue)
 						m.Group("/pin", func() {
 							m.Combo("").
 								Post(reqToken(), reqAdmin(), repo.PinIssue).
@@ -1567,6 +1639,12 @@ func Routes() *web.Router {
 								Put(bind(api.LockIssueOption{}), repo.LockIssue).
 								Delete(repo.UnlockIssue)
 						}, reqToken(), reqAdmin())
+						m.Group("/project_fields", func() {
+							m.Get("", repo.ListIssueProjectFieldValues)
+							m.Combo("/{field_id}", reqToken(), mustNotBeArchived).
+								Put(bind(api.SetProjectFieldValueOption{}), repo.SetIssueProjectFieldValue).
+								Delete(repo.DeleteIssueProjectFieldValue)
+						})
 					})
 				}, mustEnableIssuesOrPulls)
 				m.Group("/labels", func() {
@@ -1576,6 +1654,32 @@ func Routes() *web.Router {
 						Patch(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.EditLabelOption{}), repo.EditLabel).
 						Delete(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), repo.DeleteLabel)
 				})
+				m.Group("/projects", func() {
+					m.Combo("").Get(repo.ListProjects).
+						Post(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), bind(api.CreateProjectOption{}), repo.CreateProject)
+					m.Group("/{id}", func() {
+						m.Combo("").Get(repo.GetProject).
+							Patch(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), bind(api.EditProjectOption{}), repo.EditProject).
+							Delete(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), repo.DeleteProject)
+						m.Combo("/columns").Get(repo.ListProjectColumns).
+							Post(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), bind(api.CreateProjectColumnOption{}), repo.CreateProjectColumn)
+						m.Combo("/columns/{column_id}", reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects)).
+							Patch(bind(api.EditProjectColumnOption{}), repo.EditProjectColumn).
+							Delete(repo.DeleteProjectColumn)
+						m.Combo("/issues").Get(repo.ListProjectIssues).
+							Post(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), bind(api.AddProjectIssueOption{}), repo.AddProjectIssue)
+						m.Combo("/issues/{issue_id}", reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects)).
+							Patch(bind(api.MoveProjectIssueOption{}), repo.MoveProjectIssue).
+							Delete(repo.RemoveProjectIssue)
+						m.Group("/fields", func() {
+							m.Combo("").Get(repo.ListProjectFields).
+								Post(reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects), bind(api.CreateProjectFieldOption{}), repo.CreateProjectField)
+							m.Combo("/{field_id}", reqToken(), mustNotBeArchived, reqRepoWriter(unit.TypeProjects)).
+								Patch(bind(api.EditProjectFieldOption{}), repo.EditProjectField).
+								Delete(repo.DeleteProjectField)
+						})
+					})
+				}, reqRepoReader(unit.TypeProjects))
 				m.Group("/milestones", func() {
 					m.Combo("").Get(repo.ListMilestones).
 						Post(reqToken(), reqRepoWriter(unit.TypeIssues, unit.TypePullRequests), bind(api.CreateMilestoneOption{}), repo.CreateMilestone)
@@ -1632,12 +1736,41 @@ func Routes() *web.Router {
 				reqOrgOwnership(),
 				org.NewAction(),
 			)
+			m.Group("/actions/required-workflows", func() {
+				m.Combo("").Get(org.ListRequiredWorkflows).
+					Post(bind(api.CreateActionRequiredWorkflowOption{}), org.CreateRequiredWorkflow)
+				m.Delete("/{id}", org.DeleteRequiredWorkflow)
+			}, reqToken(), reqOrgOwnership())
 			m.Group("/public_members", func() {
 				m.Get("", org.ListPublicMembers)
 				m.Combo("/{username}").Get(org.IsPublicMember).
 					Put(reqToken(), reqOrgMembership(), org.PublicizeMember).
 					Delete(reqToken(), reqOrgMembership(), org.ConcealMember)
 			})
+			m.Group("/projects", func() {
+				m.Combo("").Get(org.ListProjects).
+					Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectOption{}), org.CreateProject)
+				m.Group("/{id}", func() {
+					m.Combo("").Get(org.GetProject).
+						Patch(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.EditProjectOption{}), org.EditProject).
+						Delete(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), org.DeleteProject)
+					m.Combo("/columns").Get(org.ListProjectColumns).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectColumnOption{}), org.CreateProjectColumn)
+					m.Combo("/columns/{column_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+						Patch(bind(api.EditProjectColumnOption{}), org.EditProjectColumn).
+						Delete(org.DeleteProjectColumn)
+					m.Combo("/fields").Get(org.ListProjectFields).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.CreateProjectFieldOption{}), org.CreateProjectField)
+					m.Combo("/fields/{field_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+						Patch(bind(api.EditProjectFieldOption{}), org.EditProjectField).
+						Delete(org.DeleteProjectField)
+					m.Combo("/issues").Get(org.ListProjectIssues).
+						Post(reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite), bind(api.AddProjectIssueOption{}), org.AddProjectIssue)
+					m.Combo("/issues/{issue_id}", reqToken(), reqOwnerProjectsAccess(perm.AccessModeWrite)).
+						Patch(bind(api.MoveProjectIssueOption{}), org.MoveProjectIssue).
+						Delete(org.RemoveProjectIssue)
+				})
+			}, reqOwnerProjectsAccess(perm.AccessModeRead))
 			m.Group("/teams", func() {
 				m.Get("", org.ListTeams)
 				m.Post("", reqOrgOwnership(), bind(api.CreateTeamOption{}), org.CreateTeam)
@@ -1650,6 +1783,17 @@ func Routes() *web.Router {
 					Patch(reqToken(), reqOrgOwnership(), bind(api.EditLabelOption{}), org.EditLabel).
 					Delete(reqToken(), reqOrgOwnership(), org.DeleteLabel)
 			})
+			m.Group("/issue_types", func() {
+				m.Combo("").Get(org.ListIssueTypes).
+					Post(reqToken(), reqOrgOwnership(), bind(api.CreateIssueTypeOption{}), org.CreateIssueType)
+				m.Group("/{id}", func() {
+					m.Combo("").Patch(bind(api.EditIssueTypeOption{}), org.EditIssueType).
+						Delete(org.DeleteIssueType)
+					m.Post("/fields", bind(api.CreateIssueFieldOption{}), org.CreateIssueField)
+					m.Combo("/fields/{fieldID}").Patch(bind(api.EditIssueFieldOption{}), org.EditIssueField).
+						Delete(org.DeleteIssueField)
+				}, reqToken(), reqOrgOwnership())
+			})
 			m.Group("/hooks", func() {
 				m.Combo("").Get(org.ListHooks).
 					Post(bind(api.CreateHookOption{}), org.CreateHook)
@@ -1738,6 +1882,7 @@ func Routes() *web.Router {
 				m.Group("/runners", func() {
 					m.Get("", admin.ListRunners)
 					m.Post("/registration-token", admin.CreateRegistrationToken)
+					m.Post("/generate-jitconfig", bind(api.GenerateRunnerJITConfigOption{}), admin.GenerateRunnerJITConfig)
 					m.Get("/{runner_id}", admin.GetRunner)
 					m.Delete("/{runner_id}", admin.DeleteRunner)
 				})
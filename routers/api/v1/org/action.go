@@ -491,6 +491,37 @@ func (Action) UpdateVariable(ctx *context.APIContext) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// GenerateRunnerJITConfig creates an org-level just-in-time runner
+func (Action) GenerateRunnerJITConfig(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/actions/runners/generate-jitconfig organization orgGenerateRunnerJITConfig
+	// ---
+	// summary: Create an org-level just-in-time runner
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/GenerateRunnerJITConfigOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/RunnerJITConfig"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	shared.GenerateRunnerJITConfig(ctx, ctx.Org.Organization.ID, 0)
+}
+
 // ListRunners get org-level runners
 func (Action) ListRunners(ctx *context.APIContext) {
 	// swagger:operation GET /orgs/{org}/actions/runners organization getOrgRunners
@@ -629,7 +660,7 @@ func (Action) ListWorkflowRuns(ctx *context.APIContext) {
 	//   required: false
 	// - name: status
 	//   in: query
-	//   description: workflow status (pending, queued, in_progress, failure, success, skipped)
+	//   description: workflow status (pending, queued, in_progress, failure, success, skipped, action_required)
 	//   type: string
 	//   required: false
 	// - name: actor
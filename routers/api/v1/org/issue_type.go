@@ -0,0 +1,380 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+)
+
+func getOrgIssueType(ctx *context.APIContext) *issues_model.IssueType {
+	t, err := issues_model.GetIssueTypeByID(ctx, ctx.Org.Organization.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		if issues_model.IsErrIssueTypeNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	return t
+}
+
+func getOrgIssueField(ctx *context.APIContext) (*issues_model.IssueType, *issues_model.IssueField) {
+	t := getOrgIssueType(ctx)
+	if t == nil {
+		return nil, nil
+	}
+	field, err := issues_model.GetIssueFieldByID(ctx, t.ID, ctx.PathParamInt64("fieldID"))
+	if err != nil {
+		if issues_model.IsErrIssueFieldNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil, nil
+	}
+	return t, field
+}
+
+func handleIssueTypeError(ctx *context.APIContext, err error) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+		return
+	}
+	ctx.APIErrorInternal(err)
+}
+
+// ListIssueTypes list the issue types of an organization
+func ListIssueTypes(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/issue_types organization orgListIssueTypes
+	// ---
+	// summary: List an organization's issue types and their custom fields
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueTypeList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Org.Organization.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if err := types.LoadFields(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueTypeList(types))
+}
+
+// CreateIssueType create an issue type for an organization
+func CreateIssueType(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/issue_types organization orgCreateIssueType
+	// ---
+	// summary: Create an issue type for an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateIssueTypeOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/IssueType"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreateIssueTypeOption)
+	t := &issues_model.IssueType{
+		OwnerID:     ctx.Org.Organization.ID,
+		Name:        form.Name,
+		Description: form.Description,
+		Icon:        form.Icon,
+		Color:       form.Color,
+		Fields:      issues_model.IssueFieldList{},
+	}
+	if err := issues_model.NewIssueType(ctx, t); err != nil {
+		handleIssueTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToAPIIssueType(t))
+}
+
+// EditIssueType modify an issue type of an organization
+func EditIssueType(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/issue_types/{id} organization orgEditIssueType
+	// ---
+	// summary: Update an issue type
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type to edit
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditIssueTypeOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueType"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.EditIssueTypeOption)
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		t.Name = *form.Name
+	}
+	if form.Description != nil {
+		t.Description = *form.Description
+	}
+	if form.Icon != nil {
+		t.Icon = *form.Icon
+	}
+	if form.Color != nil {
+		t.Color = *form.Color
+	}
+	if err := issues_model.UpdateIssueType(ctx, t); err != nil {
+		handleIssueTypeError(ctx, err)
+		return
+	}
+	if err := t.LoadFields(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueType(t))
+}
+
+// DeleteIssueType delete an issue type of an organization
+func DeleteIssueType(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/issue_types/{id} organization orgDeleteIssueType
+	// ---
+	// summary: Delete an issue type, the issues of the type get no type
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type to delete
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueType(ctx, t); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// CreateIssueField create a custom field for an issue type of an organization
+func CreateIssueField(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/issue_types/{id}/fields organization orgCreateIssueField
+	// ---
+	// summary: Create a custom field for an issue type
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateIssueFieldOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/IssueField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreateIssueFieldOption)
+	t := getOrgIssueType(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	field := &issues_model.IssueField{
+		Name:    form.Name,
+		Type:    issues_model.ParseIssueFieldType(form.Type),
+		Options: form.Options,
+	}
+	if err := issues_model.NewIssueField(ctx, t, field); err != nil {
+		handleIssueTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToAPIIssueField(field))
+}
+
+// EditIssueField modify a custom field of an issue type of an organization
+func EditIssueField(ctx *context.APIContext) {
+	// swagger:operation PATCH /orgs/{org}/issue_types/{id}/fields/{fieldID} organization orgEditIssueField
+	// ---
+	// summary: Update a custom field of an issue type
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: fieldID
+	//   in: path
+	//   description: id of the field to edit
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditIssueFieldOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.EditIssueFieldOption)
+	_, field := getOrgIssueField(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if form.Name != nil {
+		field.Name = *form.Name
+	}
+	if form.Options != nil {
+		field.Options = form.Options
+	}
+	if err := issues_model.UpdateIssueField(ctx, field); err != nil {
+		handleIssueTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueField(field))
+}
+
+// DeleteIssueField delete a custom field of an issue type of an organization
+func DeleteIssueField(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/issue_types/{id}/fields/{fieldID} organization orgDeleteIssueField
+	// ---
+	// summary: Delete a custom field of an issue type and its values
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the issue type
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: fieldID
+	//   in: path
+	//   description: id of the field to delete
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	_, field := getOrgIssueField(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueField(ctx, field); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"errors"
+	"net/http"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/utils"
+	actions_service "code.gitea.io/gitea/services/actions"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+)
+
+// ListRequiredWorkflows list the required workflows of an organization
+func ListRequiredWorkflows(ctx *context.APIContext) {
+	// swagger:operation GET /orgs/{org}/actions/required-workflows organization orgListRequiredWorkflows
+	// ---
+	// summary: List the required workflows of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ActionRequiredWorkflowList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	rws, count, err := db.FindAndCount[actions_model.ActionRequiredWorkflow](ctx, actions_model.FindRequiredWorkflowsOptions{
+		OwnerID:     ctx.Org.Organization.ID,
+		ListOptions: utils.GetListOptions(ctx),
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if err := actions_model.RequiredWorkflowList(rws).LoadRepos(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiRequiredWorkflows := make([]*api.ActionRequiredWorkflow, len(rws))
+	for i, rw := range rws {
+		apiRequiredWorkflows[i] = convert.ToActionRequiredWorkflow(rw)
+	}
+
+	ctx.SetTotalCountHeader(count)
+	ctx.JSON(http.StatusOK, apiRequiredWorkflows)
+}
+
+// CreateRequiredWorkflow create a required workflow of an organization
+func CreateRequiredWorkflow(ctx *context.APIContext) {
+	// swagger:operation POST /orgs/{org}/actions/required-workflows organization orgCreateRequiredWorkflow
+	// ---
+	// summary: Create a required workflow of an organization
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateActionRequiredWorkflowOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ActionRequiredWorkflow"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "409":
+	//     description: the workflow is already required
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	opt := web.GetForm(ctx).(*api.CreateActionRequiredWorkflowOption)
+
+	rw, err := actions_service.CreateRequiredWorkflow(ctx, ctx.Org.Organization.AsUser(), ctx.Doer, actions_service.CreateRequiredWorkflowOptions{
+		RepoName:     opt.RepoName,
+		WorkflowPath: opt.WorkflowPath,
+		RepoPattern:  opt.RepoPattern,
+		Topics:       opt.Topics,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, util.ErrInvalidArgument), errors.Is(err, util.ErrNotExist):
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		case errors.Is(err, util.ErrAlreadyExist):
+			ctx.APIError(http.StatusConflict, err)
+		default:
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToActionRequiredWorkflow(rw))
+}
+
+// DeleteRequiredWorkflow delete a required workflow of an organization
+func DeleteRequiredWorkflow(ctx *context.APIContext) {
+	// swagger:operation DELETE /orgs/{org}/actions/required-workflows/{id} organization orgDeleteRequiredWorkflow
+	// ---
+	// summary: Delete a required workflow of an organization
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: org
+	//   in: path
+	//   description: name of the organization
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the required workflow
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	if err := actions_model.DeleteRequiredWorkflow(ctx, ctx.Org.Organization.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIErrorNotFound(err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
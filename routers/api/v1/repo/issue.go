@@ -356,6 +356,22 @@ func ListIssues(ctx *context.APIContext) {
 	//   in: query
 	//   description: Only show items in which the given user was mentioned
 	//   type: string
+	// - name: parent
+	//   in: query
+	//   description: Only show the sub-issues of the issue with the given id
+	//   type: integer
+	//   format: int64
+	// - name: issue_type
+	//   in: query
+	//   description: Only show the issues of the issue type with the given name
+	//   type: string
+	// - name: issue_field
+	//   in: query
+	//   description: Only show the issues with the given custom field values of the issue type, like "Severity:high"
+	//   type: array
+	//   collectionFormat: multi
+	//   items:
+	//     type: string
 	// - name: page
 	//   in: query
 	//   description: page number of results to return (1-based)
@@ -503,6 +519,24 @@ func ListIssues(ctx *context.APIContext) {
 	if mentionedByID > 0 {
 		searchOpt.MentionID = optional.Some(mentionedByID)
 	}
+	if parentID := ctx.FormInt64("parent"); parentID > 0 {
+		searchOpt.ParentID = optional.Some(parentID)
+	}
+	if typeName := ctx.FormTrim("issue_type"); typeName != "" {
+		t := getIssueTypeByName(ctx, typeName)
+		if ctx.Written() {
+			return
+		}
+		searchOpt.TypeID = optional.Some(t.ID)
+		fields := make(map[string]string)
+		for _, field := range ctx.FormStrings("issue_field") {
+			name, value, _ := strings.Cut(field, ":")
+			fields[name] = value
+		}
+		if searchOpt.IssueFieldValues = toIssueFieldValues(ctx, t, fields); ctx.Written() {
+			return
+		}
+	}
 
 	ids, total, err := issue_indexer.SearchIssues(ctx, searchOpt)
 	if err != nil {
@@ -642,9 +676,21 @@ func CreateIssue(ctx *context.APIContext) {
 	}
 
 	assigneeIDs := make([]int64, 0)
+	var issueFieldValues map[int64]string
 	var err error
 	if ctx.Repo.CanWrite(unit.TypeIssues) {
 		issue.MilestoneID = form.Milestone
+		issue.Type = getIssueTypeByName(ctx, form.Type)
+		if ctx.Written() {
+			return
+		}
+		if issue.Type != nil {
+			issue.TypeID = issue.Type.ID
+		}
+		issueFieldValues = toIssueFieldValues(ctx, issue.Type, form.Fields)
+		if ctx.Written() {
+			return
+		}
 		assigneeIDs, err = issues_model.MakeIDsFromAPIAssigneesToAdd(ctx, form.Assignee, form.Assignees)
 		if err != nil {
 			if user_model.IsErrUserNotExist(err) {
@@ -689,6 +735,11 @@ func CreateIssue(ctx *context.APIContext) {
 		return
 	}
 
+	if err := issue_service.SetIssueFieldValues(ctx, ctx.Doer, issue, issueFieldValues); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
 	if form.Closed {
 		if err := issue_service.CloseIssue(ctx, issue, ctx.Doer, ""); err != nil {
 			if issues_model.IsErrDependenciesLeft(err) {
@@ -866,6 +917,36 @@ func EditIssue(ctx *context.APIContext) {
 			return
 		}
 	}
+	if canWrite && form.Type != nil && !issue.IsPull {
+		t := getIssueTypeByName(ctx, *form.Type)
+		if ctx.Written() {
+			return
+		}
+		if err := issue_service.ChangeIssueType(ctx, ctx.Doer, issue, t); err != nil {
+			handleIssueTypeError(ctx, err)
+			return
+		}
+	}
+	if canWrite && form.Fields != nil {
+		if err := issue.LoadType(ctx); err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		if issue.Type != nil {
+			if err := issue.Type.LoadFields(ctx); err != nil {
+				ctx.APIErrorInternal(err)
+				return
+			}
+		}
+		values := toIssueFieldValues(ctx, issue.Type, form.Fields)
+		if ctx.Written() {
+			return
+		}
+		if err := issue_service.SetIssueFieldValues(ctx, ctx.Doer, issue, values); err != nil {
+			handleIssueTypeError(ctx, err)
+			return
+		}
+	}
 	if form.State != nil {
 		if issue.IsPull {
 			if err := issue.LoadPullRequest(ctx); err != nil {
@@ -0,0 +1,222 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/optional"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/migrations"
+)
+
+// ExportIssues exports the issues of a repository
+func ExportIssues(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/export issue issueExportIssues
+	// ---
+	// summary: Export the issues of a repository as a portable archive
+	// description: The JSON archive contains the labels, the milestones and the comments of the issues and can be imported
+	//   into another repository. The CSV file only contains the issues. Pull requests and attachments are not exported.
+	// produces:
+	// - application/json
+	// - text/csv
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: format
+	//   in: query
+	//   description: format of the export
+	//   type: string
+	//   enum: [json, csv]
+	// - name: state
+	//   in: query
+	//   description: whether issue is open or closed
+	//   type: string
+	//   enum: [closed, open, all]
+	// - name: labels
+	//   in: query
+	//   description: comma separated list of label names, only the issues with all these labels are exported
+	//   type: string
+	// - name: milestone
+	//   in: query
+	//   description: name of the milestone of the exported issues
+	//   type: string
+	// - name: comments
+	//   in: query
+	//   description: whether the comments are exported, defaults to true
+	//   type: boolean
+	// responses:
+	//   "200":
+	//     description: success
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	format := ctx.FormString("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" {
+		ctx.APIError(http.StatusUnprocessableEntity, "invalid format")
+		return
+	}
+	opts := migrations.ExportIssuesOptions{IncludeComments: ctx.FormOptionalBool("comments").ValueOrDefault(true)}
+	switch ctx.FormString("state") {
+	case "closed":
+		opts.IsClosed = optional.Some(true)
+	case "all":
+	default:
+		opts.IsClosed = optional.Some(false)
+	}
+
+	var err error
+	repo := ctx.Repo.Repository
+	if opts.LabelIDs, err = migrations.GetIssueArchiveLabelIDs(ctx, repo, ctx.FormString("labels")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	if opts.MilestoneIDs, err = migrations.GetIssueArchiveMilestoneIDs(ctx, repo, ctx.FormString("milestone")); err != nil {
+		if issues_model.IsErrMilestoneNotExist(err) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	archive, err := migrations.ExportIssues(ctx, repo, opts)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	buf := &bytes.Buffer{}
+	contentType := "application/json"
+	if format == "csv" {
+		contentType = "text/csv"
+		err = migrations.WriteIssuesCSV(buf, archive)
+	} else {
+		err = migrations.WriteIssueArchive(buf, archive)
+	}
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.ServeContent(bytes.NewReader(buf.Bytes()), &context.ServeHeaderOptions{
+		ContentType: contentType,
+		Filename:    migrations.IssueArchiveFilename(repo, format),
+	})
+}
+
+// ImportIssues imports issues into a repository
+func ImportIssues(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/issues/import issue issueImportIssues
+	// ---
+	// summary: Import issues from a JSON or CSV export into a repository
+	// description: The issues get new numbers, the labels and the milestones are matched by name or created.
+	//   The issues and the comments are posted by the doer and the names of their authors are kept,
+	//   site administrators can attribute them to the local users with the same names.
+	// consumes:
+	// - multipart/form-data
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: file
+	//   in: formData
+	//   description: JSON archive or CSV file exported by a Gitea instance
+	//   type: file
+	//   required: true
+	// - name: format
+	//   in: query
+	//   description: format of the file, found by the extension of the file name if empty
+	//   type: string
+	//   enum: [json, csv]
+	// - name: map_users
+	//   in: query
+	//   description: attribute the issues and the comments to the local users with the same names, only for site administrators
+	//   type: boolean
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/IssueImportResult"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "413":
+	//     "$ref": "#/responses/error"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	opts := migrations.ImportIssuesOptions{MapUsers: ctx.FormBool("map_users")}
+	if opts.MapUsers && !ctx.Doer.IsAdmin {
+		ctx.APIError(http.StatusForbidden, "only site administrators can map the users")
+		return
+	}
+
+	file, header, err := ctx.Req.FormFile("file")
+	if err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+		return
+	}
+	defer file.Close()
+	format := ctx.FormString("format")
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+	}
+
+	archive, err := migrations.ReadUploadedIssueArchive(file, format)
+	if err != nil {
+		switch {
+		case errors.Is(err, migrations.ErrIssueArchiveTooLarge):
+			ctx.APIError(http.StatusRequestEntityTooLarge, err)
+		case errors.Is(err, util.ErrInvalidArgument):
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		default:
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	n, err := migrations.ImportIssues(ctx, ctx.Doer, ctx.Repo.Repository, archive, opts)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, &api.IssueImportResult{Imported: n})
+}
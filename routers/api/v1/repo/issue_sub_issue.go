@@ -0,0 +1,314 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+// ListSubIssues list the sub-issues of an issue
+func ListSubIssues(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueListSubIssues
+	// ---
+	// summary: List the direct sub-issues of an issue
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !ctx.Repo.Permission.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIErrorNotFound()
+		return
+	}
+
+	subIssues, err := issues_model.GetSubIssues(ctx, issue.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if _, err := subIssues.LoadRepositories(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	perms := map[int64]access_model.Permission{ctx.Repo.Repository.ID: ctx.Repo.Permission}
+	visible := make([]*issues_model.Issue, 0, len(subIssues))
+	for _, subIssue := range subIssues {
+		perm, ok := perms[subIssue.RepoID]
+		if !ok {
+			if perm, err = access_model.GetUserRepoPermission(ctx, subIssue.Repo, ctx.Doer); err != nil {
+				ctx.APIErrorInternal(err)
+				return
+			}
+			perms[subIssue.RepoID] = perm
+		}
+		if perm.CanReadIssuesOrPulls(subIssue.IsPull) {
+			visible = append(visible, subIssue)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueList(ctx, ctx.Doer, visible))
+}
+
+// AddSubIssue make an issue a sub-issue of the issue
+func AddSubIssue(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueAddSubIssue
+	// ---
+	// summary: Make the issue in the form a sub-issue of the issue in the url
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the parent issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/IssueMeta"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/Issue"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	parent, subIssue := getSubIssueParams(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if err := issue_service.AddSubIssue(ctx, ctx.Doer, parent, subIssue); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, convert.ToAPIIssue(ctx, ctx.Doer, subIssue))
+}
+
+// RemoveSubIssue remove a sub-issue from the issue
+func RemoveSubIssue(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/issues/{index}/sub_issues issue issueRemoveSubIssue
+	// ---
+	// summary: Remove the issue in the form from the sub-issues of the issue in the url
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the parent issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/IssueMeta"
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "423":
+	//     "$ref": "#/responses/repoArchivedError"
+
+	parent, subIssue := getSubIssueParams(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	if err := issue_service.RemoveSubIssue(ctx, ctx.Doer, parent, subIssue); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.APIErrorNotFound(err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetParentIssue get the parent of an issue
+func GetParentIssue(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/parent issue issueGetParentIssue
+	// ---
+	// summary: Get the parent of an issue
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Issue"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue := getParamsIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !ctx.Repo.Permission.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIErrorNotFound()
+		return
+	}
+
+	parent, err := issue_service.GetParentIssue(ctx, ctx.Doer, issue)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if parent == nil {
+		ctx.APIErrorNotFound()
+		return
+	}
+
+	ctx.JSON(http.StatusOK, convert.ToAPIIssue(ctx, ctx.Doer, parent))
+}
+
+// getSubIssueParams returns the issue of the url and the issue of the form if the doer can change the sub-issues of both
+func getSubIssueParams(ctx *context.APIContext) (parent, subIssue *issues_model.Issue) {
+	parent = getParamsIssue(ctx)
+	if ctx.Written() {
+		return nil, nil
+	}
+	if !ctx.Repo.Permission.CanReadIssuesOrPulls(parent.IsPull) {
+		ctx.APIErrorNotFound()
+		return nil, nil
+	}
+	if !ctx.Repo.Permission.CanWriteIssuesOrPulls(parent.IsPull) {
+		ctx.APIError(http.StatusForbidden, "no permission to change the sub-issues of this issue")
+		return nil, nil
+	}
+
+	form := web.GetForm(ctx).(*api.IssueMeta)
+	repo := ctx.Repo.Repository
+	if form.Owner == "" {
+		form.Owner = repo.OwnerName
+	}
+	if form.Name != "" && (form.Owner != repo.OwnerName || form.Name != repo.Name) {
+		var err error
+		repo, err = repo_model.GetRepositoryByOwnerAndName(ctx, form.Owner, form.Name)
+		if err != nil {
+			if repo_model.IsErrRepoNotExist(err) {
+				ctx.APIErrorNotFound("IsErrRepoNotExist", err)
+			} else {
+				ctx.APIErrorInternal(err)
+			}
+			return nil, nil
+		}
+	}
+
+	subIssue, err := issues_model.GetIssueByIndex(ctx, repo.ID, form.Index)
+	if err != nil {
+		if issues_model.IsErrIssueNotExist(err) {
+			ctx.APIErrorNotFound("IsErrIssueNotExist", err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil, nil
+	}
+	subIssue.Repo = repo
+
+	perm := getPermissionForRepo(ctx, repo)
+	if ctx.Written() {
+		return nil, nil
+	}
+	if !perm.CanReadIssuesOrPulls(subIssue.IsPull) {
+		ctx.APIErrorNotFound()
+		return nil, nil
+	}
+	if !perm.CanWriteIssuesOrPulls(subIssue.IsPull) || repo.IsArchived {
+		ctx.APIError(http.StatusForbidden, "no permission to change the parent of the sub-issue")
+		return nil, nil
+	}
+	return parent, subIssue
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+)
+
+// getIssueTypeByName returns the issue type of the owner of the repository with the name and its fields,
+// nil is returned for an empty name
+func getIssueTypeByName(ctx *context.APIContext, name string) *issues_model.IssueType {
+	if strings.TrimSpace(name) == "" {
+		return nil
+	}
+	t, err := issues_model.GetIssueTypeByName(ctx, ctx.Repo.Repository.OwnerID, name)
+	if err != nil {
+		if issues_model.IsErrIssueTypeNotExist(err) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if err := t.LoadFields(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	return t
+}
+
+// toIssueFieldValues converts the values of custom fields by field name to normalized values by field id,
+// the values are checked against the fields of the issue type
+func toIssueFieldValues(ctx *context.APIContext, t *issues_model.IssueType, fields map[string]string) map[int64]string {
+	if len(fields) == 0 {
+		return nil
+	}
+	if t == nil {
+		ctx.APIError(http.StatusUnprocessableEntity, "fields require an issue type")
+		return nil
+	}
+	values := make(map[int64]string, len(fields))
+	for name, value := range fields {
+		field := t.Fields.GetFieldByName(name)
+		if field == nil {
+			ctx.APIError(http.StatusUnprocessableEntity, util.NewInvalidArgumentErrorf("issue type %q has no field %q", t.Name, name))
+			return nil
+		}
+		value, err := field.NormalizeValue(value)
+		if err != nil {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+			return nil
+		}
+		values[field.ID] = value
+	}
+	return values
+}
+
+func handleIssueTypeError(ctx *context.APIContext, err error) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+		return
+	}
+	ctx.APIErrorInternal(err)
+}
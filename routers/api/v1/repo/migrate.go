@@ -119,6 +119,13 @@ func Migrate(ctx *context.APIContext) {
 		return
 	}
 
+	if form.ObjectFormatName != "" {
+		if !git.IsValidObjectFormat(form.ObjectFormatName) {
+			ctx.APIError(http.StatusUnprocessableEntity, fmt.Errorf("unsupported object format %q", form.ObjectFormatName))
+			return
+		}
+	}
+
 	if setting.Repository.DisableMigrations {
 		ctx.APIError(http.StatusForbidden, errors.New("the site administrator has disabled migrations"))
 		return
@@ -160,6 +167,8 @@ func Migrate(ctx *context.APIContext) {
 		Releases:       form.Releases,
 		GitServiceType: gitServiceType,
 		MirrorInterval: form.MirrorInterval,
+
+		ObjectFormatName: form.ObjectFormatName,
 	}
 	if opts.Mirror {
 		opts.Issues = false
@@ -0,0 +1,381 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/shared"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+	project_service "code.gitea.io/gitea/services/projects"
+)
+
+// ListProjectFields list the custom fields of a project
+func ListProjectFields(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/projects/{id}/fields issue issueListProjectFields
+	// ---
+	// summary: List the custom fields of a repository project
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectFieldList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectFields(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// CreateProjectField add a custom field to a project
+func CreateProjectField(ctx *context.APIContext) {
+	// swagger:operation POST /repos/{owner}/{repo}/projects/{id}/fields issue issueCreateProjectField
+	// ---
+	// summary: Add a custom field to a repository project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectFieldOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// EditProjectField change a custom field of a project
+func EditProjectField(ctx *context.APIContext) {
+	// swagger:operation PATCH /repos/{owner}/{repo}/projects/{id}/fields/{field_id} issue issueEditProjectField
+	// ---
+	// summary: Edit a custom field of a repository project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectFieldOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectField"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// DeleteProjectField remove a custom field from a project
+func DeleteProjectField(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/projects/{id}/fields/{field_id} issue issueDeleteProjectField
+	// ---
+	// summary: Delete a custom field of a repository project and its values
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProjectField(ctx, 0, ctx.Repo.Repository.ID)
+}
+
+// getIssueProjectFields returns the issue, its project and the fields of the project
+func getIssueProjectFields(ctx *context.APIContext) (*issues_model.Issue, project_model.FieldList) {
+	issue, err := issues_model.GetIssueByIndex(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("index"))
+	if err != nil {
+		if issues_model.IsErrIssueNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil, nil
+	}
+	if err := issue.LoadProject(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil, nil
+	}
+	if issue.Project == nil {
+		return issue, nil
+	}
+	fields, err := project_model.GetFields(ctx, issue.Project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return nil, nil
+	}
+	return issue, fields
+}
+
+// ListIssueProjectFieldValues list the values of the custom fields of the project of an issue
+func ListIssueProjectFieldValues(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/issues/{index}/project_fields issue issueListProjectFieldValues
+	// ---
+	// summary: List the values of the custom fields of the project of an issue
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectFieldValueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	issue, fields := getIssueProjectFields(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	apiValues := make([]*api.ProjectFieldValue, 0, len(fields))
+	if len(fields) > 0 {
+		values, err := project_model.GetFieldValues(ctx, issue.Project.ID, []int64{issue.ID})
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		for _, field := range fields {
+			if value, ok := values[issue.ID][field.ID]; ok {
+				apiValues = append(apiValues, convert.ToAPIProjectFieldValue(field, value))
+			}
+		}
+	}
+	ctx.JSON(http.StatusOK, apiValues)
+}
+
+func setIssueProjectFieldValue(ctx *context.APIContext, value string) {
+	issue, fields := getIssueProjectFields(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !ctx.Repo.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "write permission is required")
+		return
+	}
+
+	fieldID := ctx.PathParamInt64("field_id")
+	var field *project_model.Field
+	for _, f := range fields {
+		if f.ID == fieldID {
+			field = f
+		}
+	}
+	if field == nil {
+		ctx.APIErrorNotFound()
+		return
+	}
+
+	if err := project_service.SetIssueFieldValues(ctx, issue.Project, issue, map[int64]string{field.ID: value}); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	if value == "" {
+		ctx.Status(http.StatusNoContent)
+		return
+	}
+	value, _ = field.NormalizeValue(value)
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectFieldValue(field, value))
+}
+
+// SetIssueProjectFieldValue set the value of a custom field of the project of an issue
+func SetIssueProjectFieldValue(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/issues/{index}/project_fields/{field_id} issue issueSetProjectFieldValue
+	// ---
+	// summary: Set the value of a custom field of the project of an issue
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/SetProjectFieldValueOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectFieldValue"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.SetProjectFieldValueOption)
+	setIssueProjectFieldValue(ctx, form.Value)
+}
+
+// DeleteIssueProjectFieldValue clear the value of a custom field of the project of an issue
+func DeleteIssueProjectFieldValue(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/issues/{index}/project_fields/{field_id} issue issueDeleteProjectFieldValue
+	// ---
+	// summary: Clear the value of a custom field of the project of an issue
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the issue
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	setIssueProjectFieldValue(ctx, "")
+}
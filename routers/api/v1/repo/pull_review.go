@@ -15,6 +15,7 @@ import (
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/gitrepo"
 	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
 	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/routers/api/v1/utils"
 	"code.gitea.io/gitea/services/context"
@@ -467,15 +468,16 @@ func CreatePullReview(ctx *context.APIContext) {
 
 	// create review comments
 	for _, c := range opts.Comments {
-		line := c.NewLineNum
+		line, startLine := c.NewLineNum, c.StartLineNum
 		if c.OldLineNum > 0 {
-			line = c.OldLineNum * -1
+			line, startLine = c.OldLineNum*-1, c.StartLineNum*-1
 		}
 
 		if _, err := pull_service.CreateCodeComment(ctx,
 			ctx.Doer,
 			ctx.Repo.GitRepo,
 			pr.Issue,
+			startLine,
 			line,
 			c.Body,
 			c.Path,
@@ -484,7 +486,11 @@ func CreatePullReview(ctx *context.APIContext) {
 			opts.CommitID,
 			nil,
 		); err != nil {
-			ctx.APIErrorInternal(err)
+			if errors.Is(err, util.ErrInvalidArgument) {
+				ctx.APIError(http.StatusUnprocessableEntity, err)
+			} else {
+				ctx.APIErrorInternal(err)
+			}
 			return
 		}
 	}
@@ -0,0 +1,228 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unit"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	pull_service "code.gitea.io/gitea/services/pull"
+)
+
+// GetPullRequestStack returns the stack of a pull request
+func GetPullRequestStack(ctx *context.APIContext) {
+	// swagger:operation GET /repos/{owner}/{repo}/pulls/{index}/stack repository repoGetPullRequestStack
+	// ---
+	// summary: Get the stack of pull requests a pull request belongs to
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the pull request
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PullRequestStack"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pr := getPullRequestForStack(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	stack, err := pull_service.GetStack(ctx, pr)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, toAPIPullRequestStack(ctx, stack))
+}
+
+// SetPullRequestStackParent stacks a pull request on another pull request
+func SetPullRequestStackParent(ctx *context.APIContext) {
+	// swagger:operation PUT /repos/{owner}/{repo}/pulls/{index}/stack/parent repository repoSetPullRequestStackParent
+	// ---
+	// summary: Stack a pull request on a parent pull request
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the pull request
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/SetPullRequestStackParentOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/PullRequestStack"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.SetPullRequestStackParentOption)
+
+	pr := getPullRequestForStack(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !pr.Issue.IsPoster(ctx.Doer.ID) && !ctx.Repo.CanWrite(unit.TypePullRequests) {
+		ctx.Status(http.StatusForbidden)
+		return
+	}
+
+	parent, err := issues_model.GetPullRequestByIndex(ctx, ctx.Repo.Repository.ID, form.ParentNumber)
+	if err != nil {
+		if issues_model.IsErrPullRequestNotExist(err) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	if err := pull_service.SetStackParent(ctx, ctx.Doer, pr, parent, form.AutoRebase); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+
+	stack, err := pull_service.GetStack(ctx, pr)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, toAPIPullRequestStack(ctx, stack))
+}
+
+// RemovePullRequestStackParent unstacks a pull request from its parent pull request
+func RemovePullRequestStackParent(ctx *context.APIContext) {
+	// swagger:operation DELETE /repos/{owner}/{repo}/pulls/{index}/stack/parent repository repoRemovePullRequestStackParent
+	// ---
+	// summary: Unstack a pull request from its parent pull request
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: owner
+	//   in: path
+	//   description: owner of the repo
+	//   type: string
+	//   required: true
+	// - name: repo
+	//   in: path
+	//   description: name of the repo
+	//   type: string
+	//   required: true
+	// - name: index
+	//   in: path
+	//   description: index of the pull request
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	pr := getPullRequestForStack(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !pr.Issue.IsPoster(ctx.Doer.ID) && !ctx.Repo.CanWrite(unit.TypePullRequests) {
+		ctx.Status(http.StatusForbidden)
+		return
+	}
+
+	if err := pull_service.RemoveStackParent(ctx, pr); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func getPullRequestForStack(ctx *context.APIContext) *issues_model.PullRequest {
+	pr, err := issues_model.GetPullRequestByIndex(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("index"))
+	if err != nil {
+		if issues_model.IsErrPullRequestNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if err := pr.LoadIssue(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	return pr
+}
+
+func toAPIPullRequestStack(ctx *context.APIContext, stack []*pull_service.StackEntry) []*api.PullRequestStackEntry {
+	numbers := make(map[int64]int64, len(stack))
+	for _, entry := range stack {
+		numbers[entry.PullRequest.ID] = entry.PullRequest.Index
+	}
+
+	result := make([]*api.PullRequestStackEntry, 0, len(stack))
+	for _, entry := range stack {
+		pr := entry.PullRequest
+		result = append(result, &api.PullRequestStackEntry{
+			Number:       pr.Index,
+			Title:        pr.Issue.Title,
+			State:        pr.Issue.State(),
+			HasMerged:    pr.HasMerged,
+			Base:         pr.BaseBranch,
+			Head:         pr.HeadBranch,
+			ParentNumber: numbers[entry.ParentPullID],
+			AutoRebase:   entry.AutoRebase,
+			Depth:        entry.Depth,
+			HTMLURL:      pr.Issue.HTMLURL(ctx),
+		})
+	}
+	return result
+}
@@ -809,6 +809,7 @@ func updateRepoUnits(ctx *context.APIContext, opts api.EditRepoOption) error {
 					EnableTimetracker:                opts.InternalTracker.EnableTimeTracker,
 					AllowOnlyContributorsToTrackTime: opts.InternalTracker.AllowOnlyContributorsToTrackTime,
 					EnableDependencies:               opts.InternalTracker.EnableIssueDependencies,
+					CloseParentWhenSubIssuesClosed:   opts.InternalTracker.CloseParentWhenSubIssuesClosed,
 				}
 			} else if unit, err := repo.GetUnit(ctx, unit_model.TypeIssues); err != nil {
 				// Unit type doesn't exist so we make a new config file with default values
@@ -133,6 +133,11 @@ func ListRuns(ctx *context.APIContext, ownerID, repoID int64) {
 		opts.Ref = string(git.RefNameFromBranch(branch))
 	}
 	for _, status := range ctx.FormStrings("status") {
+		// the runs awaiting approval of a maintainer
+		if status == "action_required" {
+			opts.NeedApproval = true
+			continue
+		}
 		values, err := convertToInternal(status)
 		if err != nil {
 			ctx.APIError(http.StatusBadRequest, fmt.Errorf("Invalid status %s", status))
@@ -0,0 +1,670 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package shared
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	project_model "code.gitea.io/gitea/models/project"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/utils"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+	project_service "code.gitea.io/gitea/services/projects"
+)
+
+// The handlers below serve the projects of a repository when repoID is set, or of a user or an organization when ownerID is set.
+// Access rights are checked at the API route level
+
+func getProject(ctx *context.APIContext, ownerID, repoID int64) *project_model.Project {
+	if ownerID != 0 && repoID != 0 {
+		setting.PanicInDevOrTesting("ownerID and repoID should not be both set")
+	}
+
+	var project *project_model.Project
+	var err error
+	if repoID > 0 {
+		project, err = project_model.GetProjectForRepoByID(ctx, repoID, ctx.PathParamInt64("id"))
+	} else {
+		project, err = project_model.GetProjectByIDAndOwner(ctx, ctx.PathParamInt64("id"), ownerID)
+	}
+	if err != nil {
+		if project_model.IsErrProjectNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	return project
+}
+
+func getProjectColumn(ctx *context.APIContext, project *project_model.Project, columnID int64) *project_model.Column {
+	column, err := project_model.GetColumnByIDAndProjectID(ctx, columnID, project.ID)
+	if err != nil {
+		if project_model.IsErrProjectColumnNotExist(err) {
+			ctx.APIErrorNotFound("Column not found")
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	return column
+}
+
+// getProjectIssue returns an issue the doer can change the project of
+func getProjectIssue(ctx *context.APIContext, issueID int64) *issues_model.Issue {
+	issue, err := issues_model.GetIssueByID(ctx, issueID)
+	if err != nil {
+		if issues_model.IsErrIssueNotExist(err) {
+			ctx.APIErrorNotFound("Issue not found")
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	perm, err := access_model.GetUserRepoPermission(ctx, issue.Repo, ctx.Doer)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	if !perm.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.APIErrorNotFound("Issue not found")
+		return nil
+	}
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) {
+		ctx.APIError(http.StatusForbidden, "user should have a permission to write to the issue")
+		return nil
+	}
+	return issue
+}
+
+func handleProjectError(ctx *context.APIContext, err error) {
+	switch {
+	case errors.Is(err, util.ErrInvalidArgument):
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+	case errors.Is(err, util.ErrPermissionDenied):
+		ctx.APIError(http.StatusForbidden, err)
+	default:
+		ctx.APIErrorInternal(err)
+	}
+}
+
+func toAPIProject(ctx *context.APIContext, project *project_model.Project) *api.Project {
+	if err := project_service.LoadIssueNumbersForProject(ctx, project, ctx.Doer); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	return convert.ToAPIProject(ctx, project, ctx.Doer)
+}
+
+func toCardType(cardType string) project_model.CardType {
+	if cardType == "images_and_text" {
+		return project_model.CardTypeImagesAndText
+	}
+	return project_model.CardTypeTextOnly
+}
+
+// ListProjects lists the projects of a repository, a user or an organization
+func ListProjects(ctx *context.APIContext, ownerID, repoID int64) {
+	var isClosed optional.Option[bool]
+	switch ctx.FormString("state") {
+	case "closed":
+		isClosed = optional.Some(true)
+	case "all":
+	default:
+		isClosed = optional.Some(false)
+	}
+
+	listOptions := utils.GetListOptions(ctx)
+	projects, total, err := db.FindAndCount[project_model.Project](ctx, project_model.SearchOptions{
+		ListOptions: listOptions,
+		OwnerID:     ownerID,
+		RepoID:      repoID,
+		IsClosed:    isClosed,
+		OrderBy:     db.SearchOrderByNewest,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if err := project_service.LoadIssueNumbersForProjects(ctx, projects, ctx.Doer); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiProjects := make([]*api.Project, 0, len(projects))
+	for _, project := range projects {
+		apiProjects = append(apiProjects, convert.ToAPIProject(ctx, project, ctx.Doer))
+	}
+
+	ctx.SetLinkHeader(int(total), listOptions.PageSize)
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, apiProjects)
+}
+
+// CreateProject creates a project in a repository, or for a user or an organization
+func CreateProject(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectOption)
+
+	project := &project_model.Project{
+		OwnerID:     ownerID,
+		RepoID:      repoID,
+		Title:       form.Title,
+		Description: form.Description,
+		CreatorID:   ctx.Doer.ID,
+		CardType:    toCardType(form.CardType),
+	}
+	switch {
+	case repoID > 0:
+		project.Type = project_model.TypeRepository
+	case ctx.ContextUser.IsOrganization():
+		project.Type = project_model.TypeOrganization
+	default:
+		project.Type = project_model.TypeIndividual
+	}
+	switch form.Template {
+	case "basic_kanban":
+		project.TemplateType = project_model.TemplateTypeBasicKanban
+	case "bug_triage":
+		project.TemplateType = project_model.TemplateTypeBugTriage
+	default:
+		project.TemplateType = project_model.TemplateTypeNone
+	}
+
+	if err := project_service.NewProject(ctx, ctx.Doer, project); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+
+	apiProject := toAPIProject(ctx, project)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusCreated, apiProject)
+}
+
+// GetProject returns a project
+func GetProject(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	apiProject := toAPIProject(ctx, project)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, apiProject)
+}
+
+// EditProject updates a project, and closes or reopens it
+func EditProject(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	if form.Title != nil || form.Description != nil || form.CardType != nil {
+		if form.Title != nil {
+			project.Title = *form.Title
+		}
+		if form.Description != nil {
+			project.Description = *form.Description
+		}
+		if form.CardType != nil {
+			project.CardType = toCardType(*form.CardType)
+		}
+		if err := project_service.UpdateProject(ctx, ctx.Doer, project); err != nil {
+			handleProjectError(ctx, err)
+			return
+		}
+	}
+	if form.State != nil {
+		if err := project_service.ChangeProjectStatus(ctx, ctx.Doer, project, *form.State == string(api.StateClosed)); err != nil {
+			handleProjectError(ctx, err)
+			return
+		}
+	}
+
+	apiProject := toAPIProject(ctx, project)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, apiProject)
+}
+
+// DeleteProject deletes a project
+func DeleteProject(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	if err := project_service.DeleteProject(ctx, ctx.Doer, project); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectColumns lists the columns of a project
+func ListProjectColumns(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	columns, err := project.GetColumns(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiColumns := make([]*api.ProjectColumn, 0, len(columns))
+	for _, column := range columns {
+		apiColumns = append(apiColumns, convert.ToAPIProjectColumn(column))
+	}
+	ctx.JSON(http.StatusOK, apiColumns)
+}
+
+// CreateProjectColumn adds a column to a project
+func CreateProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectColumnOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	column := &project_model.Column{
+		ProjectID: project.ID,
+		Title:     form.Title,
+		Color:     form.Color,
+		CreatorID: ctx.Doer.ID,
+	}
+	if err := project_model.NewColumn(ctx, column); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIProjectColumn(column))
+}
+
+// EditProjectColumn updates a column of a project, moves it on the board or makes it the default column
+func EditProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectColumnOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	column := getProjectColumn(ctx, project, ctx.PathParamInt64("column_id"))
+	if column == nil {
+		return
+	}
+
+	if form.Default != nil && !*form.Default && column.Default {
+		ctx.APIError(http.StatusUnprocessableEntity, "a project must have a default column, make another column the default one instead")
+		return
+	}
+
+	if form.Title != nil || form.Color != nil {
+		if form.Title != nil {
+			column.Title = *form.Title
+		}
+		if form.Color != nil {
+			column.Color = *form.Color
+		}
+		if err := project_model.UpdateColumn(ctx, column); err != nil {
+			handleProjectError(ctx, err)
+			return
+		}
+	}
+	if form.Sorting != nil {
+		columns, err := project.GetColumns(ctx)
+		if err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+		columnIDs := make([]int64, 0, len(columns))
+		for _, c := range columns {
+			if c.ID != column.ID {
+				columnIDs = append(columnIDs, c.ID)
+			}
+		}
+		position := min(max(*form.Sorting, 0), len(columnIDs))
+		columnIDs = slices.Insert(columnIDs, position, column.ID)
+
+		sortedColumnIDs := make(map[int64]int64, len(columnIDs))
+		for sorting, columnID := range columnIDs {
+			sortedColumnIDs[int64(sorting)] = columnID
+		}
+		if err := project_model.MoveColumnsOnProject(ctx, project, sortedColumnIDs); err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+	}
+	if form.Default != nil && *form.Default && !column.Default {
+		if err := project_model.SetDefaultColumn(ctx, project.ID, column.ID); err != nil {
+			ctx.APIErrorInternal(err)
+			return
+		}
+	}
+
+	column, err := project_model.GetColumn(ctx, column.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectColumn(column))
+}
+
+// DeleteProjectColumn deletes a column of a project, its issues are moved to the default column
+func DeleteProjectColumn(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	column := getProjectColumn(ctx, project, ctx.PathParamInt64("column_id"))
+	if column == nil {
+		return
+	}
+
+	if err := project_model.DeleteColumnByID(ctx, column.ID); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListProjectIssues lists the issues of a project the doer can see, column by column
+func ListProjectIssues(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	columns, err := project.GetColumns(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if columnID := ctx.FormInt64("column_id"); columnID > 0 {
+		column := getProjectColumn(ctx, project, columnID)
+		if column == nil {
+			return
+		}
+		columns = project_model.ColumnList{column}
+	}
+
+	opts := &issues_model.IssuesOptions{Doer: ctx.Doer}
+	if repoID > 0 {
+		opts.RepoIDs = []int64{repoID}
+	} else {
+		opts.Owner = ctx.ContextUser
+		opts.AllPublic = ctx.Doer == nil
+	}
+	issuesMap, err := project_service.LoadIssuesFromProject(ctx, project, opts)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiIssues := make([]*api.ProjectIssue, 0, len(issuesMap))
+	for _, column := range columns {
+		for position, issue := range issuesMap[column.ID] {
+			apiIssues = append(apiIssues, convert.ToAPIProjectIssue(ctx, ctx.Doer, column, position, issue))
+		}
+	}
+	ctx.JSON(http.StatusOK, apiIssues)
+}
+
+// AddProjectIssue adds an issue to a project
+func AddProjectIssue(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.AddProjectIssueOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	var column *project_model.Column
+	if form.ColumnID > 0 {
+		if column = getProjectColumn(ctx, project, form.ColumnID); column == nil {
+			return
+		}
+	}
+	issue := getProjectIssue(ctx, form.IssueID)
+	if issue == nil {
+		return
+	}
+	if !project.CanBeAccessedByOwnerRepo(issue.Repo.OwnerID, issue.Repo) {
+		ctx.APIError(http.StatusUnprocessableEntity, "the issue can't be added to this project")
+		return
+	}
+
+	if err := project_service.AddIssueToProject(ctx, ctx.Doer, project, issue, column, optional.FromPtr(form.Position).ValueOrDefault(-1)); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	writeProjectIssue(ctx, http.StatusCreated, issue)
+}
+
+// MoveProjectIssue moves an issue of a project to a column and a position of the board
+func MoveProjectIssue(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.MoveProjectIssueOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	column := getProjectColumn(ctx, project, form.ColumnID)
+	if column == nil {
+		return
+	}
+	issue := getProjectIssue(ctx, ctx.PathParamInt64("issue_id"))
+	if issue == nil {
+		return
+	}
+	if err := issue.LoadProject(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if issue.Project == nil || issue.Project.ID != project.ID {
+		ctx.APIErrorNotFound("Issue not found in project")
+		return
+	}
+
+	if err := project_service.MoveIssueOnProject(ctx, ctx.Doer, issue, column, optional.FromPtr(form.Position).ValueOrDefault(-1)); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	writeProjectIssue(ctx, http.StatusOK, issue)
+}
+
+// RemoveProjectIssue removes an issue from a project
+func RemoveProjectIssue(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	issue := getProjectIssue(ctx, ctx.PathParamInt64("issue_id"))
+	if issue == nil {
+		return
+	}
+	if err := issue.LoadProject(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if issue.Project == nil || issue.Project.ID != project.ID {
+		ctx.APIErrorNotFound("Issue not found in project")
+		return
+	}
+
+	if err := project_service.RemoveIssueFromProject(ctx, ctx.Doer, issue); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+func getProjectField(ctx *context.APIContext, project *project_model.Project, fieldID int64) *project_model.Field {
+	field, err := project_model.GetFieldByID(ctx, project.ID, fieldID)
+	if err != nil {
+		if project_model.IsErrProjectFieldNotExist(err) {
+			ctx.APIErrorNotFound("Field not found")
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	return field
+}
+
+func toFieldOptions(options []*api.ProjectFieldOption) []*project_model.FieldOption {
+	fieldOptions := make([]*project_model.FieldOption, 0, len(options))
+	for _, option := range options {
+		fieldOptions = append(fieldOptions, &project_model.FieldOption{
+			ID:    option.ID,
+			Name:  option.Name,
+			Color: option.Color,
+		})
+	}
+	return fieldOptions
+}
+
+// ListProjectFields lists the custom fields of a project
+func ListProjectFields(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	fields, err := project_model.GetFields(ctx, project.ID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiFields := make([]*api.ProjectField, 0, len(fields))
+	for _, field := range fields {
+		apiFields = append(apiFields, convert.ToAPIProjectField(field))
+	}
+	ctx.JSON(http.StatusOK, apiFields)
+}
+
+// CreateProjectField adds a custom field to a project
+func CreateProjectField(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.CreateProjectFieldOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+
+	field := &project_model.Field{
+		ProjectID:          project.ID,
+		Name:               form.Name,
+		Type:               project_model.ParseFieldType(form.Type),
+		Options:            toFieldOptions(form.Options),
+		IterationStartDate: form.IterationStartDate,
+		IterationDuration:  form.IterationDuration,
+	}
+	// the option IDs are assigned by the server
+	for _, option := range field.Options {
+		option.ID = 0
+	}
+	if err := project_model.NewField(ctx, field); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIProjectField(field))
+}
+
+// EditProjectField updates a custom field of a project
+func EditProjectField(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.EditProjectFieldOption)
+
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	field := getProjectField(ctx, project, ctx.PathParamInt64("field_id"))
+	if field == nil {
+		return
+	}
+
+	if form.Name != nil {
+		field.Name = *form.Name
+	}
+	if form.Options != nil {
+		field.Options = toFieldOptions(form.Options)
+	}
+	if form.IterationStartDate != nil {
+		field.IterationStartDate = *form.IterationStartDate
+	}
+	if form.IterationDuration != nil {
+		field.IterationDuration = *form.IterationDuration
+	}
+	if err := project_model.UpdateField(ctx, field); err != nil {
+		handleProjectError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIProjectField(field))
+}
+
+// DeleteProjectField deletes a custom field of a project and its values
+func DeleteProjectField(ctx *context.APIContext, ownerID, repoID int64) {
+	project := getProject(ctx, ownerID, repoID)
+	if project == nil {
+		return
+	}
+	field := getProjectField(ctx, project, ctx.PathParamInt64("field_id"))
+	if field == nil {
+		return
+	}
+
+	if err := project_model.DeleteField(ctx, field); err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// writeProjectIssue responds with the column and the position of an issue on the board of its project
+func writeProjectIssue(ctx *context.APIContext, status int, issue *issues_model.Issue) {
+	columnID, err := issue.ProjectColumnID(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	column, err := project_model.GetColumn(ctx, columnID)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	projectIssues, err := column.GetIssues(ctx)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	position := slices.IndexFunc(projectIssues, func(projectIssue *project_model.ProjectIssue) bool {
+		return projectIssue.IssueID == issue.ID
+	})
+	ctx.JSON(status, convert.ToAPIProjectIssue(ctx, ctx.Doer, column, position, issue))
+}
@@ -6,13 +6,16 @@ package shared
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
 	"code.gitea.io/gitea/modules/setting"
 	api "code.gitea.io/gitea/modules/structs"
 	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/routers/api/v1/utils"
+	actions_service "code.gitea.io/gitea/services/actions"
 	"code.gitea.io/gitea/services/context"
 	"code.gitea.io/gitea/services/convert"
 )
@@ -36,6 +39,47 @@ func GetRegistrationToken(ctx *context.APIContext, ownerID, repoID int64) {
 	ctx.JSON(http.StatusOK, RegistrationToken{Token: token.Token})
 }
 
+// GenerateRunnerJITConfig creates an ephemeral runner which could run one job,
+// it's deleted if it doesn't connect before JIT_RUNNER_TIMEOUT.
+// ownerID == 0 and repoID == 0 means a global runner
+// ownerID == 0 and repoID != 0 means a runner for the given repo
+// ownerID != 0 and repoID == 0 means a runner for the given user/org
+// Access rights are checked at the API route level
+func GenerateRunnerJITConfig(ctx *context.APIContext, ownerID, repoID int64) {
+	form := web.GetForm(ctx).(*api.GenerateRunnerJITConfigOption)
+	labels := make([]string, 0, len(form.Labels))
+	for _, label := range form.Labels {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			ctx.APIError(http.StatusUnprocessableEntity, "labels can't be empty")
+			return
+		}
+		labels = append(labels, label)
+	}
+
+	runner, config, err := actions_service.CreateJITRunner(ctx, actions_service.CreateJITRunnerOptions{
+		OwnerID: ownerID,
+		RepoID:  repoID,
+		Name:    form.Name,
+		Labels:  labels,
+		Timeout: setting.Actions.JITRunnerTimeout,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	encoded, err := config.Encode()
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &api.ActionRunnerJITConfig{
+		Runner:           convert.ToActionRunner(ctx, runner),
+		EncodedJITConfig: encoded,
+	})
+}
+
 // ListRunners lists runners for api route validated ownerID and repoID
 // ownerID == 0 and repoID == 0 means all runners including global runners, does not appear in sql where clause
 // ownerID == 0 and repoID != 0 means all runners for the given repo
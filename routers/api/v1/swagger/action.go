@@ -46,3 +46,17 @@ type swaggerResponseActionWorkflowList struct {
 	// in:body
 	Body api.ActionWorkflowResponse `json:"body"`
 }
+
+// ActionRequiredWorkflow
+// swagger:response ActionRequiredWorkflow
+type swaggerResponseActionRequiredWorkflow struct {
+	// in:body
+	Body api.ActionRequiredWorkflow `json:"body"`
+}
+
+// ActionRequiredWorkflowList
+// swagger:response ActionRequiredWorkflowList
+type swaggerResponseActionRequiredWorkflowList struct {
+	// in:body
+	Body []*api.ActionRequiredWorkflow `json:"body"`
+}
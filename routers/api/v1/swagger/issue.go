@@ -56,6 +56,48 @@ type swaggerResponseLabelList struct {
 	Body []api.Label `json:"body"`
 }
 
+// IssueType
+// swagger:response IssueType
+type swaggerResponseIssueType struct {
+	// in:body
+	Body api.IssueType `json:"body"`
+}
+
+// IssueTypeList
+// swagger:response IssueTypeList
+type swaggerResponseIssueTypeList struct {
+	// in:body
+	Body []api.IssueType `json:"body"`
+}
+
+// IssueImportResult
+// swagger:response IssueImportResult
+type swaggerResponseIssueImportResult struct {
+	// in:body
+	Body api.IssueImportResult `json:"body"`
+}
+
+// IssueSavedSearch
+// swagger:response IssueSavedSearch
+type swaggerResponseIssueSavedSearch struct {
+	// in:body
+	Body api.IssueSavedSearch `json:"body"`
+}
+
+// IssueSavedSearchList
+// swagger:response IssueSavedSearchList
+type swaggerResponseIssueSavedSearchList struct {
+	// in:body
+	Body []api.IssueSavedSearch `json:"body"`
+}
+
+// IssueField
+// swagger:response IssueField
+type swaggerResponseIssueField struct {
+	// in:body
+	Body api.IssueField `json:"body"`
+}
+
 // Milestone
 // swagger:response Milestone
 type swaggerResponseMilestone struct {
@@ -125,3 +167,73 @@ type swaggerReactionList struct {
 	// in:body
 	Body []api.Reaction `json:"body"`
 }
+
+// Project
+// swagger:response Project
+type swaggerResponseProject struct {
+	// in:body
+	Body api.Project `json:"body"`
+}
+
+// ProjectList
+// swagger:response ProjectList
+type swaggerResponseProjectList struct {
+	// in:body
+	Body []api.Project `json:"body"`
+}
+
+// ProjectColumn
+// swagger:response ProjectColumn
+type swaggerResponseProjectColumn struct {
+	// in:body
+	Body api.ProjectColumn `json:"body"`
+}
+
+// ProjectColumnList
+// swagger:response ProjectColumnList
+type swaggerResponseProjectColumnList struct {
+	// in:body
+	Body []api.ProjectColumn `json:"body"`
+}
+
+// ProjectIssue
+// swagger:response ProjectIssue
+type swaggerResponseProjectIssue struct {
+	// in:body
+	Body api.ProjectIssue `json:"body"`
+}
+
+// ProjectIssueList
+// swagger:response ProjectIssueList
+type swaggerResponseProjectIssueList struct {
+	// in:body
+	Body []api.ProjectIssue `json:"body"`
+}
+
+// ProjectField
+// swagger:response ProjectField
+type swaggerResponseProjectField struct {
+	// in:body
+	Body api.ProjectField `json:"body"`
+}
+
+// ProjectFieldList
+// swagger:response ProjectFieldList
+type swaggerResponseProjectFieldList struct {
+	// in:body
+	Body []api.ProjectField `json:"body"`
+}
+
+// ProjectFieldValue
+// swagger:response ProjectFieldValue
+type swaggerResponseProjectFieldValue struct {
+	// in:body
+	Body api.ProjectFieldValue `json:"body"`
+}
+
+// ProjectFieldValueList
+// swagger:response ProjectFieldValueList
+type swaggerResponseProjectFieldValueList struct {
+	// in:body
+	Body []api.ProjectFieldValue `json:"body"`
+}
@@ -79,6 +79,8 @@ type swaggerParameterBodies struct {
 	EditPullRequestOption api.EditPullRequestOption
 	// in:body
 	MergePullRequestOption forms.MergePullRequestForm
+	// in:body
+	SetPullRequestStackParentOption api.SetPullRequestStackParentOption
 
 	// in:body
 	CreateReleaseOption api.CreateReleaseOption
@@ -216,6 +218,9 @@ type swaggerParameterBodies struct {
 	// in:body
 	CreateVariableOption api.CreateVariableOption
 
+	// in:body
+	GenerateRunnerJITConfigOption api.GenerateRunnerJITConfigOption
+
 	// in:body
 	RenameOrgOption api.RenameOrgOption
 
@@ -227,4 +232,41 @@ type swaggerParameterBodies struct {
 
 	// in:body
 	LockIssueOption api.LockIssueOption
+
+	// in:body
+	CreateProjectFieldOption api.CreateProjectFieldOption
+	// in:body
+	EditProjectFieldOption api.EditProjectFieldOption
+	// in:body
+	SetProjectFieldValueOption api.SetProjectFieldValueOption
+
+	// in:body
+	CreateProjectOption api.CreateProjectOption
+	// in:body
+	EditProjectOption api.EditProjectOption
+	// in:body
+	CreateProjectColumnOption api.CreateProjectColumnOption
+	// in:body
+	EditProjectColumnOption api.EditProjectColumnOption
+	// in:body
+	AddProjectIssueOption api.AddProjectIssueOption
+	// in:body
+	MoveProjectIssueOption api.MoveProjectIssueOption
+
+	// in:body
+	CreateIssueTypeOption api.CreateIssueTypeOption
+	// in:body
+	EditIssueTypeOption api.EditIssueTypeOption
+	// in:body
+	CreateIssueFieldOption api.CreateIssueFieldOption
+	// in:body
+	EditIssueFieldOption api.EditIssueFieldOption
+
+	// in:body
+	CreateIssueSavedSearchOption api.CreateIssueSavedSearchOption
+	// in:body
+	EditIssueSavedSearchOption api.EditIssueSavedSearchOption
+
+	// in:body
+	CreateActionRequiredWorkflowOption api.CreateActionRequiredWorkflowOption
 }
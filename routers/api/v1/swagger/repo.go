@@ -154,6 +154,13 @@ type swaggerResponsePullRequestList struct {
 	Body []api.PullRequest `json:"body"`
 }
 
+// PullRequestStack
+// swagger:response PullRequestStack
+type swaggerResponsePullRequestStack struct {
+	// in:body
+	Body []api.PullRequestStackEntry `json:"body"`
+}
+
 // PullReview
 // swagger:response PullReview
 type swaggerResponsePullReview struct {
@@ -505,6 +512,13 @@ type swaggerRunner struct {
 	Body api.ActionRunner `json:"body"`
 }
 
+// RunnerJITConfig
+// swagger:response RunnerJITConfig
+type swaggerRunnerJITConfig struct {
+	// in:body
+	Body api.ActionRunnerJITConfig `json:"body"`
+}
+
 // swagger:response Compare
 type swaggerCompare struct {
 	// in:body
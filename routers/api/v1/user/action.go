@@ -376,7 +376,7 @@ func ListWorkflowRuns(ctx *context.APIContext) {
 	//   required: false
 	// - name: status
 	//   in: query
-	//   description: workflow status (pending, queued, in_progress, failure, success, skipped)
+	//   description: workflow status (pending, queued, in_progress, failure, success, skipped, action_required)
 	//   type: string
 	//   required: false
 	// - name: actor
@@ -0,0 +1,331 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"errors"
+	"net/http"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	issue_indexer "code.gitea.io/gitea/modules/indexer/issues"
+	"code.gitea.io/gitea/modules/optional"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/routers/api/v1/utils"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/convert"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+// getIssueSavedSearch returns the saved search of the path, writeable requires the doer to be its owner
+func getIssueSavedSearch(ctx *context.APIContext, writeable bool) *issues_model.IssueSavedSearch {
+	s, err := issues_model.GetIssueSavedSearchByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		if issues_model.IsErrIssueSavedSearchNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return nil
+	}
+	if !s.CanBeRunBy(ctx.Doer) || writeable && s.OwnerID != ctx.Doer.ID {
+		ctx.APIErrorNotFound()
+		return nil
+	}
+	if err := s.LoadOwner(ctx); err != nil {
+		ctx.APIErrorInternal(err)
+		return nil
+	}
+	return s
+}
+
+// ListIssueSavedSearches lists the saved issue searches of the authenticated user
+func ListIssueSavedSearches(ctx *context.APIContext) {
+	// swagger:operation GET /user/issue_saved_searches user userListIssueSavedSearches
+	// ---
+	// summary: List the authenticated user's saved issue searches
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueSavedSearchList"
+
+	searches, total, err := db.FindAndCount[issues_model.IssueSavedSearch](ctx, issues_model.FindIssueSavedSearchOptions{
+		ListOptions: utils.GetListOptions(ctx),
+		OwnerID:     ctx.Doer.ID,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	apiSearches := make([]*api.IssueSavedSearch, len(searches))
+	for i, s := range searches {
+		s.Owner = ctx.Doer
+		apiSearches[i] = convert.ToAPIIssueSavedSearch(ctx, s, ctx.Doer)
+	}
+
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, &apiSearches)
+}
+
+// CreateIssueSavedSearch saves an issue search for the authenticated user
+func CreateIssueSavedSearch(ctx *context.APIContext) {
+	// swagger:operation POST /user/issue_saved_searches user userCreateIssueSavedSearch
+	// ---
+	// summary: Save an issue search for the authenticated user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateIssueSavedSearchOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/IssueSavedSearch"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	form := web.GetForm(ctx).(*api.CreateIssueSavedSearchOption)
+	if form.Type != "" && form.Type != "issues" && form.Type != "pulls" {
+		ctx.APIError(http.StatusUnprocessableEntity, "type must be issues or pulls")
+		return
+	}
+	s := &issues_model.IssueSavedSearch{
+		OwnerID:  ctx.Doer.ID,
+		IsPull:   form.Type == "pulls",
+		Name:     form.Name,
+		Query:    form.Query,
+		IsPinned: form.Pinned,
+		IsShared: form.Shared,
+		Owner:    ctx.Doer,
+	}
+	if err := issue_service.CreateIssueSavedSearch(ctx, s); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, convert.ToAPIIssueSavedSearch(ctx, s, ctx.Doer))
+}
+
+// GetIssueSavedSearch gets a saved issue search of the authenticated user or a shared one
+func GetIssueSavedSearch(ctx *context.APIContext) {
+	// swagger:operation GET /user/issue_saved_searches/{id} user userGetIssueSavedSearch
+	// ---
+	// summary: Get a saved issue search of the authenticated user or a search shared by another user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueSavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	s := getIssueSavedSearch(ctx, false)
+	if ctx.Written() {
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueSavedSearch(ctx, s, ctx.Doer))
+}
+
+// EditIssueSavedSearch edits a saved issue search of the authenticated user
+func EditIssueSavedSearch(ctx *context.APIContext) {
+	// swagger:operation PATCH /user/issue_saved_searches/{id} user userEditIssueSavedSearch
+	// ---
+	// summary: Edit a saved issue search of the authenticated user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditIssueSavedSearchOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueSavedSearch"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	s := getIssueSavedSearch(ctx, true)
+	if ctx.Written() {
+		return
+	}
+	form := web.GetForm(ctx).(*api.EditIssueSavedSearchOption)
+	if form.Name != nil {
+		s.Name = *form.Name
+	}
+	if form.Query != nil {
+		s.Query = *form.Query
+	}
+	if form.Pinned != nil {
+		s.IsPinned = *form.Pinned
+	}
+	if form.Shared != nil {
+		s.IsShared = *form.Shared
+	}
+	if err := issue_service.UpdateIssueSavedSearch(ctx, s); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.APIError(http.StatusUnprocessableEntity, err)
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueSavedSearch(ctx, s, ctx.Doer))
+}
+
+// DeleteIssueSavedSearch deletes a saved issue search of the authenticated user
+func DeleteIssueSavedSearch(ctx *context.APIContext) {
+	// swagger:operation DELETE /user/issue_saved_searches/{id} user userDeleteIssueSavedSearch
+	// ---
+	// summary: Delete a saved issue search of the authenticated user
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	if err := issues_model.DeleteIssueSavedSearch(ctx, ctx.Doer.ID, ctx.PathParamInt64("id")); err != nil {
+		if issues_model.IsErrIssueSavedSearchNotExist(err) {
+			ctx.APIErrorNotFound()
+		} else {
+			ctx.APIErrorInternal(err)
+		}
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// RunIssueSavedSearch returns the issues or pull requests found by a saved search
+func RunIssueSavedSearch(ctx *context.APIContext) {
+	// swagger:operation GET /user/issue_saved_searches/{id}/issues user userRunIssueSavedSearch
+	// ---
+	// summary: Run a saved issue search across the repositories that the authenticated user has access to
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: id
+	//   in: path
+	//   description: id of the saved search
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/IssueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	s := getIssueSavedSearch(ctx, false)
+	if ctx.Written() {
+		return
+	}
+	query, err := issue_indexer.ParseQuery(s.Query)
+	if err != nil {
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// the query is run in all public repositories and the private ones the doer has access to,
+	// the repo qualifiers of the query narrow it down
+	repoIDs, _, err := repo_model.SearchRepositoryIDs(ctx, repo_model.SearchRepoOptions{
+		Actor:       ctx.Doer,
+		Private:     !ctx.PublicOnly,
+		AllLimited:  true,
+		Collaborate: optional.None[bool](),
+		OrderBy:     db.SearchOrderByAlphabetically,
+	})
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	if len(repoIDs) == 0 {
+		// no repos found, don't let the indexer return all repos
+		repoIDs = []int64{0}
+	}
+
+	listOptions := utils.GetListOptions(ctx)
+	searchOpt, err := query.ToSearchOptions(ctx, ctx.Doer, &issue_indexer.SearchOptions{
+		Paginator: &listOptions,
+		RepoIDs:   repoIDs,
+		AllPublic: true,
+		IsPull:    optional.Some(s.IsPull),
+		IsClosed:  optional.Some(false),
+		SortBy:    issue_indexer.SortByCreatedDesc,
+	})
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.APIError(http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ids, total, err := issue_indexer.SearchIssues(ctx, searchOpt)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+	issues, err := issues_model.GetIssuesByIDs(ctx, ids, true)
+	if err != nil {
+		ctx.APIErrorInternal(err)
+		return
+	}
+
+	ctx.SetLinkHeader(int(total), listOptions.PageSize)
+	ctx.SetTotalCountHeader(total)
+	ctx.JSON(http.StatusOK, convert.ToAPIIssueList(ctx, ctx.Doer, issues))
+}
@@ -0,0 +1,615 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"code.gitea.io/gitea/routers/api/v1/shared"
+	"code.gitea.io/gitea/services/context"
+)
+
+// ListProjects list the projects of a user
+func ListProjects(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects user userListProjects
+	// ---
+	// summary: List the projects of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: state
+	//   in: query
+	//   description: state of the projects, open by default
+	//   type: string
+	//   enum: [open, closed, all]
+	// - name: page
+	//   in: query
+	//   description: page number of results to return (1-based)
+	//   type: integer
+	// - name: limit
+	//   in: query
+	//   description: page size of results
+	//   type: integer
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjects(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProject create a project
+func CreateProject(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects user userCreateProject
+	// ---
+	// summary: Create a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// GetProject get a project
+func GetProject(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id} user userGetProject
+	// ---
+	// summary: Get a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.GetProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProject edit a project
+func EditProject(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id} user userEditProject
+	// ---
+	// summary: Edit, close or reopen a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/Project"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProject delete a project
+func DeleteProject(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id} user userDeleteProject
+	// ---
+	// summary: Delete a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProject(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectColumns list the columns of a project
+func ListProjectColumns(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/columns user userListProjectColumns
+	// ---
+	// summary: List the columns of a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumnList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectColumns(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectColumn add a column to a project
+func CreateProjectColumn(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/columns user userCreateProjectColumn
+	// ---
+	// summary: Add a column to a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectColumnOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectColumn edit a column of a project
+func EditProjectColumn(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id}/columns/{column_id} user userEditProjectColumn
+	// ---
+	// summary: Edit, move or make default a column of a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectColumnOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectColumn"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectColumn delete a column of a project
+func DeleteProjectColumn(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/columns/{column_id} user userDeleteProjectColumn
+	// ---
+	// summary: Delete a column of a project of a user, its issues are moved to the default column
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: path
+	//   description: id of the column
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.DeleteProjectColumn(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectFields list the custom fields of a project
+func ListProjectFields(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/fields user userListProjectFields
+	// ---
+	// summary: List the custom fields of a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectFieldList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectFields(ctx, ctx.ContextUser.ID, 0)
+}
+
+// CreateProjectField add a custom field to a project
+func CreateProjectField(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/fields user userCreateProjectField
+	// ---
+	// summary: Add a custom field to a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/CreateProjectFieldOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectField"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.CreateProjectField(ctx, ctx.ContextUser.ID, 0)
+}
+
+// EditProjectField edit a custom field of a project
+func EditProjectField(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id}/fields/{field_id} user userEditProjectField
+	// ---
+	// summary: Edit a custom field of a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/EditProjectFieldOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectField"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.EditProjectField(ctx, ctx.ContextUser.ID, 0)
+}
+
+// DeleteProjectField delete a custom field of a project
+func DeleteProjectField(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/fields/{field_id} user userDeleteProjectField
+	// ---
+	// summary: Delete a custom field of a project of a user and its values
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: field_id
+	//   in: path
+	//   description: id of the field
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.DeleteProjectField(ctx, ctx.ContextUser.ID, 0)
+}
+
+// ListProjectIssues list the issues of a project
+func ListProjectIssues(ctx *context.APIContext) {
+	// swagger:operation GET /users/{username}/projects/{id}/issues user userListProjectIssues
+	// ---
+	// summary: List the issues of a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: column_id
+	//   in: query
+	//   description: only list the issues of this column
+	//   type: integer
+	//   format: int64
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectIssueList"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.ListProjectIssues(ctx, ctx.ContextUser.ID, 0)
+}
+
+// AddProjectIssue add an issue to a project
+func AddProjectIssue(ctx *context.APIContext) {
+	// swagger:operation POST /users/{username}/projects/{id}/issues user userAddProjectIssue
+	// ---
+	// summary: Add an issue to a project of a user, the issue is removed from its previous project
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/AddProjectIssueOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/ProjectIssue"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.AddProjectIssue(ctx, ctx.ContextUser.ID, 0)
+}
+
+// MoveProjectIssue move an issue on the board of a project
+func MoveProjectIssue(ctx *context.APIContext) {
+	// swagger:operation PATCH /users/{username}/projects/{id}/issues/{issue_id} user userMoveProjectIssue
+	// ---
+	// summary: Move an issue to a column and a position of a project of a user
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: issue_id
+	//   in: path
+	//   description: id of the issue, not its index in the repository
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/MoveProjectIssueOption"
+	// responses:
+	//   "200":
+	//     "$ref": "#/responses/ProjectIssue"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+
+	shared.MoveProjectIssue(ctx, ctx.ContextUser.ID, 0)
+}
+
+// RemoveProjectIssue remove an issue from a project
+func RemoveProjectIssue(ctx *context.APIContext) {
+	// swagger:operation DELETE /users/{username}/projects/{id}/issues/{issue_id} user userRemoveProjectIssue
+	// ---
+	// summary: Remove an issue from a project of a user
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: username
+	//   in: path
+	//   description: username of the user
+	//   type: string
+	//   required: true
+	// - name: id
+	//   in: path
+	//   description: id of the project
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// - name: issue_id
+	//   in: path
+	//   description: id of the issue, not its index in the repository
+	//   type: integer
+	//   format: int64
+	//   required: true
+	// responses:
+	//   "204":
+	//     "$ref": "#/responses/empty"
+	//   "403":
+	//     "$ref": "#/responses/forbidden"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+
+	shared.RemoveProjectIssue(ctx, ctx.ContextUser.ID, 0)
+}
@@ -40,6 +40,32 @@ func CreateRegistrationToken(ctx *context.APIContext) {
 	shared.GetRegistrationToken(ctx, ctx.Doer.ID, 0)
 }
 
+// GenerateRunnerJITConfig creates a user-level just-in-time runner
+func GenerateRunnerJITConfig(ctx *context.APIContext) {
+	// swagger:operation POST /user/actions/runners/generate-jitconfig user userGenerateRunnerJITConfig
+	// ---
+	// summary: Create a user-level just-in-time runner
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: body
+	//   in: body
+	//   schema:
+	//     "$ref": "#/definitions/GenerateRunnerJITConfigOption"
+	// responses:
+	//   "201":
+	//     "$ref": "#/responses/RunnerJITConfig"
+	//   "400":
+	//     "$ref": "#/responses/error"
+	//   "404":
+	//     "$ref": "#/responses/notFound"
+	//   "422":
+	//     "$ref": "#/responses/validationError"
+	shared.GenerateRunnerJITConfig(ctx, ctx.Doer.ID, 0)
+}
+
 // ListRunners get user-level runners
 func ListRunners(ctx *context.APIContext) {
 	// swagger:operation GET /user/actions/runners user getUserRunners
@@ -5,11 +5,13 @@ package common
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	repo_model "code.gitea.io/gitea/models/repo"
 	"code.gitea.io/gitea/modules/actions"
+	"code.gitea.io/gitea/modules/log"
 	"code.gitea.io/gitea/modules/util"
 	"code.gitea.io/gitea/services/context"
 )
@@ -69,3 +71,76 @@ func DownloadActionsRunJobLogs(ctx *context.Base, ctxRepo *repo_model.Repository
 	})
 	return nil
 }
+
+// DownloadActionsRunLogs writes the logs of all the jobs of a run into one file, each job starts with a header line
+func DownloadActionsRunLogs(ctx *context.Base, ctxRepo *repo_model.Repository, run *actions_model.ActionRun) error {
+	if run.RepoID != ctxRepo.ID {
+		return util.NewNotExistErrorf("run not found")
+	}
+
+	runJobs, err := actions_model.GetRunJobsByRunID(ctx, run.ID)
+	if err != nil {
+		return fmt.Errorf("GetRunJobsByRunID: %w", err)
+	}
+	if len(runJobs) == 0 {
+		return util.NewNotExistErrorf("run has no jobs")
+	}
+	tasks := make(map[int64]*actions_model.ActionTask, len(runJobs))
+	for _, job := range runJobs {
+		if job.TaskID == 0 {
+			continue
+		}
+		task, err := actions_model.GetTaskByID(ctx, job.TaskID)
+		if err != nil {
+			return fmt.Errorf("GetTaskByID: %w", err)
+		}
+		tasks[job.ID] = task
+	}
+
+	workflowName := run.WorkflowID
+	if p := strings.Index(workflowName, "."); p > 0 {
+		workflowName = workflowName[0:p]
+	}
+	ctx.SetServeHeaders(&context.ServeHeaderOptions{
+		Filename:           fmt.Sprintf("%v-%v.log", workflowName, run.Index),
+		ContentType:        "text/plain",
+		ContentTypeCharset: "utf-8",
+		Disposition:        "attachment",
+	})
+
+	// the headers have been written, so the errors of the logs are only logged
+	for _, job := range runJobs {
+		task := tasks[job.ID]
+		note := ""
+		switch {
+		case task == nil:
+			note = " (not started)"
+		case task.LogExpired:
+			note = " (logs have been cleaned up)"
+		}
+		if _, err := fmt.Fprintf(ctx.Resp, "===== %s%s =====\n", job.Name, note); err != nil {
+			return nil
+		}
+		if note != "" {
+			continue
+		}
+		if err := copyTaskLogs(ctx, ctx.Resp, task); err != nil {
+			log.Error("copy the logs of task %d of run %d: %v", task.ID, run.ID, err)
+			return nil
+		}
+	}
+	return nil
+}
+
+func copyTaskLogs(ctx *context.Base, w io.Writer, task *actions_model.ActionTask) error {
+	if task.LogLength == 0 {
+		return nil
+	}
+	reader, err := actions.OpenLogs(ctx, task.LogInStorage, task.LogFilename)
+	if err != nil {
+		return fmt.Errorf("OpenLogs: %w", err)
+	}
+	defer reader.Close()
+	_, err = io.Copy(w, reader)
+	return err
+}
@@ -21,6 +21,7 @@ import (
 	"code.gitea.io/gitea/modules/templates"
 	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/routers/web/shared/issue"
+	shared_project "code.gitea.io/gitea/routers/web/shared/project"
 	shared_user "code.gitea.io/gitea/routers/web/shared/user"
 	"code.gitea.io/gitea/services/context"
 	"code.gitea.io/gitea/services/forms"
@@ -182,7 +183,7 @@ func NewProjectPost(ctx *context.Context) {
 		newProject.Type = project_model.TypeIndividual
 	}
 
-	if err := project_model.NewProject(ctx, &newProject); err != nil {
+	if err := project_service.NewProject(ctx, ctx.Doer, &newProject); err != nil {
 		ctx.ServerError("NewProject", err)
 		return
 	}
@@ -211,8 +212,8 @@ func ChangeProjectStatus(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.ChangeProjectStatusByRepoIDAndID(ctx, 0, project.ID, toClose); err != nil {
-		ctx.NotFoundOrServerError("ChangeProjectStatusByRepoIDAndID", project_model.IsErrProjectNotExist, err)
+	if err := project_service.ChangeProjectStatus(ctx, ctx.Doer, project, toClose); err != nil {
+		ctx.ServerError("ChangeProjectStatus", err)
 		return
 	}
 	ctx.JSONRedirect(project_model.ProjectLinkForOrg(ctx.ContextUser, project.ID))
@@ -226,7 +227,7 @@ func DeleteProject(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.DeleteProjectByID(ctx, p.ID); err != nil {
+	if err := project_service.DeleteProject(ctx, ctx.Doer, p); err != nil {
 		ctx.Flash.Error("DeleteProjectByID: " + err.Error())
 	} else {
 		ctx.Flash.Success(ctx.Tr("repo.projects.deletion_success"))
@@ -295,7 +296,7 @@ func EditProjectPost(ctx *context.Context) {
 	p.Title = form.Title
 	p.Description = form.Content
 	p.CardType = form.CardType
-	if err = project_model.UpdateProject(ctx, p); err != nil {
+	if err = project_service.UpdateProject(ctx, ctx.Doer, p); err != nil {
 		ctx.ServerError("UpdateProjects", err)
 		return
 	}
@@ -321,6 +322,11 @@ func ViewProject(ctx *context.Context) {
 		return
 	}
 
+	view := shared_project.PrepareProjectView(ctx, project)
+	if ctx.Written() {
+		return
+	}
+
 	columns, err := project.GetColumns(ctx)
 	if err != nil {
 		ctx.ServerError("GetProjectColumns", err)
@@ -340,11 +346,23 @@ func ViewProject(ctx *context.Context) {
 		Doer:       ctx.Doer,
 	}
 
+	shared_project.PrepareFieldFilters(ctx, project, &opts)
+	if ctx.Written() {
+		return
+	}
+
 	issuesMap, err := project_service.LoadIssuesFromProject(ctx, project, &opts)
 	if err != nil {
 		ctx.ServerError("LoadIssuesOfColumns", err)
 		return
 	}
+	shared_project.LoadFieldValues(ctx, project, issuesMap)
+	if ctx.Written() {
+		return
+	}
+	if view != nil {
+		shared_project.PrepareViewData(ctx, view, columns, issuesMap)
+	}
 	for _, column := range columns {
 		column.NumIssues = int64(len(issuesMap[column.ID]))
 	}
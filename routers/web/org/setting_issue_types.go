@@ -0,0 +1,212 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	shared_user "code.gitea.io/gitea/routers/web/shared/user"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+)
+
+// tplSettingsIssueTypes template path for render issue types settings
+const tplSettingsIssueTypes templates.TplName = "org/settings/issue_types"
+
+// IssueTypes render the issue types of an organization and their custom fields
+func IssueTypes(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("org.settings.issue_types")
+	ctx.Data["PageIsOrgSettings"] = true
+	ctx.Data["PageIsOrgSettingsIssueTypes"] = true
+
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Org.Organization.ID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return
+	}
+	if err := types.LoadFields(ctx); err != nil {
+		ctx.ServerError("LoadFields", err)
+		return
+	}
+	ctx.Data["IssueTypes"] = types
+	ctx.Data["IssueTypeIcons"] = issues_model.IssueTypeIcons
+	ctx.Data["IssueFieldTypes"] = issues_model.IssueFieldTypes()
+
+	if _, err := shared_user.RenderUserOrgHeader(ctx); err != nil {
+		ctx.ServerError("RenderUserOrgHeader", err)
+		return
+	}
+
+	ctx.HTML(http.StatusOK, tplSettingsIssueTypes)
+}
+
+func getOrgIssueType(ctx *context.Context) *issues_model.IssueType {
+	t, err := issues_model.GetIssueTypeByID(ctx, ctx.Org.Organization.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueTypeByID", issues_model.IsErrIssueTypeNotExist, err)
+		return nil
+	}
+	return t
+}
+
+func getOrgIssueField(ctx *context.Context) (*issues_model.IssueType, *issues_model.IssueField) {
+	t := getOrgIssueType(ctx)
+	if t == nil {
+		return nil, nil
+	}
+	field, err := issues_model.GetIssueFieldByID(ctx, t.ID, ctx.PathParamInt64("fieldID"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueFieldByID", issues_model.IsErrIssueFieldNotExist, err)
+		return nil, nil
+	}
+	return t, field
+}
+
+func handleIssueTypeError(ctx *context.Context, err error, name string) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.JSONError(err.Error())
+		return
+	}
+	ctx.ServerError(name, err)
+}
+
+// NewIssueTypePost adds an issue type to an organization
+func NewIssueTypePost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditIssueTypeForm)
+	t := &issues_model.IssueType{
+		OwnerID:     ctx.Org.Organization.ID,
+		Name:        form.Name,
+		Description: form.Description,
+		Icon:        form.Icon,
+		Color:       form.Color,
+	}
+	if err := issues_model.NewIssueType(ctx, t); err != nil {
+		handleIssueTypeError(ctx, err, "NewIssueType")
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// EditIssueTypePost changes an issue type of an organization
+func EditIssueTypePost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditIssueTypeForm)
+	t := getOrgIssueType(ctx)
+	if t == nil {
+		return
+	}
+	t.Name = form.Name
+	t.Description = form.Description
+	t.Icon = form.Icon
+	t.Color = form.Color
+	if err := issues_model.UpdateIssueType(ctx, t); err != nil {
+		handleIssueTypeError(ctx, err, "UpdateIssueType")
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// DeleteIssueTypePost removes an issue type of an organization, the issues of the type get no type
+func DeleteIssueTypePost(ctx *context.Context) {
+	t := getOrgIssueType(ctx)
+	if t == nil {
+		return
+	}
+	if err := issues_model.DeleteIssueType(ctx, t); err != nil {
+		ctx.ServerError("DeleteIssueType", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// InitializeIssueTypesPost adds the default issue types which the organization doesn't have yet
+func InitializeIssueTypesPost(ctx *context.Context) {
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Org.Organization.ID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return
+	}
+	for _, t := range issues_model.DefaultIssueTypes() {
+		exists := false
+		for _, existing := range types {
+			if strings.EqualFold(existing.Name, t.Name) {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+		t.OwnerID = ctx.Org.Organization.ID
+		if err := issues_model.NewIssueType(ctx, t); err != nil {
+			handleIssueTypeError(ctx, err, "NewIssueType")
+			return
+		}
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// parseIssueFieldOptions parses the options of a select field, one option per line
+func parseIssueFieldOptions(text string) []string {
+	var options []string
+	for line := range strings.SplitSeq(text, "\n") {
+		if option := strings.TrimSpace(line); option != "" {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// NewIssueFieldPost adds a custom field to an issue type
+func NewIssueFieldPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditIssueFieldForm)
+	t := getOrgIssueType(ctx)
+	if t == nil {
+		return
+	}
+	field := &issues_model.IssueField{
+		Name:    form.Name,
+		Type:    issues_model.ParseIssueFieldType(form.Type),
+		Options: parseIssueFieldOptions(form.Options),
+	}
+	if err := issues_model.NewIssueField(ctx, t, field); err != nil {
+		handleIssueTypeError(ctx, err, "NewIssueField")
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// EditIssueFieldPost changes the name and the options of a custom field of an issue type
+func EditIssueFieldPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditIssueFieldForm)
+	_, field := getOrgIssueField(ctx)
+	if ctx.Written() {
+		return
+	}
+	field.Name = form.Name
+	field.Options = parseIssueFieldOptions(form.Options)
+	if err := issues_model.UpdateIssueField(ctx, field); err != nil {
+		handleIssueTypeError(ctx, err, "UpdateIssueField")
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
+
+// DeleteIssueFieldPost removes a custom field of an issue type and its values
+func DeleteIssueFieldPost(ctx *context.Context) {
+	_, field := getOrgIssueField(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueField(ctx, field); err != nil {
+		ctx.ServerError("DeleteIssueField", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/issue_types")
+}
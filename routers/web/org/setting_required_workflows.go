@@ -0,0 +1,93 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package org
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	shared_user "code.gitea.io/gitea/routers/web/shared/user"
+	actions_service "code.gitea.io/gitea/services/actions"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+)
+
+// tplSettingsActions template path for render the actions settings of an organization
+const tplSettingsActions templates.TplName = "org/settings/actions"
+
+// RequiredWorkflows render the required workflows of an organization
+func RequiredWorkflows(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("actions.required_workflows")
+	ctx.Data["PageType"] = "required_workflows"
+	ctx.Data["PageIsOrgSettingsRequiredWorkflows"] = true
+	ctx.Data["Link"] = ctx.Org.OrgLink + "/settings/actions/required_workflows"
+
+	rws, err := db.Find[actions_model.ActionRequiredWorkflow](ctx, actions_model.FindRequiredWorkflowsOptions{
+		OwnerID: ctx.Org.Organization.ID,
+	})
+	if err != nil {
+		ctx.ServerError("FindRequiredWorkflows", err)
+		return
+	}
+	if err := actions_model.RequiredWorkflowList(rws).LoadRepos(ctx); err != nil {
+		ctx.ServerError("LoadRepos", err)
+		return
+	}
+	ctx.Data["RequiredWorkflows"] = rws
+
+	if _, err := shared_user.RenderUserOrgHeader(ctx); err != nil {
+		ctx.ServerError("RenderUserOrgHeader", err)
+		return
+	}
+
+	ctx.HTML(http.StatusOK, tplSettingsActions)
+}
+
+// NewRequiredWorkflowPost adds a required workflow to an organization
+func NewRequiredWorkflowPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.CreateRequiredWorkflowForm)
+
+	var topics []string
+	for topic := range strings.SplitSeq(form.Topics, ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	_, err := actions_service.CreateRequiredWorkflow(ctx, ctx.Org.Organization.AsUser(), ctx.Doer, actions_service.CreateRequiredWorkflowOptions{
+		RepoName:     form.RepoName,
+		WorkflowPath: form.WorkflowPath,
+		RepoPattern:  form.RepoPattern,
+		Topics:       topics,
+	})
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) || errors.Is(err, util.ErrNotExist) || errors.Is(err, util.ErrAlreadyExist) {
+			ctx.JSONError(err.Error())
+			return
+		}
+		ctx.ServerError("CreateRequiredWorkflow", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("actions.required_workflows.created"))
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/actions/required_workflows")
+}
+
+// DeleteRequiredWorkflowPost removes a required workflow of an organization
+func DeleteRequiredWorkflowPost(ctx *context.Context) {
+	if err := actions_model.DeleteRequiredWorkflow(ctx, ctx.Org.Organization.ID, ctx.PathParamInt64("id")); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.NotFound(err)
+			return
+		}
+		ctx.ServerError("DeleteRequiredWorkflow", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("actions.required_workflows.deletion.success"))
+	ctx.JSONRedirect(ctx.Org.OrgLink + "/settings/actions/required_workflows")
+}
@@ -243,6 +243,7 @@ func prepareWorkflowDispatchTemplate(ctx *context.Context, workflowInfos []Workf
 func prepareWorkflowList(ctx *context.Context, workflows []WorkflowInfo) {
 	actorID := ctx.FormInt64("actor")
 	status := ctx.FormInt("status")
+	needApproval := ctx.FormBool("need_approval")
 	workflowID := ctx.FormString("workflow")
 	page := ctx.FormInt("page")
 	if page <= 0 {
@@ -253,7 +254,8 @@ func prepareWorkflowList(ctx *context.Context, workflows []WorkflowInfo) {
 	// they will be 0 by default, which indicates get all status or actors
 	ctx.Data["CurActor"] = actorID
 	ctx.Data["CurStatus"] = status
-	if actorID > 0 || status > int(actions_model.StatusUnknown) {
+	ctx.Data["CurNeedApproval"] = needApproval
+	if actorID > 0 || status > int(actions_model.StatusUnknown) || needApproval {
 		ctx.Data["IsFiltered"] = true
 	}
 
@@ -265,6 +267,7 @@ func prepareWorkflowList(ctx *context.Context, workflows []WorkflowInfo) {
 		RepoID:        ctx.Repo.Repository.ID,
 		WorkflowID:    workflowID,
 		TriggerUserID: actorID,
+		NeedApproval:  needApproval,
 	}
 
 	// if status is not StatusUnknown, it means user has selected a status filter
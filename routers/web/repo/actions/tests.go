@@ -0,0 +1,116 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/junit"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+)
+
+const (
+	tplRunTests   templates.TplName = "repo/actions/tests"
+	tplFlakyTests templates.TplName = "repo/actions/flaky_tests"
+
+	testResultsPageSize = 50
+)
+
+// testStatusFilters are the statuses of the test results matching the "status" query parameter
+var testStatusFilters = map[string][]junit.Status{
+	"failed":  {junit.StatusFailed, junit.StatusErrored},
+	"passed":  {junit.StatusPassed},
+	"skipped": {junit.StatusSkipped},
+}
+
+// Tests shows the results of the test reports uploaded by the jobs of a run
+func Tests(ctx *context.Context) {
+	ctx.Data["PageIsActions"] = true
+	run, err := actions_model.GetRunByIndex(ctx, ctx.Repo.Repository.ID, getRunIndex(ctx))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetRunByIndex", func(err error) bool {
+			return errors.Is(err, util.ErrNotExist)
+		}, err)
+		return
+	}
+	run.Repo = ctx.Repo.Repository
+
+	summary, err := actions_model.GetTestSummaryOfRun(ctx, run.ID)
+	if err != nil {
+		ctx.ServerError("GetTestSummaryOfRun", err)
+		return
+	}
+
+	status := ctx.FormString("status")
+	if _, ok := testStatusFilters[status]; !ok && status != "all" {
+		status = util.Iif(summary.NumFailures() > 0, "failed", "all")
+	}
+	page := max(ctx.FormInt("page"), 1)
+	opts := actions_model.FindTestResultsOptions{
+		ListOptions: db.ListOptions{Page: page, PageSize: testResultsPageSize},
+		RunID:       run.ID,
+		Statuses:    testStatusFilters[status],
+	}
+	results, total, err := db.FindAndCount[actions_model.ActionTestResult](ctx, opts)
+	if err != nil {
+		ctx.ServerError("FindTestResults", err)
+		return
+	}
+
+	// mark the failed tests known to be flaky on the default branch
+	keys := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Status.IsFailure() {
+			keys = append(keys, result.Key())
+		}
+	}
+	testCases, err := actions_model.GetTestCasesByKeys(ctx, ctx.Repo.Repository.ID, keys)
+	if err != nil {
+		ctx.ServerError("GetTestCasesByKeys", err)
+		return
+	}
+	flakyTests := make(map[int64]*actions_model.ActionTestCase)
+	for _, result := range results {
+		if tc := testCases[result.Key()]; tc != nil && tc.NumFlips > 0 {
+			flakyTests[result.ID] = tc
+		}
+	}
+
+	ctx.Data["Title"] = ctx.Tr("actions.tests.title_of_run", run.Title)
+	ctx.Data["Run"] = run
+	ctx.Data["TestSummary"] = summary
+	ctx.Data["TestResults"] = results
+	ctx.Data["FlakyTests"] = flakyTests
+	ctx.Data["CurStatus"] = status
+
+	pager := context.NewPagination(int(total), opts.PageSize, opts.Page, 5)
+	pager.AddParamFromRequest(ctx.Req)
+	ctx.Data["Page"] = pager
+	ctx.HTML(http.StatusOK, tplRunTests)
+}
+
+// FlakyTests shows the tests whose status flipped between passed and failed in the runs of the default branch
+func FlakyTests(ctx *context.Context) {
+	ctx.Data["PageIsActions"] = true
+	ctx.Data["Title"] = ctx.Tr("actions.tests.flaky_tests")
+
+	page := max(ctx.FormInt("page"), 1)
+	listOptions := db.ListOptions{Page: page, PageSize: testResultsPageSize}
+	testCases, total, err := actions_model.FindFlakyTestCases(ctx, ctx.Repo.Repository.ID, listOptions)
+	if err != nil {
+		ctx.ServerError("FindFlakyTestCases", err)
+		return
+	}
+	ctx.Data["TestCases"] = testCases
+
+	pager := context.NewPagination(int(total), listOptions.PageSize, listOptions.Page, 5)
+	pager.AddParamFromRequest(ctx.Req)
+	ctx.Data["Page"] = pager
+	ctx.HTML(http.StatusOK, tplFlakyTests)
+}
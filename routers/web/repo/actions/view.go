@@ -5,26 +5,36 @@ package actions
 
 import (
 	"archive/zip"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
 	git_model "code.gitea.io/gitea/models/git"
+	"code.gitea.io/gitea/models/renderhelper"
 	repo_model "code.gitea.io/gitea/models/repo"
 	"code.gitea.io/gitea/models/unit"
 	"code.gitea.io/gitea/modules/actions"
 	"code.gitea.io/gitea/modules/base"
+	"code.gitea.io/gitea/modules/eventsource"
 	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/graceful"
+	"code.gitea.io/gitea/modules/json"
 	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/markup/markdown"
+	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/modules/storage"
 	"code.gitea.io/gitea/modules/templates"
 	"code.gitea.io/gitea/modules/util"
@@ -73,6 +83,22 @@ func ViewWorkflowFile(ctx *context_module.Context) {
 		}, err)
 		return
 	}
+	if run.RequiredWorkflowID > 0 {
+		// the file of a required workflow is in the designated repository of the organization
+		rw, err := actions_model.GetRequiredWorkflowByID(ctx, run.OwnerID, run.RequiredWorkflowID)
+		if err != nil {
+			ctx.NotFoundOrServerError("GetRequiredWorkflowByID", func(err error) bool {
+				return errors.Is(err, util.ErrNotExist)
+			}, err)
+			return
+		}
+		if err := rw.LoadRepo(ctx); err != nil {
+			ctx.ServerError("LoadRepo", err)
+			return
+		}
+		ctx.Redirect(fmt.Sprintf("%s/src/branch/%s/%s", rw.Repo.Link(), util.PathEscapeSegments(rw.Repo.DefaultBranch), util.PathEscapeSegments(rw.WorkflowPath)))
+		return
+	}
 	commit, err := ctx.Repo.GitRepo.GetCommit(run.CommitSHA)
 	if err != nil {
 		ctx.NotFoundOrServerError("GetCommit", func(err error) bool {
@@ -111,7 +137,8 @@ type ArtifactsViewItem struct {
 }
 
 type ViewResponse struct {
-	Artifacts []*ArtifactsViewItem `json:"artifacts"`
+	Artifacts   []*ArtifactsViewItem       `json:"artifacts"`
+	TestSummary *actions_model.TestSummary `json:"testSummary"`
 
 	State struct {
 		Run struct {
@@ -131,9 +158,11 @@ type ViewResponse struct {
 			Commit            ViewCommit    `json:"commit"`
 		} `json:"run"`
 		CurrentJob struct {
-			Title  string         `json:"title"`
-			Detail string         `json:"detail"`
-			Steps  []*ViewJobStep `json:"steps"`
+			Title       string             `json:"title"`
+			Detail      string             `json:"detail"`
+			Steps       []*ViewJobStep     `json:"steps"`
+			Annotations []*ViewAnnotation  `json:"annotations"`
+			Summaries   []*ViewStepSummary `json:"summaries"`
 		} `json:"currentJob"`
 	} `json:"state"`
 	Logs struct {
@@ -173,6 +202,22 @@ type ViewJobStep struct {
 	Status   string `json:"status"`
 }
 
+type ViewAnnotation struct {
+	Level   string `json:"level"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Link    string `json:"link"`
+	Step    int    `json:"step"` // -1 if the step isn't known yet
+}
+
+type ViewStepSummary struct {
+	Step        int           `json:"step"`
+	StepName    string        `json:"stepName"`
+	ContentHTML template.HTML `json:"contentHTML"`
+}
+
 type ViewStepLog struct {
 	Step    int                `json:"step"`
 	Cursor  int64              `json:"cursor"`
@@ -214,21 +259,245 @@ func ViewPost(ctx *context_module.Context) {
 	if ctx.Written() {
 		return
 	}
+	resp, err := buildViewResponse(ctx, current, jobs, req.LogCursors)
+	if err != nil {
+		ctx.ServerError("buildViewResponse", err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// the limits of the streams of the run views, a stream is closed after maxStreamDuration and the view reconnects
+const (
+	maxStreamDuration = 30 * time.Minute
+	maxStreams        = 1000
+	maxStreamsPerUser = 10
+)
+
+// openStreams counts the open streams, by the doer or by the remote address of the anonymous users
+var openStreams = struct {
+	sync.Mutex
+	total int
+	byKey map[string]int
+}{byKey: map[string]int{}}
+
+// acquireStream counts a new stream of the key, it returns false if there are too many streams
+func acquireStream(key string) bool {
+	openStreams.Lock()
+	defer openStreams.Unlock()
+	if openStreams.total >= maxStreams || openStreams.byKey[key] >= maxStreamsPerUser {
+		return false
+	}
+	openStreams.total++
+	openStreams.byKey[key]++
+	return true
+}
+
+func releaseStream(key string) {
+	openStreams.Lock()
+	defer openStreams.Unlock()
+	openStreams.total--
+	if openStreams.byKey[key]--; openStreams.byKey[key] <= 0 {
+		delete(openStreams.byKey, key)
+	}
+}
+
+func streamKey(ctx *context_module.Context) string {
+	if ctx.Doer != nil {
+		return "user:" + strconv.FormatInt(ctx.Doer.ID, 10)
+	}
+	host, _, err := net.SplitHostPort(ctx.RemoteAddr())
+	if err != nil {
+		host = ctx.RemoteAddr()
+	}
+	return "addr:" + host
+}
+
+// ViewStream streams the state of the run and the logs of the current job with server-sent events.
+// It sends a "state" event like the response of ViewPost whenever the run is updated,
+// the cursors of the expanded steps are given by the "logCursors" query as JSON and are moved forward by the server.
+// After maxStreamDuration it sends a "reconnect" event and closes the stream.
+func ViewStream(ctx *context_module.Context) {
+	if setting.UI.Notification.EventSourceUpdateTime <= 0 {
+		ctx.NotFound(nil)
+		return
+	}
+
+	var cursors []LogCursor
+	if raw := ctx.FormString("logCursors"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &cursors); err != nil {
+			ctx.HTTPError(http.StatusBadRequest, "invalid logCursors")
+			return
+		}
+	}
+	runIndex := getRunIndex(ctx)
+	jobIndex := ctx.PathParamInt64("job")
+
+	current, _ := getRunJobs(ctx, runIndex, jobIndex)
+	if ctx.Written() {
+		return
+	}
+	runID := current.RunID
+
+	key := streamKey(ctx)
+	if !acquireStream(key) {
+		ctx.HTTPError(http.StatusTooManyRequests, "too many streams")
+		return
+	}
+	defer releaseStream(key)
+
+	ctx.Resp.Header().Set("Content-Type", "text/event-stream")
+	ctx.Resp.Header().Set("Cache-Control", "no-cache")
+	ctx.Resp.Header().Set("Connection", "keep-alive")
+	ctx.Resp.Header().Set("X-Accel-Buffering", "no")
+	ctx.Resp.WriteHeader(http.StatusOK)
+	ctx.Resp.Flush()
+
+	topic := actions_service.RunEventTopic(runID)
+	messageChan := eventsource.GetManager().RegisterTopic(topic)
+	unregister := func() {
+		eventsource.GetManager().UnregisterTopic(topic, messageChan)
+		// ensure the messageChan is closed
+		for {
+			_, ok := <-messageChan
+			if !ok {
+				break
+			}
+		}
+	}
+	defer func() { go unregister() }()
+
+	// sendState writes the current state if it has changed since the last one, and returns whether the stream should go on
+	var lastFingerprint string
+	var lastState []byte
+	sendState := func() bool {
+		current, jobs, err := loadRunJobs(ctx, runIndex, jobIndex)
+		if err != nil {
+			log.Error("loadRunJobs for the stream of run %d: %v", runID, err)
+			return false
+		}
+		fingerprint, err := runViewFingerprint(ctx, current, jobs)
+		if err != nil {
+			log.Error("runViewFingerprint for the stream of run %d: %v", runID, err)
+			return false
+		}
+		if fingerprint == lastFingerprint {
+			return true
+		}
+		lastFingerprint = fingerprint
+
+		resp, err := buildViewResponse(ctx, current, jobs, cursors)
+		if err != nil {
+			log.Error("buildViewResponse for the stream of run %d: %v", runID, err)
+			return false
+		}
+		hasLogs := false
+		for _, stepLog := range resp.Logs.StepsLog {
+			hasLogs = hasLogs || len(stepLog.Lines) > 0
+			for i := range cursors {
+				if cursors[i].Step == stepLog.Step {
+					cursors[i].Cursor = stepLog.Cursor
+				}
+			}
+		}
+		state, err := json.Marshal([]any{resp.Artifacts, resp.TestSummary, resp.State})
+		if err != nil {
+			log.Error("Unable to marshal the state of run %d: %v", runID, err)
+			return false
+		}
+		if !hasLogs && bytes.Equal(state, lastState) {
+			return true
+		}
+		lastState = state
+
+		if _, err := (&eventsource.Event{Name: "state", Data: resp}).WriteTo(ctx.Resp); err != nil {
+			log.Error("Unable to write to the stream of run %d: %v", runID, err)
+			return false
+		}
+		ctx.Resp.Flush()
+		return !resp.State.Run.Done
+	}
+
+	if !sendState() {
+		return
+	}
+
+	// the updates of the jobs and steps aren't all notified, so the state is also refreshed periodically
+	refresh := time.NewTicker(setting.UI.Notification.EventSourceUpdateTime)
+	defer refresh.Stop()
+	ping := time.NewTicker(30 * time.Second)
+	defer ping.Stop()
+	expire := time.NewTimer(maxStreamDuration)
+	defer expire.Stop()
+	shutdownCtx := graceful.GetManager().ShutdownContext()
+
+	for {
+		select {
+		case <-ping.C:
+			if _, err := (&eventsource.Event{Name: "ping"}).WriteTo(ctx.Resp); err != nil {
+				log.Error("Unable to write to the stream of run %d: %v", runID, err)
+				return
+			}
+			ctx.Resp.Flush()
+		case <-expire.C:
+			_, _ = (&eventsource.Event{Name: "reconnect"}).WriteTo(ctx.Resp)
+			ctx.Resp.Flush()
+			return
+		case <-ctx.Done():
+			return
+		case <-shutdownCtx.Done():
+			return
+		case _, ok := <-messageChan:
+			if !ok || !sendState() {
+				return
+			}
+		case <-refresh.C:
+			if !sendState() {
+				return
+			}
+		}
+	}
+}
+
+// runViewFingerprint returns a string which changes whenever the view of the run and its current job may change,
+// it's cheaper to get than the view itself
+func runViewFingerprint(ctx *context_module.Context, current *actions_model.ActionRunJob, jobs []*actions_model.ActionRunJob) (string, error) {
+	var sb strings.Builder
+	run := current.Run
+	fmt.Fprintf(&sb, "%d-%d-%d", run.Status, run.Updated, run.Version)
+	for _, job := range jobs {
+		fmt.Fprintf(&sb, "/%d-%d-%d-%d", job.ID, job.Status, job.Updated, job.TaskID)
+	}
+	if current.TaskID != 0 {
+		task, err := actions_model.GetTaskByID(ctx, current.TaskID)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "/%d-%d-%d", task.Status, task.Updated, task.LogLength)
+	}
+	return sb.String(), nil
+}
+
+// buildViewResponse returns the state of the run and the current job, with the logs of the expanded steps after the cursors
+func buildViewResponse(ctx *context_module.Context, current *actions_model.ActionRunJob, jobs []*actions_model.ActionRunJob, cursors []LogCursor) (*ViewResponse, error) {
 	run := current.Run
 	if err := run.LoadAttributes(ctx); err != nil {
-		ctx.ServerError("run.LoadAttributes", err)
-		return
+		return nil, fmt.Errorf("run.LoadAttributes: %w", err)
 	}
 
 	var err error
 	resp := &ViewResponse{}
-	resp.Artifacts, err = getActionsViewArtifacts(ctx, ctx.Repo.Repository.ID, runIndex)
+	resp.Artifacts, err = getActionsViewArtifacts(ctx, ctx.Repo.Repository.ID, run.Index)
 	if err != nil {
 		if !errors.Is(err, util.ErrNotExist) {
-			ctx.ServerError("getActionsViewArtifacts", err)
-			return
+			return nil, fmt.Errorf("getActionsViewArtifacts: %w", err)
 		}
 	}
+	resp.TestSummary, err = actions_model.GetTestSummaryOfRun(ctx, run.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetTestSummaryOfRun: %w", err)
+	}
 
 	// the title for the "run" is from the commit message
 	resp.State.Run.Title = run.Title
@@ -284,13 +553,11 @@ func ViewPost(ctx *context_module.Context) {
 		var err error
 		task, err = actions_model.GetTaskByID(ctx, current.TaskID)
 		if err != nil {
-			ctx.ServerError("actions_model.GetTaskByID", err)
-			return
+			return nil, fmt.Errorf("GetTaskByID: %w", err)
 		}
 		task.Job = current
 		if err := task.LoadAttributes(ctx); err != nil {
-			ctx.ServerError("task.LoadAttributes", err)
-			return
+			return nil, fmt.Errorf("task.LoadAttributes: %w", err)
 		}
 	}
 
@@ -299,19 +566,30 @@ func ViewPost(ctx *context_module.Context) {
 	if run.NeedApproval {
 		resp.State.CurrentJob.Detail = ctx.Locale.TrString("actions.need_approval_desc")
 	}
+	if current.ErrorMessage != "" {
+		resp.State.CurrentJob.Detail = ctx.Locale.TrString("actions.runs.job_error_message", current.ErrorMessage)
+	}
 	resp.State.CurrentJob.Steps = make([]*ViewJobStep, 0) // marshal to '[]' instead fo 'null' in json
 	resp.Logs.StepsLog = make([]*ViewStepLog, 0)          // marshal to '[]' instead fo 'null' in json
+	resp.State.CurrentJob.Annotations = make([]*ViewAnnotation, 0)
+	resp.State.CurrentJob.Summaries = make([]*ViewStepSummary, 0)
 	if task != nil {
-		steps, logs, err := convertToViewModel(ctx, req.LogCursors, task)
+		steps, logs, err := convertToViewModel(ctx, cursors, task)
 		if err != nil {
-			ctx.ServerError("convertToViewModel", err)
-			return
+			return nil, fmt.Errorf("convertToViewModel: %w", err)
 		}
 		resp.State.CurrentJob.Steps = append(resp.State.CurrentJob.Steps, steps...)
 		resp.Logs.StepsLog = append(resp.Logs.StepsLog, logs...)
+
+		annotations, summaries, err := convertToViewAnnotations(ctx, run, task)
+		if err != nil {
+			return nil, fmt.Errorf("convertToViewAnnotations: %w", err)
+		}
+		resp.State.CurrentJob.Annotations = append(resp.State.CurrentJob.Annotations, annotations...)
+		resp.State.CurrentJob.Summaries = append(resp.State.CurrentJob.Summaries, summaries...)
 	}
 
-	ctx.JSON(http.StatusOK, resp)
+	return resp, nil
 }
 
 func convertToViewModel(ctx *context_module.Context, cursors []LogCursor, task *actions_model.ActionTask) ([]*ViewJobStep, []*ViewStepLog, error) {
@@ -396,6 +674,70 @@ func convertToViewModel(ctx *context_module.Context, cursors []LogCursor, task *
 	return viewJobs, logs, nil
 }
 
+// convertToViewAnnotations returns the annotations and the rendered step summaries reported by the task
+func convertToViewAnnotations(ctx *context_module.Context, run *actions_model.ActionRun, task *actions_model.ActionTask) ([]*ViewAnnotation, []*ViewStepSummary, error) {
+	annotations, err := actions_model.GetTaskAnnotations(ctx, task.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	summaries, err := actions_model.GetTaskSummaries(ctx, task.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	steps := actions.FullSteps(task)
+	stepOfLogIndex := func(logIndex int64) int {
+		for i, step := range steps {
+			if logIndex >= step.LogIndex && logIndex < step.LogIndex+step.LogLength {
+				return i
+			}
+		}
+		return -1
+	}
+
+	viewAnnotations := make([]*ViewAnnotation, 0, len(annotations))
+	for _, a := range annotations {
+		v := &ViewAnnotation{
+			Level:   a.Level.String(),
+			Title:   a.Title,
+			Message: a.Message,
+			File:    a.File,
+			Line:    a.Line,
+			Step:    stepOfLogIndex(a.LogIndex),
+		}
+		if a.File != "" {
+			v.Link = fmt.Sprintf("%s/src/commit/%s/%s", run.Repo.Link(), url.PathEscape(task.CommitSHA), util.PathEscapeSegments(a.File))
+			if a.Line > 0 {
+				v.Link += fmt.Sprintf("#L%d", a.Line)
+			}
+		}
+		viewAnnotations = append(viewAnnotations, v)
+	}
+
+	// concatenate the parts of the summary of each step before rendering them
+	viewSummaries := make([]*ViewStepSummary, 0, len(summaries))
+	contents := make(map[int]string, len(summaries))
+	for _, s := range summaries {
+		step := stepOfLogIndex(s.LogIndex)
+		if _, ok := contents[step]; !ok {
+			stepName := ""
+			if step >= 0 {
+				stepName = steps[step].Name
+			}
+			viewSummaries = append(viewSummaries, &ViewStepSummary{Step: step, StepName: stepName})
+		}
+		contents[step] += s.Content
+	}
+	rctx := renderhelper.NewRenderContextRepoComment(ctx, run.Repo)
+	for _, v := range viewSummaries {
+		v.ContentHTML, err = markdown.RenderString(rctx, contents[v.Step])
+		if err != nil {
+			return nil, nil, fmt.Errorf("render summary: %w", err)
+		}
+	}
+	return viewAnnotations, viewSummaries, nil
+}
+
 // Rerun will rerun jobs in the given run
 // If jobIndexStr is a blank string, it means rerun all jobs
 func Rerun(ctx *context_module.Context) {
@@ -421,7 +763,8 @@ func Rerun(ctx *context_module.Context) {
 	// can not rerun job when workflow is disabled
 	cfgUnit := ctx.Repo.Repository.MustGetUnit(ctx, unit.TypeActions)
 	cfg := cfgUnit.ActionsConfig()
-	if cfg.IsWorkflowDisabled(run.WorkflowID) {
+	// the required workflows of the organization can't be disabled by the repository
+	if run.RequiredWorkflowID == 0 && cfg.IsWorkflowDisabled(run.WorkflowID) {
 		ctx.JSONError(ctx.Locale.Tr("actions.workflow.disabled"))
 		return
 	}
@@ -511,6 +854,7 @@ func rerunJob(ctx *context_module.Context, job *actions_model.ActionRunJob, shou
 	job.Status = util.Iif(shouldBlock, actions_model.StatusBlocked, actions_model.StatusWaiting)
 	job.Started = 0
 	job.Stopped = 0
+	job.ErrorMessage = ""
 
 	job.ConcurrencyGroup = ""
 	job.ConcurrencyCancel = false
@@ -537,7 +881,7 @@ func rerunJob(ctx *context_module.Context, job *actions_model.ActionRunJob, shou
 	}
 
 	if err := db.WithTx(ctx, func(ctx context.Context) error {
-		updateCols := []string{"task_id", "status", "started", "stopped", "concurrency_group", "concurrency_cancel", "is_concurrency_evaluated"}
+		updateCols := []string{"task_id", "status", "started", "stopped", "error_message", "concurrency_group", "concurrency_cancel", "is_concurrency_evaluated"}
 		_, err := actions_model.UpdateRunJob(ctx, job, builder.Eq{"status": status}, updateCols...)
 		return err
 	}); err != nil {
@@ -569,6 +913,96 @@ func Logs(ctx *context_module.Context) {
 	}
 }
 
+// logSearchLimit is the max number of the matched lines returned by SearchLogs
+const logSearchLimit = 100
+
+type LogSearchMatch struct {
+	JobIndex int    `json:"jobIndex"`
+	JobName  string `json:"jobName"`
+	Step     int    `json:"step"`
+	StepName string `json:"stepName"`
+	Line     int64  `json:"line"` // index of the line in the step, starting at 1 like ViewStepLogLine
+	Message  string `json:"message"`
+	Link     string `json:"link"`
+}
+
+type LogSearchResponse struct {
+	Matches   []*LogSearchMatch `json:"matches"`
+	Truncated bool              `json:"truncated"`
+}
+
+// SearchLogs searches the logs of all the jobs of a run, the matched lines link to the lines in the view of their jobs
+func SearchLogs(ctx *context_module.Context) {
+	keyword := strings.TrimSpace(ctx.FormString("q"))
+	_, jobs := getRunJobs(ctx, getRunIndex(ctx), -1)
+	if ctx.Written() {
+		return
+	}
+
+	resp := &LogSearchResponse{Matches: make([]*LogSearchMatch, 0)} // marshal to '[]' instead fo 'null' in json
+	if keyword == "" {
+		ctx.JSON(http.StatusOK, resp)
+		return
+	}
+	for jobIndex, job := range jobs {
+		if job.TaskID == 0 {
+			continue
+		}
+		task, err := actions_model.GetTaskByID(ctx, job.TaskID)
+		if err != nil {
+			ctx.ServerError("GetTaskByID", err)
+			return
+		}
+		if task.LogExpired || task.LogLength == 0 {
+			continue
+		}
+		if err := task.LoadAttributes(ctx); err != nil {
+			ctx.ServerError("task.LoadAttributes", err)
+			return
+		}
+
+		matches, truncated, err := searchTaskLogs(ctx, task, keyword, logSearchLimit-len(resp.Matches))
+		if err != nil {
+			ctx.ServerError("searchTaskLogs", err)
+			return
+		}
+		steps := actions.FullSteps(task)
+		for _, match := range matches {
+			for stepIndex, step := range steps {
+				if match.Index < step.LogIndex || match.Index >= step.LogIndex+step.LogLength {
+					continue
+				}
+				line := match.Index - step.LogIndex + 1
+				resp.Matches = append(resp.Matches, &LogSearchMatch{
+					JobIndex: jobIndex,
+					JobName:  job.Name,
+					Step:     stepIndex,
+					StepName: step.Name,
+					Line:     line,
+					Message:  match.Content,
+					Link:     fmt.Sprintf("%s/jobs/%d#jobstep-%d-%d", job.Run.Link(), jobIndex, stepIndex, line),
+				})
+				break
+			}
+		}
+		if truncated {
+			resp.Truncated = true
+			break
+		}
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func searchTaskLogs(ctx *context_module.Context, task *actions_model.ActionTask, keyword string, limit int) ([]*actions.LogMatch, bool, error) {
+	reader, err := actions.OpenLogs(ctx, task.LogInStorage, task.LogFilename)
+	if err != nil {
+		return nil, false, fmt.Errorf("OpenLogs: %w", err)
+	}
+	defer reader.Close()
+	return actions.SearchLogs(reader, keyword, limit)
+}
+
 func Cancel(ctx *context_module.Context) {
 	runIndex := getRunIndex(ctx)
 
@@ -616,67 +1050,45 @@ func Approve(ctx *context_module.Context) {
 	ctx.JSONOK()
 }
 
-func approveRuns(ctx *context_module.Context, runIndexes []int64) {
-	doer := ctx.Doer
-	repo := ctx.Repo.Repository
+func Reject(ctx *context_module.Context) {
+	runIndex := getRunIndex(ctx)
 
-	updatedJobs := make([]*actions_model.ActionRunJob, 0)
-	runMap := make(map[int64]*actions_model.ActionRun, len(runIndexes))
-	runJobs := make(map[int64][]*actions_model.ActionRunJob, len(runIndexes))
+	runs := getRunsByIndexes(ctx, []int64{runIndex})
+	if ctx.Written() {
+		return
+	}
 
-	err := db.WithTx(ctx, func(ctx context.Context) (err error) {
-		for _, runIndex := range runIndexes {
-			run, err := actions_model.GetRunByIndex(ctx, repo.ID, runIndex)
-			if err != nil {
-				return err
-			}
-			runMap[run.ID] = run
-			run.Repo = repo
-			run.NeedApproval = false
-			run.ApprovedBy = doer.ID
-			if err := actions_model.UpdateRun(ctx, run, "need_approval", "approved_by"); err != nil {
-				return err
-			}
-			jobs, err := actions_model.GetRunJobsByRunID(ctx, run.ID)
-			if err != nil {
-				return err
-			}
-			runJobs[run.ID] = jobs
-			for _, job := range jobs {
-				job.Status, err = actions_service.PrepareToStartJobWithConcurrency(ctx, job)
-				if err != nil {
-					return err
-				}
-				if job.Status == actions_model.StatusWaiting {
-					n, err := actions_model.UpdateRunJob(ctx, job, nil, "status")
-					if err != nil {
-						return err
-					}
-					if n > 0 {
-						updatedJobs = append(updatedJobs, job)
-					}
-				}
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		ctx.ServerError("UpdateRunJob", err)
+	if err := actions_service.RejectRuns(ctx, ctx.Repo.Repository, runs); err != nil {
+		ctx.ServerError("RejectRuns", err)
 		return
 	}
 
-	for runID, run := range runMap {
-		actions_service.CreateCommitStatusForRunJobs(ctx, run, runJobs[runID]...)
+	ctx.JSONOK()
+}
+
+func getRunsByIndexes(ctx *context_module.Context, runIndexes []int64) []*actions_model.ActionRun {
+	runs := make([]*actions_model.ActionRun, 0, len(runIndexes))
+	for _, runIndex := range runIndexes {
+		run, err := actions_model.GetRunByIndex(ctx, ctx.Repo.Repository.ID, runIndex)
+		if err != nil {
+			ctx.NotFoundOrServerError("GetRunByIndex", func(err error) bool {
+				return errors.Is(err, util.ErrNotExist)
+			}, err)
+			return nil
+		}
+		runs = append(runs, run)
 	}
+	return runs
+}
 
-	if len(updatedJobs) > 0 {
-		job := updatedJobs[0]
-		actions_service.NotifyWorkflowRunStatusUpdateWithReload(ctx, job)
+func approveRuns(ctx *context_module.Context, runIndexes []int64) {
+	runs := getRunsByIndexes(ctx, runIndexes)
+	if ctx.Written() {
+		return
 	}
 
-	for _, job := range updatedJobs {
-		_ = job.LoadAttributes(ctx)
-		notify_service.WorkflowJobStatusUpdate(ctx, job.Run.Repo, job.Run.TriggerUser, job, nil)
+	if err := actions_service.ApproveRuns(ctx, ctx.Repo.Repository, ctx.Doer, runs); err != nil {
+		ctx.ServerError("ApproveRuns", err)
 	}
 }
 
@@ -711,24 +1123,31 @@ func Delete(ctx *context_module.Context) {
 // Any error will be written to the ctx.
 // It never returns a nil job of an empty jobs, if the jobIndex is out of range, it will be treated as 0.
 func getRunJobs(ctx *context_module.Context, runIndex, jobIndex int64) (*actions_model.ActionRunJob, []*actions_model.ActionRunJob) {
-	run, err := actions_model.GetRunByIndex(ctx, ctx.Repo.Repository.ID, runIndex)
+	current, jobs, err := loadRunJobs(ctx, runIndex, jobIndex)
 	if err != nil {
 		if errors.Is(err, util.ErrNotExist) {
 			ctx.NotFound(nil)
 			return nil, nil
 		}
-		ctx.ServerError("GetRunByIndex", err)
+		ctx.ServerError("loadRunJobs", err)
 		return nil, nil
 	}
+	return current, jobs
+}
+
+// loadRunJobs is like getRunJobs, but it returns the error rather than writing it to the ctx
+func loadRunJobs(ctx *context_module.Context, runIndex, jobIndex int64) (*actions_model.ActionRunJob, []*actions_model.ActionRunJob, error) {
+	run, err := actions_model.GetRunByIndex(ctx, ctx.Repo.Repository.ID, runIndex)
+	if err != nil {
+		return nil, nil, err
+	}
 	run.Repo = ctx.Repo.Repository
 	jobs, err := actions_model.GetRunJobsByRunID(ctx, run.ID)
 	if err != nil {
-		ctx.ServerError("GetRunJobsByRunID", err)
-		return nil, nil
+		return nil, nil, fmt.Errorf("GetRunJobsByRunID: %w", err)
 	}
 	if len(jobs) == 0 {
-		ctx.NotFound(nil)
-		return nil, nil
+		return nil, nil, util.NewNotExistErrorf("run %d has no jobs", run.ID)
 	}
 
 	for _, v := range jobs {
@@ -736,9 +1155,9 @@ func getRunJobs(ctx *context_module.Context, runIndex, jobIndex int64) (*actions
 	}
 
 	if jobIndex >= 0 && jobIndex < int64(len(jobs)) {
-		return jobs[jobIndex], jobs
+		return jobs[jobIndex], jobs, nil
 	}
-	return jobs[0], jobs
+	return jobs[0], jobs, nil
 }
 
 func ArtifactsDeleteView(ctx *context_module.Context) {
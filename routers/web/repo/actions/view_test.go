@@ -0,0 +1,39 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireStream(t *testing.T) {
+	for range maxStreamsPerUser {
+		assert.True(t, acquireStream("user:1"))
+	}
+	assert.False(t, acquireStream("user:1"))
+	assert.True(t, acquireStream("user:2"))
+
+	releaseStream("user:1")
+	assert.True(t, acquireStream("user:1"))
+
+	for range maxStreamsPerUser {
+		releaseStream("user:1")
+	}
+	releaseStream("user:2")
+	assert.Zero(t, openStreams.total)
+	assert.Empty(t, openStreams.byKey)
+
+	// the number of all the streams is limited too
+	for i := range maxStreams {
+		assert.True(t, acquireStream("addr:"+strconv.Itoa(i)))
+	}
+	assert.False(t, acquireStream("user:1"))
+	for i := range maxStreams {
+		releaseStream("addr:" + strconv.Itoa(i))
+	}
+	assert.Zero(t, openStreams.total)
+}
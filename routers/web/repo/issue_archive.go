@@ -0,0 +1,100 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/gitea/modules/base"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/migrations"
+)
+
+// ExportIssues exports the issues of the issue list with its state, labels and milestone filters as JSON or CSV
+func ExportIssues(ctx *context.Context) {
+	format := ctx.FormString("format")
+	if format != "csv" {
+		format = "json"
+	}
+	opts := migrations.ExportIssuesOptions{IncludeComments: true}
+	switch ctx.FormString("state") {
+	case "closed":
+		opts.IsClosed = optional.Some(true)
+	case "all":
+	default:
+		opts.IsClosed = optional.Some(false)
+	}
+	// the excluded labels of the filter are negative
+	labelIDs, _ := base.StringsToInt64s(util.SplitTrimSpace(ctx.FormString("labels"), ","))
+	for _, id := range labelIDs {
+		if id > 0 {
+			opts.LabelIDs = append(opts.LabelIDs, id)
+		}
+	}
+	if milestoneID := ctx.FormInt64("milestone"); milestoneID > 0 {
+		opts.MilestoneIDs = []int64{milestoneID}
+	}
+
+	archive, err := migrations.ExportIssues(ctx, ctx.Repo.Repository, opts)
+	if err != nil {
+		ctx.ServerError("ExportIssues", err)
+		return
+	}
+	buf := &bytes.Buffer{}
+	contentType := "application/json"
+	if format == "csv" {
+		contentType = "text/csv"
+		err = migrations.WriteIssuesCSV(buf, archive)
+	} else {
+		err = migrations.WriteIssueArchive(buf, archive)
+	}
+	if err != nil {
+		ctx.ServerError("WriteIssueArchive", err)
+		return
+	}
+	ctx.ServeContent(bytes.NewReader(buf.Bytes()), &context.ServeHeaderOptions{
+		ContentType: contentType,
+		Filename:    migrations.IssueArchiveFilename(ctx.Repo.Repository, format),
+	})
+}
+
+// ImportIssuesPost imports the issues of a JSON or CSV export into the repository
+func ImportIssuesPost(ctx *context.Context) {
+	file, header, err := ctx.Req.FormFile("file")
+	if err != nil {
+		ctx.JSONError(ctx.Tr("repo.issues.import.no_file"))
+		return
+	}
+	defer file.Close()
+
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+	archive, err := migrations.ReadUploadedIssueArchive(file, format)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) || errors.Is(err, migrations.ErrIssueArchiveTooLarge) {
+			ctx.JSONError(ctx.Tr("repo.issues.import.invalid_file", err.Error()))
+			return
+		}
+		ctx.ServerError("ReadUploadedIssueArchive", err)
+		return
+	}
+
+	// only site administrators can post issues as other users
+	opts := migrations.ImportIssuesOptions{MapUsers: ctx.Doer.IsAdmin && ctx.FormBool("map_users")}
+	n, err := migrations.ImportIssues(ctx, ctx.Doer, ctx.Repo.Repository, archive, opts)
+	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(ctx.Tr("repo.issues.import.invalid_file", err.Error()))
+			return
+		}
+		ctx.ServerError("ImportIssues", err)
+		return
+	}
+	ctx.Flash.Success(ctx.TrN(n, "repo.issues.import.success_1", "repo.issues.import.success_n", n))
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/issues")
+}
@@ -284,7 +284,7 @@ func UpdateCommentContent(ctx *context.Context) {
 		rctx := renderhelper.NewRenderContextRepoComment(ctx, ctx.Repo.Repository, renderhelper.RepoCommentOptions{
 			FootnoteContextID: strconv.FormatInt(comment.ID, 10),
 		})
-		renderedContent, err = markdown.RenderString(rctx, comment.Content)
+		renderedContent, err = markdown.RenderString(rctx, comment.ContentWithSuggestionDiff())
 		if err != nil {
 			ctx.ServerError("RenderString", err)
 			return
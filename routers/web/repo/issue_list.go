@@ -509,6 +509,14 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID, projectID int6
 		keyword = ""
 	}
 
+	// the parent filter only shows the direct sub-issues of an issue
+	parentID := ctx.FormInt64("parent")
+
+	issueTypeID, issueFieldValues := prepareIssueTypeFilter(ctx)
+	if ctx.Written() {
+		return
+	}
+
 	var mileIDs []int64
 	if milestoneID > 0 || milestoneID == db.NoConditionID { // -1 to get those issues which have no any milestone assigned
 		mileIDs = []int64{milestoneID}
@@ -528,6 +536,9 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID, projectID int6
 		LabelIDs:          preparedLabelFilter.SelectedLabelIDs,
 		MilestoneIDs:      mileIDs,
 		ProjectID:         projectID,
+		ParentID:          parentID,
+		TypeID:            issueTypeID,
+		IssueFieldValues:  issueFieldValues,
 		AssigneeID:        assigneeID,
 		MentionedID:       mentionedID,
 		PosterID:          posterUserID,
@@ -608,6 +619,9 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID, projectID int6
 			ReviewedID:        reviewedID,
 			MilestoneIDs:      mileIDs,
 			ProjectID:         projectID,
+			ParentID:          parentID,
+			TypeID:            issueTypeID,
+			IssueFieldValues:  issueFieldValues,
 			IsClosed:          isShowClosed,
 			IsPull:            isPullOption,
 			LabelIDs:          preparedLabelFilter.SelectedLabelIDs,
@@ -659,7 +673,18 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID, projectID int6
 		return
 	}
 
+	issueIDs := make([]int64, 0, len(issues))
+	for _, iss := range issues {
+		issueIDs = append(issueIDs, iss.ID)
+	}
+	subIssueProgress, err := issues_model.GetSubIssueProgress(ctx, issueIDs)
+	if err != nil {
+		ctx.ServerError("GetSubIssueProgress", err)
+		return
+	}
+
 	ctx.Data["Issues"] = issues
+	ctx.Data["SubIssueProgress"] = subIssueProgress
 	ctx.Data["CommitLastStatus"] = lastStatus
 	ctx.Data["CommitStatuses"] = commitStatuses
 
@@ -719,6 +744,8 @@ func prepareIssueFilterAndList(ctx *context.Context, milestoneID, projectID int6
 	ctx.Data["SortType"] = sortType
 	ctx.Data["MilestoneID"] = milestoneID
 	ctx.Data["ProjectID"] = projectID
+	ctx.Data["ParentID"] = parentID
+	ctx.Data["IssueTypeID"] = issueTypeID
 	ctx.Data["AssigneeID"] = assigneeID
 	ctx.Data["PosterUsername"] = posterUsername
 	ctx.Data["Keyword"] = keyword
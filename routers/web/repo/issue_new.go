@@ -76,6 +76,7 @@ func setTemplateIfExists(ctx *context.Context, ctxDataKey string, possibleFiles
 		}
 
 		metaData.LabelsData.SetSelectedLabelNames(template.Labels)
+		setIssueTemplateType(ctx, template)
 
 		selectedAssigneeIDStrings := make([]string, 0, len(template.Assignees))
 		if userIDs, err := user_model.GetUserIDsByNames(ctx, template.Assignees, true); err == nil {
@@ -135,6 +136,11 @@ func NewIssue(ctx *context.Context) {
 	}
 	ctx.Data["Tags"] = tags
 
+	prepareNewIssueTypes(ctx)
+	if ctx.Written() {
+		return
+	}
+
 	ret := issue_service.ParseTemplatesFromDefaultBranch(ctx.Repo.Repository, ctx.Repo.GitRepo)
 	templateLoaded, errs := setTemplateIfExists(ctx, issueTemplateKey, IssueTemplateCandidates, pageMetaData)
 	maps.Copy(ret.TemplateErrors, errs)
@@ -345,6 +351,11 @@ func NewIssuePost(ctx *context.Context) {
 
 	labelIDs, assigneeIDs, milestoneID, projectID := validateRet.LabelIDs, validateRet.AssigneeIDs, validateRet.MilestoneID, validateRet.ProjectID
 
+	issueType, issueFieldValues := getNewIssueType(ctx, form.IssueTypeID)
+	if ctx.Written() {
+		return
+	}
+
 	if projectID > 0 {
 		if !ctx.Repo.CanRead(unit.TypeProjects) {
 			// User must also be able to see the project.
@@ -384,6 +395,9 @@ func NewIssuePost(ctx *context.Context) {
 		Content:     content,
 		Ref:         form.Ref,
 	}
+	if issueType != nil {
+		issue.TypeID, issue.Type = issueType.ID, issueType
+	}
 
 	if err := issue_service.NewIssue(ctx, repo, issue, labelIDs, attachments, assigneeIDs, projectID); err != nil {
 		if repo_model.IsErrUserDoesNotHaveAccessToRepo(err) {
@@ -396,6 +410,11 @@ func NewIssuePost(ctx *context.Context) {
 		return
 	}
 
+	if err := issue_service.SetIssueFieldValues(ctx, ctx.Doer, issue, issueFieldValues); err != nil {
+		ctx.ServerError("SetIssueFieldValues", err)
+		return
+	}
+
 	log.Trace("Issue created: %d/%d", repo.ID, issue.ID)
 	if ctx.FormString("redirect_after_creation") == "project" && projectID > 0 {
 		project, err := project_model.GetProjectByID(ctx, projectID)
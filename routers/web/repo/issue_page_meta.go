@@ -37,6 +37,10 @@ type issueSidebarProjectsData struct {
 	SelectedProjectID int64
 	OpenProjects      []*project_model.Project
 	ClosedProjects    []*project_model.Project
+
+	// the custom fields of the project of the issue and their values by field ID
+	Fields      project_model.FieldList
+	FieldValues map[int64]string
 }
 
 type IssuePageMetaData struct {
@@ -92,6 +96,11 @@ func retrieveRepoIssueMetaData(ctx *context.Context, repo *repo_model.Repository
 		return data
 	}
 
+	data.retrieveProjectFieldsData(ctx)
+	if ctx.Written() {
+		return data
+	}
+
 	// TODO: the issue/pull permissions are quite complex and unclear
 	// A reader could create an issue/PR with setting some meta (eg: assignees from issue template, reviewers, target branch)
 	// A reader(creator) could update some meta (eg: target branch), but can't change assignees anymore.
@@ -166,6 +175,31 @@ func (d *IssuePageMetaData) retrieveProjectsDataForIssueWriter(ctx *context.Cont
 	d.ProjectsData.OpenProjects, d.ProjectsData.ClosedProjects = retrieveProjectsInternal(ctx, ctx.Repo.Repository)
 }
 
+func (d *IssuePageMetaData) retrieveProjectFieldsData(ctx *context.Context) {
+	if d.Issue == nil {
+		return
+	}
+	if err := d.Issue.LoadProject(ctx); err != nil {
+		ctx.ServerError("LoadProject", err)
+		return
+	}
+	if d.Issue.Project == nil {
+		return
+	}
+	var err error
+	d.ProjectsData.Fields, err = project_model.GetFields(ctx, d.Issue.Project.ID)
+	if err != nil {
+		ctx.ServerError("GetFields", err)
+		return
+	}
+	values, err := project_model.GetFieldValues(ctx, d.Issue.Project.ID, []int64{d.Issue.ID})
+	if err != nil {
+		ctx.ServerError("GetFieldValues", err)
+		return
+	}
+	d.ProjectsData.FieldValues = values[d.Issue.ID]
+}
+
 // repoReviewerSelection items to bee shown
 type repoReviewerSelection struct {
 	IsTeam         bool
@@ -0,0 +1,154 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+// AddSubIssue makes an issue of a repository of the same owner a sub-issue of the issue
+func AddSubIssue(ctx *context.Context) {
+	parent := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if parent.IsPull {
+		ctx.HTTPError(http.StatusNotFound)
+		return
+	}
+
+	subIssue := getWritableIssueByRef(ctx, ctx.FormTrim("sub_issue"))
+	if ctx.Written() {
+		return
+	}
+
+	if err := issue_service.AddSubIssue(ctx, ctx.Doer, parent, subIssue); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("AddSubIssue", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// RemoveSubIssue removes a sub-issue from the issue
+func RemoveSubIssue(ctx *context.Context) {
+	parent := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	subIssue, err := issues_model.GetIssueByID(ctx, ctx.FormInt64("sub_issue_id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueByID", issues_model.IsErrIssueNotExist, err)
+		return
+	}
+
+	if err := issue_service.RemoveSubIssue(ctx, ctx.Doer, parent, subIssue); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("RemoveSubIssue", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// getWritableIssueByRef returns the issue of a reference like "#1", "repo#1" or "owner/repo#1" if the doer can write to it,
+// a reference without owner is an issue of the owner of the current repository
+func getWritableIssueByRef(ctx *context.Context, ref string) *issues_model.Issue {
+	repoRef, indexStr, _ := strings.Cut(ref, "#")
+	if indexStr == "" {
+		repoRef, indexStr = "", ref
+	}
+	index, err := strconv.ParseInt(indexStr, 10, 64)
+	if err != nil || index <= 0 {
+		ctx.JSONError(ctx.Tr("repo.issues.sub_issues.invalid_reference", ref))
+		return nil
+	}
+
+	repo := ctx.Repo.Repository
+	if repoRef != "" {
+		ownerName, repoName, ok := strings.Cut(repoRef, "/")
+		if !ok {
+			ownerName, repoName = repo.OwnerName, repoRef
+		}
+		if !strings.EqualFold(ownerName, repo.OwnerName) {
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issues.other_owner", repo.OwnerName))
+			return nil
+		}
+		if repo, err = repo_model.GetRepositoryByName(ctx, repo.OwnerID, repoName); err != nil {
+			if repo_model.IsErrRepoNotExist(err) {
+				ctx.JSONError(ctx.Tr("repo.issues.sub_issues.not_exist", ref))
+			} else {
+				ctx.ServerError("GetRepositoryByName", err)
+			}
+			return nil
+		}
+	}
+
+	issue, err := issues_model.GetIssueByIndex(ctx, repo.ID, index)
+	if err != nil {
+		if issues_model.IsErrIssueNotExist(err) {
+			ctx.JSONError(ctx.Tr("repo.issues.sub_issues.not_exist", ref))
+		} else {
+			ctx.ServerError("GetIssueByIndex", err)
+		}
+		return nil
+	}
+	issue.Repo = repo
+
+	perm, err := access_model.GetUserRepoPermission(ctx, repo, ctx.Doer)
+	if err != nil {
+		ctx.ServerError("GetUserRepoPermission", err)
+		return nil
+	}
+	if !perm.CanReadIssuesOrPulls(issue.IsPull) {
+		ctx.JSONError(ctx.Tr("repo.issues.sub_issues.not_exist", ref))
+		return nil
+	}
+	if !perm.CanWriteIssuesOrPulls(issue.IsPull) || repo.IsArchived {
+		ctx.JSONError(ctx.Tr("repo.issues.sub_issues.no_permission", ref))
+		return nil
+	}
+	return issue
+}
+
+func prepareIssueViewSubIssues(ctx *context.Context, issue *issues_model.Issue) {
+	if issue.IsPull {
+		return
+	}
+	parent, err := issue_service.GetParentIssue(ctx, ctx.Doer, issue)
+	if err != nil {
+		ctx.ServerError("GetParentIssue", err)
+		return
+	}
+	tree, err := issue_service.GetSubIssueTree(ctx, ctx.Doer, issue)
+	if err != nil {
+		ctx.ServerError("GetSubIssueTree", err)
+		return
+	}
+	progress, err := issues_model.GetSubIssueProgress(ctx, []int64{issue.ID})
+	if err != nil {
+		ctx.ServerError("GetSubIssueProgress", err)
+		return
+	}
+	ctx.Data["ParentIssue"] = parent
+	ctx.Data["SubIssueTree"] = tree
+	ctx.Data["SubIssueProgress"] = progress[issue.ID]
+	ctx.Data["CanChangeSubIssues"] = ctx.Repo.CanWriteIssuesOrPulls(false) && !ctx.Repo.Repository.IsArchived
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unit"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+// parseIssueFieldValuesForm returns the values of the custom fields posted as "issue_field_{id}" form values by field ID
+func parseIssueFieldValuesForm(ctx *context.Context, fields issues_model.IssueFieldList) map[int64]string {
+	values := make(map[int64]string, len(fields))
+	for _, field := range fields {
+		key := fmt.Sprintf("issue_field_%d", field.ID)
+		if _, ok := ctx.Req.Form[key]; ok {
+			values[field.ID] = ctx.Req.Form.Get(key)
+		}
+	}
+	return values
+}
+
+// prepareIssueTypeFilter loads the issue types of the owner of the repository for the issue list and returns
+// the "issue_type" filter, -1 for the issues without type, and the "issue_field_{id}" filters of the fields of the type
+func prepareIssueTypeFilter(ctx *context.Context) (typeID int64, fieldValues map[int64]string) {
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Repo.Repository.OwnerID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return 0, nil
+	}
+	ctx.Data["IssueTypes"] = types
+
+	typeID = ctx.FormInt64("issue_type")
+	if typeID <= 0 {
+		if typeID != db.NoConditionID {
+			typeID = 0
+		}
+		return typeID, nil
+	}
+
+	for _, t := range types {
+		if t.ID != typeID {
+			continue
+		}
+		if err := t.LoadFields(ctx); err != nil {
+			ctx.ServerError("LoadFields", err)
+			return 0, nil
+		}
+		for _, field := range t.Fields {
+			value, err := field.NormalizeValue(ctx.FormString(fmt.Sprintf("issue_field_%d", field.ID)))
+			if err != nil || value == "" {
+				continue
+			}
+			if fieldValues == nil {
+				fieldValues = make(map[int64]string)
+			}
+			fieldValues[field.ID] = value
+		}
+		return typeID, fieldValues
+	}
+	// the type doesn't exist, no issue matches
+	return typeID, nil
+}
+
+func prepareIssueViewType(ctx *context.Context, issue *issues_model.Issue) {
+	if issue.IsPull {
+		return
+	}
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, issue.Repo.OwnerID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return
+	}
+	if len(types) == 0 && issue.TypeID == 0 {
+		return
+	}
+	if err := issue.LoadType(ctx); err != nil {
+		ctx.ServerError("LoadType", err)
+		return
+	}
+	if issue.Type != nil {
+		if err := issue.Type.LoadFields(ctx); err != nil {
+			ctx.ServerError("LoadFields", err)
+			return
+		}
+		values, err := issues_model.GetIssueFieldValues(ctx, []int64{issue.ID})
+		if err != nil {
+			ctx.ServerError("GetIssueFieldValues", err)
+			return
+		}
+		ctx.Data["IssueFieldValues"] = values[issue.ID]
+	}
+	ctx.Data["IssueTypes"] = types
+	ctx.Data["CanChangeIssueType"] = ctx.Repo.CanWriteIssuesOrPulls(false) && !ctx.Repo.Repository.IsArchived
+}
+
+// UpdateIssueType changes the type of an issue to one of the types of the owner of the repository
+func UpdateIssueType(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if issue.IsPull {
+		ctx.HTTPError(http.StatusNotFound)
+		return
+	}
+
+	var t *issues_model.IssueType
+	if typeID := ctx.FormInt64("type_id"); typeID > 0 {
+		var err error
+		if t, err = issues_model.GetIssueTypeByID(ctx, ctx.Repo.Repository.OwnerID, typeID); err != nil {
+			ctx.NotFoundOrServerError("GetIssueTypeByID", issues_model.IsErrIssueTypeNotExist, err)
+			return
+		}
+	}
+
+	if err := issue_service.ChangeIssueType(ctx, ctx.Doer, issue, t); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("ChangeIssueType", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// UpdateIssueTypeFields changes the values of the custom fields of the type of an issue
+func UpdateIssueTypeFields(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if issue.Type == nil {
+		ctx.HTTPError(http.StatusNotFound)
+		return
+	}
+	if err := issue.Type.LoadFields(ctx); err != nil {
+		ctx.ServerError("LoadFields", err)
+		return
+	}
+
+	if err := issue_service.SetIssueFieldValues(ctx, ctx.Doer, issue, parseIssueFieldValuesForm(ctx, issue.Type.Fields)); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("SetIssueFieldValues", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// prepareNewIssueTypes loads the issue types of the owner of the repository and their fields for the new issue page,
+// a type can be preselected by the "issue_type" query
+func prepareNewIssueTypes(ctx *context.Context) {
+	if !ctx.Repo.CanWrite(unit.TypeIssues) {
+		return
+	}
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Repo.Repository.OwnerID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return
+	}
+	if err := types.LoadFields(ctx); err != nil {
+		ctx.ServerError("LoadFields", err)
+		return
+	}
+	ctx.Data["IssueTypes"] = types
+	ctx.Data["SelectedIssueTypeID"] = ctx.FormInt64("issue_type")
+	ctx.Data["SelectedIssueFieldValues"] = map[int64]string{}
+}
+
+// setIssueTemplateType preselects the issue type and the custom field values of an issue template,
+// the unknown fields and the invalid values are ignored
+func setIssueTemplateType(ctx *context.Context, template *api.IssueTemplate) {
+	types, _ := ctx.Data["IssueTypes"].(issues_model.IssueTypeList)
+	for _, t := range types {
+		if !strings.EqualFold(t.Name, strings.TrimSpace(template.IssueType)) {
+			continue
+		}
+		values := make(map[int64]string, len(template.IssueFields))
+		for name, value := range template.IssueFields {
+			field := t.Fields.GetFieldByName(name)
+			if field == nil {
+				continue
+			}
+			if value, err := field.NormalizeValue(value); err == nil && value != "" {
+				values[field.ID] = value
+			}
+		}
+		ctx.Data["SelectedIssueTypeID"] = t.ID
+		ctx.Data["SelectedIssueFieldValues"] = values
+		return
+	}
+}
+
+// getNewIssueType returns the type and the custom field values chosen for a new issue,
+// like the labels only the writers can choose them
+func getNewIssueType(ctx *context.Context, typeID int64) (*issues_model.IssueType, map[int64]string) {
+	if typeID <= 0 || !ctx.Repo.CanWrite(unit.TypeIssues) {
+		return nil, nil
+	}
+	t, err := issues_model.GetIssueTypeByID(ctx, ctx.Repo.Repository.OwnerID, typeID)
+	if err != nil {
+		if issues_model.IsErrIssueTypeNotExist(err) {
+			ctx.JSONError(ctx.Tr("repo.issues.issue_type.not_exist"))
+		} else {
+			ctx.ServerError("GetIssueTypeByID", err)
+		}
+		return nil, nil
+	}
+	if err := t.LoadFields(ctx); err != nil {
+		ctx.ServerError("LoadFields", err)
+		return nil, nil
+	}
+	values := parseIssueFieldValuesForm(ctx, t.Fields)
+	for _, field := range t.Fields {
+		if _, err := field.NormalizeValue(values[field.ID]); err != nil {
+			ctx.JSONError(err.Error())
+			return nil, nil
+		}
+	}
+	return t, values
+}
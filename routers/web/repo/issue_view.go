@@ -386,7 +386,10 @@ func ViewIssue(ctx *context.Context) {
 		prepareIssueViewSidebarWatch,
 		prepareIssueViewSidebarTimeTracker,
 		prepareIssueViewSidebarDependency,
+		prepareIssueViewSubIssues,
+		prepareIssueViewType,
 		prepareIssueViewSidebarPin,
+		prepareIssueViewSidebarPullStack,
 		func(ctx *context.Context, issue *issues_model.Issue) { preparePullViewPullInfo(ctx, issue) },
 		preparePullViewReviewAndMerge,
 	}
@@ -0,0 +1,168 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/services/context"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+const tplMilestoneAnalytics templates.TplName = "repo/issue/milestone_analytics"
+
+// milestoneVelocityLimit is the number of the last closed milestones shown in the velocity chart
+const milestoneVelocityLimit = 10
+
+func getMilestoneForAnalytics(ctx *context.Context) *issues_model.Milestone {
+	milestone, err := issues_model.GetMilestoneByRepoID(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetMilestoneByRepoID", issues_model.IsErrMilestoneNotExist, err)
+		return nil
+	}
+	return milestone
+}
+
+// secondsToHours converts a sum of time estimates to hours rounded to a tenth
+func secondsToHours(seconds int64) float64 {
+	return float64(seconds*10/3600) / 10
+}
+
+// MilestoneAnalytics render the burndown and the velocity charts of a milestone with its daily snapshots
+func MilestoneAnalytics(ctx *context.Context) {
+	milestone := getMilestoneForAnalytics(ctx)
+	if ctx.Written() {
+		return
+	}
+	snapshots, err := issue_service.GetMilestoneSnapshots(ctx, milestone)
+	if err != nil {
+		ctx.ServerError("GetMilestoneSnapshots", err)
+		return
+	}
+	slices.Reverse(snapshots)
+
+	ctx.Data["Title"] = ctx.Tr("repo.milestones.analytics.title", milestone.Name)
+	ctx.Data["PageIsMilestones"] = true
+	ctx.Data["Milestone"] = milestone
+	ctx.Data["Snapshots"] = snapshots
+	ctx.Data["CanWriteIssues"] = ctx.Repo.CanWriteIssuesOrPulls(false) || ctx.Repo.CanWriteIssuesOrPulls(true)
+	ctx.HTML(http.StatusOK, tplMilestoneAnalytics)
+}
+
+type milestoneBurndownDay struct {
+	Day            int64   `json:"day"`
+	OpenIssues     int     `json:"open_issues"`
+	ClosedIssues   int     `json:"closed_issues"`
+	OpenEstimate   float64 `json:"open_estimate"`
+	ClosedEstimate float64 `json:"closed_estimate"`
+}
+
+type milestoneVelocity struct {
+	Name           string  `json:"name"`
+	Closed         int64   `json:"closed"`
+	ClosedIssues   int64   `json:"closed_issues"`
+	ClosedEstimate float64 `json:"closed_estimate"`
+}
+
+// MilestoneAnalyticsData returns the data of the burndown chart of a milestone and of the velocity chart of the repository,
+// the estimates are in hours
+func MilestoneAnalyticsData(ctx *context.Context) {
+	milestone := getMilestoneForAnalytics(ctx)
+	if ctx.Written() {
+		return
+	}
+	snapshots, err := issue_service.GetMilestoneSnapshots(ctx, milestone)
+	if err != nil {
+		ctx.ServerError("GetMilestoneSnapshots", err)
+		return
+	}
+	velocities, err := issues_model.GetMilestoneVelocities(ctx, ctx.Repo.Repository.ID, milestoneVelocityLimit)
+	if err != nil {
+		ctx.ServerError("GetMilestoneVelocities", err)
+		return
+	}
+
+	burndown := make([]*milestoneBurndownDay, 0, len(snapshots))
+	for _, s := range snapshots {
+		burndown = append(burndown, &milestoneBurndownDay{
+			Day:            int64(s.Day),
+			OpenIssues:     s.NumOpenIssues,
+			ClosedIssues:   s.NumClosedIssues(),
+			OpenEstimate:   secondsToHours(s.OpenEstimate),
+			ClosedEstimate: secondsToHours(s.ClosedEstimate),
+		})
+	}
+	velocity := make([]*milestoneVelocity, 0, len(velocities))
+	for _, v := range velocities {
+		velocity = append(velocity, &milestoneVelocity{
+			Name:           v.Milestone.Name,
+			Closed:         int64(v.Milestone.ClosedDateUnix),
+			ClosedIssues:   v.NumClosedIssues,
+			ClosedEstimate: secondsToHours(v.ClosedEstimate),
+		})
+	}
+	ctx.JSON(http.StatusOK, map[string]any{
+		"deadline": int64(milestone.DeadlineUnix),
+		"burndown": burndown,
+		"velocity": velocity,
+	})
+}
+
+// MilestoneAnalyticsExport exports the daily snapshots of a milestone as CSV
+func MilestoneAnalyticsExport(ctx *context.Context) {
+	milestone := getMilestoneForAnalytics(ctx)
+	if ctx.Written() {
+		return
+	}
+	snapshots, err := issue_service.GetMilestoneSnapshots(ctx, milestone)
+	if err != nil {
+		ctx.ServerError("GetMilestoneSnapshots", err)
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	_ = w.Write([]string{"date", "total_issues", "open_issues", "closed_issues", "open_estimate_hours", "closed_estimate_hours"})
+	for _, s := range snapshots {
+		_ = w.Write([]string{
+			s.Day.AsTime().Format("2006-01-02"),
+			strconv.Itoa(s.NumIssues),
+			strconv.Itoa(s.NumOpenIssues),
+			strconv.Itoa(s.NumClosedIssues()),
+			strconv.FormatFloat(secondsToHours(s.OpenEstimate), 'f', -1, 64),
+			strconv.FormatFloat(secondsToHours(s.ClosedEstimate), 'f', -1, 64),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		ctx.ServerError("csv.Writer", err)
+		return
+	}
+
+	ctx.ServeContent(bytes.NewReader(buf.Bytes()), &context.ServeHeaderOptions{
+		ContentType: "text/csv",
+		Filename:    fmt.Sprintf("%s-milestone-%d.csv", ctx.Repo.Repository.Name, milestone.ID),
+	})
+}
+
+// MilestoneAnalyticsBackfill computes and saves the snapshots of every day of a milestone from the history of its issues
+func MilestoneAnalyticsBackfill(ctx *context.Context) {
+	milestone := getMilestoneForAnalytics(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issue_service.BackfillMilestoneSnapshots(ctx, milestone); err != nil {
+		ctx.ServerError("BackfillMilestoneSnapshots", err)
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("repo.milestones.analytics.backfill_success"))
+	ctx.JSONRedirect(fmt.Sprintf("%s/milestone/%d/analytics", ctx.Repo.RepoLink, milestone.ID))
+}
@@ -24,6 +24,7 @@ import (
 	"code.gitea.io/gitea/modules/util"
 	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/routers/web/shared/issue"
+	shared_project "code.gitea.io/gitea/routers/web/shared/project"
 	shared_user "code.gitea.io/gitea/routers/web/shared/user"
 	"code.gitea.io/gitea/services/context"
 	"code.gitea.io/gitea/services/forms"
@@ -148,7 +149,7 @@ func NewProjectPost(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.NewProject(ctx, &project_model.Project{
+	if err := project_service.NewProject(ctx, ctx.Doer, &project_model.Project{
 		RepoID:       ctx.Repo.Repository.ID,
 		Title:        form.Title,
 		Description:  form.Content,
@@ -179,8 +180,13 @@ func ChangeProjectStatus(ctx *context.Context) {
 	}
 	id := ctx.PathParamInt64("id")
 
-	if err := project_model.ChangeProjectStatusByRepoIDAndID(ctx, ctx.Repo.Repository.ID, id, toClose); err != nil {
-		ctx.NotFoundOrServerError("ChangeProjectStatusByRepoIDAndID", project_model.IsErrProjectNotExist, err)
+	project, err := project_model.GetProjectForRepoByID(ctx, ctx.Repo.Repository.ID, id)
+	if err != nil {
+		ctx.NotFoundOrServerError("GetProjectForRepoByID", project_model.IsErrProjectNotExist, err)
+		return
+	}
+	if err := project_service.ChangeProjectStatus(ctx, ctx.Doer, project, toClose); err != nil {
+		ctx.ServerError("ChangeProjectStatus", err)
 		return
 	}
 	ctx.JSONRedirect(project_model.ProjectLinkForRepo(ctx.Repo.Repository, id))
@@ -202,7 +208,7 @@ func DeleteProject(ctx *context.Context) {
 		return
 	}
 
-	if err := project_model.DeleteProjectByID(ctx, p.ID); err != nil {
+	if err := project_service.DeleteProject(ctx, ctx.Doer, p); err != nil {
 		ctx.Flash.Error("DeleteProjectByID: " + err.Error())
 	} else {
 		ctx.Flash.Success(ctx.Tr("repo.projects.deletion_success"))
@@ -275,7 +281,7 @@ func EditProjectPost(ctx *context.Context) {
 	p.Title = form.Title
 	p.Description = form.Content
 	p.CardType = form.CardType
-	if err = project_model.UpdateProject(ctx, p); err != nil {
+	if err = project_service.UpdateProject(ctx, ctx.Doer, p); err != nil {
 		ctx.ServerError("UpdateProjects", err)
 		return
 	}
@@ -304,6 +310,11 @@ func ViewProject(ctx *context.Context) {
 		return
 	}
 
+	view := shared_project.PrepareProjectView(ctx, project)
+	if ctx.Written() {
+		return
+	}
+
 	columns, err := project.GetColumns(ctx)
 	if err != nil {
 		ctx.ServerError("GetProjectColumns", err)
@@ -314,15 +325,28 @@ func ViewProject(ctx *context.Context) {
 
 	assigneeID := ctx.FormString("assignee")
 
-	issuesMap, err := project_service.LoadIssuesFromProject(ctx, project, &issues_model.IssuesOptions{
+	opts := issues_model.IssuesOptions{
 		RepoIDs:    []int64{ctx.Repo.Repository.ID},
 		LabelIDs:   preparedLabelFilter.SelectedLabelIDs,
 		AssigneeID: assigneeID,
-	})
+	}
+	shared_project.PrepareFieldFilters(ctx, project, &opts)
+	if ctx.Written() {
+		return
+	}
+
+	issuesMap, err := project_service.LoadIssuesFromProject(ctx, project, &opts)
 	if err != nil {
 		ctx.ServerError("LoadIssuesOfColumns", err)
 		return
 	}
+	shared_project.LoadFieldValues(ctx, project, issuesMap)
+	if ctx.Written() {
+		return
+	}
+	if view != nil {
+		shared_project.PrepareViewData(ctx, view, columns, issuesMap)
+	}
 	for _, column := range columns {
 		column.NumIssues = int64(len(issuesMap[column.ID]))
 	}
@@ -446,11 +470,11 @@ func UpdateIssueProject(ctx *context.Context) {
 		if issue.Project != nil && issue.Project.ID == projectID {
 			continue
 		}
-		if err := issues_model.IssueAssignOrRemoveProject(ctx, issue, ctx.Doer, projectID, 0); err != nil {
+		if err := project_service.ChangeIssueProject(ctx, ctx.Doer, issue, projectID); err != nil {
 			if errors.Is(err, util.ErrPermissionDenied) {
 				continue
 			}
-			ctx.ServerError("IssueAssignOrRemoveProject", err)
+			ctx.ServerError("ChangeIssueProject", err)
 			return
 		}
 	}
@@ -458,6 +482,37 @@ func UpdateIssueProject(ctx *context.Context) {
 	ctx.JSONOK()
 }
 
+// UpdateIssueProjectFields changes the values of the custom fields of the project of an issue
+func UpdateIssueProjectFields(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issue.LoadProject(ctx); err != nil {
+		ctx.ServerError("LoadProject", err)
+		return
+	}
+	if issue.Project == nil {
+		ctx.NotFound(nil)
+		return
+	}
+
+	fields, err := project_model.GetFields(ctx, issue.Project.ID)
+	if err != nil {
+		ctx.ServerError("GetFields", err)
+		return
+	}
+	if err := project_service.SetIssueFieldValues(ctx, issue.Project, issue, shared_project.ParseFieldValuesForm(ctx, fields)); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("SetIssueFieldValues", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
 // DeleteProjectColumn allows for the deletion of a project column
 func DeleteProjectColumn(ctx *context.Context) {
 	if ctx.Doer == nil {
@@ -397,7 +397,13 @@ func prepareViewPullInfo(ctx *context.Context, issue *issues_model.Issue) *git_s
 		ctx.ServerError("LoadProtectedBranch", err)
 		return nil
 	}
-	ctx.Data["EnableStatusCheck"] = pb != nil && pb.EnableStatusCheck
+	requiredContexts, err := pull_service.GetRequiredStatusContexts(ctx, pull.BaseRepo, pb)
+	if err != nil {
+		ctx.ServerError("GetRequiredStatusContexts", err)
+		return nil
+	}
+	enableStatusCheck := pb != nil && (pb.EnableStatusCheck || len(requiredContexts) > 0)
+	ctx.Data["EnableStatusCheck"] = enableStatusCheck
 
 	var baseGitRepo *git.Repository
 	if pull.BaseRepoID == ctx.Repo.Repository.ID && ctx.Repo.GitRepo != nil {
@@ -529,9 +535,9 @@ func prepareViewPullInfo(ctx *context.Context, issue *issues_model.Issue) *git_s
 		ctx.Data["LatestCommitStatus"] = statusCheckData.LatestCommitStatus
 	}
 
-	if pb != nil && pb.EnableStatusCheck {
+	if enableStatusCheck {
 		var missingRequiredChecks []string
-		for _, requiredContext := range pb.StatusCheckContexts {
+		for _, requiredContext := range requiredContexts {
 			contextFound := false
 			matchesRequiredContext := createRequiredContextMatcher(requiredContext)
 			for _, presentStatus := range commitStatuses {
@@ -548,7 +554,7 @@ func prepareViewPullInfo(ctx *context.Context, issue *issues_model.Issue) *git_s
 		statusCheckData.MissingRequiredChecks = missingRequiredChecks
 
 		statusCheckData.IsContextRequired = func(context string) bool {
-			for _, c := range pb.StatusCheckContexts {
+			for _, c := range requiredContexts {
 				if c == context {
 					return true
 				}
@@ -563,7 +569,7 @@ func prepareViewPullInfo(ctx *context.Context, issue *issues_model.Issue) *git_s
 			}
 			return false
 		}
-		statusCheckData.RequiredChecksState = pull_service.MergeRequiredContextsCommitStatus(commitStatuses, pb.StatusCheckContexts)
+		statusCheckData.RequiredChecksState = pull_service.MergeRequiredContextsCommitStatus(commitStatuses, requiredContexts)
 	}
 
 	ctx.Data["HeadBranchMovedOn"] = headBranchSha != sha
@@ -843,6 +849,13 @@ func viewPullFiles(ctx *context.Context, beforeCommitID, afterCommitID string) {
 		return
 	}
 
+	if ctx.Repo.CanRead(unit.TypeActions) {
+		if err = diff.LoadAnnotations(ctx, ctx.Repo.Repository.ID, afterCommitID); err != nil {
+			ctx.ServerError("LoadAnnotations", err)
+			return
+		}
+	}
+
 	allComments := issues_model.CommentList{}
 	for _, file := range diff.Files {
 		for _, section := range file.Sections {
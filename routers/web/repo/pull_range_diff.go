@@ -0,0 +1,71 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	pull_service "code.gitea.io/gitea/services/pull"
+)
+
+const tplPullRangeDiff templates.TplName = "repo/pulls/range_diff"
+
+// ViewPullRangeDiff shows the range-diff between two versions of the commits of a pull request,
+// by default between the last commit reviewed by the signed-in user and the head of the pull request
+func ViewPullRangeDiff(ctx *context.Context) {
+	ctx.Data["PageIsPullList"] = true
+	ctx.Data["PageIsPullCommits"] = true
+
+	issue, ok := getPullInfo(ctx)
+	if !ok {
+		return
+	}
+	prInfo := preparePullViewPullInfo(ctx, issue)
+	if ctx.Written() {
+		return
+	} else if prInfo == nil {
+		ctx.NotFound(nil)
+		return
+	}
+	pr := issue.PullRequest
+
+	oldCommitID, newCommitID := ctx.FormString("old"), ctx.FormString("new")
+	if newCommitID == "" {
+		headCommitID, err := ctx.Repo.GitRepo.GetRefCommitID(pr.GetGitHeadRefName())
+		if err != nil {
+			ctx.ServerError("GetRefCommitID", err)
+			return
+		}
+		newCommitID = headCommitID
+	}
+	if oldCommitID == "" && ctx.IsSigned {
+		lastReviewedCommitID, err := issues_model.GetLastReviewedCommitID(ctx, issue.ID, ctx.Doer.ID)
+		if err != nil {
+			ctx.ServerError("GetLastReviewedCommitID", err)
+			return
+		}
+		oldCommitID = lastReviewedCommitID
+		ctx.Data["IsSinceLastReview"] = true
+	}
+
+	if oldCommitID != "" {
+		rangeDiff, err := pull_service.GetRangeDiff(ctx, pr, oldCommitID, newCommitID)
+		if err != nil {
+			if errors.Is(err, util.ErrInvalidArgument) {
+				ctx.NotFound(err)
+			} else {
+				ctx.ServerError("GetRangeDiff", err)
+			}
+			return
+		}
+		ctx.Data["RangeDiff"] = rangeDiff
+	}
+
+	ctx.HTML(http.StatusOK, tplPullRangeDiff)
+}
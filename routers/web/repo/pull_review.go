@@ -7,21 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	issues_model "code.gitea.io/gitea/models/issues"
 	"code.gitea.io/gitea/models/organization"
 	pull_model "code.gitea.io/gitea/models/pull"
 	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/base"
 	"code.gitea.io/gitea/modules/json"
 	"code.gitea.io/gitea/modules/log"
 	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
 	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/services/context"
 	"code.gitea.io/gitea/services/context/upload"
 	"code.gitea.io/gitea/services/forms"
 	issue_service "code.gitea.io/gitea/services/issue"
 	pull_service "code.gitea.io/gitea/services/pull"
+	files_service "code.gitea.io/gitea/services/repository/files"
 	user_service "code.gitea.io/gitea/services/user"
 )
 
@@ -77,9 +81,10 @@ func CreateCodeComment(ctx *context.Context) {
 		return
 	}
 
-	signedLine := form.Line
+	signedLine, signedStartLine := form.Line, form.StartLine
 	if form.Side == "previous" {
 		signedLine *= -1
+		signedStartLine *= -1
 	}
 
 	var attachments []string
@@ -91,6 +96,7 @@ func CreateCodeComment(ctx *context.Context) {
 		ctx.Doer,
 		ctx.Repo.GitRepo,
 		issue,
+		signedStartLine,
 		signedLine,
 		form.Content,
 		form.TreePath,
@@ -100,6 +106,10 @@ func CreateCodeComment(ctx *context.Context) {
 		attachments,
 	)
 	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.HTTPError(http.StatusBadRequest, err.Error())
+			return
+		}
 		ctx.ServerError("CreateCodeComment", err)
 		return
 	}
@@ -450,3 +460,58 @@ func UpdatePullReviewRequest(ctx *context.Context) {
 
 	ctx.JSONOK()
 }
+
+// ApplyCodeSuggestions commits the changes suggested by the selected code comments to the head branch of the pull request
+func ApplyCodeSuggestions(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !issue.IsPull {
+		ctx.NotFound(nil)
+		return
+	}
+
+	commentIDs, err := base.StringsToInt64s(ctx.FormStrings("comment_ids"))
+	if err != nil {
+		ctx.HTTPError(http.StatusBadRequest)
+		return
+	}
+	slices.Sort(commentIDs)
+	commentIDs = slices.Compact(commentIDs)
+	if len(commentIDs) == 0 {
+		ctx.JSONError(ctx.Tr("repo.diff.suggestion.none_selected"))
+		return
+	}
+
+	comments := make([]*issues_model.Comment, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		comment, err := issues_model.GetCommentByID(ctx, id)
+		if err != nil {
+			if issues_model.IsErrCommentNotExist(err) {
+				ctx.NotFound(err)
+			} else {
+				ctx.ServerError("GetCommentByID", err)
+			}
+			return
+		}
+		if comment.IssueID != issue.ID {
+			ctx.NotFound(errors.New("comment doesn't belong to the pull request"))
+			return
+		}
+		comments = append(comments, comment)
+	}
+
+	if _, err := files_service.ApplyCodeSuggestions(ctx, ctx.Doer, issue.PullRequest, comments, ctx.FormString("message")); err != nil {
+		switch {
+		case errors.Is(err, util.ErrPermissionDenied):
+			ctx.HTTPError(http.StatusForbidden)
+		case errors.Is(err, util.ErrInvalidArgument):
+			ctx.JSONError(ctx.Tr("repo.diff.suggestion.apply_failed", err.Error()))
+		default:
+			editorHandleFileOperationError(ctx, issue.PullRequest.HeadBranch, err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
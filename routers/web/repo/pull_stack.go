@@ -0,0 +1,83 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repo
+
+import (
+	"errors"
+	"net/http"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/context"
+	pull_service "code.gitea.io/gitea/services/pull"
+)
+
+// SetPullStackParent stacks a pull request on another pull request or unstacks it
+func SetPullStackParent(ctx *context.Context) {
+	issue := GetActionIssue(ctx)
+	if ctx.Written() {
+		return
+	}
+	if !issue.IsPull {
+		ctx.HTTPError(http.StatusNotFound)
+		return
+	}
+	if !ctx.IsSigned || (!issue.IsPoster(ctx.Doer.ID) && !ctx.Repo.CanWriteIssuesOrPulls(issue.IsPull)) {
+		ctx.HTTPError(http.StatusForbidden)
+		return
+	}
+
+	parentIndex := ctx.FormInt64("parent_index")
+	if ctx.FormBool("unstack") || parentIndex == 0 {
+		if err := pull_service.RemoveStackParent(ctx, issue.PullRequest); err != nil {
+			ctx.ServerError("RemoveStackParent", err)
+			return
+		}
+		ctx.JSONRedirect("")
+		return
+	}
+
+	parent, err := issues_model.GetPullRequestByIndex(ctx, ctx.Repo.Repository.ID, parentIndex)
+	if err != nil {
+		if issues_model.IsErrPullRequestNotExist(err) {
+			ctx.JSONError(ctx.Tr("repo.pulls.stack_parent_not_exist"))
+		} else {
+			ctx.ServerError("GetPullRequestByIndex", err)
+		}
+		return
+	}
+
+	if err := pull_service.SetStackParent(ctx, ctx.Doer, issue.PullRequest, parent, ctx.FormBool("auto_rebase")); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("SetStackParent", err)
+		}
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+func prepareIssueViewSidebarPullStack(ctx *context.Context, issue *issues_model.Issue) {
+	if !issue.IsPull {
+		return
+	}
+	stack, err := pull_service.GetStack(ctx, issue.PullRequest)
+	if err != nil {
+		ctx.ServerError("GetStack", err)
+		return
+	}
+	ctx.Data["PullStack"] = stack
+	for _, entry := range stack {
+		if entry.PullRequest.ID != issue.PullRequest.ID {
+			continue
+		}
+		for _, parent := range stack {
+			if parent.PullRequest.ID == entry.ParentPullID {
+				ctx.Data["PullStackParent"] = parent
+			}
+		}
+		ctx.Data["PullStackAutoRebase"] = entry.AutoRebase
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	actions_model "code.gitea.io/gitea/models/actions"
 	repo_model "code.gitea.io/gitea/models/repo"
 	unit_model "code.gitea.io/gitea/models/unit"
 	user_model "code.gitea.io/gitea/models/user"
@@ -44,6 +45,19 @@ func ActionsGeneralSettings(ctx *context.Context) {
 		ctx.Data["CollaborativeOwners"] = collaborativeOwners
 	}
 
+	ctx.Data["ApprovalPolicy"] = actionsUnit.ActionsConfig().GetApprovalPolicy()
+	ctx.Data["ApprovalPolicies"] = []repo_model.ActionsApprovalPolicy{
+		repo_model.ActionsApprovalFirstTimeContributors,
+		repo_model.ActionsApprovalForks,
+		repo_model.ActionsApprovalOutsideCollaborators,
+	}
+	approvedContributors, err := actions_model.GetApprovedContributors(ctx, ctx.Repo.Repository.ID)
+	if err != nil {
+		ctx.ServerError("GetApprovedContributors", err)
+		return
+	}
+	ctx.Data["ApprovedContributors"] = approvedContributors
+
 	ctx.HTML(http.StatusOK, tplRepoActionsGeneralSettings)
 }
 
@@ -119,3 +133,41 @@ func DeleteCollaborativeOwner(ctx *context.Context) {
 
 	ctx.JSONOK()
 }
+
+func ApprovalPolicyPost(ctx *context.Context) {
+	policy := repo_model.ActionsApprovalPolicy(ctx.FormString("approval_policy"))
+	if !policy.IsValid() {
+		ctx.HTTPError(http.StatusBadRequest, "invalid approval policy")
+		return
+	}
+
+	actionsUnit, err := ctx.Repo.Repository.GetUnit(ctx, unit_model.TypeActions)
+	if err != nil {
+		ctx.ServerError("GetUnit", err)
+		return
+	}
+	actionsUnit.ActionsConfig().ApprovalPolicy = policy
+	if err := repo_model.UpdateRepoUnit(ctx, actionsUnit); err != nil {
+		ctx.ServerError("UpdateRepoUnit", err)
+		return
+	}
+
+	ctx.Flash.Success(ctx.Tr("repo.settings.update_settings_success"))
+	ctx.Redirect(ctx.Repo.RepoLink + "/settings/actions/general")
+}
+
+func DeleteApprovedContributor(ctx *context.Context) {
+	userID := ctx.FormInt64("id")
+
+	if err := actions_model.DeleteApprovedContributor(ctx, ctx.Repo.Repository.ID, userID); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			ctx.Flash.Error(ctx.Tr("actions.general.approved_contributor_not_exist"))
+			ctx.JSONErrorNotFound()
+		} else {
+			ctx.ServerError("DeleteApprovedContributor", err)
+		}
+		return
+	}
+
+	ctx.JSONOK()
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/base"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+const (
+	// tplIssueSchedules template path for render the issue schedules settings
+	tplIssueSchedules templates.TplName = "repo/settings/issue_schedules"
+	// tplIssueScheduleEdit template path for render the page to add or edit an issue schedule
+	tplIssueScheduleEdit templates.TplName = "repo/settings/issue_schedule_edit"
+)
+
+// issueScheduleProjectColumns is an open project whose columns can be chosen by an issue schedule
+type issueScheduleProjectColumns struct {
+	Project *project_model.Project
+	Columns project_model.ColumnList
+}
+
+// IssueSchedules render the issue schedules of a repository
+func IssueSchedules(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("repo.settings.issue_schedules")
+	ctx.Data["PageIsSettingsIssueSchedules"] = true
+
+	schedules, err := issues_model.GetIssueSchedulesByRepoID(ctx, ctx.Repo.Repository.ID)
+	if err != nil {
+		ctx.ServerError("GetIssueSchedulesByRepoID", err)
+		return
+	}
+	ctx.Data["Schedules"] = schedules
+
+	ctx.HTML(http.StatusOK, tplIssueSchedules)
+}
+
+// prepareIssueScheduleEdit sets the templates, labels, assignees and project columns which can be chosen by a schedule
+func prepareIssueScheduleEdit(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("repo.settings.issue_schedules")
+	ctx.Data["PageIsSettingsIssueSchedules"] = true
+
+	ret := issue_service.ParseTemplatesFromDefaultBranch(ctx.Repo.Repository, ctx.Repo.GitRepo)
+	ctx.Data["IssueTemplates"] = ret.IssueTemplates
+
+	labels, err := issues_model.GetLabelsByRepoID(ctx, ctx.Repo.Repository.ID, "", db.ListOptions{})
+	if err != nil {
+		ctx.ServerError("GetLabelsByRepoID", err)
+		return
+	}
+	if ctx.Repo.Owner.IsOrganization() {
+		orgLabels, err := issues_model.GetLabelsByOrgID(ctx, ctx.Repo.Owner.ID, "", db.ListOptions{})
+		if err != nil {
+			ctx.ServerError("GetLabelsByOrgID", err)
+			return
+		}
+		labels = append(labels, orgLabels...)
+	}
+	ctx.Data["Labels"] = labels
+
+	assignees, err := repo_model.GetRepoAssignees(ctx, ctx.Repo.Repository)
+	if err != nil {
+		ctx.ServerError("GetRepoAssignees", err)
+		return
+	}
+	ctx.Data["Assignees"] = assignees
+
+	projects, err := db.Find[project_model.Project](ctx, project_model.SearchOptions{
+		ListOptions: db.ListOptionsAll,
+		RepoID:      ctx.Repo.Repository.ID,
+		IsClosed:    optional.Some(false),
+		Type:        project_model.TypeRepository,
+	})
+	if err != nil {
+		ctx.ServerError("FindProjects", err)
+		return
+	}
+	ownerProjects, err := db.Find[project_model.Project](ctx, project_model.SearchOptions{
+		ListOptions: db.ListOptionsAll,
+		OwnerID:     ctx.Repo.Owner.ID,
+		IsClosed:    optional.Some(false),
+		Type:        util.Iif(ctx.Repo.Owner.IsOrganization(), project_model.TypeOrganization, project_model.TypeIndividual),
+	})
+	if err != nil {
+		ctx.ServerError("FindProjects", err)
+		return
+	}
+	projectColumns := make([]*issueScheduleProjectColumns, 0, len(projects)+len(ownerProjects))
+	for _, p := range append(projects, ownerProjects...) {
+		columns, err := p.GetColumns(ctx)
+		if err != nil {
+			ctx.ServerError("GetColumns", err)
+			return
+		}
+		projectColumns = append(projectColumns, &issueScheduleProjectColumns{Project: p, Columns: columns})
+	}
+	ctx.Data["ProjectColumns"] = projectColumns
+}
+
+func getRepoIssueSchedule(ctx *context.Context) *issues_model.IssueSchedule {
+	s, err := issues_model.GetIssueScheduleByID(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueScheduleByID", issues_model.IsErrIssueScheduleNotExist, err)
+		return nil
+	}
+	return s
+}
+
+// NewIssueSchedule render the page to add an issue schedule
+func NewIssueSchedule(ctx *context.Context) {
+	prepareIssueScheduleEdit(ctx)
+	if ctx.Written() {
+		return
+	}
+	ctx.Data["Schedule"] = &issues_model.IssueSchedule{IsActive: true}
+	ctx.HTML(http.StatusOK, tplIssueScheduleEdit)
+}
+
+// EditIssueSchedule render the page to edit an issue schedule
+func EditIssueSchedule(ctx *context.Context) {
+	s := getRepoIssueSchedule(ctx)
+	if ctx.Written() {
+		return
+	}
+	prepareIssueScheduleEdit(ctx)
+	if ctx.Written() {
+		return
+	}
+	ctx.Data["Schedule"] = s
+	ctx.Data["LabelIDs"] = strings.Join(base.Int64sToStrings(s.LabelIDs), ",")
+	ctx.Data["AssigneeIDs"] = strings.Join(base.Int64sToStrings(s.AssigneeIDs), ",")
+	ctx.HTML(http.StatusOK, tplIssueScheduleEdit)
+}
+
+// applyIssueScheduleForm fills the schedule with the form, the labels and the project column must be usable
+// by the repository, the assignees are checked again when the issues are opened
+func applyIssueScheduleForm(ctx *context.Context, s *issues_model.IssueSchedule, form *forms.IssueScheduleForm) error {
+	repo := ctx.Repo.Repository
+	labelIDs, err := base.StringsToInt64s(util.SplitTrimSpace(form.LabelIDs, ","))
+	if err != nil {
+		return util.NewInvalidArgumentErrorf("invalid labels")
+	}
+	if len(labelIDs) > 0 {
+		labels, err := issues_model.GetLabelsByIDs(ctx, labelIDs, "id", "repo_id", "org_id")
+		if err != nil {
+			return err
+		}
+		if len(labels) != len(labelIDs) {
+			return util.NewInvalidArgumentErrorf("label does not exist")
+		}
+		for _, label := range labels {
+			if label.RepoID != repo.ID && label.OrgID != ctx.Repo.Owner.ID {
+				return util.NewInvalidArgumentErrorf("label does not exist")
+			}
+		}
+	}
+	assigneeIDs, err := base.StringsToInt64s(util.SplitTrimSpace(form.AssigneeIDs, ","))
+	if err != nil {
+		return util.NewInvalidArgumentErrorf("invalid assignees")
+	}
+	if form.ProjectColumnID > 0 {
+		column, err := project_model.GetColumn(ctx, form.ProjectColumnID)
+		if project_model.IsErrProjectColumnNotExist(err) {
+			return util.NewInvalidArgumentErrorf("project column does not exist")
+		} else if err != nil {
+			return err
+		}
+		project, err := project_model.GetProjectByID(ctx, column.ProjectID)
+		if project_model.IsErrProjectNotExist(err) || err == nil && !project.CanBeAccessedByOwnerRepo(repo.OwnerID, repo) {
+			return util.NewInvalidArgumentErrorf("project column does not exist")
+		} else if err != nil {
+			return err
+		}
+	}
+	s.Name = form.Name
+	s.TemplateFile = form.TemplateFile
+	s.Spec = form.Spec
+	s.LabelIDs = labelIDs
+	s.AssigneeIDs = assigneeIDs
+	s.ProjectColumnID = form.ProjectColumnID
+	s.ClosePrevious = form.ClosePrevious
+	s.IsActive = form.IsActive
+	return nil
+}
+
+func handleIssueScheduleError(ctx *context.Context, err error, name string) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.JSONError(err.Error())
+		return
+	}
+	ctx.ServerError(name, err)
+}
+
+// NewIssueSchedulePost adds an issue schedule to a repository, the issues are posted by the doer
+func NewIssueSchedulePost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueScheduleForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	s := &issues_model.IssueSchedule{RepoID: ctx.Repo.Repository.ID, CreatorID: ctx.Doer.ID}
+	if err := applyIssueScheduleForm(ctx, s, form); err != nil {
+		handleIssueScheduleError(ctx, err, "applyIssueScheduleForm")
+		return
+	}
+	if err := issue_service.CreateIssueSchedule(ctx, s); err != nil {
+		handleIssueScheduleError(ctx, err, "CreateIssueSchedule")
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("repo.settings.issue_schedules.saved", s.Name))
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_schedules")
+}
+
+// EditIssueSchedulePost changes an issue schedule of a repository
+func EditIssueSchedulePost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueScheduleForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	s := getRepoIssueSchedule(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := applyIssueScheduleForm(ctx, s, form); err != nil {
+		handleIssueScheduleError(ctx, err, "applyIssueScheduleForm")
+		return
+	}
+	if err := issue_service.UpdateIssueSchedule(ctx, s); err != nil {
+		handleIssueScheduleError(ctx, err, "UpdateIssueSchedule")
+		return
+	}
+	ctx.Flash.Success(ctx.Tr("repo.settings.issue_schedules.saved", s.Name))
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_schedules")
+}
+
+// RunIssueSchedulePost opens the issue of an issue schedule now, the next run is computed again
+func RunIssueSchedulePost(ctx *context.Context) {
+	s := getRepoIssueSchedule(ctx)
+	if ctx.Written() {
+		return
+	}
+	// the issue may have been opened even if the run failed later, e.g. when closing the previous issue
+	issue, err := issue_service.RunIssueSchedule(ctx, s)
+	if err != nil {
+		ctx.Flash.Error(ctx.Tr("repo.settings.issue_schedules.run_failed", err.Error()))
+	}
+	if issue == nil {
+		ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_schedules")
+		return
+	}
+	ctx.JSONRedirect(issue.Link())
+}
+
+// DeleteIssueSchedulePost removes an issue schedule of a repository
+func DeleteIssueSchedulePost(ctx *context.Context) {
+	s := getRepoIssueSchedule(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueSchedule(ctx, s.RepoID, s.ID); err != nil {
+		ctx.ServerError("DeleteIssueSchedule", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_schedules")
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package setting
+
+import (
+	"errors"
+	"net/http"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/organization"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+	sla_service "code.gitea.io/gitea/services/sla"
+)
+
+// tplIssueSLA template path for render the SLA policies settings
+const tplIssueSLA templates.TplName = "repo/settings/issue_sla"
+
+// IssueSLA render the SLA policies of a repository
+func IssueSLA(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("repo.settings.issue_sla")
+	ctx.Data["PageIsSettingsIssueSLA"] = true
+
+	policies, err := issues_model.GetIssueSLAPoliciesByRepoID(ctx, ctx.Repo.Repository.ID)
+	if err != nil {
+		ctx.ServerError("GetIssueSLAPoliciesByRepoID", err)
+		return
+	}
+	if err := policies.LoadAttributes(ctx); err != nil {
+		ctx.ServerError("LoadAttributes", err)
+		return
+	}
+	ctx.Data["Policies"] = policies
+
+	labels, err := issues_model.GetLabelsByRepoID(ctx, ctx.Repo.Repository.ID, "", db.ListOptions{})
+	if err != nil {
+		ctx.ServerError("GetLabelsByRepoID", err)
+		return
+	}
+	if ctx.Repo.Owner.IsOrganization() {
+		orgLabels, err := issues_model.GetLabelsByOrgID(ctx, ctx.Repo.Owner.ID, "", db.ListOptions{})
+		if err != nil {
+			ctx.ServerError("GetLabelsByOrgID", err)
+			return
+		}
+		labels = append(labels, orgLabels...)
+
+		teams, err := organization.FindOrgTeams(ctx, ctx.Repo.Owner.ID)
+		if err != nil {
+			ctx.ServerError("FindOrgTeams", err)
+			return
+		}
+		teamNames := make(map[int64]string, len(teams))
+		for _, team := range teams {
+			teamNames[team.ID] = team.Name
+		}
+		ctx.Data["Teams"] = teams
+		ctx.Data["TeamNames"] = teamNames
+	}
+	ctx.Data["Labels"] = labels
+
+	types, err := issues_model.GetIssueTypesByOwnerID(ctx, ctx.Repo.Owner.ID)
+	if err != nil {
+		ctx.ServerError("GetIssueTypesByOwnerID", err)
+		return
+	}
+	ctx.Data["IssueTypes"] = types
+	ctx.Data["BusinessHours"] = setting.IssueSLA.BusinessHours
+	ctx.Data["BusinessDays"] = setting.IssueSLA.BusinessDays
+	ctx.Data["BusinessTimezone"] = setting.IssueSLA.Location.String()
+
+	ctx.HTML(http.StatusOK, tplIssueSLA)
+}
+
+func getRepoIssueSLAPolicy(ctx *context.Context) *issues_model.IssueSLAPolicy {
+	p, err := issues_model.GetIssueSLAPolicyByID(ctx, ctx.Repo.Repository.ID, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueSLAPolicyByID", issues_model.IsErrIssueSLAPolicyNotExist, err)
+		return nil
+	}
+	return p
+}
+
+// applyIssueSLAPolicyForm fills the policy with the form, the label, the type and the team must belong
+// to the repository or to its owner
+func applyIssueSLAPolicyForm(ctx *context.Context, p *issues_model.IssueSLAPolicy, form *forms.IssueSLAPolicyForm) error {
+	repo := ctx.Repo.Repository
+	if form.LabelID > 0 {
+		label, err := issues_model.GetLabelByID(ctx, form.LabelID)
+		if issues_model.IsErrLabelNotExist(err) || err == nil && label.RepoID != repo.ID && label.OrgID != ctx.Repo.Owner.ID {
+			return util.NewInvalidArgumentErrorf("label does not exist")
+		} else if err != nil {
+			return err
+		}
+	}
+	if form.TypeID > 0 {
+		if _, err := issues_model.GetIssueTypeByID(ctx, ctx.Repo.Owner.ID, form.TypeID); issues_model.IsErrIssueTypeNotExist(err) {
+			return util.NewInvalidArgumentErrorf("issue type does not exist")
+		} else if err != nil {
+			return err
+		}
+	}
+	if form.EscalationTeamID > 0 {
+		team, err := organization.GetTeamByID(ctx, form.EscalationTeamID)
+		if organization.IsErrTeamNotExist(err) || err == nil && team.OrgID != ctx.Repo.Owner.ID {
+			return util.NewInvalidArgumentErrorf("team does not exist")
+		} else if err != nil {
+			return err
+		}
+	}
+	p.Name = form.Name
+	p.LabelID = form.LabelID
+	p.TypeID = form.TypeID
+	p.FirstResponseHours = form.FirstResponseHours
+	p.ResolutionHours = form.ResolutionHours
+	p.EscalateToAssignees = form.EscalateToAssignees
+	p.EscalationTeamID = form.EscalationTeamID
+	return nil
+}
+
+func handleIssueSLAPolicyError(ctx *context.Context, err error, name string) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.JSONError(err.Error())
+		return
+	}
+	ctx.ServerError(name, err)
+}
+
+// NewIssueSLAPolicyPost adds an SLA policy to a repository and applies it to the issues
+func NewIssueSLAPolicyPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueSLAPolicyForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	p := &issues_model.IssueSLAPolicy{RepoID: ctx.Repo.Repository.ID}
+	if err := applyIssueSLAPolicyForm(ctx, p, form); err != nil {
+		handleIssueSLAPolicyError(ctx, err, "applyIssueSLAPolicyForm")
+		return
+	}
+	if err := issues_model.NewIssueSLAPolicy(ctx, p); err != nil {
+		handleIssueSLAPolicyError(ctx, err, "NewIssueSLAPolicy")
+		return
+	}
+	if err := sla_service.RefreshRepoIssueSLAs(ctx, ctx.Repo.Repository); err != nil {
+		ctx.ServerError("RefreshRepoIssueSLAs", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_sla")
+}
+
+// EditIssueSLAPolicyPost changes an SLA policy of a repository and applies it to the issues again
+func EditIssueSLAPolicyPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueSLAPolicyForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	p := getRepoIssueSLAPolicy(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := applyIssueSLAPolicyForm(ctx, p, form); err != nil {
+		handleIssueSLAPolicyError(ctx, err, "applyIssueSLAPolicyForm")
+		return
+	}
+	if err := issues_model.UpdateIssueSLAPolicy(ctx, p); err != nil {
+		handleIssueSLAPolicyError(ctx, err, "UpdateIssueSLAPolicy")
+		return
+	}
+	if err := sla_service.RefreshRepoIssueSLAs(ctx, ctx.Repo.Repository); err != nil {
+		ctx.ServerError("RefreshRepoIssueSLAs", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_sla")
+}
+
+// DeleteIssueSLAPolicyPost removes an SLA policy of a repository, the issues it applied to may get another policy
+func DeleteIssueSLAPolicyPost(ctx *context.Context) {
+	p := getRepoIssueSLAPolicy(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueSLAPolicy(ctx, p); err != nil {
+		ctx.ServerError("DeleteIssueSLAPolicy", err)
+		return
+	}
+	if err := sla_service.RefreshRepoIssueSLAs(ctx, ctx.Repo.Repository); err != nil {
+		ctx.ServerError("RefreshRepoIssueSLAs", err)
+		return
+	}
+	ctx.JSONRedirect(ctx.Repo.RepoLink + "/settings/issue_sla")
+}
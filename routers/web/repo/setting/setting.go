@@ -577,6 +577,7 @@ func handleSettingsPostAdvanced(ctx *context.Context) {
 			EnableTimetracker:                form.EnableTimetracker,
 			AllowOnlyContributorsToTrackTime: form.AllowOnlyContributorsToTrackTime,
 			EnableDependencies:               form.EnableIssueDependencies,
+			CloseParentWhenSubIssuesClosed:   form.CloseParentWhenSubIssuesClosed,
 		}))
 		deleteUnitTypes = append(deleteUnitTypes, unit_model.TypeExternalTracker)
 	} else {
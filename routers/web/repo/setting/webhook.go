@@ -187,6 +187,8 @@ func ParseHookEvent(form forms.WebhookForm) *webhook_module.HookEvent {
 			webhook_module.HookEventStatus:                   form.Status,
 			webhook_module.HookEventWorkflowRun:              form.WorkflowRun,
 			webhook_module.HookEventWorkflowJob:              form.WorkflowJob,
+			webhook_module.HookEventProject:                  form.Project,
+			webhook_module.HookEventProjectCard:              form.ProjectCard,
 		},
 		BranchFilter: form.BranchFilter,
 	}
@@ -0,0 +1,129 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/modules/templates"
+	shared_user "code.gitea.io/gitea/routers/web/shared/user"
+	actions_service "code.gitea.io/gitea/services/actions"
+	"code.gitea.io/gitea/services/context"
+)
+
+const (
+	tplRepoInsights templates.TplName = "repo/settings/actions"
+	tplOrgInsights  templates.TplName = "org/settings/actions"
+)
+
+// insightsPeriods are the numbers of days which can be chosen as the period of the insights
+var insightsPeriods = []int{7, 30, 90}
+
+type insightsCtx struct {
+	OwnerID          int64
+	RepoID           int64
+	InsightsTemplate templates.TplName
+	Link             string
+}
+
+func getInsightsCtx(ctx *context.Context) (*insightsCtx, error) {
+	if ctx.Data["PageIsRepoSettings"] == true {
+		return &insightsCtx{
+			RepoID:           ctx.Repo.Repository.ID,
+			InsightsTemplate: tplRepoInsights,
+			Link:             ctx.Repo.RepoLink + "/settings/actions/insights",
+		}, nil
+	}
+
+	if ctx.Data["PageIsOrgSettings"] == true {
+		if _, err := shared_user.RenderUserOrgHeader(ctx); err != nil {
+			ctx.ServerError("RenderUserOrgHeader", err)
+			return nil, nil
+		}
+		return &insightsCtx{
+			OwnerID:          ctx.Org.Organization.ID,
+			InsightsTemplate: tplOrgInsights,
+			Link:             ctx.Org.OrgLink + "/settings/actions/insights",
+		}, nil
+	}
+
+	return nil, errors.New("unable to set Insights context")
+}
+
+func getInsights(ctx *context.Context, iCtx *insightsCtx) (*actions_service.Insights, bool) {
+	days := ctx.FormInt("days")
+	if !slices.Contains(insightsPeriods, days) {
+		days = insightsPeriods[1]
+	}
+	insights, err := actions_service.GetInsights(ctx, actions_model.FindStatsOptions{
+		RepoID:     iCtx.RepoID,
+		OwnerID:    iCtx.OwnerID,
+		WorkflowID: ctx.FormString("workflow"),
+	}, days)
+	if err != nil {
+		ctx.ServerError("GetInsights", err)
+		return nil, false
+	}
+	return insights, true
+}
+
+// Insights renders the timing analytics of the actions jobs
+func Insights(ctx *context.Context) {
+	ctx.Data["Title"] = ctx.Tr("actions.insights")
+	ctx.Data["PageType"] = "insights"
+	ctx.Data["PageIsSharedSettingsInsights"] = true
+
+	iCtx, err := getInsightsCtx(ctx)
+	if err != nil {
+		ctx.ServerError("getInsightsCtx", err)
+		return
+	}
+	if ctx.Written() {
+		return
+	}
+
+	insights, ok := getInsights(ctx, iCtx)
+	if !ok {
+		return
+	}
+
+	curWorkflow := ctx.FormString("workflow")
+	query := url.Values{}
+	query.Set("days", ctx.FormString("days"))
+	if curWorkflow != "" {
+		query.Set("workflow", curWorkflow)
+	}
+
+	ctx.Data["Insights"] = insights
+	ctx.Data["InsightsPeriods"] = insightsPeriods
+	ctx.Data["CurWorkflow"] = curWorkflow
+	ctx.Data["InsightsLink"] = iCtx.Link
+	ctx.Data["InsightsDataLink"] = iCtx.Link + "/data?" + query.Encode()
+	ctx.Data["ShowRepoColumn"] = iCtx.RepoID == 0
+	ctx.HTML(http.StatusOK, iCtx.InsightsTemplate)
+}
+
+// InsightsData returns the daily trends of the actions jobs for the charts of the insights
+func InsightsData(ctx *context.Context) {
+	iCtx, err := getInsightsCtx(ctx)
+	if err != nil {
+		ctx.ServerError("getInsightsCtx", err)
+		return
+	}
+	if ctx.Written() {
+		return
+	}
+
+	insights, ok := getInsights(ctx, iCtx)
+	if !ok {
+		return
+	}
+	ctx.JSON(http.StatusOK, map[string]any{
+		"daily": insights.Daily,
+	})
+}
@@ -159,6 +159,24 @@ func Runners(ctx *context.Context) {
 	ctx.Data["RunnerRepoID"] = opts.RepoID
 	ctx.Data["SortType"] = opts.Sort
 
+	if rCtx.IsAdmin && setting.Actions.Scaler.Enabled {
+		decisions, err := actions_model.GetLatestScaleDecisions(ctx, 20)
+		if err != nil {
+			ctx.ServerError("GetLatestScaleDecisions", err)
+			return
+		}
+		scaledRunners, err := actions_model.GetScaledRunners(ctx)
+		if err != nil {
+			ctx.ServerError("GetScaledRunners", err)
+			return
+		}
+		ctx.Data["ScalerEnabled"] = true
+		ctx.Data["ScalerProvider"] = setting.Actions.Scaler.Provider
+		ctx.Data["ScalerMaxRunners"] = setting.Actions.Scaler.MaxRunners
+		ctx.Data["ScaledRunnerCount"] = len(scaledRunners)
+		ctx.Data["ScaleDecisions"] = decisions
+	}
+
 	pager := context.NewPagination(int(count), opts.PageSize, opts.Page, 5)
 
 	ctx.Data["Page"] = pager
@@ -0,0 +1,266 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	"code.gitea.io/gitea/modules/templates"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	shared_user "code.gitea.io/gitea/routers/web/shared/user"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+	project_service "code.gitea.io/gitea/services/projects"
+)
+
+const (
+	tplRepoProjectFields templates.TplName = "repo/projects/fields"
+	tplOrgProjectFields  templates.TplName = "org/projects/fields"
+)
+
+func getProject(ctx *context.Context) *project_model.Project {
+	project, err := project_model.GetProjectByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetProjectByID", project_model.IsErrProjectNotExist, err)
+		return nil
+	}
+	if !project.CanBeAccessedByOwnerRepo(ctx.ContextUser.ID, ctx.Repo.Repository) {
+		ctx.NotFound(nil)
+		return nil
+	}
+	return project
+}
+
+func getProjectField(ctx *context.Context) (*project_model.Project, *project_model.Field) {
+	project := getProject(ctx)
+	if project == nil {
+		return nil, nil
+	}
+	field, err := project_model.GetFieldByID(ctx, project.ID, ctx.PathParamInt64("fieldID"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetFieldByID", project_model.IsErrProjectFieldNotExist, err)
+		return nil, nil
+	}
+	return project, field
+}
+
+// Fields renders the custom fields of a project
+func Fields(ctx *context.Context) {
+	project := getProject(ctx)
+	if project == nil {
+		return
+	}
+	fields, err := project_model.GetFields(ctx, project.ID)
+	if err != nil {
+		ctx.ServerError("GetFields", err)
+		return
+	}
+
+	ctx.Data["Title"] = project.Title
+	ctx.Data["Project"] = project
+	ctx.Data["ProjectFields"] = fields
+	ctx.Data["ProjectFieldTypes"] = []project_model.FieldType{
+		project_model.FieldTypeText,
+		project_model.FieldTypeNumber,
+		project_model.FieldTypeDate,
+		project_model.FieldTypeSingleSelect,
+		project_model.FieldTypeIteration,
+	}
+
+	if ctx.Repo.Repository != nil {
+		ctx.Data["IsProjectsPage"] = true
+		ctx.HTML(http.StatusOK, tplRepoProjectFields)
+		return
+	}
+	ctx.Data["PageIsViewProjects"] = true
+	if _, err := shared_user.RenderUserOrgHeader(ctx); err != nil {
+		ctx.ServerError("RenderUserOrgHeader", err)
+		return
+	}
+	ctx.HTML(http.StatusOK, tplOrgProjectFields)
+}
+
+// parseFieldOptions parses the options of a single select field, one option per line with an optional color,
+// the options which keep their names keep their IDs so the values referring to them are kept
+func parseFieldOptions(text string, oldOptions []*project_model.FieldOption) []*project_model.FieldOption {
+	var options []*project_model.FieldOption
+	for line := range strings.SplitSeq(text, "\n") {
+		name, color := strings.TrimSpace(line), ""
+		if pos := strings.LastIndex(name, " #"); pos > 0 && project_model.ColumnColorPattern.MatchString(name[pos+1:]) {
+			name, color = strings.TrimSpace(name[:pos]), name[pos+1:]
+		}
+		if name == "" {
+			continue
+		}
+		option := &project_model.FieldOption{Name: name, Color: color}
+		for _, oldOption := range oldOptions {
+			if oldOption.Name == name {
+				option.ID = oldOption.ID
+				break
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+func handleFormError(ctx *context.Context, err error, name string) {
+	if errors.Is(err, util.ErrInvalidArgument) {
+		ctx.JSONError(err.Error())
+		return
+	}
+	ctx.ServerError(name, err)
+}
+
+// NewFieldPost adds a custom field to a project
+func NewFieldPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditProjectFieldForm)
+	project := getProject(ctx)
+	if project == nil {
+		return
+	}
+
+	field := &project_model.Field{
+		ProjectID:          project.ID,
+		Name:               form.Name,
+		Type:               project_model.ParseFieldType(form.Type),
+		Options:            parseFieldOptions(form.Options, nil),
+		IterationStartDate: form.IterationStartDate,
+		IterationDuration:  form.IterationDuration,
+	}
+	if err := project_model.NewField(ctx, field); err != nil {
+		handleFormError(ctx, err, "NewField")
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx) + "/fields")
+}
+
+// EditFieldPost changes a custom field of a project
+func EditFieldPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditProjectFieldForm)
+	project, field := getProjectField(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	field.Name = form.Name
+	field.Options = parseFieldOptions(form.Options, field.Options)
+	field.IterationStartDate = form.IterationStartDate
+	field.IterationDuration = form.IterationDuration
+	if err := project_model.UpdateField(ctx, field); err != nil {
+		handleFormError(ctx, err, "UpdateField")
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx) + "/fields")
+}
+
+// DeleteFieldPost removes a custom field and its values from a project
+func DeleteFieldPost(ctx *context.Context) {
+	project, field := getProjectField(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := project_model.DeleteField(ctx, field); err != nil {
+		ctx.ServerError("DeleteField", err)
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx) + "/fields")
+}
+
+// ParseFieldValuesForm returns the values of the custom fields posted as "field_{id}" form values by field ID
+func ParseFieldValuesForm(ctx *context.Context, fields project_model.FieldList) map[int64]string {
+	values := make(map[int64]string, len(fields))
+	for _, field := range fields {
+		key := fmt.Sprintf("field_%d", field.ID)
+		if _, ok := ctx.Req.Form[key]; ok {
+			values[field.ID] = ctx.Req.Form.Get(key)
+		}
+	}
+	return values
+}
+
+// UpdateIssueFieldValues changes the values of the custom fields of a project for an issue on the board
+func UpdateIssueFieldValues(ctx *context.Context) {
+	project := getProject(ctx)
+	if project == nil {
+		return
+	}
+	issue, err := issues_model.GetIssueByID(ctx, ctx.PathParamInt64("issueID"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueByID", issues_model.IsErrIssueNotExist, err)
+		return
+	}
+	fields, err := project_model.GetFields(ctx, project.ID)
+	if err != nil {
+		ctx.ServerError("GetFields", err)
+		return
+	}
+
+	if err := project_service.SetIssueFieldValues(ctx, project, issue, ParseFieldValuesForm(ctx, fields)); err != nil {
+		handleFormError(ctx, err, "SetIssueFieldValues")
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// PrepareFieldFilters loads the custom fields of a project for the board and applies the "field_{id}" filters
+// of the single select and iteration fields to the issue search options
+func PrepareFieldFilters(ctx *context.Context, project *project_model.Project, opts *issues_model.IssuesOptions) {
+	fields, err := project_model.GetFields(ctx, project.ID)
+	if err != nil {
+		ctx.ServerError("GetFields", err)
+		return
+	}
+
+	query := url.Values{}
+	selectedValues := make(map[int64]string)
+	for _, field := range fields {
+		if field.Type != project_model.FieldTypeSingleSelect && field.Type != project_model.FieldTypeIteration {
+			continue
+		}
+		key := fmt.Sprintf("field_%d", field.ID)
+		value := ctx.FormString(key)
+		if value == "" {
+			continue
+		}
+		if value != "(none)" {
+			if _, err := field.NormalizeValue(value); err != nil {
+				continue
+			}
+		}
+		if opts.ProjectFieldValues == nil {
+			opts.ProjectFieldValues = make(map[int64]string)
+		}
+		opts.ProjectFieldValues[field.ID] = value
+		selectedValues[field.ID] = value
+		query.Set(key, value)
+	}
+
+	ctx.Data["ProjectFields"] = fields
+	ctx.Data["SelectedFieldValues"] = selectedValues
+	ctx.Data["FieldFilterQuery"] = "?" + query.Encode()
+}
+
+// LoadFieldValues loads the values of the custom fields of a project for the issues on the board
+func LoadFieldValues(ctx *context.Context, project *project_model.Project, issuesMap map[int64]issues_model.IssueList) {
+	var issueIDs []int64
+	for _, issues := range issuesMap {
+		for _, issue := range issues {
+			issueIDs = append(issueIDs, issue.ID)
+		}
+	}
+	values, err := project_model.GetFieldValues(ctx, project.ID, issueIDs)
+	if err != nil {
+		ctx.ServerError("GetFieldValues", err)
+		return
+	}
+	ctx.Data["ProjectFieldValues"] = values
+}
@@ -0,0 +1,405 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+)
+
+// ViewIssue is an issue shown in a table or roadmap view with the column of the board it is in
+type ViewIssue struct {
+	Issue  *issues_model.Issue
+	Column *project_model.Column
+
+	// the bar of the issue on the roadmap, in percent of the width of the timeline
+	Start timeutil.TimeStamp
+	End   timeutil.TimeStamp
+	Left  float64
+	Width float64
+}
+
+// ViewGroup is a group of the issues of a view, an issue can be in several groups when it has several labels or assignees
+type ViewGroup struct {
+	Title  string
+	Color  string
+	Issues []*ViewIssue
+}
+
+// RoadmapMonth is the start of a month on the timeline of a roadmap view
+type RoadmapMonth struct {
+	Title string
+	Left  float64
+}
+
+// isViewFilterKey checks if a query parameter of the board is a filter which is saved in a view
+func isViewFilterKey(key string) bool {
+	switch key {
+	case "labels", "assignee", "archived_labels":
+		return true
+	}
+	return strings.HasPrefix(key, "field_")
+}
+
+// parseViewQuery splits the query string of a view into the saved filter, the sort and the grouping
+func parseViewQuery(query string) (filter, sortType, groupBy string) {
+	values, _ := url.ParseQuery(query)
+	filterValues := url.Values{}
+	for key, value := range values {
+		if isViewFilterKey(key) && len(value) > 0 && value[0] != "" {
+			filterValues[key] = value
+		}
+	}
+	return filterValues.Encode(), values.Get("sort"), values.Get("group")
+}
+
+func getProjectView(ctx *context.Context) (*project_model.Project, *project_model.View) {
+	project := getProject(ctx)
+	if project == nil {
+		return nil, nil
+	}
+	view, err := project_model.GetViewByID(ctx, project.ID, ctx.PathParamInt64("viewID"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetViewByID", project_model.IsErrProjectViewNotExist, err)
+		return nil, nil
+	}
+	return project, view
+}
+
+func viewLink(ctx *context.Context, project *project_model.Project, view *project_model.View) string {
+	return fmt.Sprintf("%s/views/%d", project.Link(ctx), view.ID)
+}
+
+// NewViewPost adds a view to a project with the filters of the board
+func NewViewPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditProjectViewForm)
+	project := getProject(ctx)
+	if project == nil {
+		return
+	}
+
+	filter, _, _ := parseViewQuery(form.Query)
+	view := &project_model.View{
+		ProjectID: project.ID,
+		Name:      form.Name,
+		Type:      project_model.ParseViewType(form.Type),
+		Filter:    filter,
+		CreatorID: ctx.Doer.ID,
+	}
+	if err := project_model.NewView(ctx, view); err != nil {
+		handleFormError(ctx, err, "NewView")
+		return
+	}
+	ctx.JSONRedirect(viewLink(ctx, project, view))
+}
+
+// EditViewPost renames a view and saves its current filters, sort and grouping
+func EditViewPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.EditProjectViewForm)
+	project, view := getProjectView(ctx)
+	if ctx.Written() {
+		return
+	}
+
+	view.Name = form.Name
+	view.Filter, view.SortType, view.GroupBy = parseViewQuery(form.Query)
+	if view.SortType == "column" {
+		view.SortType = ""
+	}
+	if err := project_model.UpdateView(ctx, view); err != nil {
+		handleFormError(ctx, err, "UpdateView")
+		return
+	}
+	ctx.JSONRedirect(viewLink(ctx, project, view) + "?" + view.Query())
+}
+
+// DeleteViewPost removes a view from a project
+func DeleteViewPost(ctx *context.Context) {
+	project, view := getProjectView(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := project_model.DeleteView(ctx, view); err != nil {
+		ctx.ServerError("DeleteView", err)
+		return
+	}
+	ctx.JSONRedirect(project.Link(ctx))
+}
+
+// PrepareProjectView loads the views of a project and returns the view requested by the "viewID" path parameter,
+// nil is returned for the board. A view requested without query string is redirected to its saved filters.
+func PrepareProjectView(ctx *context.Context, project *project_model.Project) *project_model.View {
+	views, err := project_model.GetViews(ctx, project.ID)
+	if err != nil {
+		ctx.ServerError("GetViews", err)
+		return nil
+	}
+	ctx.Data["ProjectViews"] = views
+	ctx.Data["ViewQuery"] = ctx.Req.URL.RawQuery
+
+	viewID := ctx.PathParamInt64("viewID")
+	if viewID == 0 {
+		return nil
+	}
+	var view *project_model.View
+	for _, v := range views {
+		if v.ID == viewID {
+			view = v
+			break
+		}
+	}
+	if view == nil {
+		ctx.NotFound(nil)
+		return nil
+	}
+
+	link := viewLink(ctx, project, view)
+	if ctx.Req.URL.RawQuery == "" {
+		if query := view.Query(); query != "" {
+			ctx.Redirect(link + "?" + query)
+			return nil
+		}
+	}
+
+	sortType := ctx.FormString("sort")
+	if !project_model.IsValidViewSortType(sortType) {
+		sortType = "column"
+	}
+	groupBy := ctx.FormString("group")
+	if !slices.Contains(project_model.ViewGroupBys, groupBy) {
+		groupBy = ""
+	}
+
+	// the links of the board, like the ones to move issues or to edit the project, are relative to the project
+	ctx.Data["Link"] = project.Link(ctx)
+	ctx.Data["ProjectView"] = view
+	ctx.Data["ViewLink"] = link
+	ctx.Data["ViewSortType"] = sortType
+	ctx.Data["ViewGroupBy"] = groupBy
+	ctx.Data["ViewSortTypes"] = project_model.ViewSortTypes
+	ctx.Data["ViewGroupBys"] = project_model.ViewGroupBys
+	return view
+}
+
+// PrepareViewData lays out the issues of the board for a table or a roadmap view
+func PrepareViewData(ctx *context.Context, view *project_model.View, columns project_model.ColumnList, issuesMap map[int64]issues_model.IssueList) {
+	var issues []*ViewIssue
+	for _, column := range columns {
+		for _, issue := range issuesMap[column.ID] {
+			issues = append(issues, &ViewIssue{Issue: issue, Column: column})
+		}
+	}
+
+	sortType := ctx.Data["ViewSortType"].(string)
+	sortViewIssues(issues, strings.TrimPrefix(sortType, "-"), strings.HasPrefix(sortType, "-"))
+	ctx.Data["ViewIssueCount"] = len(issues)
+
+	if view.Type == project_model.ViewTypeRoadmap {
+		var undated []*ViewIssue
+		issues, undated = layoutRoadmap(ctx, issues)
+		ctx.Data["RoadmapUndatedIssues"] = undated
+	}
+
+	ctx.Data["ViewGroups"] = groupViewIssues(ctx, issues, columns, ctx.Data["ViewGroupBy"].(string))
+}
+
+func firstAssigneeName(issue *issues_model.Issue) string {
+	if len(issue.Assignees) == 0 {
+		return ""
+	}
+	return strings.ToLower(issue.Assignees[0].Name)
+}
+
+func milestoneName(issue *issues_model.Issue) string {
+	if issue.Milestone == nil {
+		return ""
+	}
+	return strings.ToLower(issue.Milestone.Name)
+}
+
+// sortViewIssues sorts the issues of a view, the issues without the sorted attribute are always last
+func sortViewIssues(issues []*ViewIssue, sortType string, desc bool) {
+	var compare func(a, b *issues_model.Issue) int
+	missing := func(issue *issues_model.Issue) bool { return false }
+	switch sortType {
+	case "title":
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		}
+	case "assignee":
+		missing = func(issue *issues_model.Issue) bool { return len(issue.Assignees) == 0 }
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(firstAssigneeName(a), firstAssigneeName(b))
+		}
+	case "milestone":
+		missing = func(issue *issues_model.Issue) bool { return issue.Milestone == nil }
+		compare = func(a, b *issues_model.Issue) int {
+			return cmp.Compare(milestoneName(a), milestoneName(b))
+		}
+	case "deadline":
+		missing = func(issue *issues_model.Issue) bool { return issue.DeadlineUnix == 0 }
+		compare = func(a, b *issues_model.Issue) int { return cmp.Compare(a.DeadlineUnix, b.DeadlineUnix) }
+	case "created":
+		compare = func(a, b *issues_model.Issue) int { return cmp.Compare(a.CreatedUnix, b.CreatedUnix) }
+	case "updated":
+		compare = func(a, b *issues_model.Issue) int { return cmp.Compare(a.UpdatedUnix, b.UpdatedUnix) }
+	default:
+		// the issues are already in the order of the board
+		if desc {
+			slices.Reverse(issues)
+		}
+		return
+	}
+
+	slices.SortStableFunc(issues, func(a, b *ViewIssue) int {
+		missingA, missingB := missing(a.Issue), missing(b.Issue)
+		switch {
+		case missingA && missingB:
+			return 0
+		case missingA:
+			return 1
+		case missingB:
+			return -1
+		case desc:
+			return compare(b.Issue, a.Issue)
+		}
+		return compare(a.Issue, b.Issue)
+	})
+}
+
+// groupViewIssues groups the sorted issues of a view, the groups are in the order of their first issue
+// except for the columns which keep the order of the board, the issues without value are in the last group
+func groupViewIssues(ctx *context.Context, issues []*ViewIssue, columns project_model.ColumnList, groupBy string) []*ViewGroup {
+	if groupBy == "" {
+		return []*ViewGroup{{Issues: issues}}
+	}
+
+	var groups []*ViewGroup
+	groupsByKey := make(map[string]*ViewGroup)
+	addToGroup := func(key, title, color string, issue *ViewIssue) {
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &ViewGroup{Title: title, Color: color}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+		group.Issues = append(group.Issues, issue)
+	}
+
+	if groupBy == "column" {
+		for _, column := range columns {
+			group := &ViewGroup{Title: column.Title, Color: column.Color}
+			groupsByKey[fmt.Sprint(column.ID)] = group
+			groups = append(groups, group)
+		}
+	}
+
+	noValueGroup := &ViewGroup{Title: ctx.Locale.TrString("projects.views.no_value")}
+	for _, issue := range issues {
+		switch groupBy {
+		case "column":
+			addToGroup(fmt.Sprint(issue.Column.ID), issue.Column.Title, issue.Column.Color, issue)
+		case "milestone":
+			if issue.Issue.Milestone == nil {
+				noValueGroup.Issues = append(noValueGroup.Issues, issue)
+				continue
+			}
+			addToGroup(fmt.Sprint(issue.Issue.Milestone.ID), issue.Issue.Milestone.Name, "", issue)
+		case "assignee":
+			if len(issue.Issue.Assignees) == 0 {
+				noValueGroup.Issues = append(noValueGroup.Issues, issue)
+				continue
+			}
+			for _, assignee := range issue.Issue.Assignees {
+				addToGroup(fmt.Sprint(assignee.ID), assignee.GetDisplayName(), "", issue)
+			}
+		case "label":
+			if len(issue.Issue.Labels) == 0 {
+				noValueGroup.Issues = append(noValueGroup.Issues, issue)
+				continue
+			}
+			for _, label := range issue.Issue.Labels {
+				addToGroup(fmt.Sprint(label.ID), label.Name, label.Color, issue)
+			}
+		}
+	}
+
+	groups = slices.DeleteFunc(groups, func(group *ViewGroup) bool { return len(group.Issues) == 0 })
+	if len(noValueGroup.Issues) > 0 {
+		groups = append(groups, noValueGroup)
+	}
+	return groups
+}
+
+// roadmapIssueDates returns the dates of the bar of an issue on the roadmap: from its creation to its deadline
+// or to the deadline of its milestone. The end is 0 when the issue has no deadline.
+func roadmapIssueDates(issue *issues_model.Issue) (start, end timeutil.TimeStamp) {
+	start, end = issue.CreatedUnix, issue.DeadlineUnix
+	// 253370764800 is 01/01/9999 @ 12:00am (UTC), the deadline of the migrated milestones without due date
+	if end == 0 && issue.Milestone != nil && issue.Milestone.DeadlineUnix < 253370764800 {
+		end = issue.Milestone.DeadlineUnix
+	}
+	if end != 0 && start > end {
+		start = end
+	}
+	return start, end
+}
+
+// layoutRoadmap places the issues with a deadline on a timeline spanning whole months and returns the issues without deadline
+func layoutRoadmap(ctx *context.Context, issues []*ViewIssue) (dated, undated []*ViewIssue) {
+	var minStart, maxEnd timeutil.TimeStamp
+	for _, issue := range issues {
+		issue.Start, issue.End = roadmapIssueDates(issue.Issue)
+		if issue.End == 0 {
+			undated = append(undated, issue)
+			continue
+		}
+		dated = append(dated, issue)
+		if minStart == 0 || issue.Start < minStart {
+			minStart = issue.Start
+		}
+		if issue.End > maxEnd {
+			maxEnd = issue.End
+		}
+	}
+	if len(dated) == 0 {
+		return dated, undated
+	}
+
+	startTime := minStart.AsTime()
+	from := time.Date(startTime.Year(), startTime.Month(), 1, 0, 0, 0, 0, startTime.Location())
+	endTime := maxEnd.AsTime()
+	to := time.Date(endTime.Year(), endTime.Month()+1, 1, 0, 0, 0, 0, endTime.Location())
+	span := float64(to.Unix() - from.Unix())
+	percent := func(unix int64) float64 {
+		return float64(unix-from.Unix()) * 100 / span
+	}
+
+	var months []*RoadmapMonth
+	for month := from; month.Before(to); month = month.AddDate(0, 1, 0) {
+		months = append(months, &RoadmapMonth{
+			Title: month.Format("Jan 2006"),
+			Left:  percent(month.Unix()),
+		})
+	}
+	ctx.Data["RoadmapMonths"] = months
+
+	for _, issue := range dated {
+		issue.Left = percent(int64(issue.Start))
+		// a bar is at least one day wide so that the issues due on the day they are created are visible
+		issue.Width = max(percent(int64(issue.End))-issue.Left, percent(from.Unix()+86400))
+	}
+	return dated, undated
+}
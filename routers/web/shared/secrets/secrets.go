@@ -4,9 +4,12 @@
 package secrets
 
 import (
+	"errors"
+
 	"code.gitea.io/gitea/models/db"
 	secret_model "code.gitea.io/gitea/models/secret"
 	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/modules/util"
 	"code.gitea.io/gitea/modules/web"
 	"code.gitea.io/gitea/services/context"
@@ -24,6 +27,20 @@ func SetSecretsContext(ctx *context.Context, ownerID, repoID int64) {
 	ctx.Data["Secrets"] = secrets
 	ctx.Data["DataMaxLength"] = secret_model.SecretDataMaxLength
 	ctx.Data["DescriptionMaxLength"] = secret_model.SecretDescriptionMaxLength
+
+	if setting.Actions.ExternalSecrets.Enabled {
+		accessLogs, err := db.Find[secret_model.AccessLog](ctx, secret_model.FindAccessLogsOptions{
+			ListOptions: db.ListOptions{PageSize: 20},
+			OwnerID:     ownerID,
+			RepoID:      repoID,
+		})
+		if err != nil {
+			ctx.ServerError("FindAccessLogs", err)
+			return
+		}
+		ctx.Data["ExternalSecretsProvider"] = setting.Actions.ExternalSecrets.Provider
+		ctx.Data["SecretAccessLogs"] = accessLogs
+	}
 }
 
 func PerformSecretsPost(ctx *context.Context, ownerID, repoID int64, redirectURL string) {
@@ -31,6 +48,10 @@ func PerformSecretsPost(ctx *context.Context, ownerID, repoID int64, redirectURL
 
 	s, _, err := secret_service.CreateOrUpdateSecret(ctx, ownerID, repoID, form.Name, util.ReserveLineBreakForTextarea(form.Data), form.Description)
 	if err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+			return
+		}
 		log.Error("CreateOrUpdateSecret failed: %v", err)
 		ctx.JSONError(ctx.Tr("secrets.save_failed"))
 		return
@@ -513,10 +513,29 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 
 	// keyword holds the search term entered into the search field.
 	keyword := strings.Trim(ctx.FormString("q"), " ")
+
+	// a saved search fills the search field if it's empty
+	savedSearch := getDashboardSavedSearch(ctx, isPullList)
+	if ctx.Written() {
+		return
+	}
+	if savedSearch != nil && keyword == "" {
+		keyword = savedSearch.Query
+	}
 	ctx.Data["Keyword"] = keyword
 
+	// the search field accepts qualifiers like "label:bug repo:org/* assignee:@me"
+	query, err := issue_indexer.ParseQuery(keyword)
+	if err != nil {
+		ctx.Flash.Error(ctx.Tr("home.issues.invalid_query", err.Error()), true)
+		query = &issue_indexer.Query{Keyword: keyword}
+	}
+
 	// Educated guess: Do or don't show closed issues.
 	isShowClosed := ctx.FormString("state") == "closed"
+	if query.IsClosed.Has() {
+		isShowClosed = query.IsClosed.Value()
+	}
 	opts.IsClosed = optional.Some(isShowClosed)
 
 	// Make sure page number is at least 1. Will be posted to ctx.Data.
@@ -541,15 +560,22 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 	// Get issues as defined by opts.
 	// ------------------------------
 
+	// The qualifiers of the query narrow down the search options built from opts.
+	searchOpts, err := query.ToSearchOptions(ctx, ctx.Doer, issue_indexer.ToSearchOptions(query.Keyword, opts).Copy(
+		func(o *issue_indexer.SearchOptions) {
+			o.SearchMode = indexer.SearchModeType(searchMode)
+		},
+	))
+	if err != nil {
+		ctx.ServerError("ToSearchOptions", err)
+		return
+	}
+
 	// Slice of Issues that will be displayed on the overview page
 	// USING FINAL STATE OF opts FOR A QUERY.
 	var issues issues_model.IssueList
 	{
-		issueIDs, _, err := issue_indexer.SearchIssues(ctx, issue_indexer.ToSearchOptions(keyword, opts).Copy(
-			func(o *issue_indexer.SearchOptions) {
-				o.SearchMode = indexer.SearchModeType(searchMode)
-			},
-		))
+		issueIDs, _, err := issue_indexer.SearchIssues(ctx, searchOpts)
 		if err != nil {
 			ctx.ServerError("issueIDsFromSearch", err)
 			return
@@ -575,11 +601,7 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 	// -------------------------------
 	// Fill stats to post to ctx.Data.
 	// -------------------------------
-	issueStats, err := getUserIssueStats(ctx, ctxUser, filterMode, issue_indexer.ToSearchOptions(keyword, opts).Copy(
-		func(o *issue_indexer.SearchOptions) {
-			o.SearchMode = indexer.SearchModeType(searchMode)
-		},
-	))
+	issueStats, err := getUserIssueStats(ctx, ctxUser, filterMode, searchOpts, len(query.Repos) > 0)
 	if err != nil {
 		ctx.ServerError("getUserIssueStats", err)
 		return
@@ -636,6 +658,18 @@ func buildIssueOverview(ctx *context.Context, unitType unit.Type) {
 	ctx.Data["SearchModes"] = issue_indexer.SupportedSearchModes()
 	ctx.Data["SelectedSearchMode"] = ctx.FormTrim("search_mode")
 
+	savedSearches, err := db.Find[issues_model.IssueSavedSearch](ctx, issues_model.FindIssueSavedSearchOptions{
+		ListOptions: db.ListOptionsAll,
+		OwnerID:     ctx.Doer.ID,
+		IsPull:      optional.Some(isPullList),
+	})
+	if err != nil {
+		ctx.ServerError("FindIssueSavedSearches", err)
+		return
+	}
+	ctx.Data["SavedSearches"] = savedSearches
+	ctx.Data["SavedSearch"] = savedSearch
+
 	if isShowClosed {
 		ctx.Data["State"] = "closed"
 	} else {
@@ -772,7 +806,9 @@ func UsernameSubRoute(ctx *context.Context) {
 	}
 }
 
-func getUserIssueStats(ctx *context.Context, ctxUser *user_model.User, filterMode int, opts *issue_indexer.SearchOptions) (ret *issues_model.IssueStats, err error) {
+// getUserIssueStats counts the issues of the dashboard, limitedRepos means the repositories of the options
+// are chosen by the query, so the public repositories must not be added to them
+func getUserIssueStats(ctx *context.Context, ctxUser *user_model.User, filterMode int, opts *issue_indexer.SearchOptions, limitedRepos bool) (ret *issues_model.IssueStats, err error) {
 	ret = &issues_model.IssueStats{}
 	doerID := ctx.Doer.ID
 
@@ -781,7 +817,7 @@ func getUserIssueStats(ctx *context.Context, ctxUser *user_model.User, filterMod
 		// it's not enough to show the repos that the doer owns or has been explicitly granted access to,
 		// because the doer may create issues or be mentioned in any public repo.
 		// So we need search issues in all public repos.
-		o.AllPublic = doerID == ctxUser.ID
+		o.AllPublic = doerID == ctxUser.ID && !limitedRepos
 	})
 
 	// Open/Closed are for the tabs of the issue list
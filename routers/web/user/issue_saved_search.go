@@ -0,0 +1,143 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package user
+
+import (
+	"errors"
+	"strconv"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/httplib"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/modules/web"
+	"code.gitea.io/gitea/services/context"
+	"code.gitea.io/gitea/services/forms"
+	issue_service "code.gitea.io/gitea/services/issue"
+)
+
+// getDashboardSavedSearch returns the saved search chosen by the "saved_search" parameter, it can be a search of the doer
+// or a search shared by another user
+func getDashboardSavedSearch(ctx *context.Context, isPull bool) *issues_model.IssueSavedSearch {
+	id := ctx.FormInt64("saved_search")
+	if id <= 0 {
+		return nil
+	}
+	s, err := issues_model.GetIssueSavedSearchByID(ctx, id)
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueSavedSearchByID", issues_model.IsErrIssueSavedSearchNotExist, err)
+		return nil
+	}
+	if !s.CanBeRunBy(ctx.Doer) || s.IsPull != isPull {
+		ctx.NotFound(nil)
+		return nil
+	}
+	if err := s.LoadOwner(ctx); err != nil {
+		ctx.ServerError("LoadOwner", err)
+		return nil
+	}
+	return s
+}
+
+func getDoerIssueSavedSearch(ctx *context.Context) *issues_model.IssueSavedSearch {
+	s, err := issues_model.GetIssueSavedSearchByID(ctx, ctx.PathParamInt64("id"))
+	if err != nil {
+		ctx.NotFoundOrServerError("GetIssueSavedSearchByID", issues_model.IsErrIssueSavedSearchNotExist, err)
+		return nil
+	}
+	if s.OwnerID != ctx.Doer.ID {
+		ctx.NotFound(nil)
+		return nil
+	}
+	return s
+}
+
+// issueSavedSearchLink returns the link of the dashboard page showing a saved search, the page can be
+// an organization dashboard given by redirectTo
+func issueSavedSearchLink(ctx *context.Context, s *issues_model.IssueSavedSearch, redirectTo string) string {
+	link := setting.AppSubURL + util.Iif(s.IsPull, "/pulls", "/issues")
+	if redirectTo != "" && httplib.IsCurrentGiteaSiteURL(ctx, redirectTo) {
+		link = redirectTo
+	}
+	return link + "?saved_search=" + strconv.FormatInt(s.ID, 10)
+}
+
+// NewIssueSavedSearchPost saves the query of the issues or pull requests dashboard for the doer
+func NewIssueSavedSearchPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueSavedSearchForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	s := &issues_model.IssueSavedSearch{
+		OwnerID:  ctx.Doer.ID,
+		IsPull:   form.IsPull,
+		Name:     form.Name,
+		Query:    form.Query,
+		IsPinned: form.IsPinned,
+		IsShared: form.IsShared,
+	}
+	if err := issue_service.CreateIssueSavedSearch(ctx, s); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("CreateIssueSavedSearch", err)
+		}
+		return
+	}
+	ctx.JSONRedirect(issueSavedSearchLink(ctx, s, form.RedirectTo))
+}
+
+// EditIssueSavedSearchPost changes the name, the query and the flags of a saved search of the doer
+func EditIssueSavedSearchPost(ctx *context.Context) {
+	form := web.GetForm(ctx).(*forms.IssueSavedSearchForm)
+	if ctx.HasError() {
+		ctx.JSONError(ctx.GetErrMsg())
+		return
+	}
+	s := getDoerIssueSavedSearch(ctx)
+	if ctx.Written() {
+		return
+	}
+	s.Name = form.Name
+	s.Query = form.Query
+	s.IsPinned = form.IsPinned
+	s.IsShared = form.IsShared
+	if err := issue_service.UpdateIssueSavedSearch(ctx, s); err != nil {
+		if errors.Is(err, util.ErrInvalidArgument) {
+			ctx.JSONError(err.Error())
+		} else {
+			ctx.ServerError("UpdateIssueSavedSearch", err)
+		}
+		return
+	}
+	ctx.JSONRedirect(issueSavedSearchLink(ctx, s, form.RedirectTo))
+}
+
+// PinIssueSavedSearchPost pins or unpins a saved search of the doer to the dashboard
+func PinIssueSavedSearchPost(ctx *context.Context) {
+	s := getDoerIssueSavedSearch(ctx)
+	if ctx.Written() {
+		return
+	}
+	s.IsPinned = !s.IsPinned
+	if err := issues_model.UpdateIssueSavedSearch(ctx, s); err != nil {
+		ctx.ServerError("UpdateIssueSavedSearch", err)
+		return
+	}
+	ctx.JSONRedirect("")
+}
+
+// DeleteIssueSavedSearchPost removes a saved search of the doer
+func DeleteIssueSavedSearchPost(ctx *context.Context) {
+	s := getDoerIssueSavedSearch(ctx)
+	if ctx.Written() {
+		return
+	}
+	if err := issues_model.DeleteIssueSavedSearch(ctx, ctx.Doer.ID, s.ID); err != nil {
+		ctx.ServerError("DeleteIssueSavedSearch", err)
+		return
+	}
+	ctx.JSONRedirect(setting.AppSubURL + util.Iif(s.IsPull, "/pulls", "/issues"))
+}
@@ -443,6 +443,13 @@ func registerWebRoutes(m *web.Router) {
 		})
 	}
 
+	addSettingsInsightsRoutes := func() {
+		m.Group("/insights", func() {
+			m.Get("", shared_actions.Insights)
+			m.Get("/data", shared_actions.InsightsData)
+		})
+	}
+
 	addSettingsSecretsRoutes := func() {
 		m.Group("/secrets", func() {
 			m.Get("", repo_setting.Secrets)
@@ -505,6 +512,12 @@ func registerWebRoutes(m *web.Router) {
 	m.Group("/issues", func() {
 		m.Get("", user.Issues)
 		m.Get("/search", repo.SearchIssues)
+		m.Group("/saved_searches", func() {
+			m.Post("/new", web.Bind(forms.IssueSavedSearchForm{}), user.NewIssueSavedSearchPost)
+			m.Post("/{id}/edit", web.Bind(forms.IssueSavedSearchForm{}), user.EditIssueSavedSearchPost)
+			m.Post("/{id}/pin", user.PinIssueSavedSearchPost)
+			m.Post("/{id}/delete", user.DeleteIssueSavedSearchPost)
+		})
 	}, reqSignIn)
 
 	m.Get("/pulls", reqSignIn, user.Pulls)
@@ -955,11 +968,30 @@ func registerWebRoutes(m *web.Router) {
 					m.Post("/initialize", web.Bind(forms.InitializeLabelsForm{}), org.InitializeLabels)
 				})
 
+				m.Group("/issue_types", func() {
+					m.Get("", org.IssueTypes)
+					m.Post("/new", web.Bind(forms.EditIssueTypeForm{}), org.NewIssueTypePost)
+					m.Post("/initialize", org.InitializeIssueTypesPost)
+					m.Group("/{id}", func() {
+						m.Post("/edit", web.Bind(forms.EditIssueTypeForm{}), org.EditIssueTypePost)
+						m.Post("/delete", org.DeleteIssueTypePost)
+						m.Post("/fields/new", web.Bind(forms.EditIssueFieldForm{}), org.NewIssueFieldPost)
+						m.Post("/fields/{fieldID}/edit", web.Bind(forms.EditIssueFieldForm{}), org.EditIssueFieldPost)
+						m.Post("/fields/{fieldID}/delete", org.DeleteIssueFieldPost)
+					})
+				})
+
 				m.Group("/actions", func() {
 					m.Get("", org_setting.RedirectToDefaultSetting)
 					addSettingsRunnersRoutes()
 					addSettingsSecretsRoutes()
 					addSettingsVariablesRoutes()
+					addSettingsInsightsRoutes()
+					m.Group("/required_workflows", func() {
+						m.Get("", org.RequiredWorkflows)
+						m.Post("/new", web.Bind(forms.CreateRequiredWorkflowForm{}), org.NewRequiredWorkflowPost)
+						m.Post("/{id}/delete", org.DeleteRequiredWorkflowPost)
+					})
 				}, actions.MustEnableActions)
 
 				m.Post("/rename", web.Bind(forms.RenameOrgForm{}), org.SettingsRenamePost)
@@ -1029,6 +1061,7 @@ func registerWebRoutes(m *web.Router) {
 			m.Group("", func() {
 				m.Get("", org.Projects)
 				m.Get("/{id}", org.ViewProject)
+				m.Get("/{id}/views/{viewID}", org.ViewProject)
 			}, reqUnitAccess(unit.TypeProjects, perm.AccessModeRead, true))
 			m.Group("", func() { //nolint:dupl // duplicates lines 1421-1441
 				m.Get("/new", org.RenderNewProject)
@@ -1043,6 +1076,14 @@ func registerWebRoutes(m *web.Router) {
 					// TODO: improper name. Others are "delete project", "edit project", but this one is "move columns"
 					m.Post("/move", project.MoveColumns)
 					m.Post("/columns/new", web.Bind(forms.EditProjectColumnForm{}), org.AddColumnToProjectPost)
+					m.Get("/fields", project.Fields)
+					m.Post("/fields/new", web.Bind(forms.EditProjectFieldForm{}), project.NewFieldPost)
+					m.Post("/fields/{fieldID}/edit", web.Bind(forms.EditProjectFieldForm{}), project.EditFieldPost)
+					m.Post("/fields/{fieldID}/delete", project.DeleteFieldPost)
+					m.Post("/views/new", web.Bind(forms.EditProjectViewForm{}), project.NewViewPost)
+					m.Post("/views/{viewID}/edit", web.Bind(forms.EditProjectViewForm{}), project.EditViewPost)
+					m.Post("/views/{viewID}/delete", project.DeleteViewPost)
+					m.Post("/issues/{issueID}/fields", project.UpdateIssueFieldValues)
 					m.Group("/{columnID}", func() {
 						m.Put("", web.Bind(forms.EditProjectColumnForm{}), org.EditProjectColumn)
 						m.Delete("", org.DeleteProjectColumn)
@@ -1135,6 +1176,23 @@ func registerWebRoutes(m *web.Router) {
 			m.Post("/delete", repo_setting.DeleteDeployKey)
 		})
 
+		m.Group("/issue_sla", func() {
+			m.Get("", repo_setting.IssueSLA)
+			m.Post("/new", web.Bind(forms.IssueSLAPolicyForm{}), repo_setting.NewIssueSLAPolicyPost)
+			m.Post("/{id}/edit", web.Bind(forms.IssueSLAPolicyForm{}), repo_setting.EditIssueSLAPolicyPost)
+			m.Post("/{id}/delete", repo_setting.DeleteIssueSLAPolicyPost)
+		}, reqUnitIssuesReader)
+
+		m.Group("/issue_schedules", func() {
+			m.Get("", repo_setting.IssueSchedules)
+			m.Combo("/new").Get(repo_setting.NewIssueSchedule).
+				Post(web.Bind(forms.IssueScheduleForm{}), repo_setting.NewIssueSchedulePost)
+			m.Combo("/{id}/edit").Get(repo_setting.EditIssueSchedule).
+				Post(web.Bind(forms.IssueScheduleForm{}), repo_setting.EditIssueSchedulePost)
+			m.Post("/{id}/run", repo_setting.RunIssueSchedulePost)
+			m.Post("/{id}/delete", repo_setting.DeleteIssueSchedulePost)
+		}, reqUnitIssuesReader)
+
 		m.Group("/lfs", func() {
 			m.Get("/", repo_setting.LFSFiles)
 			m.Get("/show/{oid}", repo_setting.LFSFileGet)
@@ -1157,11 +1215,14 @@ func registerWebRoutes(m *web.Router) {
 			addSettingsRunnersRoutes()
 			addSettingsSecretsRoutes()
 			addSettingsVariablesRoutes()
+			addSettingsInsightsRoutes()
 			m.Group("/general", func() {
 				m.Group("/collaborative_owner", func() {
 					m.Post("/add", repo_setting.AddCollaborativeOwner)
 					m.Post("/delete", repo_setting.DeleteCollaborativeOwner)
 				})
+				m.Post("/approval_policy", repo_setting.ApprovalPolicyPost)
+				m.Post("/approved_contributor/delete", repo_setting.DeleteApprovedContributor)
 			})
 		}, actions.MustEnableActions)
 		// the follow handler must be under "settings", otherwise this incomplete repo can't be accessed
@@ -1222,7 +1283,13 @@ func registerWebRoutes(m *web.Router) {
 		m.Get("/labels", repo.RetrieveLabelsForList, repo.Labels)
 		m.Get("/milestones", repo.Milestones)
 		m.Get("/milestone/{id}", repo.MilestoneIssuesAndPulls)
+		m.Group("/milestone/{id}/analytics", func() {
+			m.Get("", repo.MilestoneAnalytics)
+			m.Get("/data", repo.MilestoneAnalyticsData)
+			m.Get("/export", repo.MilestoneAnalyticsExport)
+		})
 		m.Get("/issues/suggestions", repo.IssueSuggestions)
+		m.Get("/issues/export", reqUnitIssuesReader, repo.ExportIssues)
 	}, optSignIn, context.RepoAssignment, reqRepoIssuesOrPullsReader) // issue/pull attachments, labels, milestones
 	// end "/{username}/{reponame}": view milestone, label, issue, pull, etc
 
@@ -1241,6 +1308,7 @@ func registerWebRoutes(m *web.Router) {
 				m.Get("/choose", repo.NewIssueChooseTemplate)
 			})
 			m.Get("/search", repo.SearchRepoIssuesJSON)
+			m.Post("/import", reqRepoAdmin, repo.ImportIssuesPost)
 		}, reqUnitIssuesReader)
 
 		addIssuesPullsUpdateRoutes := func() {
@@ -1268,6 +1336,13 @@ func registerWebRoutes(m *web.Router) {
 					})
 				})
 				m.Post("/time_estimate", repo.UpdateIssueTimeEstimate)
+				m.Post("/project_fields", reqRepoIssuesOrPullsWriter, reqRepoProjectsReader, repo.UpdateIssueProjectFields)
+				m.Group("/sub_issues", func() {
+					m.Post("/add", repo.AddSubIssue)
+					m.Post("/remove", repo.RemoveSubIssue)
+				}, reqRepoIssuesOrPullsWriter)
+				m.Post("/issue_type", reqRepoIssuesOrPullsWriter, repo.UpdateIssueType)
+				m.Post("/issue_fields", reqRepoIssuesOrPullsWriter, repo.UpdateIssueTypeFields)
 				m.Post("/reactions/{action}", web.Bind(forms.ReactionForm{}), repo.ChangeIssueReaction)
 				m.Post("/lock", reqRepoIssuesOrPullsWriter, web.Bind(forms.IssueLockForm{}), repo.LockIssue)
 				m.Post("/unlock", reqRepoIssuesOrPullsWriter, repo.UnlockIssue)
@@ -1309,6 +1384,7 @@ func registerWebRoutes(m *web.Router) {
 				Post(web.Bind(forms.CreateMilestoneForm{}), repo.NewMilestonePost)
 			m.Get("/{id}/edit", repo.EditMilestone)
 			m.Post("/{id}/edit", web.Bind(forms.CreateMilestoneForm{}), repo.EditMilestonePost)
+			m.Post("/{id}/analytics/backfill", repo.MilestoneAnalyticsBackfill)
 			m.Post("/{id}/{action}", repo.ChangeMilestoneStatus)
 			m.Post("/delete", repo.DeleteMilestone)
 		}, reqRepoIssuesOrPullsWriter)
@@ -1428,6 +1504,7 @@ func registerWebRoutes(m *web.Router) {
 	m.Group("/{username}/{reponame}/projects", func() {
 		m.Get("", repo.Projects)
 		m.Get("/{id}", repo.ViewProject)
+		m.Get("/{id}/views/{viewID}", repo.ViewProject)
 		m.Group("", func() { //nolint:dupl // duplicates lines 1034-1054
 			m.Get("/new", repo.RenderNewProject)
 			m.Post("/new", web.Bind(forms.CreateProjectForm{}), repo.NewProjectPost)
@@ -1441,6 +1518,14 @@ func registerWebRoutes(m *web.Router) {
 				// TODO: improper name. Others are "delete project", "edit project", but this one is "move columns"
 				m.Post("/move", project.MoveColumns)
 				m.Post("/columns/new", web.Bind(forms.EditProjectColumnForm{}), repo.AddColumnToProjectPost)
+				m.Get("/fields", project.Fields)
+				m.Post("/fields/new", web.Bind(forms.EditProjectFieldForm{}), project.NewFieldPost)
+				m.Post("/fields/{fieldID}/edit", web.Bind(forms.EditProjectFieldForm{}), project.EditFieldPost)
+				m.Post("/fields/{fieldID}/delete", project.DeleteFieldPost)
+				m.Post("/views/new", web.Bind(forms.EditProjectViewForm{}), project.NewViewPost)
+				m.Post("/views/{viewID}/edit", web.Bind(forms.EditProjectViewForm{}), project.EditViewPost)
+				m.Post("/views/{viewID}/delete", project.DeleteViewPost)
+				m.Post("/issues/{issueID}/fields", project.UpdateIssueFieldValues)
 				m.Group("/{columnID}", func() {
 					m.Put("", web.Bind(forms.EditProjectColumnForm{}), repo.EditProjectColumn)
 					m.Delete("", repo.DeleteProjectColumn)
@@ -1459,6 +1544,7 @@ func registerWebRoutes(m *web.Router) {
 		m.Post("/run", reqRepoActionsWriter, actions.Run)
 		m.Get("/workflow-dispatch-inputs", reqRepoActionsWriter, actions.WorkflowDispatchInputs)
 		m.Post("/approve-all-checks", reqRepoActionsWriter, actions.ApproveAllChecks)
+		m.Get("/flaky-tests", actions.FlakyTests)
 
 		m.Group("/runs/{run}", func() {
 			m.Combo("").
@@ -1470,10 +1556,14 @@ func registerWebRoutes(m *web.Router) {
 					Post(web.Bind(actions.ViewRequest{}), actions.ViewPost)
 				m.Post("/rerun", reqRepoActionsWriter, actions.Rerun)
 				m.Get("/logs", actions.Logs)
+				m.Get("/stream", actions.ViewStream)
 			})
+			m.Get("/logs/search", actions.SearchLogs)
 			m.Get("/workflow", actions.ViewWorkflowFile)
+			m.Get("/tests", actions.Tests)
 			m.Post("/cancel", reqRepoActionsWriter, actions.Cancel)
 			m.Post("/approve", reqRepoActionsWriter, actions.Approve)
+			m.Post("/reject", reqRepoActionsWriter, actions.Reject)
 			m.Post("/delete", reqRepoActionsWriter, actions.Delete)
 			m.Get("/artifacts/{artifact_name}", actions.ArtifactsDownloadView)
 			m.Delete("/artifacts/{artifact_name}", reqRepoActionsWriter, actions.ArtifactsDeleteView)
@@ -1539,10 +1629,12 @@ func registerWebRoutes(m *web.Router) {
 				m.Get("/list", repo.GetPullCommits)
 				m.Get("/{sha:[a-f0-9]{7,64}}", repo.SetEditorconfigIfExists, repo.SetDiffViewStyle, repo.SetWhitespaceBehavior, repo.SetShowOutdatedComments, repo.ViewPullFilesForSingleCommit)
 			})
+			m.Get("/range-diff", repo.ViewPullRangeDiff)
 			m.Post("/merge", context.RepoMustNotBeArchived(), web.Bind(forms.MergePullRequestForm{}), repo.MergePullRequest)
 			m.Post("/cancel_auto_merge", context.RepoMustNotBeArchived(), repo.CancelAutoMergePullRequest)
 			m.Post("/update", repo.UpdatePullRequest)
 			m.Post("/set_allow_maintainer_edit", web.Bind(forms.UpdateAllowEditsForm{}), repo.SetAllowEdits)
+			m.Post("/stack_parent", context.RepoMustNotBeArchived(), repo.SetPullStackParent)
 			m.Post("/cleanup", context.RepoMustNotBeArchived(), repo.CleanUpPullRequest)
 			m.Group("/files", func() {
 				m.Get("", repo.SetEditorconfigIfExists, repo.SetDiffViewStyle, repo.SetWhitespaceBehavior, repo.SetShowOutdatedComments, repo.ViewPullFilesForAllCommitsOfPr)
@@ -1552,6 +1644,7 @@ func registerWebRoutes(m *web.Router) {
 					m.Post("/comments", web.Bind(forms.CodeCommentForm{}), repo.SetShowOutdatedComments, repo.CreateCodeComment)
 					m.Post("/submit", web.Bind(forms.SubmitReviewForm{}), repo.SubmitReview)
 				}, context.RepoMustNotBeArchived())
+				m.Post("/suggestions/apply", context.RepoMustNotBeArchived(), repo.ApplyCodeSuggestions)
 			})
 		})
 	}, optSignIn, context.RepoAssignment, repo.MustAllowPulls, reqUnitPullsReader)
@@ -0,0 +1,186 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	unit_model "code.gitea.io/gitea/models/unit"
+	user_model "code.gitea.io/gitea/models/user"
+	actions_module "code.gitea.io/gitea/modules/actions"
+	"code.gitea.io/gitea/modules/log"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+// GetApprovalPolicy returns the approval policy of workflow runs of the repository
+func GetApprovalPolicy(ctx context.Context, repo *repo_model.Repository) repo_model.ActionsApprovalPolicy {
+	actionsUnit, err := repo.GetUnit(ctx, unit_model.TypeActions)
+	if err != nil {
+		return repo_model.ActionsApprovalFirstTimeContributors
+	}
+	return actionsUnit.ActionsConfig().GetApprovalPolicy()
+}
+
+func ifNeedApproval(ctx context.Context, run *actions_model.ActionRun, repo *repo_model.Repository, user *user_model.User) (bool, error) {
+	// don't need approval if the event is `pull_request_target` since the workflow will run in the context of base branch
+	// see https://docs.github.com/en/actions/managing-workflow-runs/approving-workflow-runs-from-public-forks#about-workflow-runs-from-public-forks
+	if run.TriggerEvent == actions_module.GithubEventPullRequestTarget {
+		return false, nil
+	}
+
+	// only the "outside collaborators" policy needs approval for the runs which are not from a fork PR
+	policy := GetApprovalPolicy(ctx, repo)
+	if !run.IsForkPullRequest && policy != repo_model.ActionsApprovalOutsideCollaborators {
+		return false, nil
+	}
+
+	// always need approval if the user is restricted
+	if user.IsRestricted {
+		log.Trace("need approval because user %d is restricted", user.ID)
+		return true, nil
+	}
+
+	// don't need approval if the user can write
+	if perm, err := access_model.GetUserRepoPermission(ctx, repo, user); err != nil {
+		return false, fmt.Errorf("GetUserRepoPermission: %w", err)
+	} else if perm.CanWrite(unit_model.TypeActions) {
+		log.Trace("do not need approval because user %d can write", user.ID)
+		return false, nil
+	}
+
+	if policy != repo_model.ActionsApprovalFirstTimeContributors {
+		log.Trace("need approval because user %d can't write and the approval policy is %q", user.ID, policy)
+		return true, nil
+	}
+
+	// don't need approval if the user has been approved before
+	if approved, err := actions_model.IsContributorApproved(ctx, repo.ID, user.ID); err != nil {
+		return false, fmt.Errorf("IsContributorApproved: %w", err)
+	} else if approved {
+		log.Trace("do not need approval because user %d has been approved before", user.ID)
+		return false, nil
+	}
+
+	// otherwise, need approval
+	log.Trace("need approval because it's the first time user %d triggered actions", user.ID)
+	return true, nil
+}
+
+// ApproveRuns approves the runs waiting for approval and starts their jobs,
+// the trigger users of the runs are remembered as approved contributors of the repository.
+func ApproveRuns(ctx context.Context, repo *repo_model.Repository, doer *user_model.User, runs []*actions_model.ActionRun) error {
+	updatedJobs := make([]*actions_model.ActionRunJob, 0)
+	runJobs := make(map[int64][]*actions_model.ActionRunJob, len(runs))
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		for _, run := range runs {
+			if !run.NeedApproval {
+				continue
+			}
+			run.Repo = repo
+			run.NeedApproval = false
+			run.ApprovedBy = doer.ID
+			if err := actions_model.UpdateRun(ctx, run, "need_approval", "approved_by"); err != nil {
+				return err
+			}
+			if err := actions_model.ApproveContributor(ctx, repo.ID, run.TriggerUserID, doer.ID); err != nil {
+				return err
+			}
+			jobs, err := actions_model.GetRunJobsByRunID(ctx, run.ID)
+			if err != nil {
+				return err
+			}
+			runJobs[run.ID] = jobs
+			for _, job := range jobs {
+				job.Status, err = PrepareToStartJobWithConcurrency(ctx, job)
+				if err != nil {
+					return err
+				}
+				if job.Status == actions_model.StatusWaiting {
+					n, err := actions_model.UpdateRunJob(ctx, job, nil, "status")
+					if err != nil {
+						return err
+					}
+					if n > 0 {
+						updatedJobs = append(updatedJobs, job)
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		if jobs, ok := runJobs[run.ID]; ok {
+			CreateCommitStatusForRunJobs(ctx, run, jobs...)
+		}
+	}
+
+	if len(updatedJobs) > 0 {
+		job := updatedJobs[0]
+		NotifyWorkflowRunStatusUpdateWithReload(ctx, job)
+	}
+
+	for _, job := range updatedJobs {
+		_ = job.LoadAttributes(ctx)
+		notify_service.WorkflowJobStatusUpdate(ctx, job.Run.Repo, job.Run.TriggerUser, job, nil)
+	}
+	return nil
+}
+
+// RejectRuns rejects the runs waiting for approval, their jobs are cancelled without running
+func RejectRuns(ctx context.Context, repo *repo_model.Repository, runs []*actions_model.ActionRun) error {
+	var updatedJobs []*actions_model.ActionRunJob
+	runJobs := make(map[int64][]*actions_model.ActionRunJob, len(runs))
+
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		for _, run := range runs {
+			if !run.NeedApproval {
+				continue
+			}
+			run.Repo = repo
+			run.NeedApproval = false
+			if err := actions_model.UpdateRun(ctx, run, "need_approval"); err != nil {
+				return err
+			}
+			jobs, err := actions_model.GetRunJobsByRunID(ctx, run.ID)
+			if err != nil {
+				return err
+			}
+			runJobs[run.ID] = jobs
+			cancelledJobs, err := actions_model.CancelJobs(ctx, jobs)
+			if err != nil {
+				return fmt.Errorf("cancel jobs: %w", err)
+			}
+			updatedJobs = append(updatedJobs, cancelledJobs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		if jobs, ok := runJobs[run.ID]; ok {
+			CreateCommitStatusForRunJobs(ctx, run, jobs...)
+		}
+	}
+
+	for _, job := range updatedJobs {
+		_ = job.LoadAttributes(ctx)
+		notify_service.WorkflowJobStatusUpdate(ctx, job.Run.Repo, job.Run.TriggerUser, job, nil)
+	}
+	if len(updatedJobs) > 0 {
+		NotifyWorkflowRunStatusUpdateWithReload(ctx, updatedJobs[0])
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
+	secret_model "code.gitea.io/gitea/models/secret"
 	actions_module "code.gitea.io/gitea/modules/actions"
 	"code.gitea.io/gitea/modules/container"
 	"code.gitea.io/gitea/modules/log"
@@ -21,7 +22,7 @@ import (
 	"xorm.io/builder"
 )
 
-// Cleanup removes expired actions logs, data, artifacts and used ephemeral runners
+// Cleanup removes expired actions logs, data, artifacts, used ephemeral runners and expired just-in-time runners
 func Cleanup(ctx context.Context) error {
 	// clean up expired artifacts
 	if err := CleanupArtifacts(ctx); err != nil {
@@ -33,11 +34,24 @@ func Cleanup(ctx context.Context) error {
 		return fmt.Errorf("cleanup logs: %w", err)
 	}
 
+	// clean up the access logs of the external secrets as old as the expired logs
+	olderThan := timeutil.TimeStampNow().AddDuration(-time.Duration(setting.Actions.LogRetentionDays) * 24 * time.Hour)
+	if _, err := secret_model.DeleteAccessLogsBefore(ctx, olderThan); err != nil {
+		return fmt.Errorf("cleanup secret access logs: %w", err)
+	}
+
 	// clean up old ephemeral runners
 	if err := CleanupEphemeralRunners(ctx); err != nil {
 		return fmt.Errorf("cleanup old ephemeral runners: %w", err)
 	}
 
+	// clean up the just-in-time runners which have never connected
+	if n, err := actions_model.DeleteExpiredRunners(ctx); err != nil {
+		return fmt.Errorf("cleanup expired runners: %w", err)
+	} else if n > 0 {
+		log.Info("Removed %d expired runners", n)
+	}
+
 	return nil
 }
 
@@ -223,11 +237,23 @@ func DeleteRun(ctx context.Context, run *actions_model.ActionRun) error {
 		recordsToDelete = append(recordsToDelete, &actions_model.ActionTaskOutput{
 			TaskID: tas.ID,
 		})
+		recordsToDelete = append(recordsToDelete, &actions_model.ActionTaskAnnotation{
+			RepoID: repoID,
+			TaskID: tas.ID,
+		})
+		recordsToDelete = append(recordsToDelete, &actions_model.ActionTaskSummary{
+			RepoID: repoID,
+			TaskID: tas.ID,
+		})
 	}
 	recordsToDelete = append(recordsToDelete, &actions_model.ActionArtifact{
 		RepoID: repoID,
 		RunID:  run.ID,
 	})
+	recordsToDelete = append(recordsToDelete, &actions_model.ActionTestResult{
+		RepoID: repoID,
+		RunID:  run.ID,
+	})
 
 	if err := db.WithTx(ctx, func(ctx context.Context) error {
 		// TODO: Deleting task records could break current ephemeral runner implementation. This is a temporary workaround suggested by ChristopherHX.
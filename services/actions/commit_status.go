@@ -48,6 +48,10 @@ func CreateCommitStatusForRunJobs(ctx context.Context, run *actions_model.Action
 	}
 
 	for _, job := range jobs {
+		// the placeholder of a dynamic matrix will be replaced by the expanded jobs, unless it fails to be expanded
+		if job.DynamicMatrix == actions_model.DynamicMatrixPending && !job.Status.IsDone() {
+			continue
+		}
 		if err = createCommitStatus(ctx, run.Repo, event, commitID, run, job); err != nil {
 			log.Error("Failed to create commit status for job %d: %v", job.ID, err)
 		}
@@ -129,6 +133,19 @@ func createCommitStatus(ctx context.Context, repo *repo_model.Repository, event,
 	if wfs, err := jobparser.Parse(job.WorkflowPayload); err == nil && len(wfs) > 0 {
 		runName = wfs[0].Name
 	}
+	if run.RequiredWorkflowID > 0 {
+		// the contexts of the required workflows are required by the protected branches, see GetRequiredWorkflowStatusContexts
+		// if the required workflow has been deleted, the run is named like the workflows of the repository
+		rw, err := actions_model.GetRequiredWorkflowByID(ctx, run.OwnerID, run.RequiredWorkflowID)
+		if err == nil {
+			err = rw.LoadRepo(ctx)
+		}
+		if err == nil {
+			runName = rw.StatusContextName()
+		} else if !errors.Is(err, util.ErrNotExist) {
+			return fmt.Errorf("load required workflow: %w", err)
+		}
+	}
 	ctxName := fmt.Sprintf("%s / %s (%s)", runName, job.Name, event)
 	ctxName = strings.TrimSpace(ctxName) // git_model.NewCommitStatus also trims spaces
 	state := toCommitStatus(job.Status)
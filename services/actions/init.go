@@ -66,6 +66,12 @@ func Init(ctx context.Context) error {
 	}
 	go graceful.GetManager().RunWithCancel(jobEmitterQueue)
 
+	testReportQueue = queue.CreateUniqueQueue(graceful.GetManager().ShutdownContext(), "actions_test_report", testReportQueueHandler)
+	if testReportQueue == nil {
+		return errors.New("unable to create actions_test_report queue")
+	}
+	go graceful.GetManager().RunWithCancel(testReportQueue)
+
 	notify_service.RegisterNotifier(NewNotifier())
 	return initGlobalRunnerToken(ctx)
 }
@@ -27,6 +27,8 @@ type API interface {
 	GetRegistrationToken(*context.APIContext)
 	// CreateRegistrationToken get registration token
 	CreateRegistrationToken(*context.APIContext)
+	// GenerateRunnerJITConfig create a just-in-time runner
+	GenerateRunnerJITConfig(*context.APIContext)
 	// ListRunners list runners
 	ListRunners(*context.APIContext)
 	// GetRunner get a runner
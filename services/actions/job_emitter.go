@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
@@ -212,19 +214,57 @@ func checkJobsOfRun(ctx context.Context, run *actions_model.ActionRun) (jobs, up
 			job.Run = run
 		}
 
-		updates := newJobStatusResolver(jobs, vars).Resolve(ctx)
-		for _, job := range jobs {
-			if status, ok := updates[job.ID]; ok {
-				job.Status = status
-				if n, err := actions_model.UpdateRunJob(ctx, job, builder.Eq{"status": actions_model.StatusBlocked}, "status"); err != nil {
-					return err
-				} else if n != 1 {
-					return fmt.Errorf("no affected for updating blocked job %v", job.ID)
+		cancelledJobs, err := cancelMatrixJobsByFailFast(ctx, jobs)
+		if err != nil {
+			return fmt.Errorf("cancel matrix jobs: %w", err)
+		}
+		for _, cancelledJob := range cancelledJobs {
+			cancelledJob.Run = run
+			for i, job := range jobs {
+				if job.ID == cancelledJob.ID {
+					jobs[i] = cancelledJob
+				}
+			}
+		}
+		updatedJobs = append(updatedJobs, cancelledJobs...)
+
+		// the expanded jobs of dynamic matrices are blocked, so resolve the jobs again until there is nothing to expand
+		for {
+			resolver := newJobStatusResolver(jobs, vars)
+			updates := resolver.Resolve(ctx)
+			for _, job := range jobs {
+				if status, ok := updates[job.ID]; ok {
+					job.Status = status
+					if n, err := actions_model.UpdateRunJob(ctx, job, builder.Eq{"status": actions_model.StatusBlocked}, "status"); err != nil {
+						return err
+					} else if n != 1 {
+						return fmt.Errorf("no affected for updating blocked job %v", job.ID)
+					}
+					updatedJobs = append(updatedJobs, job)
+				}
+			}
+			if len(resolver.matrixJobsToExpand) == 0 {
+				return nil
+			}
+
+			for _, job := range jobs {
+				if !resolver.matrixJobsToExpand.Contains(job.ID) {
+					continue
+				}
+				expandedJobs, err := expandDynamicMatrixJob(ctx, job, vars)
+				if err != nil {
+					return fmt.Errorf("expand dynamic matrix job %d: %w", job.ID, err)
+				}
+				for _, expandedJob := range expandedJobs {
+					if expandedJob.ID != job.ID {
+						jobs = append(jobs, expandedJob)
+					}
+				}
+				if job.Status.IsDone() {
+					updatedJobs = append(updatedJobs, job)
 				}
-				updatedJobs = append(updatedJobs, job)
 			}
 		}
-		return nil
 	}); err != nil {
 		return nil, nil, err
 	}
@@ -246,6 +286,9 @@ type jobStatusResolver struct {
 	needs    map[int64][]int64
 	jobMap   map[int64]*actions_model.ActionRunJob
 	vars     map[string]string
+
+	// matrixJobsToExpand are the placeholder jobs of dynamic matrices which can be expanded since their needs are done
+	matrixJobsToExpand container.Set[int64]
 }
 
 func newJobStatusResolver(jobs actions_model.ActionJobList, vars map[string]string) *jobStatusResolver {
@@ -271,6 +314,8 @@ func newJobStatusResolver(jobs actions_model.ActionJobList, vars map[string]stri
 		needs:    needs,
 		jobMap:   jobMap,
 		vars:     vars,
+
+		matrixJobsToExpand: make(container.Set[int64]),
 	}
 }
 
@@ -311,9 +356,37 @@ func (r *jobStatusResolver) resolveJobHasIfCondition(actionRunJob *actions_model
 	return hasIf
 }
 
+// resolveMaxParallelReached returns whether the job is a combination of an expanded dynamic matrix,
+// and the number of its sibling jobs which are waiting or running has reached the "max-parallel" of the strategy.
+func (r *jobStatusResolver) resolveMaxParallelReached(actionRunJob *actions_model.ActionRunJob, updated map[int64]actions_model.Status) bool {
+	if actionRunJob.DynamicMatrix != actions_model.DynamicMatrixExpanded {
+		return false
+	}
+	maxParallel := getMaxParallel(actionRunJob)
+	if maxParallel <= 0 {
+		return false
+	}
+	count := 0
+	for id, job := range r.jobMap {
+		if job.JobID != actionRunJob.JobID || job.DynamicMatrix != actions_model.DynamicMatrixExpanded {
+			continue
+		}
+		status := r.statuses[id]
+		if s, ok := updated[id]; ok {
+			status = s
+		}
+		if status.In(actions_model.StatusWaiting, actions_model.StatusRunning) {
+			count++
+		}
+	}
+	return count >= maxParallel
+}
+
 func (r *jobStatusResolver) resolve(ctx context.Context) map[int64]actions_model.Status {
 	ret := map[int64]actions_model.Status{}
-	for id, status := range r.statuses {
+	// resolve the jobs in the order of their IDs, so the combinations of a matrix are started in order when "max-parallel" is set
+	for _, id := range slices.Sorted(maps.Keys(r.statuses)) {
+		status := r.statuses[id]
 		actionRunJob := r.jobMap[id]
 		if status != actions_model.StatusBlocked {
 			continue
@@ -323,6 +396,16 @@ func (r *jobStatusResolver) resolve(ctx context.Context) map[int64]actions_model
 			continue
 		}
 
+		if actionRunJob.DynamicMatrix == actions_model.DynamicMatrixPending {
+			// the placeholder is skipped like other jobs, otherwise it will be expanded, then the expanded jobs will be resolved
+			if allSucceed || r.resolveJobHasIfCondition(actionRunJob) {
+				r.matrixJobsToExpand.Add(id)
+			} else {
+				ret[id] = actions_model.StatusSkipped
+			}
+			continue
+		}
+
 		// update concurrency and check whether the job can run now
 		err := updateConcurrencyEvaluationForJobWithNeeds(ctx, actionRunJob, r.vars)
 		if err != nil {
@@ -343,6 +426,9 @@ func (r *jobStatusResolver) resolve(ctx context.Context) map[int64]actions_model
 		}
 
 		newStatus := util.Iif(shouldStartJob, actions_model.StatusWaiting, actions_model.StatusSkipped)
+		if newStatus == actions_model.StatusWaiting && r.resolveMaxParallelReached(actionRunJob, ret) {
+			continue
+		}
 		if newStatus == actions_model.StatusWaiting {
 			newStatus, err = PrepareToStartJobWithConcurrency(ctx, actionRunJob)
 			if err != nil {
@@ -11,6 +11,20 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const maxParallelPayload = `
+name: test
+on: push
+jobs:
+  job2:
+    runs-on: ubuntu-latest
+    strategy:
+      max-parallel: 2
+      matrix:
+        version: [1]
+    steps:
+      - run: echo "version ${{ matrix.version }}"
+`
+
 func Test_jobStatusResolver_Resolve(t *testing.T) {
 	tests := []struct {
 		name string
@@ -126,6 +140,60 @@ jobs:
 			},
 			want: map[int64]actions_model.Status{2: actions_model.StatusSkipped},
 		},
+		{
+			name: "placeholder of dynamic matrix is expanded instead of started",
+			jobs: actions_model.ActionJobList{
+				{ID: 1, JobID: "job1", Status: actions_model.StatusSuccess, Needs: []string{}},
+				{ID: 2, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixPending},
+				{ID: 3, JobID: "job3", Status: actions_model.StatusBlocked, Needs: []string{"job2"}},
+			},
+			want: map[int64]actions_model.Status{},
+		},
+		{
+			name: "placeholder of dynamic matrix is skipped",
+			jobs: actions_model.ActionJobList{
+				{ID: 1, JobID: "job1", Status: actions_model.StatusFailure, Needs: []string{}},
+				{ID: 2, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixPending, WorkflowPayload: []byte(
+					`
+name: test
+on: push
+jobs:
+  job2:
+    runs-on: ubuntu-latest
+    strategy:
+      matrix: ${{ fromJSON(needs.job1.outputs.matrix) }}
+    steps:
+      - run: echo "should be skipped"
+`)},
+			},
+			want: map[int64]actions_model.Status{2: actions_model.StatusSkipped},
+		},
+		{
+			name: "max-parallel of expanded dynamic matrix",
+			jobs: actions_model.ActionJobList{
+				{ID: 1, JobID: "job1", Status: actions_model.StatusSuccess, Needs: []string{}},
+				{ID: 2, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+				{ID: 3, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+				{ID: 4, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+			},
+			want: map[int64]actions_model.Status{
+				2: actions_model.StatusWaiting,
+				3: actions_model.StatusWaiting,
+			},
+		},
+		{
+			name: "max-parallel of expanded dynamic matrix with running jobs",
+			jobs: actions_model.ActionJobList{
+				{ID: 1, JobID: "job1", Status: actions_model.StatusSuccess, Needs: []string{}},
+				{ID: 2, JobID: "job2", Status: actions_model.StatusSuccess, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+				{ID: 3, JobID: "job2", Status: actions_model.StatusRunning, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+				{ID: 4, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+				{ID: 5, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixExpanded, WorkflowPayload: []byte(maxParallelPayload)},
+			},
+			want: map[int64]actions_model.Status{
+				4: actions_model.StatusWaiting,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -134,3 +202,15 @@ jobs:
 		})
 	}
 }
+
+func Test_jobStatusResolver_matrixJobsToExpand(t *testing.T) {
+	jobs := actions_model.ActionJobList{
+		{ID: 1, JobID: "job1", Status: actions_model.StatusSuccess, Needs: []string{}},
+		{ID: 2, JobID: "job2", Status: actions_model.StatusBlocked, Needs: []string{"job1"}, DynamicMatrix: actions_model.DynamicMatrixPending},
+		{ID: 3, JobID: "job3", Status: actions_model.StatusBlocked, Needs: []string{"job4"}, DynamicMatrix: actions_model.DynamicMatrixPending},
+		{ID: 4, JobID: "job4", Status: actions_model.StatusRunning, Needs: []string{}},
+	}
+	r := newJobStatusResolver(jobs, nil)
+	assert.Empty(t, r.Resolve(t.Context()))
+	assert.Equal(t, []int64{2}, r.matrixJobsToExpand.Values())
+}
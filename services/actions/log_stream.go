@@ -0,0 +1,23 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"fmt"
+
+	"code.gitea.io/gitea/modules/eventsource"
+)
+
+// RunUpdateEventName is the name of the eventsource event sent when the jobs, steps or logs of a run are updated
+const RunUpdateEventName = "actions-run-update"
+
+// RunEventTopic returns the eventsource topic of the updates of a run
+func RunEventTopic(runID int64) string {
+	return fmt.Sprintf("actions-run-%d", runID)
+}
+
+// NotifyRunUpdated wakes up the log streams of a run, the event carries no data since the streams read the new state themselves
+func NotifyRunUpdated(runID int64) {
+	eventsource.GetManager().SendTopicMessage(RunEventTopic(runID), &eventsource.Event{Name: RunUpdateEventName})
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/nektos/act/pkg/jobparser"
+	act_model "github.com/nektos/act/pkg/model"
+	"gopkg.in/yaml.v3"
+	"xorm.io/builder"
+)
+
+const (
+	// maxMatrixCombinations is the max number of jobs a dynamic matrix can be expanded to, the same as GitHub
+	maxMatrixCombinations = 256
+	// maxMatrixAxesProduct is the max number of combinations of the axes of a dynamic matrix before the excluded ones are removed,
+	// so a huge matrix is rejected before its combinations are built
+	maxMatrixAxesProduct = 16 * maxMatrixCombinations
+)
+
+// needsExpressionRegexp matches the expressions which refer to the "needs" context, like "${{ fromJSON(needs.setup.outputs.matrix) }}"
+var needsExpressionRegexp = regexp.MustCompile(`\$\{\{[^}]*\bneeds\.`)
+
+// isDynamicMatrix returns whether the matrix depends on the outputs of the needed jobs,
+// such a matrix can't be expanded until the needed jobs are done.
+func isDynamicMatrix(matrix *yaml.Node) bool {
+	if matrix.IsZero() {
+		return false
+	}
+	content, err := yaml.Marshal(matrix)
+	if err != nil {
+		return false
+	}
+	return needsExpressionRegexp.Match(content)
+}
+
+// deferDynamicMatrixJobs restores the raw matrix and "runs-on" of the jobs whose matrix depends on the outputs of the needed jobs,
+// jobparser.Parse can't expand such a matrix, so it returns a single job with an empty matrix which is useless for the job emitter.
+func deferDynamicMatrixJobs(content []byte, jobs []*jobparser.SingleWorkflow) error {
+	origin, err := act_model.ReadWorkflow(bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("ReadWorkflow: %w", err)
+	}
+	for _, swf := range jobs {
+		id, job := swf.Job()
+		originJob := origin.GetJob(id)
+		if job == nil || originJob == nil || originJob.Strategy == nil || len(job.Needs()) == 0 || !isDynamicMatrix(&originJob.Strategy.RawMatrix) {
+			continue
+		}
+		job.Strategy.RawMatrix = originJob.Strategy.RawMatrix
+		job.RawRunsOn = originJob.RawRunsOn
+		if err := swf.SetJob(id, job); err != nil {
+			return fmt.Errorf("SetJob: %w", err)
+		}
+	}
+	return nil
+}
+
+// evaluateDynamicMatrix evaluates the matrix of a placeholder job with the outputs of the needed jobs,
+// and returns the single workflows of the combinations of the matrix.
+// The errors caused by the workflow, which the users have to fix, are util.ErrInvalidArgument.
+func evaluateDynamicMatrix(ctx context.Context, job *actions_model.ActionRunJob, vars map[string]string) ([]*jobparser.SingleWorkflow, error) {
+	if err := job.LoadAttributes(ctx); err != nil {
+		return nil, fmt.Errorf("job LoadAttributes: %w", err)
+	}
+
+	var swf jobparser.SingleWorkflow
+	if err := yaml.Unmarshal(job.WorkflowPayload, &swf); err != nil {
+		return nil, util.NewInvalidArgumentErrorf("unmarshal workflow payload: %v", err)
+	}
+	id, workflowJob := swf.Job()
+	if workflowJob == nil {
+		return nil, util.NewInvalidArgumentErrorf("workflow payload doesn't contain a job")
+	}
+
+	jobResults, err := findJobNeedsAndFillJobResults(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("find job needs and fill job results: %w", err)
+	}
+	inputs, err := getInputsFromRun(job.Run)
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("get inputs: %v", err)
+	}
+	giteaCtx := GenerateGiteaContext(job.Run, job)
+	gitCtx := giteaCtx.ToGitHubContext()
+
+	actJob := &act_model.Job{
+		Strategy: &act_model.Strategy{
+			FailFastString:    workflowJob.Strategy.FailFastString,
+			MaxParallelString: workflowJob.Strategy.MaxParallelString,
+		},
+	}
+	evaluator := jobparser.NewExpressionEvaluator(jobparser.NewInterpeter(id, actJob, nil, gitCtx, jobResults, vars, inputs))
+	matrix := workflowJob.Strategy.RawMatrix
+	if err := evaluator.EvaluateYamlNode(&matrix); err != nil {
+		return nil, util.NewInvalidArgumentErrorf("evaluate matrix: %v", err)
+	}
+	if matrix.Kind != yaml.MappingNode || len(matrix.Content) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("matrix is not a non-empty mapping")
+	}
+	if n := countMatrixAxesProduct(&matrix); n > maxMatrixAxesProduct {
+		return nil, util.NewInvalidArgumentErrorf("matrix has more than %d combinations", maxMatrixCombinations)
+	}
+
+	workflowJob.Strategy.RawMatrix = matrix
+	if err := swf.SetJob(id, workflowJob); err != nil {
+		return nil, fmt.Errorf("SetJob: %w", err)
+	}
+	content, err := swf.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal workflow: %w", err)
+	}
+	swfs, err := jobparser.Parse(content, jobparser.WithVars(vars), jobparser.WithGitContext(gitCtx), jobparser.WithInputs(inputs))
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("parse workflow: %v", err)
+	}
+	if len(swfs) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("matrix has no combinations")
+	}
+	if len(swfs) > maxMatrixCombinations {
+		return nil, util.NewInvalidArgumentErrorf("matrix has %d combinations, more than %d", len(swfs), maxMatrixCombinations)
+	}
+	return swfs, nil
+}
+
+// countMatrixAxesProduct returns the number of combinations of the axes of an evaluated matrix and of its included combinations,
+// the excluded combinations aren't removed. The count stops growing once it is larger than maxMatrixAxesProduct.
+func countMatrixAxesProduct(matrix *yaml.Node) int {
+	product, included := 1, 0
+	for i := 0; i+1 < len(matrix.Content); i += 2 {
+		key, value := matrix.Content[i].Value, matrix.Content[i+1]
+		if value.Kind != yaml.SequenceNode {
+			continue
+		}
+		switch key {
+		case "include":
+			included = len(value.Content)
+		case "exclude":
+		default:
+			product = min(product*len(value.Content), maxMatrixAxesProduct+1)
+		}
+	}
+	return product + included
+}
+
+// expandDynamicMatrixJob expands a placeholder job to the jobs of the combinations of its matrix.
+// The placeholder becomes the first combination and the others are inserted, all of them are blocked to be started by the job emitter.
+// If the matrix can't be evaluated, the placeholder fails with the error as its message, it will be expanded again if it is rerun.
+// It returns the updated placeholder and the inserted jobs.
+func expandDynamicMatrixJob(ctx context.Context, job *actions_model.ActionRunJob, vars map[string]string) ([]*actions_model.ActionRunJob, error) {
+	swfs, err := evaluateDynamicMatrix(ctx, job, vars)
+	if errors.Is(err, util.ErrInvalidArgument) {
+		log.Error("evaluateDynamicMatrix failed, this job will fail: job: %d, err: %v", job.ID, err)
+		job.Status = actions_model.StatusFailure
+		job.Stopped = timeutil.TimeStampNow()
+		job.ErrorMessage = err.Error()
+		if _, err := actions_model.UpdateRunJob(ctx, job, builder.Eq{"status": actions_model.StatusBlocked}, "status", "stopped", "error_message"); err != nil {
+			return nil, err
+		}
+		return []*actions_model.ActionRunJob{job}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*actions_model.ActionRunJob, 0, len(swfs))
+	for i, swf := range swfs {
+		id, workflowJob := swf.Job()
+		payload, err := swf.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("marshal workflow: %w", err)
+		}
+
+		runJob := job
+		if i > 0 {
+			runJob = &actions_model.ActionRunJob{
+				RunID:             job.RunID,
+				Run:               job.Run,
+				RepoID:            job.RepoID,
+				OwnerID:           job.OwnerID,
+				CommitSHA:         job.CommitSHA,
+				IsForkPullRequest: job.IsForkPullRequest,
+				Attempt:           job.Attempt,
+				JobID:             id,
+				Needs:             job.Needs,
+				RawConcurrency:    job.RawConcurrency,
+			}
+		}
+		runJob.Name = util.EllipsisDisplayString(workflowJob.Name, 255)
+		runJob.WorkflowPayload = payload
+		runJob.RunsOn = workflowJob.RunsOn()
+		runJob.Status = actions_model.StatusBlocked
+		runJob.DynamicMatrix = actions_model.DynamicMatrixExpanded
+
+		if i == 0 {
+			if n, err := actions_model.UpdateRunJob(ctx, runJob, builder.Eq{"status": actions_model.StatusBlocked}, "name", "workflow_payload", "runs_on", "dynamic_matrix"); err != nil {
+				return nil, err
+			} else if n != 1 {
+				return nil, fmt.Errorf("no affected for expanding blocked job %v", runJob.ID)
+			}
+		} else if err := db.Insert(ctx, runJob); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, runJob)
+	}
+	return jobs, nil
+}
+
+// getMaxParallel returns the "max-parallel" of the strategy of the job, 0 means unlimited
+func getMaxParallel(job *actions_model.ActionRunJob) int {
+	workflowJob, err := job.ParseJob()
+	if err != nil || workflowJob.Strategy.MaxParallelString == "" {
+		return 0
+	}
+	maxParallel, err := strconv.Atoi(workflowJob.Strategy.MaxParallelString)
+	if err != nil || maxParallel < 0 {
+		return 0
+	}
+	return maxParallel
+}
+
+// isFailFast returns the "fail-fast" of the strategy of the job, it is true by default
+func isFailFast(job *actions_model.ActionRunJob) bool {
+	workflowJob, err := job.ParseJob()
+	if err != nil || workflowJob.Strategy.FailFastString == "" {
+		return true
+	}
+	failFast, err := strconv.ParseBool(workflowJob.Strategy.FailFastString)
+	return err != nil || failFast
+}
+
+// cancelMatrixJobsByFailFast cancels the jobs of the expanded dynamic matrices which have a failed job and "fail-fast" is enabled.
+// It returns the cancelled jobs.
+func cancelMatrixJobsByFailFast(ctx context.Context, jobs []*actions_model.ActionRunJob) ([]*actions_model.ActionRunJob, error) {
+	failedJobs := make(map[string]*actions_model.ActionRunJob)
+	for _, job := range jobs {
+		if job.DynamicMatrix == actions_model.DynamicMatrixExpanded && job.Status == actions_model.StatusFailure {
+			failedJobs[job.JobID] = job
+		}
+	}
+
+	var toCancel []*actions_model.ActionRunJob
+	for jobID, failedJob := range failedJobs {
+		if !isFailFast(failedJob) {
+			continue
+		}
+		for _, job := range jobs {
+			if job.JobID == jobID && job.DynamicMatrix == actions_model.DynamicMatrixExpanded && !job.Status.IsDone() {
+				toCancel = append(toCancel, job)
+			}
+		}
+	}
+	if len(toCancel) == 0 {
+		return nil, nil
+	}
+	return actions_model.CancelJobs(ctx, toCancel)
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+
+	"github.com/nektos/act/pkg/jobparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_deferDynamicMatrixJobs(t *testing.T) {
+	content := []byte(`
+name: test
+on: push
+jobs:
+  setup:
+    runs-on: ubuntu-latest
+    outputs:
+      matrix: ${{ steps.gen.outputs.matrix }}
+    steps:
+      - id: gen
+        run: echo "matrix={}" >> "$GITHUB_OUTPUT"
+  static:
+    runs-on: ${{ matrix.os }}
+    needs: setup
+    strategy:
+      matrix:
+        os: [linux, windows]
+    steps:
+      - run: echo ${{ matrix.os }}
+  dynamic:
+    runs-on: ${{ matrix.os }}
+    needs: setup
+    strategy:
+      max-parallel: 2
+      matrix: ${{ fromJSON(needs.setup.outputs.matrix) }}
+    steps:
+      - run: echo ${{ matrix.os }}
+`)
+	jobs, err := jobparser.Parse(content)
+	require.NoError(t, err)
+	require.NoError(t, deferDynamicMatrixJobs(content, jobs))
+	require.Len(t, jobs, 4)
+
+	dynamicCount := 0
+	for _, swf := range jobs {
+		id, job := swf.Job()
+		if isDynamicMatrix(&job.Strategy.RawMatrix) {
+			dynamicCount++
+			assert.Equal(t, "dynamic", id)
+			assert.Equal(t, []string{"${{ matrix.os }}"}, job.RunsOn())
+			assert.Equal(t, "2", job.Strategy.MaxParallelString)
+		} else if id == "static" {
+			assert.NotEqual(t, []string{"${{ matrix.os }}"}, job.RunsOn())
+		}
+	}
+	assert.Equal(t, 1, dynamicCount)
+}
+
+// insertDynamicMatrixPlaceholder inserts the placeholder job of a dynamic matrix, its matrix is replaced by the given one if it isn't empty
+func insertDynamicMatrixPlaceholder(t *testing.T, matrix string) *actions_model.ActionRunJob {
+	content := []byte(`
+name: test
+on: push
+jobs:
+  setup:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo "no matrix"
+  dynamic:
+    runs-on: ${{ matrix.os }}
+    needs: setup
+    strategy:
+      matrix: ${{ fromJSON(needs.setup.outputs.matrix) }}
+    steps:
+      - run: echo ${{ matrix.os }}
+`)
+	swfs, err := jobparser.Parse(content)
+	require.NoError(t, err)
+	require.NoError(t, deferDynamicMatrixJobs(content, swfs))
+	var payload []byte
+	for _, swf := range swfs {
+		if id, job := swf.Job(); id == "dynamic" {
+			if matrix != "" {
+				var node yaml.Node
+				require.NoError(t, yaml.Unmarshal([]byte(matrix), &node))
+				job.Strategy.RawMatrix = *node.Content[0]
+				require.NoError(t, swf.SetJob(id, job))
+			}
+			payload, err = swf.Marshal()
+			require.NoError(t, err)
+		}
+	}
+	require.NotEmpty(t, payload)
+
+	run := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRun{ID: 791})
+	job := &actions_model.ActionRunJob{
+		RunID:           run.ID,
+		RepoID:          run.RepoID,
+		OwnerID:         run.OwnerID,
+		CommitSHA:       run.CommitSHA,
+		Name:            "dynamic",
+		JobID:           "dynamic",
+		Needs:           []string{"setup"},
+		WorkflowPayload: payload,
+		Status:          actions_model.StatusBlocked,
+		DynamicMatrix:   actions_model.DynamicMatrixPending,
+	}
+	require.NoError(t, db.Insert(t.Context(), job))
+	return job
+}
+
+func Test_expandDynamicMatrixJobFailure(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	axis := func(n int) string {
+		values := make([]string, n)
+		for i := range values {
+			values[i] = strconv.Itoa(i)
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	}
+	cases := []struct {
+		name    string
+		matrix  string
+		message string
+	}{
+		// the needed job has no "matrix" output, so the matrix can't be evaluated
+		{name: "NoOutput", message: "matrix"},
+		{name: "TooManyJobs", matrix: fmt.Sprintf("{os: %s, arch: %s}", axis(17), axis(17)), message: "289 combinations"},
+		{name: "TooManyAxesCombinations", matrix: fmt.Sprintf("{os: %s, arch: %s, go: %s}", axis(20), axis(20), axis(20)), message: "more than 256 combinations"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			job := insertDynamicMatrixPlaceholder(t, c.matrix)
+			jobs, err := expandDynamicMatrixJob(t.Context(), job, nil)
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+
+			job = unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{ID: job.ID})
+			assert.Equal(t, actions_model.StatusFailure, job.Status)
+			assert.NotZero(t, job.Stopped)
+			assert.Contains(t, job.ErrorMessage, c.message)
+			assert.Equal(t, actions_model.DynamicMatrixPending, job.DynamicMatrix)
+			unittest.AssertCount(t, &actions_model.ActionRunJob{RunID: job.RunID, JobID: "dynamic"}, 1)
+			_, err = db.DeleteByID[actions_model.ActionRunJob](t.Context(), job.ID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_countMatrixAxesProduct(t *testing.T) {
+	var matrix yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(`{os: [a, b, c], arch: [x, y], include: [{os: d}], exclude: [{os: a}], name: single}`), &matrix))
+	assert.Equal(t, 7, countMatrixAxesProduct(matrix.Content[0]))
+}
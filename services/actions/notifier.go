@@ -12,6 +12,7 @@ import (
 	packages_model "code.gitea.io/gitea/models/packages"
 	perm_model "code.gitea.io/gitea/models/perm"
 	access_model "code.gitea.io/gitea/models/perm/access"
+	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/git"
@@ -20,9 +21,11 @@ import (
 	"code.gitea.io/gitea/modules/repository"
 	"code.gitea.io/gitea/modules/setting"
 	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
 	webhook_module "code.gitea.io/gitea/modules/webhook"
 	"code.gitea.io/gitea/services/convert"
 	notify_service "code.gitea.io/gitea/services/notify"
+	project_service "code.gitea.io/gitea/services/projects"
 )
 
 type actionsNotifier struct {
@@ -556,6 +559,13 @@ func (n *actionsNotifier) PushCommits(ctx context.Context, pusher *user_model.Us
 
 	ctx = withMethod(ctx, "PushCommits")
 
+	if opts.RefFullName.IsBranch() && opts.RefFullName.BranchName() == repo.DefaultBranch {
+		// the repository may store the workflow files of required workflows
+		if err := updateRequiredWorkflowsOfRepo(ctx, repo); err != nil {
+			log.Error("updateRequiredWorkflowsOfRepo: %v", err)
+		}
+	}
+
 	apiPusher := convert.ToUser(ctx, pusher, nil)
 	apiCommits, apiHeadCommit, err := commits.ToAPIPayloadCommits(ctx, repo)
 	if err != nil {
@@ -680,6 +690,83 @@ func (n *actionsNotifier) PackageDelete(ctx context.Context, doer *user_model.Us
 	notifyPackage(ctx, doer, pd, api.HookPackageDeleted)
 }
 
+func (n *actionsNotifier) NewProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	ctx = withMethod(ctx, "NewProject")
+	notifyProject(ctx, doer, project, api.HookProjectCreated)
+}
+
+func (n *actionsNotifier) UpdateProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	ctx = withMethod(ctx, "UpdateProject")
+	notifyProject(ctx, doer, project, api.HookProjectEdited)
+}
+
+func (n *actionsNotifier) ChangeProjectStatus(ctx context.Context, doer *user_model.User, project *project_model.Project, isClosed bool) {
+	ctx = withMethod(ctx, "ChangeProjectStatus")
+	notifyProject(ctx, doer, project, util.Iif(isClosed, api.HookProjectClosed, api.HookProjectReopened))
+}
+
+func (n *actionsNotifier) DeleteProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	ctx = withMethod(ctx, "DeleteProject")
+	notifyProject(ctx, doer, project, api.HookProjectDeleted)
+}
+
+// notifyProject triggers the workflows of the repository of a project, the projects of users and organizations don't trigger any workflow
+func notifyProject(ctx context.Context, doer *user_model.User, project *project_model.Project, action api.HookProjectAction) {
+	if project.RepoID == 0 {
+		return
+	}
+	if err := project.LoadRepo(ctx); err != nil {
+		log.Error("LoadRepo: %v", err)
+		return
+	}
+	if action != api.HookProjectDeleted {
+		if err := project_service.LoadIssueNumbersForProject(ctx, project, nil); err != nil {
+			log.Error("LoadIssueNumbersForProject: %v", err)
+			return
+		}
+	}
+
+	newNotifyInput(project.Repo, doer, webhook_module.HookEventProject).
+		WithPayload(&api.ProjectPayload{
+			Action:     action,
+			Project:    convert.ToAPIProject(ctx, project, nil),
+			Repository: convert.ToRepo(ctx, project.Repo, access_model.Permission{AccessMode: perm_model.AccessModeNone}),
+			Sender:     convert.ToUser(ctx, doer, nil),
+		}).
+		Notify(ctx)
+}
+
+func (n *actionsNotifier) IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, project *project_model.Project, oldColumn, newColumn *project_model.Column) {
+	ctx = withMethod(ctx, "IssueChangeProjectColumn")
+
+	if err := issue.LoadRepo(ctx); err != nil {
+		log.Error("LoadRepo: %v", err)
+		return
+	}
+
+	payload := &api.ProjectCardPayload{
+		Action:     api.HookProjectCardMoved,
+		Project:    convert.ToAPIProject(ctx, project, nil),
+		Issue:      convert.ToAPIIssue(ctx, doer, issue),
+		Repository: convert.ToRepo(ctx, issue.Repo, access_model.Permission{AccessMode: perm_model.AccessModeNone}),
+		Sender:     convert.ToUser(ctx, doer, nil),
+	}
+	if oldColumn == nil {
+		payload.Action = api.HookProjectCardCreated
+	} else {
+		payload.OldColumn = convert.ToAPIProjectColumn(oldColumn)
+	}
+	if newColumn == nil {
+		payload.Action = api.HookProjectCardDeleted
+	} else {
+		payload.Column = convert.ToAPIProjectColumn(newColumn)
+	}
+
+	newNotifyInput(issue.Repo, doer, webhook_module.HookEventProjectCard).
+		WithPayload(payload).
+		Notify(ctx)
+}
+
 func (n *actionsNotifier) AutoMergePullRequest(ctx context.Context, doer *user_model.User, pr *issues_model.PullRequest) {
 	ctx = withMethod(ctx, "AutoMergePullRequest")
 	n.MergePullRequest(ctx, doer, pr)
@@ -788,8 +875,13 @@ func (n *actionsNotifier) MigrateRepository(ctx context.Context, doer, u *user_m
 	}).Notify(ctx)
 }
 
+func (n *actionsNotifier) WorkflowJobStatusUpdate(ctx context.Context, repo *repo_model.Repository, sender *user_model.User, job *actions_model.ActionRunJob, task *actions_model.ActionTask) {
+	NotifyRunUpdated(job.RunID)
+}
+
 func (n *actionsNotifier) WorkflowRunStatusUpdate(ctx context.Context, repo *repo_model.Repository, sender *user_model.User, run *actions_model.ActionRun) {
 	ctx = withMethod(ctx, "WorkflowRunStatusUpdate")
+	NotifyRunUpdated(run.ID)
 
 	var org *api.Organization
 	if repo.Owner.IsOrganization() {
@@ -0,0 +1,233 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	actions_module "code.gitea.io/gitea/modules/actions"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/gitrepo"
+	"code.gitea.io/gitea/modules/glob"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/nektos/act/pkg/jobparser"
+)
+
+// CreateRequiredWorkflowOptions are the options to create a required workflow of an organization
+type CreateRequiredWorkflowOptions struct {
+	RepoName     string // the designated repository of the organization
+	WorkflowPath string
+	RepoPattern  string
+	Topics       []string
+}
+
+// CreateRequiredWorkflow validates the options and creates a required workflow of the organization
+func CreateRequiredWorkflow(ctx context.Context, org, doer *user_model.User, opts CreateRequiredWorkflowOptions) (*actions_model.ActionRequiredWorkflow, error) {
+	if !org.IsOrganization() {
+		return nil, util.NewInvalidArgumentErrorf("required workflows are only supported by organizations")
+	}
+	repo, err := repo_model.GetRepositoryByName(ctx, org.ID, opts.RepoName)
+	if err != nil {
+		if repo_model.IsErrRepoNotExist(err) {
+			return nil, util.NewInvalidArgumentErrorf("repository %q does not exist in the organization", opts.RepoName)
+		}
+		return nil, err
+	}
+	workflowPath := strings.TrimPrefix(path.Clean("/"+strings.TrimSpace(opts.WorkflowPath)), "/")
+	if !actions_module.IsWorkflow(workflowPath) {
+		return nil, util.NewInvalidArgumentErrorf("%q is not a workflow file in .gitea/workflows or .github/workflows", workflowPath)
+	}
+	repoPattern := strings.TrimSpace(opts.RepoPattern)
+	if _, err := glob.Compile(repoPattern); err != nil {
+		return nil, util.NewInvalidArgumentErrorf("invalid repository pattern %q: %v", repoPattern, err)
+	}
+	topics, invalidTopics := repo_model.SanitizeAndValidateTopics(opts.Topics)
+	if len(invalidTopics) > 0 {
+		return nil, util.NewInvalidArgumentErrorf("invalid topics: %s", strings.Join(invalidTopics, ", "))
+	}
+
+	rw := &actions_model.ActionRequiredWorkflow{
+		OwnerID:      org.ID,
+		RepoID:       repo.ID,
+		Repo:         repo,
+		WorkflowPath: workflowPath,
+		RepoPattern:  repoPattern,
+		Topics:       topics,
+		CreatedBy:    doer.ID,
+	}
+	content, commitID, err := readRequiredWorkflowContent(ctx, rw)
+	if err != nil {
+		return nil, err
+	}
+	events, err := actions_module.GetEventsFromContent(content)
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("invalid workflow %q: %v", workflowPath, err)
+	}
+	rw.CommitSHA = commitID
+	rw.TriggeredByPullRequest = isTriggeredByPullRequest(events)
+
+	if has, err := db.GetEngine(ctx).Exist(&actions_model.ActionRequiredWorkflow{OwnerID: org.ID, RepoID: repo.ID, WorkflowPath: workflowPath}); err != nil {
+		return nil, err
+	} else if has {
+		return nil, util.NewAlreadyExistErrorf("workflow %q of repository %q is already required", workflowPath, repo.Name)
+	}
+	if err := db.Insert(ctx, rw); err != nil {
+		return nil, err
+	}
+	return rw, nil
+}
+
+// readRequiredWorkflowContent reads the workflow file of the required workflow from the default branch of its designated repository.
+// It also returns the ID of the commit of the default branch, even if the workflow file doesn't exist in it.
+func readRequiredWorkflowContent(ctx context.Context, rw *actions_model.ActionRequiredWorkflow) ([]byte, string, error) {
+	if err := rw.LoadRepo(ctx); err != nil {
+		return nil, "", fmt.Errorf("LoadRepo: %w", err)
+	}
+	gitRepo, err := gitrepo.OpenRepository(ctx, rw.Repo)
+	if err != nil {
+		return nil, "", fmt.Errorf("OpenRepository: %w", err)
+	}
+	defer gitRepo.Close()
+
+	commit, err := gitRepo.GetBranchCommit(rw.Repo.DefaultBranch)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return nil, "", util.NewNotExistErrorf("default branch of repository %q does not exist", rw.Repo.Name)
+		}
+		return nil, "", fmt.Errorf("GetBranchCommit: %w", err)
+	}
+	commitID := commit.ID.String()
+	entry, err := commit.GetTreeEntryByPath(rw.WorkflowPath)
+	if err != nil {
+		if git.IsErrNotExist(err) {
+			return nil, commitID, util.NewNotExistErrorf("workflow %q does not exist in repository %q", rw.WorkflowPath, rw.Repo.Name)
+		}
+		return nil, "", fmt.Errorf("GetTreeEntryByPath: %w", err)
+	}
+	content, err := actions_module.GetContentFromEntry(entry)
+	if err != nil {
+		return nil, "", err
+	}
+	return content, commitID, nil
+}
+
+// isTriggeredByPullRequest returns whether the events of a workflow contain the pull request events
+func isTriggeredByPullRequest(events []*jobparser.Event) bool {
+	for _, evt := range events {
+		if evt.Name == actions_module.GithubEventPullRequest || evt.Name == actions_module.GithubEventPullRequestTarget {
+			return true
+		}
+	}
+	return false
+}
+
+// updateRequiredWorkflowTrigger reads the workflow file of the required workflow and stores whether it is triggered by pull requests.
+// A workflow file which doesn't exist or is invalid isn't triggered by pull requests.
+func updateRequiredWorkflowTrigger(ctx context.Context, rw *actions_model.ActionRequiredWorkflow) error {
+	content, commitID, err := readRequiredWorkflowContent(ctx, rw)
+	if err != nil && !errors.Is(err, util.ErrNotExist) {
+		return err
+	}
+	rw.CommitSHA = commitID
+	rw.TriggeredByPullRequest = false
+	if err == nil {
+		events, err := actions_module.GetEventsFromContent(content)
+		if err != nil {
+			log.Warn("required workflow %d of org %d is invalid: %v", rw.ID, rw.OwnerID, err)
+		} else {
+			rw.TriggeredByPullRequest = isTriggeredByPullRequest(events)
+		}
+	}
+	return actions_model.UpdateRequiredWorkflowTrigger(ctx, rw)
+}
+
+// updateRequiredWorkflowsOfRepo updates the triggers of the required workflows whose workflow files are in the repository,
+// it is called when the default branch of the repository has been pushed to.
+func updateRequiredWorkflowsOfRepo(ctx context.Context, repo *repo_model.Repository) error {
+	rws, err := db.Find[actions_model.ActionRequiredWorkflow](ctx, actions_model.FindRequiredWorkflowsOptions{RepoID: repo.ID})
+	if err != nil {
+		return err
+	}
+	for _, rw := range rws {
+		rw.Repo = repo
+		if err := updateRequiredWorkflowTrigger(ctx, rw); err != nil {
+			return fmt.Errorf("update required workflow %d: %w", rw.ID, err)
+		}
+	}
+	return nil
+}
+
+// detectRequiredWorkflows detects the required workflows of the organization which target the repository and match the event.
+// A required workflow which can't be read is ignored, so the problem of the designated repository doesn't break the other workflows.
+func detectRequiredWorkflows(ctx context.Context, input *notifyInput, gitRepo *git.Repository, commit *git.Commit) ([]*actions_module.DetectedWorkflow, error) {
+	if err := input.Repo.LoadOwner(ctx); err != nil {
+		return nil, fmt.Errorf("LoadOwner: %w", err)
+	}
+	if !input.Repo.Owner.IsOrganization() {
+		return nil, nil
+	}
+	rws, err := actions_model.GetRequiredWorkflowsTargeting(ctx, input.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRequiredWorkflowsTargeting: %w", err)
+	}
+
+	var workflows []*actions_module.DetectedWorkflow
+	for _, rw := range rws {
+		content, _, err := readRequiredWorkflowContent(ctx, rw)
+		if err != nil {
+			log.Error("read required workflow %d of org %d: %v", rw.ID, rw.OwnerID, err)
+			continue
+		}
+		dwfs, err := actions_module.DetectWorkflowFromContent(gitRepo, commit, rw.WorkflowID(), content, input.Event, input.Payload)
+		if err != nil {
+			log.Warn("ignore invalid required workflow %d of org %d: %v", rw.ID, rw.OwnerID, err)
+			continue
+		}
+		for _, dwf := range dwfs {
+			dwf.RequiredWorkflowID = rw.ID
+		}
+		workflows = append(workflows, dwfs...)
+	}
+	return workflows, nil
+}
+
+// GetRequiredWorkflowStatusContexts returns the patterns of the commit status contexts of the required workflows which target the repository
+// and are triggered by pull requests, so their results are required to merge the pull requests into the protected branches.
+// The triggers of the required workflows are stored, their workflow files are only read if it hasn't been done yet.
+func GetRequiredWorkflowStatusContexts(ctx context.Context, repo *repo_model.Repository) ([]string, error) {
+	rws, err := actions_model.GetRequiredWorkflowsTargeting(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRequiredWorkflowsTargeting: %w", err)
+	}
+	if err := actions_model.RequiredWorkflowList(rws).LoadRepos(ctx); err != nil {
+		return nil, fmt.Errorf("LoadRepos: %w", err)
+	}
+
+	var contexts []string
+	for _, rw := range rws {
+		if rw.Repo == nil {
+			continue
+		}
+		if rw.CommitSHA == "" {
+			if err := updateRequiredWorkflowTrigger(ctx, rw); err != nil {
+				log.Error("update required workflow %d of org %d: %v", rw.ID, rw.OwnerID, err)
+				continue
+			}
+		}
+		if rw.TriggeredByPullRequest {
+			contexts = append(contexts, rw.StatusContextPattern())
+		}
+	}
+	return contexts, nil
+}
@@ -48,6 +48,11 @@ func PrepareRunAndInsert(ctx context.Context, content []byte, run *actions_model
 		return fmt.Errorf("parse workflow: %w", err)
 	}
 
+	// the jobs whose matrix depends on the outputs of the needed jobs will be expanded by job emitter later
+	if err = deferDynamicMatrixJobs(content, jobs); err != nil {
+		return fmt.Errorf("deferDynamicMatrixJobs: %w", err)
+	}
+
 	if len(jobs) > 0 && jobs[0].RunName != "" {
 		run.Title = jobs[0].RunName
 	}
@@ -127,6 +132,9 @@ func InsertRun(ctx context.Context, run *actions_model.ActionRun, jobs []*jobpar
 				RunsOn:            job.RunsOn(),
 				Status:            util.Iif(shouldBlockJob, actions_model.StatusBlocked, actions_model.StatusWaiting),
 			}
+			if len(needs) > 0 && isDynamicMatrix(&job.Strategy.RawMatrix) {
+				runJob.DynamicMatrix = actions_model.DynamicMatrixPending
+			}
 			// check job concurrency
 			if job.RawConcurrency != nil {
 				rawConcurrency, err := yaml.Marshal(job.RawConcurrency)
@@ -0,0 +1,78 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/modules/json"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	gouuid "github.com/google/uuid"
+)
+
+// JITRunnerConfig is the state file (.runner) of act_runner for a pre-registered runner,
+// a runner started with it doesn't need to register with a registration token.
+type JITRunnerConfig struct {
+	ID        int64    `json:"id"`
+	UUID      string   `json:"uuid"`
+	Name      string   `json:"name"`
+	Token     string   `json:"token"`
+	Address   string   `json:"address"`
+	Labels    []string `json:"labels"`
+	Ephemeral bool     `json:"ephemeral"`
+}
+
+// Encode returns the base64 encoded JSON of the config
+func (c *JITRunnerConfig) Encode() (string, error) {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
+
+// CreateJITRunnerOptions represents the options of a just-in-time runner
+type CreateJITRunnerOptions struct {
+	OwnerID int64
+	RepoID  int64
+	Name    string
+	Labels  []string
+	Timeout time.Duration // the runner is deleted if it hasn't connected after this duration, 0 means never
+}
+
+// CreateJITRunner registers an ephemeral runner which could only run one job,
+// the returned config contains the token of the runner and can't be retrieved later.
+func CreateJITRunner(ctx context.Context, opts CreateJITRunnerOptions) (*actions_model.ActionRunner, *JITRunnerConfig, error) {
+	runner := &actions_model.ActionRunner{
+		UUID:        gouuid.New().String(),
+		Name:        opts.Name,
+		OwnerID:     opts.OwnerID,
+		RepoID:      opts.RepoID,
+		AgentLabels: opts.Labels,
+		Ephemeral:   true,
+	}
+	if opts.Timeout > 0 {
+		runner.Expires = timeutil.TimeStamp(time.Now().Add(opts.Timeout).Unix())
+	}
+	if err := runner.GenerateToken(); err != nil {
+		return nil, nil, err
+	}
+	if err := actions_model.CreateRunner(ctx, runner); err != nil {
+		return nil, nil, err
+	}
+	return runner, &JITRunnerConfig{
+		ID:        runner.ID,
+		UUID:      runner.UUID,
+		Name:      runner.Name,
+		Token:     runner.Token,
+		Address:   setting.AppURL,
+		Labels:    runner.AgentLabels,
+		Ephemeral: true,
+	}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/gitea/modules/process"
+	"code.gitea.io/gitea/modules/setting"
+)
+
+func init() {
+	RegisterProvider("exec", newExecProvider)
+}
+
+// execProvider starts and stops the runners by running the commands of the settings
+type execProvider struct {
+	cfg *setting.ActionsScaler
+}
+
+func newExecProvider(_ context.Context, cfg *setting.ActionsScaler) (Provider, error) {
+	if cfg.ExecStartCommand == "" {
+		return nil, errors.New("no start command is configured for the exec provider")
+	}
+	return &execProvider{cfg: cfg}, nil
+}
+
+func (p *execProvider) StartRunner(ctx context.Context, runner *Runner) (string, error) {
+	env := append(os.Environ(),
+		"GITEA_INSTANCE_URL="+runner.Address,
+		"GITEA_RUNNER_ID="+strconv.FormatInt(runner.ID, 10),
+		"GITEA_RUNNER_UUID="+runner.UUID,
+		"GITEA_RUNNER_NAME="+runner.Name,
+		"GITEA_RUNNER_TOKEN="+runner.Token,
+		"GITEA_RUNNER_LABELS="+strings.Join(runner.Labels, ","),
+		"GITEA_RUNNER_JIT_CONFIG="+runner.JITConfig,
+	)
+	stdout, _, err := process.GetManager().ExecDirEnv(ctx, p.cfg.ExecTimeout, "",
+		fmt.Sprintf("Start runner %s", runner.Name), env, p.cfg.ExecStartCommand, p.cfg.ExecStartArgs...)
+	if err != nil {
+		return "", err
+	}
+	instanceID, _, _ := strings.Cut(strings.TrimSpace(stdout), "\n")
+	return strings.TrimSpace(instanceID), nil
+}
+
+func (p *execProvider) StopRunner(ctx context.Context, runnerID int64, instanceID string) error {
+	if p.cfg.ExecStopCommand == "" {
+		return nil
+	}
+	env := append(os.Environ(),
+		"GITEA_RUNNER_ID="+strconv.FormatInt(runnerID, 10),
+		"GITEA_RUNNER_INSTANCE_ID="+instanceID,
+	)
+	_, _, err := process.GetManager().ExecDirEnv(ctx, p.cfg.ExecTimeout, "",
+		fmt.Sprintf("Stop runner %d", runnerID), env, p.cfg.ExecStopCommand, p.cfg.ExecStopArgs...)
+	return err
+}
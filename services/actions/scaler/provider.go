@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/gitea/modules/setting"
+	actions_service "code.gitea.io/gitea/services/actions"
+)
+
+// Runner represents a pre-registered ephemeral runner to be started by a provider
+type Runner struct {
+	*actions_service.JITRunnerConfig
+	JITConfig string // the base64 encoded config, it can be used as the .runner file of act_runner
+}
+
+// Provider starts and stops the runners of the runner scaler
+type Provider interface {
+	// StartRunner starts an instance running the runner and returns the ID of the instance
+	StartRunner(ctx context.Context, runner *Runner) (string, error)
+	// StopRunner stops the instance of a runner, it shouldn't fail if the instance has exited already
+	StopRunner(ctx context.Context, runnerID int64, instanceID string) error
+}
+
+// NewProviderFunc creates a provider with the settings of the runner scaler
+type NewProviderFunc func(ctx context.Context, cfg *setting.ActionsScaler) (Provider, error)
+
+var providerMap = map[string]NewProviderFunc{}
+
+// RegisterProvider registers a provider with a function to create it
+func RegisterProvider(name string, fn NewProviderFunc) {
+	providerMap[name] = fn
+}
+
+// NewProvider creates the provider configured by the settings
+func NewProvider(ctx context.Context, cfg *setting.ActionsScaler) (Provider, error) {
+	fn, ok := providerMap[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported runner scaler provider %q", cfg.Provider)
+	}
+	return fn(ctx, cfg)
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+	actions_service "code.gitea.io/gitea/services/actions"
+)
+
+// decisionRetention is how long the decisions are kept to be shown in the admin runners page
+const decisionRetention = 7 * 24 * time.Hour
+
+type scaledRunnerState int
+
+const (
+	scaledRunnerStarting scaledRunnerState = iota // the runner hasn't picked a job yet
+	scaledRunnerBusy                              // the runner is running its job
+	scaledRunnerFinished                          // the job of the runner is done or the runner has been deleted
+)
+
+// ScaleRunners starts runners for the waiting jobs and stops the runners which are not needed anymore
+func ScaleRunners(ctx context.Context) error {
+	cfg := &setting.Actions.Scaler
+	if !cfg.Enabled {
+		return nil
+	}
+	provider, err := NewProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	return scaleRunners(ctx, cfg, provider)
+}
+
+// decisionRecorder merges the decisions of the same type for the same labels
+type decisionRecorder struct {
+	decisions []*actions_model.ActionScaleDecision
+}
+
+func (r *decisionRecorder) add(typ actions_model.ScaleDecisionType, labels []string, numWaiting int, message string) {
+	for _, d := range r.decisions {
+		if d.Type == typ && slices.Equal(d.Labels, labels) && d.Message == message {
+			d.NumRunners++
+			return
+		}
+	}
+	r.decisions = append(r.decisions, &actions_model.ActionScaleDecision{
+		Type:       typ,
+		Labels:     labels,
+		NumWaiting: numWaiting,
+		NumRunners: 1,
+		Message:    message,
+	})
+}
+
+func (r *decisionRecorder) save(ctx context.Context) error {
+	for _, d := range r.decisions {
+		log.Info("Runner scaler: %s for labels %v, %d runners, %d waiting jobs %s", d.Type, d.Labels, d.NumRunners, d.NumWaiting, d.Message)
+		if err := actions_model.InsertScaleDecision(ctx, d); err != nil {
+			return err
+		}
+	}
+	return actions_model.DeleteScaleDecisionsBefore(ctx, timeutil.TimeStamp(time.Now().Add(-decisionRetention).Unix()))
+}
+
+func scaleRunners(ctx context.Context, cfg *setting.ActionsScaler, provider Provider) error {
+	recorder := &decisionRecorder{}
+
+	scaledRunners, err := actions_model.GetScaledRunners(ctx)
+	if err != nil {
+		return err
+	}
+	numActive := 0
+	numStarting := make(map[string]int)
+	for _, sr := range scaledRunners {
+		state, err := getScaledRunnerState(ctx, sr)
+		if err != nil {
+			return err
+		}
+		switch {
+		case state == scaledRunnerBusy:
+			numActive++
+			continue
+		case state == scaledRunnerStarting && time.Since(sr.Created.AsTime()) < cfg.IdleTimeout:
+			numActive++
+			numStarting[labelsKey(sr.Labels)]++
+			continue
+		}
+
+		// delete the runner before stopping its instance, so it can't pick a job anymore
+		if err := actions_model.DeleteEphemeralRunner(ctx, sr.RunnerID); err != nil {
+			return err
+		}
+		if err := provider.StopRunner(ctx, sr.RunnerID, sr.InstanceID); err != nil {
+			// keep the record to retry later
+			log.Error("Runner scaler: failed to stop runner %d: %v", sr.RunnerID, err)
+			recorder.add(actions_model.ScaleDecisionError, sr.Labels, 0, err.Error())
+			numActive++
+			continue
+		}
+		if err := actions_model.DeleteScaledRunner(ctx, sr.ID); err != nil {
+			return err
+		}
+		if state == scaledRunnerFinished {
+			recorder.add(actions_model.ScaleDecisionStopFinished, sr.Labels, 0, "")
+		} else {
+			recorder.add(actions_model.ScaleDecisionStopIdle, sr.Labels, 0, "")
+		}
+	}
+
+	numWaiting, labelsList, err := getWaitingJobs(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	for _, labels := range labelsList {
+		key := labelsKey(labels)
+		need := min(numWaiting[key]-numStarting[key], cfg.MaxRunners-numActive)
+		for range need {
+			if err := startRunner(ctx, cfg, provider, labels); err != nil {
+				log.Error("Runner scaler: failed to start a runner for labels %v: %v", labels, err)
+				recorder.add(actions_model.ScaleDecisionError, labels, numWaiting[key], err.Error())
+				break
+			}
+			numActive++
+			recorder.add(actions_model.ScaleDecisionStart, labels, numWaiting[key], "")
+		}
+	}
+
+	return recorder.save(ctx)
+}
+
+func labelsKey(labels []string) string {
+	return strings.Join(labels, ",")
+}
+
+func getScaledRunnerState(ctx context.Context, sr *actions_model.ActionScaledRunner) (scaledRunnerState, error) {
+	if _, err := actions_model.GetRunnerByID(ctx, sr.RunnerID); err != nil {
+		if errors.Is(err, util.ErrNotExist) {
+			return scaledRunnerFinished, nil
+		}
+		return 0, err
+	}
+	tasks, err := db.Find[actions_model.ActionTask](ctx, actions_model.FindTaskOptions{RunnerID: sr.RunnerID})
+	if err != nil {
+		return 0, err
+	}
+	if len(tasks) == 0 {
+		return scaledRunnerStarting, nil
+	}
+	if tasks[0].Status.IsDone() {
+		return scaledRunnerFinished, nil
+	}
+	return scaledRunnerBusy, nil
+}
+
+// getWaitingJobs returns the number of the waiting jobs by their sorted labels,
+// the jobs whose labels can't be provided are ignored.
+func getWaitingJobs(ctx context.Context, cfg *setting.ActionsScaler) (map[string]int, [][]string, error) {
+	runsOnList, err := actions_model.GetWaitingJobsRunsOn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	providedLabels := container.SetOf(cfg.Labels...)
+	numWaiting := make(map[string]int)
+	var labelsList [][]string
+	for _, runsOn := range runsOnList {
+		if len(runsOn) == 0 {
+			continue
+		}
+		if len(providedLabels) > 0 && !providedLabels.Contains(runsOn...) {
+			continue
+		}
+		labels := slices.Compact(slices.Sorted(slices.Values(runsOn)))
+		key := labelsKey(labels)
+		if numWaiting[key] == 0 {
+			labelsList = append(labelsList, labels)
+		}
+		numWaiting[key]++
+	}
+	slices.SortFunc(labelsList, func(a, b []string) int {
+		return strings.Compare(labelsKey(a), labelsKey(b))
+	})
+	return numWaiting, labelsList, nil
+}
+
+func startRunner(ctx context.Context, cfg *setting.ActionsScaler, provider Provider, labels []string) error {
+	suffix, err := util.CryptoRandomString(8)
+	if err != nil {
+		return err
+	}
+	runner, config, err := actions_service.CreateJITRunner(ctx, actions_service.CreateJITRunnerOptions{
+		Name:    "autoscaled-" + strings.ToLower(suffix),
+		Labels:  labels,
+		Timeout: cfg.IdleTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	jitConfig, err := config.Encode()
+	if err != nil {
+		return err
+	}
+	instanceID, err := provider.StartRunner(ctx, &Runner{JITRunnerConfig: config, JITConfig: jitConfig})
+	if err != nil {
+		if err := actions_model.DeleteEphemeralRunner(ctx, runner.ID); err != nil {
+			log.Error("Runner scaler: failed to delete runner %d: %v", runner.ID, err)
+		}
+		return err
+	}
+	if instanceID == "" {
+		instanceID = runner.UUID
+	}
+	if err := actions_model.CreateScaledRunner(ctx, &actions_model.ActionScaledRunner{
+		RunnerID:   runner.ID,
+		Provider:   cfg.Provider,
+		InstanceID: instanceID,
+		Labels:     labels,
+	}); err != nil {
+		return fmt.Errorf("record the scaled runner %d: %w", runner.ID, err)
+	}
+	return nil
+}
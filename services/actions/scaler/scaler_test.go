@@ -0,0 +1,183 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/timeutil"
+	actions_service "code.gitea.io/gitea/services/actions"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	started  []*Runner
+	stopped  []string
+	startErr error
+}
+
+func (p *testProvider) StartRunner(_ context.Context, runner *Runner) (string, error) {
+	if p.startErr != nil {
+		return "", p.startErr
+	}
+	p.started = append(p.started, runner)
+	return fmt.Sprintf("instance-%d", runner.ID), nil
+}
+
+func (p *testProvider) StopRunner(_ context.Context, _ int64, instanceID string) error {
+	p.stopped = append(p.stopped, instanceID)
+	return nil
+}
+
+func insertWaitingJob(t *testing.T, runsOn ...string) {
+	require.NoError(t, db.Insert(t.Context(), &actions_model.ActionRunJob{
+		RunID:  791,
+		RepoID: 4,
+		Name:   "job",
+		JobID:  "job",
+		RunsOn: runsOn,
+		Status: actions_model.StatusWaiting,
+	}))
+}
+
+func TestScaleRunners(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	ctx := t.Context()
+
+	cfg := &setting.ActionsScaler{
+		Enabled:     true,
+		Provider:    "test",
+		MaxRunners:  3,
+		IdleTimeout: 10 * time.Minute,
+	}
+	provider := &testProvider{}
+
+	insertWaitingJob(t, "ubuntu-latest")
+	insertWaitingJob(t, "ubuntu-latest")
+	insertWaitingJob(t, "linux", "gpu")
+	insertWaitingJob(t, "gpu", "linux")
+	insertWaitingJob(t, "windows")
+
+	require.NoError(t, scaleRunners(ctx, cfg, provider))
+	// the labels are handled in order, "windows" doesn't get a runner because of MAX_RUNNERS
+	require.Len(t, provider.started, 3)
+	assert.Equal(t, []string{"gpu", "linux"}, provider.started[0].Labels)
+	assert.Equal(t, []string{"gpu", "linux"}, provider.started[1].Labels)
+	assert.Equal(t, []string{"ubuntu-latest"}, provider.started[2].Labels)
+	assert.NotEmpty(t, provider.started[0].JITConfig)
+	assert.Equal(t, setting.AppURL, provider.started[0].Address)
+
+	runner := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunner{ID: provider.started[0].ID})
+	assert.True(t, runner.Ephemeral)
+	assert.Zero(t, runner.OwnerID)
+	assert.Zero(t, runner.RepoID)
+	assert.Equal(t, []string{"gpu", "linux"}, runner.AgentLabels)
+	unittest.AssertExistsAndLoadBean(t, &actions_model.ActionScaledRunner{RunnerID: runner.ID, InstanceID: fmt.Sprintf("instance-%d", runner.ID)})
+
+	decisions, err := actions_model.GetLatestScaleDecisions(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	assert.Equal(t, actions_model.ScaleDecisionStart, decisions[0].Type)
+	assert.Equal(t, []string{"ubuntu-latest"}, decisions[0].Labels)
+	assert.Equal(t, 1, decisions[0].NumRunners)
+	assert.Equal(t, 2, decisions[0].NumWaiting)
+	assert.Equal(t, []string{"gpu", "linux"}, decisions[1].Labels)
+	assert.Equal(t, 2, decisions[1].NumRunners)
+
+	// the starting runners are counted, one more runner is needed for "ubuntu-latest" but MAX_RUNNERS is reached
+	cfg.MaxRunners = 4
+	require.NoError(t, scaleRunners(ctx, cfg, provider))
+	require.Len(t, provider.started, 4)
+	assert.Equal(t, []string{"ubuntu-latest"}, provider.started[3].Labels)
+
+	// the first runner is running a job, the second has finished its job, the third is idle for too long
+	require.NoError(t, db.Insert(ctx, &actions_model.ActionTask{RunnerID: provider.started[0].ID, Status: actions_model.StatusRunning, TokenHash: "scaler-test-1"}))
+	require.NoError(t, db.Insert(ctx, &actions_model.ActionTask{RunnerID: provider.started[1].ID, Status: actions_model.StatusSuccess, TokenHash: "scaler-test-2"}))
+	_, err = db.GetEngine(ctx).Table("action_scaled_runner").Where("runner_id = ?", provider.started[2].ID).
+		Update(map[string]any{"created": timeutil.TimeStamp(time.Now().Add(-time.Hour).Unix())})
+	require.NoError(t, err)
+	_, err = db.GetEngine(ctx).Where("runs_on LIKE ?", "%gpu%").Cols("task_id").Update(&actions_model.ActionRunJob{TaskID: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, scaleRunners(ctx, cfg, provider))
+	assert.Equal(t, []string{
+		fmt.Sprintf("instance-%d", provider.started[1].ID),
+		fmt.Sprintf("instance-%d", provider.started[2].ID),
+	}, provider.stopped)
+	unittest.AssertNotExistsBean(t, &actions_model.ActionRunner{ID: provider.started[1].ID})
+	unittest.AssertNotExistsBean(t, &actions_model.ActionRunner{ID: provider.started[2].ID})
+	unittest.AssertNotExistsBean(t, &actions_model.ActionScaledRunner{RunnerID: provider.started[2].ID})
+	unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunner{ID: provider.started[0].ID})
+	// the runner of "ubuntu-latest" which is still starting covers one of the two waiting jobs
+	require.Len(t, provider.started, 6)
+	assert.Equal(t, []string{"ubuntu-latest"}, provider.started[4].Labels)
+	assert.Equal(t, []string{"windows"}, provider.started[5].Labels)
+
+	decisions, err = actions_model.GetLatestScaleDecisions(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, actions_model.ScaleDecisionStart, decisions[0].Type)
+	assert.Equal(t, actions_model.ScaleDecisionStart, decisions[1].Type)
+	assert.Equal(t, actions_model.ScaleDecisionStopIdle, decisions[2].Type)
+	assert.Equal(t, actions_model.ScaleDecisionStopFinished, decisions[3].Type)
+
+	t.Run("ProviderError", func(t *testing.T) {
+		_, err := db.GetEngine(ctx).Where("runs_on LIKE ?", "%windows%").Cols("task_id").Update(&actions_model.ActionRunJob{TaskID: 1})
+		require.NoError(t, err)
+		insertWaitingJob(t, "macos")
+		failingProvider := &testProvider{startErr: errors.New("quota exceeded")}
+		cfg.MaxRunners = 10
+
+		require.NoError(t, scaleRunners(ctx, cfg, failingProvider))
+		decisions, err := actions_model.GetLatestScaleDecisions(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, actions_model.ScaleDecisionError, decisions[0].Type)
+		assert.Equal(t, []string{"macos"}, decisions[0].Labels)
+		assert.Equal(t, "quota exceeded", decisions[0].Message)
+		// the runner created for the failed instance has been deleted
+		count, err := db.GetEngine(ctx).Where("name LIKE ?", "autoscaled-%").Count(new(actions_model.ActionRunner))
+		require.NoError(t, err)
+		assert.EqualValues(t, 4, count)
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		cfg.Labels = []string{"ubuntu-latest"}
+		numWaiting, labelsList, err := getWaitingJobs(ctx, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"ubuntu-latest"}}, labelsList)
+		assert.Equal(t, 2, numWaiting["ubuntu-latest"])
+	})
+}
+
+func TestJITRunnerConfig(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	runner, config, err := actions_service.CreateJITRunner(t.Context(), actions_service.CreateJITRunnerOptions{
+		RepoID: 1,
+		Name:   "jit-runner",
+		Labels: []string{"linux"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, runner.UUID, config.UUID)
+	encoded, err := config.Encode()
+	require.NoError(t, err)
+	assert.NotEmpty(t, encoded)
+
+	// the token of the config authenticates the runner
+	r, err := actions_model.GetRunnerByUUID(t.Context(), config.UUID)
+	require.NoError(t, err)
+	assert.True(t, r.Ephemeral)
+	assert.Equal(t, int64(1), r.RepoID)
+	assert.Equal(t, r.TokenHash, auth_model.HashToken(config.Token, r.TokenSalt))
+}
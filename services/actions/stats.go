@@ -0,0 +1,493 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/timeutil"
+)
+
+// statsBatchSize is the number of tasks loaded at once when rolling up the stats of a day
+const statsBatchSize = 200
+
+// RollupStats rolls up the stats of the days since the last rolled up day until yesterday,
+// the stats older than keep are deleted and the days older than keep are never rolled up.
+func RollupStats(ctx context.Context, keep time.Duration) error {
+	now := time.Now()
+	today := actions_model.StatDay(now)
+	from := actions_model.StatDay(now.Add(-keep))
+	if err := actions_model.DeleteStatsBefore(ctx, timeutil.TimeStamp(from.Unix())); err != nil {
+		return err
+	}
+
+	last, err := actions_model.GetLastStatDay(ctx)
+	if err != nil {
+		return err
+	}
+	if last > 0 && !last.AsTime().Before(from) {
+		from = actions_model.StatDay(last.AsTime()).AddDate(0, 0, 1)
+	}
+
+	for day := from; day.Before(today); day = day.AddDate(0, 0, 1) {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before rolling up the actions stats of %s", day.Format(time.DateOnly))
+		default:
+		}
+		if err := RollupStatsOfDay(ctx, day); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jobStatKey struct {
+	RepoID     int64
+	WorkflowID string
+	JobName    string
+	StepName   string
+}
+
+type labelStatKey struct {
+	RepoID int64
+	Label  string
+}
+
+// RollupStatsOfDay computes and saves the stats of the tasks stopped during the day starting at day
+func RollupStatsOfDay(ctx context.Context, day time.Time) error {
+	start := timeutil.TimeStamp(day.Unix())
+	end := timeutil.TimeStamp(day.AddDate(0, 0, 1).Unix())
+
+	jobStats := make(map[jobStatKey]*actions_model.ActionJobStat)
+	labelStats := make(map[labelStatKey]*actions_model.ActionRunnerLabelStat)
+	getJobStat := func(key jobStatKey, ownerID int64) *actions_model.ActionJobStat {
+		stat, ok := jobStats[key]
+		if !ok {
+			stat = &actions_model.ActionJobStat{
+				RepoID:     key.RepoID,
+				OwnerID:    ownerID,
+				Day:        start,
+				WorkflowID: key.WorkflowID,
+				JobName:    key.JobName,
+				StepName:   key.StepName,
+			}
+			jobStats[key] = stat
+		}
+		return stat
+	}
+
+	err := actions_model.FindTasksStoppedBetween(ctx, start, end, statsBatchSize, func(tasks []*actions_model.ActionTask) error {
+		jobIDs := make(container.Set[int64], len(tasks))
+		taskIDs := make([]int64, 0, len(tasks))
+		for _, task := range tasks {
+			jobIDs.Add(task.JobID)
+			taskIDs = append(taskIDs, task.ID)
+		}
+		jobs, err := actions_model.GetRunJobsMapByIDs(ctx, jobIDs.Values())
+		if err != nil {
+			return err
+		}
+		runIDs := make(container.Set[int64], len(jobs))
+		for _, job := range jobs {
+			runIDs.Add(job.RunID)
+		}
+		runs, err := actions_model.GetRunsMapByIDs(ctx, runIDs.Values())
+		if err != nil {
+			return err
+		}
+		steps, err := actions_model.GetTaskStepsByTaskIDs(ctx, taskIDs)
+		if err != nil {
+			return err
+		}
+		taskSteps := make(map[int64][]*actions_model.ActionTaskStep, len(tasks))
+		for _, step := range steps {
+			taskSteps[step.TaskID] = append(taskSteps[step.TaskID], step)
+		}
+
+		for _, task := range tasks {
+			job := jobs[task.JobID]
+			if job == nil {
+				continue
+			}
+			run := runs[job.RunID]
+			if run == nil {
+				continue
+			}
+
+			key := jobStatKey{RepoID: task.RepoID, WorkflowID: run.WorkflowID, JobName: job.Name}
+			stat := getJobStat(key, task.OwnerID)
+			stat.NumTasks++
+			if task.Status.IsFailure() {
+				stat.NumFailures++
+			}
+			if task.Attempt > 1 {
+				stat.NumRetries++
+				if task.Status.IsSuccess() {
+					stat.NumFlaky++
+				}
+			}
+			if seconds, ok := taskQueueSeconds(job, task); ok {
+				stat.NumQueued++
+				stat.QueueSeconds += seconds
+			}
+			if task.Started == 0 {
+				continue
+			}
+			runSeconds := max(int64(task.Stopped-task.Started), 0)
+			stat.RunSeconds += runSeconds
+			stat.RunHistogram.Add(runSeconds)
+
+			for _, label := range job.RunsOn {
+				lk := labelStatKey{RepoID: task.RepoID, Label: label}
+				labelStat, ok := labelStats[lk]
+				if !ok {
+					labelStat = &actions_model.ActionRunnerLabelStat{RepoID: task.RepoID, OwnerID: task.OwnerID, Day: start, Label: label}
+					labelStats[lk] = labelStat
+				}
+				labelStat.NumTasks++
+				labelStat.BusySeconds += runSeconds
+			}
+
+			for _, step := range taskSteps[task.ID] {
+				if step.Started == 0 || !step.Status.IsDone() || step.Status.IsSkipped() {
+					continue
+				}
+				key.StepName = step.Name
+				stepStat := getJobStat(key, task.OwnerID)
+				stepStat.NumTasks++
+				if step.Status.IsFailure() {
+					stepStat.NumFailures++
+				}
+				stepSeconds := max(int64(step.Stopped-step.Started), 0)
+				stepStat.RunSeconds += stepSeconds
+				stepStat.RunHistogram.Add(stepSeconds)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Trace("rolled up the actions stats of %s: %d job stats, %d label stats", day.Format(time.DateOnly), len(jobStats), len(labelStats))
+	return actions_model.SaveStats(ctx, start, sortedJobStats(jobStats), sortedLabelStats(labelStats))
+}
+
+// taskQueueSeconds returns how long the task has waited for a runner,
+// it's unknown if the job has been queued again by a rerun after the task was created
+func taskQueueSeconds(job *actions_model.ActionRunJob, task *actions_model.ActionTask) (int64, bool) {
+	queued := job.Created
+	if job.Queued > 0 {
+		queued = job.Queued
+	}
+	if queued == 0 || queued > task.Created {
+		return 0, false
+	}
+	return int64(task.Created - queued), true
+}
+
+// sortedJobStats returns the job stats in a stable order, it keeps the stats of a day readable in the database
+func sortedJobStats(m map[jobStatKey]*actions_model.ActionJobStat) []*actions_model.ActionJobStat {
+	stats := slices.Collect(maps.Values(m))
+	slices.SortFunc(stats, func(a, b *actions_model.ActionJobStat) int {
+		return cmp.Or(
+			cmp.Compare(a.RepoID, b.RepoID),
+			cmp.Compare(a.WorkflowID, b.WorkflowID),
+			cmp.Compare(a.JobName, b.JobName),
+			cmp.Compare(a.StepName, b.StepName),
+		)
+	})
+	return stats
+}
+
+func sortedLabelStats(m map[labelStatKey]*actions_model.ActionRunnerLabelStat) []*actions_model.ActionRunnerLabelStat {
+	stats := slices.Collect(maps.Values(m))
+	slices.SortFunc(stats, func(a, b *actions_model.ActionRunnerLabelStat) int {
+		return cmp.Or(cmp.Compare(a.RepoID, b.RepoID), cmp.Compare(a.Label, b.Label))
+	})
+	return stats
+}
+
+// JobInsight is the aggregate of the stats of a job, or of a step of the job if StepName isn't empty, over a period
+type JobInsight struct {
+	RepoID       int64
+	Repo         *repo_model.Repository // only loaded for the insights of an owner
+	WorkflowID   string
+	JobName      string
+	StepName     string
+	NumTasks     int64
+	NumFailures  int64
+	NumRetries   int64
+	NumFlaky     int64
+	NumQueued    int64
+	QueueSeconds int64
+	RunSeconds   int64
+	RunHistogram actions_model.DurationHistogram
+}
+
+func (j *JobInsight) add(stat *actions_model.ActionJobStat) {
+	j.NumTasks += stat.NumTasks
+	j.NumFailures += stat.NumFailures
+	j.NumRetries += stat.NumRetries
+	j.NumFlaky += stat.NumFlaky
+	j.NumQueued += stat.NumQueued
+	j.QueueSeconds += stat.QueueSeconds
+	j.RunSeconds += stat.RunSeconds
+	j.RunHistogram.Merge(stat.RunHistogram)
+}
+
+// FailureRate returns the percentage of the failed tasks
+func (j *JobInsight) FailureRate() float64 {
+	return percentage(j.NumFailures, j.NumTasks)
+}
+
+// FlakyRate returns the percentage of the tasks which succeeded in a rerun attempt
+func (j *JobInsight) FlakyRate() float64 {
+	return percentage(j.NumFlaky, j.NumTasks)
+}
+
+// RetryRate returns the percentage of the tasks of a rerun attempt
+func (j *JobInsight) RetryRate() float64 {
+	return percentage(j.NumRetries, j.NumTasks)
+}
+
+// AvgQueueTime returns the average time the tasks have waited for a runner
+func (j *JobInsight) AvgQueueTime() time.Duration {
+	if j.NumQueued == 0 {
+		return 0
+	}
+	return time.Duration(j.QueueSeconds/j.NumQueued) * time.Second
+}
+
+// AvgRunTime returns the average run time of the tasks
+func (j *JobInsight) AvgRunTime() time.Duration {
+	if j.NumTasks == 0 {
+		return 0
+	}
+	return time.Duration(j.RunSeconds/j.NumTasks) * time.Second
+}
+
+// TotalRunTime returns the total run time of the tasks
+func (j *JobInsight) TotalRunTime() time.Duration {
+	return time.Duration(j.RunSeconds) * time.Second
+}
+
+// RunTimePercentile returns an estimate of the percentile p (0-100) of the run times
+func (j *JobInsight) RunTimePercentile(p int) time.Duration {
+	return time.Duration(j.RunHistogram.Percentile(p)) * time.Second
+}
+
+// LabelInsight is the aggregate of the stats of a runner label over a period
+type LabelInsight struct {
+	Label       string
+	NumTasks    int64
+	BusySeconds int64
+	NumRunners  int // the number of the current runners having the label
+	periodDays  int
+}
+
+// BusyTime returns the total time spent by the runners on the jobs requiring the label
+func (l *LabelInsight) BusyTime() time.Duration {
+	return time.Duration(l.BusySeconds) * time.Second
+}
+
+// AvgBusyRunners returns the average number of the runners busy with the jobs requiring the label
+func (l *LabelInsight) AvgBusyRunners() float64 {
+	if l.periodDays == 0 {
+		return 0
+	}
+	return float64(l.BusySeconds) / float64(l.periodDays*86400)
+}
+
+// Utilization returns the percentage of the time the current runners having the label would have been busy
+func (l *LabelInsight) Utilization() float64 {
+	if l.NumRunners == 0 {
+		return 0
+	}
+	return min(l.AvgBusyRunners()/float64(l.NumRunners)*100, 100)
+}
+
+// DailyInsight is the aggregate of the job stats of a day, it is used to draw the trends
+type DailyInsight struct {
+	Day          string  `json:"day"`
+	NumTasks     int64   `json:"numTasks"`
+	FailureRate  float64 `json:"failureRate"`
+	FlakyRate    float64 `json:"flakyRate"`
+	AvgQueueTime int64   `json:"avgQueueSeconds"`
+	P50RunTime   int64   `json:"p50RunSeconds"`
+	P95RunTime   int64   `json:"p95RunSeconds"`
+}
+
+// Insights are the timing analytics of the jobs of a repository or of the repositories of an owner over a period
+type Insights struct {
+	Days      int
+	Workflows []string
+	Jobs      []*JobInsight
+	Steps     []*JobInsight
+	Labels    []*LabelInsight
+	Daily     []*DailyInsight
+}
+
+// maxStepInsights is the number of the steps with the longest total run time shown in the insights
+const maxStepInsights = 20
+
+// GetInsights aggregates the daily stats of the last days, opts.Since is computed from days
+func GetInsights(ctx context.Context, opts actions_model.FindStatsOptions, days int) (*Insights, error) {
+	today := actions_model.StatDay(time.Now())
+	since := today.AddDate(0, 0, -days)
+	opts.Since = timeutil.TimeStamp(since.Unix())
+
+	jobStats, err := actions_model.FindJobStats(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	labelStats, err := actions_model.FindRunnerLabelStats(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	insights := &Insights{Days: days}
+	workflows := make(container.Set[string])
+	jobs := make(map[jobStatKey]*JobInsight)
+	daily := make(map[timeutil.TimeStamp]*JobInsight)
+	for _, stat := range jobStats {
+		workflows.Add(stat.WorkflowID)
+		key := jobStatKey{RepoID: stat.RepoID, WorkflowID: stat.WorkflowID, JobName: stat.JobName, StepName: stat.StepName}
+		job, ok := jobs[key]
+		if !ok {
+			job = &JobInsight{RepoID: stat.RepoID, WorkflowID: stat.WorkflowID, JobName: stat.JobName, StepName: stat.StepName}
+			jobs[key] = job
+		}
+		job.add(stat)
+
+		if stat.StepName != "" {
+			continue
+		}
+		day, ok := daily[stat.Day]
+		if !ok {
+			day = &JobInsight{}
+			daily[stat.Day] = day
+		}
+		day.add(stat)
+	}
+
+	if opts.RepoID == 0 {
+		if err := loadJobInsightsRepos(ctx, jobs); err != nil {
+			return nil, err
+		}
+	}
+	for _, job := range jobs {
+		if job.StepName == "" {
+			insights.Jobs = append(insights.Jobs, job)
+		} else {
+			insights.Steps = append(insights.Steps, job)
+		}
+	}
+	slices.SortFunc(insights.Jobs, func(a, b *JobInsight) int {
+		return cmp.Or(cmp.Compare(a.RepoID, b.RepoID), cmp.Compare(a.WorkflowID, b.WorkflowID), cmp.Compare(a.JobName, b.JobName))
+	})
+	slices.SortFunc(insights.Steps, func(a, b *JobInsight) int {
+		return cmp.Or(cmp.Compare(b.RunSeconds, a.RunSeconds), cmp.Compare(a.WorkflowID, b.WorkflowID),
+			cmp.Compare(a.JobName, b.JobName), cmp.Compare(a.StepName, b.StepName))
+	})
+	if len(insights.Steps) > maxStepInsights {
+		insights.Steps = insights.Steps[:maxStepInsights]
+	}
+	insights.Workflows = workflows.Values()
+	slices.Sort(insights.Workflows)
+
+	// fill the days without stats to keep the trends continuous
+	for day := since; day.Before(today); day = day.AddDate(0, 0, 1) {
+		item := &DailyInsight{Day: day.Format(time.DateOnly)}
+		if stat, ok := daily[timeutil.TimeStamp(day.Unix())]; ok {
+			item.NumTasks = stat.NumTasks
+			item.FailureRate = stat.FailureRate()
+			item.FlakyRate = stat.FlakyRate()
+			item.AvgQueueTime = int64(stat.AvgQueueTime().Seconds())
+			item.P50RunTime = stat.RunHistogram.Percentile(50)
+			item.P95RunTime = stat.RunHistogram.Percentile(95)
+		}
+		insights.Daily = append(insights.Daily, item)
+	}
+
+	insights.Labels, err = getLabelInsights(ctx, opts, labelStats, days)
+	if err != nil {
+		return nil, err
+	}
+	return insights, nil
+}
+
+func loadJobInsightsRepos(ctx context.Context, jobs map[jobStatKey]*JobInsight) error {
+	repoIDs := make(container.Set[int64])
+	for _, job := range jobs {
+		repoIDs.Add(job.RepoID)
+	}
+	repos, err := repo_model.GetRepositoriesMapByIDs(ctx, repoIDs.Values())
+	if err != nil {
+		return err
+	}
+	for key, job := range jobs {
+		job.Repo = repos[job.RepoID]
+		if job.Repo == nil {
+			// the repository has been deleted since the stats were loaded
+			delete(jobs, key)
+		}
+	}
+	return nil
+}
+
+func getLabelInsights(ctx context.Context, opts actions_model.FindStatsOptions, stats []*actions_model.ActionRunnerLabelStat, days int) ([]*LabelInsight, error) {
+	labels := make(map[string]*LabelInsight)
+	for _, stat := range stats {
+		label, ok := labels[stat.Label]
+		if !ok {
+			label = &LabelInsight{Label: stat.Label, periodDays: days}
+			labels[stat.Label] = label
+		}
+		label.NumTasks += stat.NumTasks
+		label.BusySeconds += stat.BusySeconds
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	runners, err := db.Find[actions_model.ActionRunner](ctx, actions_model.FindRunnerOptions{
+		RepoID:        opts.RepoID,
+		OwnerID:       opts.OwnerID,
+		WithAvailable: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, runner := range runners {
+		for _, name := range runner.AgentLabels {
+			if label, ok := labels[name]; ok {
+				label.NumRunners++
+			}
+		}
+	}
+
+	insights := slices.Collect(maps.Values(labels))
+	slices.SortFunc(insights, func(a, b *LabelInsight) int {
+		return cmp.Or(cmp.Compare(b.BusySeconds, a.BusySeconds), cmp.Compare(a.Label, b.Label))
+	})
+	return insights, nil
+}
+
+func percentage(n, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
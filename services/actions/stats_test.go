@@ -0,0 +1,123 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/timeutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupStatsOfDay(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	// most of the tasks of the fixtures are stopped at this time, the tasks without a job or a run are ignored
+	day := actions_model.StatDay(timeutil.TimeStamp(1683636626).AsTime())
+	start, end := timeutil.TimeStamp(day.Unix()), timeutil.TimeStamp(day.AddDate(0, 0, 1).Unix())
+	numTasks, err := db.GetEngine(t.Context()).Table("action_task").
+		Join("INNER", "action_run_job", "action_run_job.id = action_task.job_id").
+		Join("INNER", "action_run", "action_run.id = action_run_job.run_id").
+		Where("action_task.stopped >= ? AND action_task.stopped < ?", start, end).
+		In("action_task.status", []actions_model.Status{actions_model.StatusSuccess, actions_model.StatusFailure, actions_model.StatusCancelled}).
+		Count()
+	require.NoError(t, err)
+	require.Positive(t, numTasks)
+
+	// rolling up a day twice replaces its stats
+	for range 2 {
+		require.NoError(t, RollupStatsOfDay(t.Context(), day))
+
+		stats, err := actions_model.FindJobStats(t.Context(), actions_model.FindStatsOptions{})
+		require.NoError(t, err)
+		var numJobTasks int64
+		for _, stat := range stats {
+			assert.Equal(t, start, stat.Day)
+			assert.NotZero(t, stat.RepoID)
+			assert.NotEmpty(t, stat.WorkflowID)
+			if stat.StepName == "" {
+				numJobTasks += stat.NumTasks
+			}
+		}
+		assert.Equal(t, numTasks, numJobTasks)
+	}
+
+	lastDay, err := actions_model.GetLastStatDay(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, start, lastDay)
+
+	require.NoError(t, actions_model.DeleteStatsBefore(t.Context(), end))
+	unittest.AssertCount(t, &actions_model.ActionJobStat{}, 0)
+	unittest.AssertCount(t, &actions_model.ActionRunnerLabelStat{}, 0)
+}
+
+func TestGetInsights(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	today := actions_model.StatDay(time.Now())
+	newHistogram := func(seconds ...int64) (h actions_model.DurationHistogram) {
+		for _, s := range seconds {
+			h.Add(s)
+		}
+		return h
+	}
+	for i, numFailures := range []int64{1, 0} {
+		day := timeutil.TimeStamp(today.AddDate(0, 0, -1-i).Unix())
+		require.NoError(t, actions_model.SaveStats(t.Context(), day, []*actions_model.ActionJobStat{
+			{
+				RepoID: 4, OwnerID: 1, Day: day, WorkflowID: "test.yaml", JobName: "build",
+				NumTasks: 2, NumFailures: numFailures, NumRetries: 1, NumFlaky: 1, NumQueued: 2, QueueSeconds: 20,
+				RunSeconds: 130, RunHistogram: newHistogram(30, 100),
+			},
+			{
+				RepoID: 4, OwnerID: 1, Day: day, WorkflowID: "test.yaml", JobName: "build", StepName: "compile",
+				NumTasks: 2, RunSeconds: 80, RunHistogram: newHistogram(20, 60),
+			},
+		}, []*actions_model.ActionRunnerLabelStat{
+			{RepoID: 4, OwnerID: 1, Day: day, Label: "ubuntu-latest", NumTasks: 2, BusySeconds: 8640},
+		}))
+	}
+
+	insights, err := GetInsights(t.Context(), actions_model.FindStatsOptions{RepoID: 4}, 7)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test.yaml"}, insights.Workflows)
+
+	require.Len(t, insights.Jobs, 1)
+	job := insights.Jobs[0]
+	assert.EqualValues(t, 4, job.NumTasks)
+	assert.InDelta(t, 25, job.FailureRate(), 0.01)
+	assert.InDelta(t, 50, job.FlakyRate(), 0.01)
+	assert.Equal(t, 10*time.Second, job.AvgQueueTime())
+	assert.Equal(t, 30*time.Second, job.RunTimePercentile(50))
+	assert.Equal(t, 120*time.Second, job.RunTimePercentile(95))
+	assert.Nil(t, job.Repo)
+
+	require.Len(t, insights.Steps, 1)
+	assert.Equal(t, "compile", insights.Steps[0].StepName)
+	assert.Equal(t, 160*time.Second, insights.Steps[0].TotalRunTime())
+
+	require.Len(t, insights.Labels, 1)
+	assert.Equal(t, "ubuntu-latest", insights.Labels[0].Label)
+	assert.InDelta(t, 0.0286, insights.Labels[0].AvgBusyRunners(), 0.001)
+
+	require.Len(t, insights.Daily, 7)
+	assert.Equal(t, today.AddDate(0, 0, -1).Format(time.DateOnly), insights.Daily[6].Day)
+	assert.EqualValues(t, 2, insights.Daily[6].NumTasks)
+	assert.InDelta(t, 50, insights.Daily[6].FailureRate, 0.01)
+	assert.EqualValues(t, 2, insights.Daily[5].NumTasks)
+	assert.Zero(t, insights.Daily[5].FailureRate)
+	assert.Zero(t, insights.Daily[0].NumTasks)
+
+	// the insights of an owner load the repositories of the jobs
+	insights, err = GetInsights(t.Context(), actions_model.FindStatsOptions{OwnerID: 1}, 7)
+	require.NoError(t, err)
+	require.Len(t, insights.Jobs, 1)
+	assert.NotNil(t, insights.Jobs[0].Repo)
+}
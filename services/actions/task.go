@@ -11,7 +11,9 @@ import (
 	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
 	secret_model "code.gitea.io/gitea/models/secret"
+	"code.gitea.io/gitea/modules/log"
 	notify_service "code.gitea.io/gitea/services/notify"
+	secret_service "code.gitea.io/gitea/services/secrets"
 
 	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -59,11 +61,6 @@ func PickTask(ctx context.Context, runner *actions_model.ActionRunner) (*runnerv
 		job = t.Job
 		actionTask = t
 
-		secrets, err := secret_model.GetSecretsOfTask(ctx, t)
-		if err != nil {
-			return fmt.Errorf("GetSecretsOfTask: %w", err)
-		}
-
 		vars, err := actions_model.GetVariablesOfRun(ctx, t.Job.Run)
 		if err != nil {
 			return fmt.Errorf("GetVariablesOfRun: %w", err)
@@ -83,7 +80,6 @@ func PickTask(ctx context.Context, runner *actions_model.ActionRunner) (*runnerv
 			Id:              t.ID,
 			WorkflowPayload: t.Job.WorkflowPayload,
 			Context:         taskContext,
-			Secrets:         secrets,
 			Vars:            vars,
 			Needs:           needs,
 		}
@@ -97,12 +93,49 @@ func PickTask(ctx context.Context, runner *actions_model.ActionRunner) (*runnerv
 		return nil, false, nil
 	}
 
+	// the secrets can reference an external secret manager, so they are resolved out of the transaction
+	secrets, err := secret_model.GetSecretsOfTask(ctx, actionTask, secret_service.NewTaskResolver(actionTask))
+	if err != nil {
+		log.Error("GetSecretsOfTask for task %d: %v", actionTask.ID, err)
+		message := "Unable to load the secrets"
+		var errNotResolved secret_model.ErrSecretNotResolved
+		if errors.As(err, &errNotResolved) {
+			message = fmt.Sprintf("Unable to resolve the secret %s, its accesses are listed in the secrets settings", errNotResolved.Name)
+		}
+		// the task has been assigned to the runner, so it fails rather than being left running
+		if err := failTaskBeforeRun(ctx, actionTask, message); err != nil {
+			return nil, false, fmt.Errorf("fail task %d: %w", actionTask.ID, err)
+		}
+		return nil, false, nil
+	}
+	task.Secrets = secrets
+
 	CreateCommitStatusForRunJobs(ctx, job.Run, job)
 	notify_service.WorkflowJobStatusUpdate(ctx, job.Run.Repo, job.Run.TriggerUser, job, actionTask)
 
 	return task, true, nil
 }
 
+// failTaskBeforeRun fails a task which can't be sent to its runner, the message is shown to the users as the error of its job
+func failTaskBeforeRun(ctx context.Context, task *actions_model.ActionTask, message string) error {
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		if _, err := actions_model.UpdateRunJob(ctx, &actions_model.ActionRunJob{ID: task.JobID, ErrorMessage: message}, nil, "error_message"); err != nil {
+			return err
+		}
+		return actions_model.StopTask(ctx, task.ID, actions_model.StatusFailure)
+	}); err != nil {
+		return err
+	}
+
+	job, err := actions_model.GetRunJobByID(ctx, task.JobID)
+	if err != nil {
+		return err
+	}
+	notifyWorkflowJobStatusUpdate(ctx, []*actions_model.ActionRunJob{job})
+	EmitJobsIfReadyByJobs([]*actions_model.ActionRunJob{job})
+	return nil
+}
+
 func generateTaskContext(t *actions_model.ActionTask) (*structpb.Struct, error) {
 	giteaRuntimeToken, err := CreateAuthorizationToken(t.ID, t.Job.RunID, t.JobID)
 	if err != nil {
@@ -0,0 +1,281 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/db"
+	git_model "code.gitea.io/gitea/models/git"
+	user_model "code.gitea.io/gitea/models/user"
+	actions_module "code.gitea.io/gitea/modules/actions"
+	"code.gitea.io/gitea/modules/commitstatus"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/graceful"
+	"code.gitea.io/gitea/modules/junit"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/queue"
+	"code.gitea.io/gitea/modules/storage"
+	"code.gitea.io/gitea/modules/util"
+	commitstatus_service "code.gitea.io/gitea/services/repository/commitstatus"
+
+	"github.com/nektos/act/pkg/jobparser"
+)
+
+// the limits of the test reports which are parsed from the test report artifacts of a task
+const (
+	// maxTestReportSize is the max size of a test report artifact, or of a report in a zipped artifact
+	maxTestReportSize = 64 << 20
+	// maxTestReportTotalSize is the max size of all the decompressed reports of the artifacts
+	maxTestReportTotalSize = 256 << 20
+	// maxTestReportFiles is the max number of entries of a zipped artifact, and of reports of all the artifacts
+	maxTestReportFiles = 1000
+	// maxTestReportCases is the max number of test cases of all the reports
+	maxTestReportCases = 100_000
+)
+
+var errTestReportLimit = errors.New("test report limit exceeded")
+
+var testReportQueue *queue.WorkerPoolQueue[*testReportUpdate]
+
+type testReportUpdate struct {
+	TaskID       int64
+	ArtifactName string
+}
+
+// QueueTestReports queues the test report artifacts with the given name uploaded by a task to be ingested, see IngestTestReports
+func QueueTestReports(task *actions_model.ActionTask, artifactName string) error {
+	if !actions_model.IsTestReportArtifactName(artifactName) {
+		return nil
+	}
+	err := testReportQueue.Push(&testReportUpdate{
+		TaskID:       task.ID,
+		ArtifactName: artifactName,
+	})
+	if errors.Is(err, queue.ErrAlreadyInQueue) {
+		return nil
+	}
+	return err
+}
+
+func testReportQueueHandler(items ...*testReportUpdate) []*testReportUpdate {
+	ctx := graceful.GetManager().ShutdownContext()
+	var ret []*testReportUpdate
+	for _, update := range items {
+		task, err := actions_model.GetTaskByID(ctx, update.TaskID)
+		if err == nil {
+			err = IngestTestReports(ctx, task, update.ArtifactName)
+		}
+		if err != nil {
+			log.Error("ingest test reports %q of task %d: %v", update.ArtifactName, update.TaskID, err)
+			if !errors.Is(err, util.ErrNotExist) {
+				ret = append(ret, update)
+			}
+		}
+	}
+	return ret
+}
+
+// IngestTestReports parses the JUnit XML reports of the test report artifacts with the given name uploaded by a task,
+// the results replace the ones previously parsed from the same artifacts.
+// The test results of the runs of the default branch are counted in the history of the test cases to find the flaky tests.
+func IngestTestReports(ctx context.Context, task *actions_model.ActionTask, artifactName string) error {
+	if !actions_model.IsTestReportArtifactName(artifactName) {
+		return nil
+	}
+	if err := task.LoadJob(ctx); err != nil {
+		return err
+	}
+	run, err := actions_model.GetRunByRepoAndID(ctx, task.RepoID, task.Job.RunID)
+	if err != nil {
+		return err
+	}
+	if err := run.LoadAttributes(ctx); err != nil {
+		return err
+	}
+
+	artifacts, err := db.Find[actions_model.ActionArtifact](ctx, actions_model.FindArtifactsOptions{
+		RunID:        run.ID,
+		ArtifactName: artifactName,
+		Status:       int(actions_model.ArtifactStatusUploadConfirmed),
+	})
+	if err != nil {
+		return err
+	}
+
+	var allResults []*actions_model.ActionTestResult
+	budget := &testReportBudget{size: maxTestReportTotalSize, files: maxTestReportFiles, cases: maxTestReportCases}
+	for _, artifact := range artifacts {
+		cases, err := parseTestReportArtifact(artifact, budget)
+		if err != nil {
+			log.Warn("Failed to parse the test report artifact %d of run %d: %v", artifact.ID, run.ID, err)
+			continue
+		}
+		results := make([]*actions_model.ActionTestResult, 0, len(cases))
+		for _, tc := range cases {
+			result := actions_model.NewTestResult(tc)
+			result.RepoID = run.RepoID
+			result.RunID = run.ID
+			result.JobID = task.JobID
+			result.ArtifactID = artifact.ID
+			results = append(results, result)
+		}
+		if err := actions_model.ReplaceTestResultsOfArtifact(ctx, artifact.ID, results); err != nil {
+			return err
+		}
+		allResults = append(allResults, results...)
+	}
+
+	if run.Ref == git.BranchPrefix+run.Repo.DefaultBranch && !run.Event.IsPullRequest() {
+		if err := actions_model.UpdateTestCasesHistory(ctx, run.RepoID, run.ID, task.ID, allResults); err != nil {
+			return err
+		}
+	}
+
+	summary, err := actions_model.GetTestSummaryOfRun(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+	if summary.Total() > 0 {
+		createTestReportCommitStatus(ctx, run, task.Job, summary)
+	}
+	return nil
+}
+
+// testReportBudget is what is left of the limits of the test reports while they are parsed
+type testReportBudget struct {
+	size  int64
+	files int
+	cases int
+}
+
+// parse parses a report and charges the bytes read and the test cases found to the budget
+func (b *testReportBudget) parse(r io.Reader) ([]*junit.TestCase, error) {
+	if b.files <= 0 || b.size <= 0 {
+		return nil, fmt.Errorf("%w: more than %d reports or %d bytes", errTestReportLimit, maxTestReportFiles, maxTestReportTotalSize)
+	}
+	b.files--
+	limit := min(maxTestReportSize, b.size)
+	lr := &io.LimitedReader{R: r, N: limit}
+	cases, err := junit.Parse(lr)
+	b.size -= limit - lr.N
+	if err != nil {
+		return nil, err
+	}
+	if len(cases) > b.cases {
+		return nil, fmt.Errorf("%w: more than %d test cases", errTestReportLimit, maxTestReportCases)
+	}
+	b.cases -= len(cases)
+	return cases, nil
+}
+
+func parseTestReportArtifact(artifact *actions_model.ActionArtifact, budget *testReportBudget) ([]*junit.TestCase, error) {
+	if artifact.FileCompressedSize > maxTestReportSize {
+		return nil, fmt.Errorf("the artifact is larger than %d bytes", maxTestReportSize)
+	}
+	f, err := storage.ActionsArtifacts.Open(artifact.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if !actions_module.IsArtifactV4(artifact) {
+		// an artifact of the old backend is a single file, which may be gzipped
+		if !isTestReportFile(artifact.ArtifactPath) {
+			return nil, nil
+		}
+		var r io.Reader = f
+		if artifact.ContentEncoding == "gzip" {
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				return nil, err
+			}
+			defer gz.Close()
+			r = gz
+		}
+		return budget.parse(r)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(f, maxTestReportSize))
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+	if len(zr.File) > maxTestReportFiles {
+		return nil, fmt.Errorf("%w: the artifact has more than %d entries", errTestReportLimit, maxTestReportFiles)
+	}
+	var cases []*junit.TestCase
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() || !isTestReportFile(zf.Name) {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, err
+		}
+		fileCases, err := budget.parse(rc)
+		_ = rc.Close()
+		if errors.Is(err, errTestReportLimit) {
+			return nil, err
+		} else if err != nil {
+			log.Warn("Failed to parse the test report %q of the artifact %d: %v", zf.Name, artifact.ID, err)
+			continue
+		}
+		cases = append(cases, fileCases...)
+	}
+	return cases, nil
+}
+
+func isTestReportFile(name string) bool {
+	return strings.EqualFold(path.Ext(name), ".xml")
+}
+
+// createTestReportCommitStatus creates a commit status with the summary of the test results of the run, it links to the test results.
+// It doesn't return an error if it fails, the error is logged because the commit status isn't critical.
+func createTestReportCommitStatus(ctx context.Context, run *actions_model.ActionRun, job *actions_model.ActionRunJob, summary *actions_model.TestSummary) {
+	if run.ScheduleID != 0 {
+		return
+	}
+	event, commitID, err := getCommitStatusEventNameAndCommitID(run)
+	if err != nil {
+		log.Error("GetCommitStatusEventNameAndSHA: %v", err)
+	}
+	if event == "" || commitID == "" {
+		return
+	}
+
+	runName := path.Base(run.WorkflowID)
+	if wfs, err := jobparser.Parse(job.WorkflowPayload); err == nil && len(wfs) > 0 {
+		runName = wfs[0].Name
+	}
+
+	state := commitstatus.CommitStatusSuccess
+	if summary.NumFailures() > 0 {
+		state = commitstatus.CommitStatusFailure
+	}
+	creator := user_model.NewActionsUser()
+	status := git_model.CommitStatus{
+		SHA:         commitID,
+		TargetURL:   run.Link() + "/tests",
+		Description: fmt.Sprintf("%d failed, %d passed, %d skipped", summary.NumFailures(), summary.Passed, summary.Skipped),
+		Context:     strings.TrimSpace(fmt.Sprintf("%s / Test report (%s)", runName, event)),
+		CreatorID:   creator.ID,
+		State:       state,
+	}
+	if err := commitstatus_service.CreateCommitStatus(ctx, run.Repo, creator, commitID, &status); err != nil {
+		log.Error("Failed to create the test report commit status of run %d: %v", run.ID, err)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package actions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestReportBudget(t *testing.T) {
+	report := `<testsuite name="pkg"><testcase name="TestA"/><testcase name="TestB"/></testsuite>`
+
+	budget := &testReportBudget{size: maxTestReportTotalSize, files: 2, cases: 3}
+	cases, err := budget.parse(strings.NewReader(report))
+	require.NoError(t, err)
+	assert.Len(t, cases, 2)
+	assert.EqualValues(t, maxTestReportTotalSize-len(report), budget.size)
+
+	// the second report has more cases than what is left
+	_, err = budget.parse(strings.NewReader(report))
+	assert.ErrorIs(t, err, errTestReportLimit)
+	// and no more report can be parsed
+	_, err = budget.parse(strings.NewReader(report))
+	assert.ErrorIs(t, err, errTestReportLimit)
+
+	// the decompressed size of all the reports is limited
+	budget = &testReportBudget{size: int64(len(report)), files: 2, cases: 10}
+	_, err = budget.parse(strings.NewReader(report))
+	require.NoError(t, err)
+	_, err = budget.parse(strings.NewReader(report))
+	assert.ErrorIs(t, err, errTestReportLimit)
+}
@@ -253,6 +253,9 @@ func ToActionWorkflowRun(ctx context.Context, repo *repo_model.Repository, run *
 		return nil, err
 	}
 	status, conclusion := ToActionsStatus(run.Status)
+	if run.NeedApproval {
+		conclusion = "action_required"
+	}
 	return &api.ActionWorkflowRun{
 		ID:           run.ID,
 		URL:          fmt.Sprintf("%s/actions/runs/%d", repo.APIURL(), run.ID),
@@ -529,6 +532,23 @@ func ToActionRunner(ctx context.Context, runner *actions_model.ActionRunner) *ap
 	}
 }
 
+// ToActionRequiredWorkflow converts an org required workflow to api format, the repository should have been loaded
+func ToActionRequiredWorkflow(rw *actions_model.ActionRequiredWorkflow) *api.ActionRequiredWorkflow {
+	topics := rw.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+	return &api.ActionRequiredWorkflow{
+		ID:           rw.ID,
+		RepoName:     rw.Repo.Name,
+		WorkflowPath: rw.WorkflowPath,
+		RepoPattern:  rw.RepoPattern,
+		Topics:       topics,
+		CreatedAt:    rw.Created.AsLocalTime(),
+		UpdatedAt:    rw.Updated.AsLocalTime(),
+	}
+}
+
 // ToVerification convert a git.Commit.Signature to an api.PayloadCommitVerification
 func ToVerification(ctx context.Context, c *git.Commit) *api.PayloadCommitVerification {
 	verif := asymkey_service.ParseCommitWithSignature(ctx, c)
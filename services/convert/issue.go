@@ -122,6 +122,11 @@ func toIssue(ctx context.Context, doer *user_model.User, issue *issues_model.Iss
 		apiIssue.Deadline = issue.DeadlineUnix.AsTimePtr()
 	}
 
+	var err error
+	if apiIssue.Type, apiIssue.Fields, err = toIssueTypeAndFields(ctx, issue); err != nil {
+		return &api.Issue{}
+	}
+
 	return apiIssue
 }
 
@@ -0,0 +1,28 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package convert
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	user_model "code.gitea.io/gitea/models/user"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// ToAPIIssueSavedSearch converts a saved issue search to API format, its owner must be loaded
+func ToAPIIssueSavedSearch(ctx context.Context, s *issues_model.IssueSavedSearch, doer *user_model.User) *api.IssueSavedSearch {
+	return &api.IssueSavedSearch{
+		ID:      s.ID,
+		Name:    s.Name,
+		Query:   s.Query,
+		Type:    util.Iif(s.IsPull, "pulls", "issues"),
+		Pinned:  s.IsPinned,
+		Shared:  s.IsShared,
+		Owner:   ToUser(ctx, s.Owner, doer),
+		Created: s.CreatedUnix.AsTime(),
+		Updated: s.UpdatedUnix.AsTime(),
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package convert
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	api "code.gitea.io/gitea/modules/structs"
+)
+
+// ToAPIIssueType converts an issue type to API format, its fields must be loaded
+func ToAPIIssueType(t *issues_model.IssueType) *api.IssueType {
+	fields := make([]*api.IssueField, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		fields = append(fields, ToAPIIssueField(field))
+	}
+	return &api.IssueType{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		Icon:        t.Icon,
+		Color:       t.Color,
+		Fields:      fields,
+	}
+}
+
+// ToAPIIssueTypeList converts a list of issue types to API format, their fields must be loaded
+func ToAPIIssueTypeList(types issues_model.IssueTypeList) []*api.IssueType {
+	result := make([]*api.IssueType, len(types))
+	for i := range types {
+		result[i] = ToAPIIssueType(types[i])
+	}
+	return result
+}
+
+// ToAPIIssueField converts a custom field of an issue type to API format
+func ToAPIIssueField(field *issues_model.IssueField) *api.IssueField {
+	return &api.IssueField{
+		ID:      field.ID,
+		Name:    field.Name,
+		Type:    field.Type.String(),
+		Options: field.Options,
+	}
+}
+
+// toIssueTypeAndFields returns the issue type of an issue and the values of its custom fields by field name
+func toIssueTypeAndFields(ctx context.Context, issue *issues_model.Issue) (*api.IssueType, map[string]string, error) {
+	if err := issue.LoadType(ctx); err != nil {
+		return nil, nil, err
+	}
+	if issue.Type == nil {
+		return nil, nil, nil
+	}
+	if err := issue.Type.LoadFields(ctx); err != nil {
+		return nil, nil, err
+	}
+	values, err := issues_model.GetIssueFieldValues(ctx, []int64{issue.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := make(map[string]string, len(values[issue.ID]))
+	for _, field := range issue.Type.Fields {
+		if value, ok := values[issue.ID][field.ID]; ok {
+			fields[field.Name] = value
+		}
+	}
+	return ToAPIIssueType(issue.Type), fields, nil
+}
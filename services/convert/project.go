@@ -0,0 +1,103 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package convert
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/httplib"
+	api "code.gitea.io/gitea/modules/structs"
+)
+
+// ToAPIProject converts a project to API format, the issue numbers of the project have to be loaded by the caller
+func ToAPIProject(ctx context.Context, project *project_model.Project, doer *user_model.User) *api.Project {
+	apiProject := &api.Project{
+		ID:           project.ID,
+		Title:        project.Title,
+		Description:  project.Description,
+		OwnerID:      project.OwnerID,
+		RepoID:       project.RepoID,
+		State:        api.StateOpen,
+		CardType:     "text_only",
+		OpenIssues:   project.NumOpenIssues,
+		ClosedIssues: project.NumClosedIssues,
+		HTMLURL:      httplib.MakeAbsoluteURL(ctx, project.Link(ctx)),
+		Created:      project.CreatedUnix.AsTime(),
+		Updated:      project.UpdatedUnix.AsTime(),
+	}
+	if project.CardType == project_model.CardTypeImagesAndText {
+		apiProject.CardType = "images_and_text"
+	}
+	if project.IsClosed {
+		apiProject.State = api.StateClosed
+		apiProject.Closed = project.ClosedDateUnix.AsTimePtr()
+	}
+	if creator, err := user_model.GetPossibleUserByID(ctx, project.CreatorID); err == nil {
+		apiProject.Creator = ToUser(ctx, creator, doer)
+	} else {
+		apiProject.Creator = ToUser(ctx, user_model.NewGhostUser(), doer)
+	}
+	return apiProject
+}
+
+// ToAPIProjectColumn converts a column of a project to API format
+func ToAPIProjectColumn(column *project_model.Column) *api.ProjectColumn {
+	return &api.ProjectColumn{
+		ID:        column.ID,
+		ProjectID: column.ProjectID,
+		Title:     column.Title,
+		Color:     column.Color,
+		Default:   column.Default,
+		Sorting:   int(column.Sorting),
+		Created:   column.CreatedUnix.AsTime(),
+		Updated:   column.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToAPIProjectIssue converts an issue at a position of a column of a project to API format
+func ToAPIProjectIssue(ctx context.Context, doer *user_model.User, column *project_model.Column, position int, issue *issues_model.Issue) *api.ProjectIssue {
+	return &api.ProjectIssue{
+		ProjectID: column.ProjectID,
+		ColumnID:  column.ID,
+		Position:  position,
+		Issue:     ToAPIIssue(ctx, doer, issue),
+	}
+}
+
+// ToAPIProjectField converts a custom field of a project to API format
+func ToAPIProjectField(field *project_model.Field) *api.ProjectField {
+	options := make([]*api.ProjectFieldOption, 0, len(field.Options))
+	for _, option := range field.Options {
+		options = append(options, &api.ProjectFieldOption{
+			ID:    option.ID,
+			Name:  option.Name,
+			Color: option.Color,
+		})
+	}
+	return &api.ProjectField{
+		ID:                 field.ID,
+		ProjectID:          field.ProjectID,
+		Name:               field.Name,
+		Type:               field.Type.String(),
+		Options:            options,
+		IterationStartDate: field.IterationStartDate,
+		IterationDuration:  field.IterationDuration,
+		Created:            field.CreatedUnix.AsTime(),
+		Updated:            field.UpdatedUnix.AsTime(),
+	}
+}
+
+// ToAPIProjectFieldValue converts the value of a custom field of a project to API format
+func ToAPIProjectFieldValue(field *project_model.Field, value string) *api.ProjectFieldValue {
+	return &api.ProjectFieldValue{
+		FieldID:      field.ID,
+		FieldName:    field.Name,
+		Type:         field.Type.String(),
+		Value:        value,
+		DisplayValue: field.DisplayValue(value),
+	}
+}
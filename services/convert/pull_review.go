@@ -123,6 +123,15 @@ func ToPullReviewComment(ctx context.Context, comment *issues_model.Comment, doe
 		apiComment.LineNum = comment.UnsignedLine()
 	}
 
+	apiComment.SubjectType = "line"
+	if comment.IsFileComment() {
+		apiComment.SubjectType = "file"
+	} else if comment.IsMultiLine() && comment.Line < 0 {
+		apiComment.OldStartLineNum = comment.UnsignedStartLine()
+	} else if comment.IsMultiLine() {
+		apiComment.StartLineNum = comment.UnsignedStartLine()
+	}
+
 	return apiComment
 }
 
@@ -5,10 +5,12 @@ package cron
 
 import (
 	"context"
+	"time"
 
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/setting"
 	actions_service "code.gitea.io/gitea/services/actions"
+	"code.gitea.io/gitea/services/actions/scaler"
 )
 
 func initActionsTasks() {
@@ -20,6 +22,10 @@ func initActionsTasks() {
 	registerCancelAbandonedJobs()
 	registerScheduleTasks()
 	registerActionsCleanup()
+	registerRollupActionsStats()
+	if setting.Actions.Scaler.Enabled {
+		registerScaleRunners()
+	}
 }
 
 func registerStopZombieTasks() {
@@ -74,3 +80,27 @@ func registerActionsCleanup() {
 		return actions_service.Cleanup(ctx)
 	})
 }
+
+func registerRollupActionsStats() {
+	RegisterTaskFatal("actions_rollup_stats", &OlderThanConfig{
+		BaseConfig: BaseConfig{
+			Enabled:    true,
+			RunAtStart: true,
+			Schedule:   "@midnight",
+		},
+		OlderThan: 90 * 24 * time.Hour,
+	}, func(ctx context.Context, _ *user_model.User, config Config) error {
+		realConfig := config.(*OlderThanConfig)
+		return actions_service.RollupStats(ctx, realConfig.OlderThan)
+	})
+}
+
+func registerScaleRunners() {
+	RegisterTaskFatal("actions_scale_runners", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: true,
+		Schedule:   "@every 30s",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return scaler.ScaleRunners(ctx)
+	})
+}
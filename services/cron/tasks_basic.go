@@ -14,11 +14,13 @@ import (
 	"code.gitea.io/gitea/modules/git/gitcmd"
 	"code.gitea.io/gitea/modules/setting"
 	"code.gitea.io/gitea/services/auth"
+	issue_service "code.gitea.io/gitea/services/issue"
 	"code.gitea.io/gitea/services/migrations"
 	mirror_service "code.gitea.io/gitea/services/mirror"
 	packages_cleanup_service "code.gitea.io/gitea/services/packages/cleanup"
 	repo_service "code.gitea.io/gitea/services/repository"
 	archiver_service "code.gitea.io/gitea/services/repository/archiver"
+	sla_service "code.gitea.io/gitea/services/sla"
 )
 
 func registerUpdateMirrorTask() {
@@ -166,6 +168,36 @@ func registerSyncRepoLicenses() {
 	})
 }
 
+func registerCheckIssueSLAs() {
+	RegisterTaskFatal("check_issue_slas", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@every 10m",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return sla_service.CheckIssueSLAs(ctx)
+	})
+}
+
+func registerCreateScheduledIssues() {
+	RegisterTaskFatal("create_scheduled_issues", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@every 1m",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return issue_service.RunDueIssueSchedules(ctx)
+	})
+}
+
+func registerUpdateMilestoneSnapshots() {
+	RegisterTaskFatal("update_milestone_snapshots", &BaseConfig{
+		Enabled:    true,
+		RunAtStart: false,
+		Schedule:   "@midnight",
+	}, func(ctx context.Context, _ *user_model.User, _ Config) error {
+		return issue_service.UpdateMilestoneSnapshots(ctx)
+	})
+}
+
 func initBasicTasks() {
 	if setting.Mirror.Enabled {
 		registerUpdateMirrorTask()
@@ -183,4 +215,7 @@ func initBasicTasks() {
 		registerCleanupPackages()
 	}
 	registerSyncRepoLicenses()
+	registerCheckIssueSLAs()
+	registerCreateScheduledIssues()
+	registerUpdateMilestoneSnapshots()
 }
@@ -77,3 +77,28 @@ func (f *CreateTeamForm) Validate(req *http.Request, errs binding.Errors) bindin
 	ctx := context.GetValidateContext(req)
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
+
+// EditIssueTypeForm is a form for creating or editing an issue type of an organization
+type EditIssueTypeForm struct {
+	Name        string `binding:"Required;MaxSize(100)"`
+	Description string `binding:"MaxSize(255)"`
+	Icon        string
+	Color       string `binding:"MaxSize(7)"`
+}
+
+// EditIssueFieldForm is a form for creating or editing a custom field of an issue type
+type EditIssueFieldForm struct {
+	Name string `binding:"Required;MaxSize(100)"`
+	Type string
+	// the options of a select field, one option per line
+	Options string
+}
+
+// CreateRequiredWorkflowForm is a form for creating a required workflow of an organization
+type CreateRequiredWorkflowForm struct {
+	RepoName     string `binding:"Required"`
+	WorkflowPath string `binding:"Required;MaxSize(255)"`
+	RepoPattern  string `binding:"MaxSize(255)"`
+	// the topics of the targeted repositories, separated by commas
+	Topics string
+}
@@ -146,6 +146,7 @@ type RepoSettingForm struct {
 	EnableTimetracker                bool
 	AllowOnlyContributorsToTrackTime bool
 	EnableIssueDependencies          bool
+	CloseParentWhenSubIssuesClosed   bool
 
 	// Signing Settings
 	TrustModel string
@@ -230,6 +231,8 @@ type WebhookForm struct {
 	Status                   bool
 	WorkflowRun              bool
 	WorkflowJob              bool
+	Project                  bool
+	ProjectCard              bool
 	Active                   bool
 	BranchFilter             string `binding:"GlobPattern"`
 	AuthorizationHeader      string
@@ -414,6 +417,7 @@ type CreateIssueForm struct {
 	Ref                 string `form:"ref"`
 	MilestoneID         int64
 	ProjectID           int64
+	IssueTypeID         int64
 	Content             string
 	Files               []string
 	AllowMaintainerEdit bool
@@ -475,6 +479,24 @@ type EditProjectColumnForm struct {
 	Color   string `binding:"MaxSize(7)"`
 }
 
+// EditProjectFieldForm is a form for creating or editing a custom field of a project
+type EditProjectFieldForm struct {
+	Name string `binding:"Required;MaxSize(100)"`
+	Type string
+	// the options of a single select field, one option per line with an optional color: "Done #2ea44f"
+	Options            string
+	IterationStartDate string
+	IterationDuration  int
+}
+
+// EditProjectViewForm is a form for creating or saving a view of a project
+type EditProjectViewForm struct {
+	Name string `binding:"Required;MaxSize(100)"`
+	Type string
+	// the query string of the board or the view when the form was submitted, its filters, sort and grouping are saved
+	Query string
+}
+
 // CreateMilestoneForm form for creating milestone
 type CreateMilestoneForm struct {
 	Title    string `binding:"Required;MaxSize(50)"`
@@ -516,6 +538,41 @@ func (f *InitializeLabelsForm) Validate(req *http.Request, errs binding.Errors)
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
 
+// IssueSLAPolicyForm form for creating or editing an SLA policy of a repository
+type IssueSLAPolicyForm struct {
+	Name                string `binding:"Required;MaxSize(100)"`
+	LabelID             int64
+	TypeID              int64
+	FirstResponseHours  int `binding:"Range(0,10000)"`
+	ResolutionHours     int `binding:"Range(0,10000)"`
+	EscalateToAssignees bool
+	EscalationTeamID    int64
+}
+
+// Validate validates the fields
+func (f *IssueSLAPolicyForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
+// IssueScheduleForm form for creating or editing an issue schedule of a repository
+type IssueScheduleForm struct {
+	Name            string `binding:"Required;MaxSize(100)"`
+	TemplateFile    string `binding:"Required;MaxSize(255)"`
+	Spec            string `binding:"Required;MaxSize(255)"`
+	LabelIDs        string `form:"label_ids"`
+	AssigneeIDs     string `form:"assignee_ids"`
+	ProjectColumnID int64
+	ClosePrevious   bool
+	IsActive        bool
+}
+
+// Validate validates the fields
+func (f *IssueScheduleForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
+
 // MergePullRequestForm form for merging Pull Request
 // swagger:model MergePullRequestOption
 type MergePullRequestForm struct {
@@ -543,6 +600,7 @@ type CodeCommentForm struct {
 	Content        string `binding:"Required"`
 	Side           string `binding:"Required;In(previous,proposed)"`
 	Line           int64
+	StartLine      int64
 	TreePath       string `form:"path" binding:"Required"`
 	SingleReview   bool   `form:"single_review"`
 	Reply          int64  `form:"reply"`
@@ -436,3 +436,18 @@ func (f *BlockUserForm) Validate(req *http.Request, errs binding.Errors) binding
 	ctx := context.GetValidateContext(req)
 	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
 }
+
+// IssueSavedSearchForm is a form for saving a query of the issues or pull requests dashboard
+type IssueSavedSearchForm struct {
+	Name       string `binding:"Required;MaxSize(100)"`
+	Query      string `binding:"Required;MaxSize(1024)"`
+	IsPull     bool
+	IsPinned   bool
+	IsShared   bool
+	RedirectTo string
+}
+
+func (f *IssueSavedSearchForm) Validate(req *http.Request, errs binding.Errors) binding.Errors {
+	ctx := context.GetValidateContext(req)
+	return middleware.Validate(errs, ctx.Data, f, ctx.Locale)
+}
@@ -23,6 +23,7 @@ var hiddenCommentTypeGroups = hiddenCommentTypeGroupsType{
 	},
 	"label": {
 		/*7*/ issues_model.CommentTypeLabel,
+		/*43*/ issues_model.CommentTypeChangeIssueType,
 	},
 	"milestone": {
 		/*8*/ issues_model.CommentTypeMilestone,
@@ -53,6 +54,10 @@ var hiddenCommentTypeGroups = hiddenCommentTypeGroupsType{
 	"dependency": {
 		/*19*/ issues_model.CommentTypeAddDependency,
 		/*20*/ issues_model.CommentTypeRemoveDependency,
+		/*39*/ issues_model.CommentTypeAddSubIssue,
+		/*40*/ issues_model.CommentTypeRemoveSubIssue,
+		/*41*/ issues_model.CommentTypeAddParentIssue,
+		/*42*/ issues_model.CommentTypeRemoveParentIssue,
 	},
 	"lock": {
 		/*23*/ issues_model.CommentTypeLock,
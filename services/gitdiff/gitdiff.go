@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strings"
 
+	actions_model "code.gitea.io/gitea/models/actions"
 	"code.gitea.io/gitea/models/db"
 	git_model "code.gitea.io/gitea/models/git"
 	issues_model "code.gitea.io/gitea/models/issues"
@@ -76,6 +77,7 @@ type DiffLine struct {
 	Type        DiffLineType
 	Content     string
 	Comments    issues_model.CommentList // related PR code comments
+	Annotations []*actions_model.ActionTaskAnnotation
 	SectionInfo *DiffLineSectionInfo
 }
 
@@ -426,6 +428,9 @@ type DiffFile struct {
 	// will be filled by route handler
 	IsProtected bool
 
+	// will be filled by LoadComments, the comments on the whole file
+	Comments issues_model.CommentList
+
 	// will be filled by SyncUserSpecificDiff
 	IsViewed                  bool // User specific
 	HasChangedSinceLastReview bool // User specific
@@ -572,12 +577,14 @@ func (diff *Diff) LoadComments(ctx context.Context, issue *issues_model.Issue, c
 	}
 	for _, file := range diff.Files {
 		if lineCommits, ok := allComments[file.Name]; ok {
+			// the comments on the whole file have no line
+			file.Comments = lineCommits[0]
 			for _, section := range file.Sections {
 				for _, line := range section.Lines {
-					if comments, ok := lineCommits[int64(line.LeftIdx*-1)]; ok {
+					if comments, ok := lineCommits[int64(line.LeftIdx*-1)]; ok && line.LeftIdx > 0 {
 						line.Comments = append(line.Comments, comments...)
 					}
-					if comments, ok := lineCommits[int64(line.RightIdx)]; ok {
+					if comments, ok := lineCommits[int64(line.RightIdx)]; ok && line.RightIdx > 0 {
 						line.Comments = append(line.Comments, comments...)
 					}
 					sort.SliceStable(line.Comments, func(i, j int) bool {
@@ -592,6 +599,40 @@ func (diff *Diff) LoadComments(ctx context.Context, issue *issues_model.Issue, c
 	return nil
 }
 
+// LoadAnnotations loads the annotations reported by the actions tasks run on the commit into the lines of the new files,
+// an annotation of several lines is shown below its last line.
+func (diff *Diff) LoadAnnotations(ctx context.Context, repoID int64, commitSHA string) error {
+	annotations, err := actions_model.GetAnnotationsOfCommit(ctx, repoID, commitSHA)
+	if err != nil {
+		return err
+	}
+	fileAnnotations := make(map[string]map[int][]*actions_model.ActionTaskAnnotation)
+	for _, a := range annotations {
+		if a.Line <= 0 {
+			continue
+		}
+		if fileAnnotations[a.File] == nil {
+			fileAnnotations[a.File] = make(map[int][]*actions_model.ActionTaskAnnotation)
+		}
+		line := max(a.Line, a.EndLine)
+		fileAnnotations[a.File][line] = append(fileAnnotations[a.File][line], a)
+	}
+	for _, file := range diff.Files {
+		lineAnnotations, ok := fileAnnotations[file.Name]
+		if !ok {
+			continue
+		}
+		for _, section := range file.Sections {
+			for _, line := range section.Lines {
+				if line.RightIdx > 0 && line.Type != DiffLineSection {
+					line.Annotations = lineAnnotations[line.RightIdx]
+				}
+			}
+		}
+	}
+	return nil
+}
+
 const cmdDiffHead = "diff --git "
 
 // ParsePatch builds a Diff object from a io.Reader and some parameters.
@@ -1551,7 +1592,8 @@ func generatePatchForUnchangedLineFromReader(reader io.Reader, treePath string,
 
 // CommentMustAsDiff executes AsDiff and logs the error instead of returning
 func CommentMustAsDiff(ctx context.Context, c *issues_model.Comment) *Diff {
-	if c == nil {
+	if c == nil || c.IsFileComment() {
+		// a comment on the whole file has no patch
 		return nil
 	}
 	defer func() {
@@ -307,12 +307,17 @@ func deleteIssue(ctx context.Context, issue *issues_model.Issue) ([]string, erro
 			&issues_model.Stopwatch{IssueID: issue.ID},
 			&issues_model.TrackedTime{IssueID: issue.ID},
 			&project_model.ProjectIssue{IssueID: issue.ID},
+			&project_model.FieldValue{IssueID: issue.ID},
 			&repo_model.Attachment{IssueID: issue.ID},
 			&issues_model.PullRequest{IssueID: issue.ID},
 			&issues_model.Comment{RefIssueID: issue.ID},
 			&issues_model.IssueDependency{DependencyID: issue.ID},
 			&issues_model.Comment{DependentIssueID: issue.ID},
 			&issues_model.IssuePin{IssueID: issue.ID},
+			&issues_model.SubIssue{IssueID: issue.ID},
+			&issues_model.SubIssue{ParentID: issue.ID},
+			&issues_model.IssueFieldValue{IssueID: issue.ID},
+			&issues_model.IssueSLA{IssueID: issue.ID},
 		); err != nil {
 			return nil, err
 		}
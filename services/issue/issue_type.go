@@ -0,0 +1,73 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"slices"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/util"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+// ChangeIssueType changes the type of an issue, a nil type removes it
+func ChangeIssueType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, t *issues_model.IssueType) error {
+	if err := issue.LoadType(ctx); err != nil {
+		return err
+	}
+	oldTypeID, oldType := issue.TypeID, issue.Type
+	if err := issues_model.ChangeIssueType(ctx, doer, issue, t); err != nil {
+		return err
+	}
+	if issue.TypeID != oldTypeID {
+		notify_service.IssueChangeType(ctx, doer, issue, oldType)
+	}
+	return nil
+}
+
+// SetIssueFieldValues sets the values of custom fields of the type of an issue by field ID, an empty value removes it.
+// No value is set if one of them is invalid.
+func SetIssueFieldValues(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, values map[int64]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	if err := issue.LoadType(ctx); err != nil {
+		return err
+	}
+	if issue.Type == nil {
+		return util.NewInvalidArgumentErrorf("the issue has no type")
+	}
+	if err := issue.Type.LoadFields(ctx); err != nil {
+		return err
+	}
+
+	fields := make(map[int64]*issues_model.IssueField, len(values))
+	for fieldID, value := range values {
+		idx := slices.IndexFunc(issue.Type.Fields, func(field *issues_model.IssueField) bool { return field.ID == fieldID })
+		if idx < 0 {
+			return issues_model.ErrIssueFieldNotExist{ID: fieldID}
+		}
+		if _, err := issue.Type.Fields[idx].NormalizeValue(value); err != nil {
+			return err
+		}
+		fields[fieldID] = issue.Type.Fields[idx]
+	}
+
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		for fieldID, value := range values {
+			if err := issues_model.SetIssueFieldValue(ctx, issue, fields[fieldID], value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	notify_service.IssueChangeFieldValues(ctx, doer, issue)
+	return nil
+}
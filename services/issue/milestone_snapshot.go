@@ -0,0 +1,102 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/log"
+
+	"xorm.io/builder"
+)
+
+// maxMilestoneSnapshotDays is the number of the last days of a milestone whose snapshots are backfilled
+const maxMilestoneSnapshotDays = 366
+
+// milestoneSnapshotDays returns the days between the start and the end of a milestone, the end is now for an open milestone
+func milestoneSnapshotDays(m *issues_model.Milestone, from, now time.Time) []time.Time {
+	first := issues_model.MilestoneSnapshotDay(m.CreatedUnix.AsTime())
+	last := issues_model.MilestoneSnapshotDay(now)
+	if m.IsClosed && m.ClosedDateUnix > 0 {
+		last = issues_model.MilestoneSnapshotDay(m.ClosedDateUnix.AsTime())
+	}
+	if from = issues_model.MilestoneSnapshotDay(from); from.After(first) {
+		first = from
+	}
+	if limit := last.AddDate(0, 0, -maxMilestoneSnapshotDays+1); limit.After(first) {
+		first = limit
+	}
+	var days []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// BackfillMilestoneSnapshots computes the snapshots of every day of a milestone from the history of its issues
+func BackfillMilestoneSnapshots(ctx context.Context, m *issues_model.Milestone) error {
+	return updateMilestoneSnapshots(ctx, m, time.Time{}, time.Now())
+}
+
+func updateMilestoneSnapshots(ctx context.Context, m *issues_model.Milestone, from, now time.Time) error {
+	days := milestoneSnapshotDays(m, from, now)
+	if len(days) == 0 {
+		return nil
+	}
+	snapshots, err := issues_model.ComputeMilestoneSnapshots(ctx, m, days)
+	if err != nil {
+		return err
+	}
+	return issues_model.SaveMilestoneSnapshots(ctx, m.ID, snapshots)
+}
+
+// UpdateMilestoneSnapshots collects the snapshots of yesterday and today of the open milestones and of the milestones
+// closed since yesterday, the milestones without snapshots are backfilled
+func UpdateMilestoneSnapshots(ctx context.Context) error {
+	now := time.Now()
+	yesterday := issues_model.MilestoneSnapshotDay(now).AddDate(0, 0, -1)
+	cond := builder.Eq{"is_closed": false}.Or(builder.Gte{"closed_date_unix": yesterday.Unix()})
+	return db.Iterate(ctx, cond, func(ctx context.Context, m *issues_model.Milestone) error {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("before updating the snapshots of milestone %d", m.ID)
+		default:
+		}
+		from := yesterday
+		if has, err := issues_model.HasMilestoneSnapshots(ctx, m.ID); err != nil {
+			return err
+		} else if !has {
+			from = time.Time{}
+		}
+		if err := updateMilestoneSnapshots(ctx, m, from, now); err != nil {
+			log.Error("updateMilestoneSnapshots [%d]: %v", m.ID, err)
+		}
+		return nil
+	})
+}
+
+// GetMilestoneSnapshots returns the snapshots of every day of a milestone, the days not collected yet are computed
+// from the history of its issues but not saved
+func GetMilestoneSnapshots(ctx context.Context, m *issues_model.Milestone) ([]*issues_model.MilestoneSnapshot, error) {
+	snapshots, err := issues_model.GetMilestoneSnapshots(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	var from time.Time
+	if len(snapshots) > 0 {
+		from = snapshots[len(snapshots)-1].Day.AsTime().AddDate(0, 0, 1)
+	}
+	days := milestoneSnapshotDays(m, from, time.Now())
+	if len(days) == 0 {
+		return snapshots, nil
+	}
+	missing, err := issues_model.ComputeMilestoneSnapshots(ctx, m, days)
+	if err != nil {
+		return nil, err
+	}
+	return append(snapshots, missing...), nil
+}
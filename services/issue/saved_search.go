@@ -0,0 +1,27 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	issue_indexer "code.gitea.io/gitea/modules/indexer/issues"
+)
+
+// CreateIssueSavedSearch checks the query of a saved search and adds it to its owner
+func CreateIssueSavedSearch(ctx context.Context, s *issues_model.IssueSavedSearch) error {
+	if _, err := issue_indexer.ParseQuery(s.Query); err != nil {
+		return err
+	}
+	return issues_model.NewIssueSavedSearch(ctx, s)
+}
+
+// UpdateIssueSavedSearch checks the query of a saved search and updates it
+func UpdateIssueSavedSearch(ctx context.Context, s *issues_model.IssueSavedSearch) error {
+	if _, err := issue_indexer.ParseQuery(s.Query); err != nil {
+		return err
+	}
+	return issues_model.UpdateIssueSavedSearch(ctx, s)
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	project_model "code.gitea.io/gitea/models/project"
+	"code.gitea.io/gitea/models/unit"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/gitrepo"
+	issue_template "code.gitea.io/gitea/modules/issue/template"
+	"code.gitea.io/gitea/modules/log"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// setIssueScheduleNext parses the spec of an issue schedule and sets its next run after now
+func setIssueScheduleNext(s *issues_model.IssueSchedule, now time.Time) error {
+	schedule, err := actions_model.ParseScheduleSpec(strings.TrimSpace(s.Spec))
+	if err != nil {
+		return util.NewInvalidArgumentErrorf("invalid schedule spec %q: %v", s.Spec, err)
+	}
+	s.Next = timeutil.TimeStamp(schedule.Next(now).Unix())
+	return nil
+}
+
+// CreateIssueSchedule checks the spec of an issue schedule and adds it to its repository
+func CreateIssueSchedule(ctx context.Context, s *issues_model.IssueSchedule) error {
+	if err := setIssueScheduleNext(s, time.Now()); err != nil {
+		return err
+	}
+	return issues_model.NewIssueSchedule(ctx, s)
+}
+
+// UpdateIssueSchedule checks the spec of an issue schedule and updates it, the next run is computed again
+func UpdateIssueSchedule(ctx context.Context, s *issues_model.IssueSchedule) error {
+	if err := setIssueScheduleNext(s, time.Now()); err != nil {
+		return err
+	}
+	return issues_model.UpdateIssueSchedule(ctx, s)
+}
+
+// issueTemplateValues returns the default values of the fields of an issue form
+func issueTemplateValues(template *api.IssueTemplate) url.Values {
+	values := url.Values{}
+	for _, field := range template.Fields {
+		if value, ok := field.Attributes["value"].(string); ok && field.ID != "" {
+			values.Set("form-field-"+field.ID, value)
+		}
+	}
+	return values
+}
+
+// issueScheduleLabelIDs returns the labels of the template and the schedule which can still be used in the repository
+func issueScheduleLabelIDs(ctx context.Context, s *issues_model.IssueSchedule, template *api.IssueTemplate) ([]int64, error) {
+	ids := container.SetOf(s.LabelIDs...)
+	if len(template.Labels) > 0 {
+		repoLabelIDs, err := issues_model.GetLabelIDsInRepoByNames(ctx, s.RepoID, template.Labels)
+		if err != nil {
+			return nil, err
+		}
+		ids.AddMultiple(repoLabelIDs...)
+		if s.Repo.Owner.IsOrganization() {
+			orgLabelIDs, err := issues_model.GetLabelIDsInOrgByNames(ctx, s.Repo.OwnerID, template.Labels)
+			if err != nil {
+				return nil, err
+			}
+			ids.AddMultiple(orgLabelIDs...)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	labels, err := issues_model.GetLabelsByIDs(ctx, ids.Values(), "id", "repo_id", "org_id")
+	if err != nil {
+		return nil, err
+	}
+	labelIDs := make([]int64, 0, len(labels))
+	for _, label := range labels {
+		if label.RepoID == s.RepoID || (label.BelongsToOrg() && label.OrgID == s.Repo.OwnerID) {
+			labelIDs = append(labelIDs, label.ID)
+		}
+	}
+	return labelIDs, nil
+}
+
+// issueScheduleAssigneeIDs returns the assignees of the template and the schedule which can be assigned to the issue
+func issueScheduleAssigneeIDs(ctx context.Context, s *issues_model.IssueSchedule, template *api.IssueTemplate) ([]int64, error) {
+	ids := container.SetOf(s.AssigneeIDs...)
+	if len(template.Assignees) > 0 {
+		userIDs, err := user_model.GetUserIDsByNames(ctx, template.Assignees, true)
+		if err != nil {
+			return nil, err
+		}
+		ids.AddMultiple(userIDs...)
+	}
+	assigneeIDs := make([]int64, 0, len(ids))
+	for _, id := range ids.Values() {
+		u, err := user_model.GetUserByID(ctx, id)
+		if err != nil {
+			if user_model.IsErrUserNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if ok, err := access_model.CanBeAssigned(ctx, u, s.Repo, false); err != nil {
+			return nil, err
+		} else if ok {
+			assigneeIDs = append(assigneeIDs, id)
+		}
+	}
+	return assigneeIDs, nil
+}
+
+// issueScheduleIssueType returns the issue type named by the template and its field values
+func issueScheduleIssueType(ctx context.Context, s *issues_model.IssueSchedule, template *api.IssueTemplate) (*issues_model.IssueType, map[int64]string, error) {
+	name := strings.TrimSpace(template.IssueType)
+	if name == "" {
+		return nil, nil, nil
+	}
+	t, err := issues_model.GetIssueTypeByName(ctx, s.Repo.OwnerID, name)
+	if err != nil {
+		if issues_model.IsErrIssueTypeNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if err := t.LoadFields(ctx); err != nil {
+		return nil, nil, err
+	}
+	values := make(map[int64]string, len(template.IssueFields))
+	for fieldName, value := range template.IssueFields {
+		field := t.Fields.GetFieldByName(fieldName)
+		if field == nil {
+			continue
+		}
+		if value, err := field.NormalizeValue(value); err == nil && value != "" {
+			values[field.ID] = value
+		}
+	}
+	return t, values, nil
+}
+
+// RunIssueSchedule opens the issue of an issue schedule from its template and closes the issue of the previous run if needed,
+// the run and the next run are recorded even if opening the issue fails so a broken schedule doesn't run again and again
+func RunIssueSchedule(ctx context.Context, s *issues_model.IssueSchedule) (*issues_model.Issue, error) {
+	now := time.Now()
+	issue, err := runIssueSchedule(ctx, s)
+	if issue != nil {
+		s.LastIssueID = issue.ID
+	}
+	s.Prev = timeutil.TimeStamp(now.Unix())
+	if err := setIssueScheduleNext(s, now); err != nil {
+		// the spec was checked when the schedule was saved, stop it rather than retrying every minute
+		s.Next = 0
+	}
+	if updateErr := issues_model.UpdateIssueScheduleRun(ctx, s); updateErr != nil {
+		return issue, errors.Join(err, updateErr)
+	}
+	return issue, err
+}
+
+func runIssueSchedule(ctx context.Context, s *issues_model.IssueSchedule) (*issues_model.Issue, error) {
+	if err := s.LoadRepo(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.Repo.LoadOwner(ctx); err != nil {
+		return nil, err
+	}
+	if s.Repo.IsArchived || s.Repo.IsEmpty {
+		return nil, fmt.Errorf("repository %s is archived or empty", s.Repo.FullName())
+	}
+	if err := s.LoadCreator(ctx); err != nil {
+		return nil, err
+	}
+	perm, err := access_model.GetUserRepoPermission(ctx, s.Repo, s.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !perm.CanWrite(unit.TypeIssues) {
+		return nil, util.NewPermissionDeniedErrorf("user %s can't write issues of %s", s.Creator.Name, s.Repo.FullName())
+	}
+
+	gitRepo, err := gitrepo.OpenRepository(ctx, s.Repo)
+	if err != nil {
+		return nil, err
+	}
+	defer gitRepo.Close()
+	template, err := issue_template.UnmarshalFromRepo(gitRepo, s.Repo.DefaultBranch, s.TemplateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs, err := issueScheduleLabelIDs(ctx, s, template)
+	if err != nil {
+		return nil, err
+	}
+	assigneeIDs, err := issueScheduleAssigneeIDs(ctx, s, template)
+	if err != nil {
+		return nil, err
+	}
+	issueType, issueFieldValues, err := issueScheduleIssueType(ctx, s, template)
+	if err != nil {
+		return nil, err
+	}
+
+	content := template.Content
+	if len(template.Fields) > 0 {
+		content = issue_template.RenderToMarkdown(template, issueTemplateValues(template))
+	}
+	issue := &issues_model.Issue{
+		RepoID:   s.RepoID,
+		Repo:     s.Repo,
+		Title:    util.IfZero(strings.TrimSpace(template.Title), s.Name),
+		PosterID: s.Creator.ID,
+		Poster:   s.Creator,
+		Content:  content,
+	}
+	if issueType != nil {
+		issue.TypeID, issue.Type = issueType.ID, issueType
+	}
+	if err := NewIssue(ctx, s.Repo, issue, labelIDs, nil, assigneeIDs, 0); err != nil {
+		return nil, err
+	}
+	if err := SetIssueFieldValues(ctx, s.Creator, issue, issueFieldValues); err != nil {
+		return issue, err
+	}
+
+	if s.ProjectColumnID > 0 {
+		column, err := project_model.GetColumn(ctx, s.ProjectColumnID)
+		if err != nil && !project_model.IsErrProjectColumnNotExist(err) {
+			return issue, err
+		}
+		if column != nil {
+			if err := issues_model.IssueAssignOrRemoveProject(ctx, issue, s.Creator, column.ProjectID, column.ID); err != nil {
+				if !errors.Is(err, util.ErrPermissionDenied) && !project_model.IsErrProjectNotExist(err) {
+					return issue, err
+				}
+				log.Warn("Issue schedule %d can't add issue %d to project column %d: %v", s.ID, issue.ID, column.ID, err)
+			}
+		}
+	}
+
+	if s.ClosePrevious && s.LastIssueID > 0 {
+		previous, err := issues_model.GetIssueByID(ctx, s.LastIssueID)
+		if err != nil && !issues_model.IsErrIssueNotExist(err) {
+			return issue, err
+		}
+		if previous != nil && previous.RepoID == s.RepoID && !previous.IsClosed {
+			if err := CloseIssue(ctx, previous, s.Creator, ""); err != nil {
+				return issue, err
+			}
+		}
+	}
+	return issue, nil
+}
+
+// RunDueIssueSchedules opens the issues of the issue schedules whose next run is due,
+// the schedules left over are run by the next call
+func RunDueIssueSchedules(ctx context.Context) error {
+	schedules, err := issues_model.FindDueIssueSchedules(ctx, timeutil.TimeStampNow(), 100)
+	if err != nil {
+		return err
+	}
+	for _, s := range schedules {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if _, err := RunIssueSchedule(ctx, s); err != nil {
+			log.Error("RunIssueSchedule [%d] in repo %d: %v", s.ID, s.RepoID, err)
+		}
+	}
+	return nil
+}
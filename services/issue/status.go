@@ -36,6 +36,8 @@ func CloseIssue(ctx context.Context, issue *issues_model.Issue, doer *user_model
 
 	notify_service.IssueChangeStatus(ctx, doer, commitID, issue, comment, true)
 
+	closeParentIfSubIssuesClosed(ctx, doer, issue)
+
 	return nil
 }
 
@@ -0,0 +1,161 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/log"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+// SubIssueTreeNode is an issue of a tree of sub-issues with its position in the tree
+type SubIssueTreeNode struct {
+	Issue    *issues_model.Issue
+	ParentID int64
+	Depth    int
+	// Progress counts the sub-issues below the issue, it is nil if the issue has no sub-issues
+	Progress *issues_model.SubIssueProgress
+}
+
+// AddSubIssue makes an issue a sub-issue of the parent issue
+func AddSubIssue(ctx context.Context, doer *user_model.User, parent, issue *issues_model.Issue) error {
+	if err := issues_model.AddSubIssue(ctx, doer, parent, issue); err != nil {
+		return err
+	}
+	notify_service.IssueChangeParent(ctx, doer, issue, nil, parent)
+	return nil
+}
+
+// RemoveSubIssue removes an issue from the sub-issues of its parent
+func RemoveSubIssue(ctx context.Context, doer *user_model.User, parent, issue *issues_model.Issue) error {
+	if err := issues_model.RemoveSubIssue(ctx, doer, parent, issue); err != nil {
+		return err
+	}
+	notify_service.IssueChangeParent(ctx, doer, issue, parent, nil)
+	return nil
+}
+
+// GetParentIssue returns the parent of an issue if the doer can read it, nil is returned otherwise
+func GetParentIssue(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) (*issues_model.Issue, error) {
+	parentID, err := issues_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil || parentID == 0 {
+		return nil, err
+	}
+	parent, err := issues_model.GetIssueByID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := parent.LoadRepo(ctx); err != nil {
+		return nil, err
+	}
+	perm, err := access_model.GetUserRepoPermission(ctx, parent.Repo, doer)
+	if err != nil {
+		return nil, err
+	}
+	if !perm.CanReadIssuesOrPulls(parent.IsPull) {
+		return nil, nil
+	}
+	return parent, nil
+}
+
+// GetSubIssueTree returns the sub-issues below an issue which the doer can read, every issue is followed
+// by its own sub-issues. The sub-issues of an issue the doer can't read are skipped with it.
+func GetSubIssueTree(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) ([]*SubIssueTreeNode, error) {
+	perms := map[int64]access_model.Permission{}
+	canRead := func(issue *issues_model.Issue) (bool, error) {
+		if err := issue.LoadRepo(ctx); err != nil {
+			return false, err
+		}
+		perm, ok := perms[issue.RepoID]
+		if !ok {
+			var err error
+			if perm, err = access_model.GetUserRepoPermission(ctx, issue.Repo, doer); err != nil {
+				return false, err
+			}
+			perms[issue.RepoID] = perm
+		}
+		return perm.CanReadIssuesOrPulls(issue.IsPull), nil
+	}
+
+	var nodes []*SubIssueTreeNode
+	var walk func(parentID int64, depth int) error
+	walk = func(parentID int64, depth int) error {
+		if depth >= issues_model.MaxSubIssueDepth {
+			return nil
+		}
+		children, err := issues_model.GetSubIssues(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if ok, err := canRead(child); err != nil {
+				return err
+			} else if !ok {
+				continue
+			}
+			nodes = append(nodes, &SubIssueTreeNode{Issue: child, ParentID: parentID, Depth: depth})
+			if err := walk(child.ID, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(issue.ID, 0); err != nil {
+		return nil, err
+	}
+
+	issueIDs := make([]int64, 0, len(nodes))
+	for _, node := range nodes {
+		issueIDs = append(issueIDs, node.Issue.ID)
+	}
+	progress, err := issues_model.GetSubIssueProgress(ctx, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		node.Progress = progress[node.Issue.ID]
+	}
+	return nodes, nil
+}
+
+// closeParentIfSubIssuesClosed closes the parent of an issue when all its sub-issues are closed and the repository
+// of the parent enables it, the parent of the parent is checked in turn when the parent is closed
+func closeParentIfSubIssuesClosed(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) {
+	parentID, err := issues_model.GetParentIssueID(ctx, issue.ID)
+	if err != nil {
+		log.Error("GetParentIssueID[%d]: %v", issue.ID, err)
+		return
+	}
+	if parentID == 0 {
+		return
+	}
+	parent, err := issues_model.GetIssueByID(ctx, parentID)
+	if err != nil {
+		log.Error("GetIssueByID[%d]: %v", parentID, err)
+		return
+	}
+	if parent.IsClosed {
+		return
+	}
+	if err := parent.LoadRepo(ctx); err != nil {
+		log.Error("LoadRepo: %v", err)
+		return
+	}
+	if parent.Repo.IsArchived || !parent.Repo.CloseParentWhenSubIssuesClosed(ctx) {
+		return
+	}
+	if hasOpen, err := issues_model.HasOpenSubIssues(ctx, parent.ID); err != nil {
+		log.Error("HasOpenSubIssues[%d]: %v", parent.ID, err)
+		return
+	} else if hasOpen {
+		return
+	}
+	if err := CloseIssue(ctx, parent, doer, ""); err != nil && !issues_model.IsErrDependenciesLeft(err) && !issues_model.IsErrIssueIsClosed(err) {
+		log.Error("CloseIssue[%d]: %v", parent.ID, err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package issue
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unit"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseParentWhenSubIssuesClosed(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	parent := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})  // user2/repo1#1
+	child1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 7})  // user2/repo2#2
+	child2 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 10}) // user2/repo42#1
+	require.NoError(t, AddSubIssue(t.Context(), doer, parent, child1))
+	require.NoError(t, AddSubIssue(t.Context(), doer, parent, child2))
+
+	// the parent stays open as long as the repository doesn't enable the rule
+	require.NoError(t, CloseIssue(t.Context(), child1, doer, ""))
+	require.NoError(t, ReopenIssue(t.Context(), child1, doer, ""))
+	require.NoError(t, CloseIssue(t.Context(), child2, doer, ""))
+	require.NoError(t, CloseIssue(t.Context(), child1, doer, ""))
+	assert.False(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: parent.ID}).IsClosed)
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: parent.RepoID})
+	issuesUnit, err := repo.GetUnit(t.Context(), unit.TypeIssues)
+	require.NoError(t, err)
+	issuesUnit.IssuesConfig().CloseParentWhenSubIssuesClosed = true
+	require.NoError(t, repo_model.UpdateRepoUnit(t.Context(), issuesUnit))
+
+	require.NoError(t, ReopenIssue(t.Context(), child1, doer, ""))
+	require.NoError(t, CloseIssue(t.Context(), child1, doer, ""))
+	assert.True(t, unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: parent.ID}).IsClosed)
+}
@@ -129,6 +129,7 @@ func (h *ReplyHandler) Handle(ctx context.Context, content *MailContent, doer *u
 				doer,
 				nil,
 				issue,
+				comment.StartLine,
 				comment.Line,
 				content.Content,
 				comment.TreePath,
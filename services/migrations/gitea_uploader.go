@@ -129,6 +129,8 @@ func (g *GiteaLocalUploader) CreateRepo(ctx context.Context, repo *base.Reposito
 		Wiki:           opts.Wiki,
 		Releases:       opts.Releases, // if didn't get releases, then sync them from tags
 		MirrorInterval: opts.MirrorInterval,
+
+		ObjectFormatName: opts.ObjectFormatName,
 	}, NewMigrationHTTPTransport())
 
 	g.sameApp = strings.HasPrefix(repo.OriginalURL, setting.AppURL)
@@ -566,6 +568,20 @@ func (g *GiteaLocalUploader) CreatePullRequests(ctx context.Context, prs ...*bas
 	return nil
 }
 
+// convertedCommitID returns the ID of a source commit in the migrated repository,
+// they differ when the repository has been converted to another object format while migrating
+func (g *GiteaLocalUploader) convertedCommitID(ctx context.Context, commitID string) string {
+	objectFormat := git.ObjectFormatFromName(g.repo.ObjectFormatName)
+	if commitID == "" || objectFormat == nil || len(commitID) == objectFormat.FullLength() {
+		return commitID
+	}
+	newID, err := repo_model.GetConvertedObjectID(ctx, g.repo.ID, commitID, objectFormat.FullLength())
+	if err != nil {
+		return commitID
+	}
+	return newID
+}
+
 func (g *GiteaLocalUploader) updateGitForPullRequest(ctx context.Context, pr *base.PullRequest) (head string, err error) {
 	// SECURITY: this pr must have been must have been ensured safe
 	if !pr.EnsuredSafe {
@@ -573,6 +589,10 @@ func (g *GiteaLocalUploader) updateGitForPullRequest(ctx context.Context, pr *ba
 		return "", fmt.Errorf("the PR[%d] was not checked for safety", pr.Number)
 	}
 
+	pr.Head.SHA = g.convertedCommitID(ctx, pr.Head.SHA)
+	pr.Base.SHA = g.convertedCommitID(ctx, pr.Base.SHA)
+	pr.MergeCommitSHA = g.convertedCommitID(ctx, pr.MergeCommitSHA)
+
 	// Anonymous function to download the patch file (allows us to use defer)
 	err = func() error {
 		// if the patchURL is empty there is nothing to download
@@ -907,6 +927,7 @@ func (g *GiteaLocalUploader) CreateReviews(ctx context.Context, reviews ...*base
 				comment.UpdatedAt = comment.CreatedAt
 			}
 
+			comment.CommitID = g.convertedCommitID(ctx, comment.CommitID)
 			objectFormat := git.ObjectFormatFromName(g.repo.ObjectFormatName)
 			if !objectFormat.IsValid(comment.CommitID) {
 				log.Warn("Invalid comment CommitID[%s] on comment[%d] in PR #%d of %s/%s replaced with %s", comment.CommitID, pr.Index, g.repoOwner, g.repoName, headCommitID)
@@ -0,0 +1,715 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/container"
+	issue_indexer "code.gitea.io/gitea/modules/indexer/issues"
+	"code.gitea.io/gitea/modules/json"
+	base "code.gitea.io/gitea/modules/migration"
+	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// IssueArchiveVersion is the version of the format of the issue archives
+const IssueArchiveVersion = 1
+
+// IssueArchive is a portable archive of the issues of a repository with their labels, milestones and comments,
+// it can be imported into another repository without its git data
+type IssueArchive struct {
+	Version    int               `json:"version"`
+	Repository string            `json:"repository"`
+	Exported   time.Time         `json:"exported"`
+	Labels     []*base.Label     `json:"labels"`
+	Milestones []*base.Milestone `json:"milestones"`
+	Issues     []*base.Issue     `json:"issues"`
+	Comments   []*base.Comment   `json:"comments"`
+}
+
+// ExportIssuesOptions are the filters of the exported issues
+type ExportIssuesOptions struct {
+	IsClosed         optional.Option[bool]
+	LabelIDs         []int64
+	MilestoneIDs     []int64
+	UpdatedAfterUnix int64
+	// IncludeComments exports the comments and the changes of the state and of the title of the issues
+	IncludeComments bool
+}
+
+// exportedCommentTypes are the types of the comments which don't reference other objects of the repository
+var exportedCommentTypes = []issues_model.CommentType{
+	issues_model.CommentTypeComment,
+	issues_model.CommentTypeReopen,
+	issues_model.CommentTypeClose,
+	issues_model.CommentTypeChangeTitle,
+}
+
+func unixTimePtr(ts int64) *time.Time {
+	if ts == 0 {
+		return nil
+	}
+	t := time.Unix(ts, 0)
+	return &t
+}
+
+// posterOf returns the original author of a migrated issue or comment, or its poster.
+// The archive can be exported by anyone who can read the issues, so only the placeholder email of the poster is written.
+func posterOf(originalAuthor string, originalAuthorID int64, poster *user_model.User) (int64, string, string) {
+	if originalAuthor != "" && originalAuthorID != 0 {
+		return originalAuthorID, originalAuthor, ""
+	}
+	if poster == nil {
+		return 0, "", ""
+	}
+	return poster.ID, poster.Name, poster.GetPlaceholderEmail()
+}
+
+// GetIssueArchiveLabelIDs returns the IDs of the labels of a repository or of its organization named in a comma separated list
+func GetIssueArchiveLabelIDs(ctx context.Context, repo *repo_model.Repository, names string) ([]int64, error) {
+	labelNames := util.SplitTrimSpace(names, ",")
+	if len(labelNames) == 0 {
+		return nil, nil
+	}
+	if err := repo.LoadOwner(ctx); err != nil {
+		return nil, err
+	}
+	labels, err := issues_model.GetLabelsByRepoID(ctx, repo.ID, "", db.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if repo.Owner.IsOrganization() {
+		orgLabels, err := issues_model.GetLabelsByOrgID(ctx, repo.OwnerID, "", db.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, orgLabels...)
+	}
+	labelIDs := make([]int64, 0, len(labelNames))
+	for _, name := range labelNames {
+		idx := slices.IndexFunc(labels, func(label *issues_model.Label) bool { return label.Name == name })
+		if idx < 0 {
+			return nil, util.NewNotExistErrorf("label %q does not exist", name)
+		}
+		labelIDs = append(labelIDs, labels[idx].ID)
+	}
+	return labelIDs, nil
+}
+
+// GetIssueArchiveMilestoneIDs returns the ID of the milestone of a repository with the given name
+func GetIssueArchiveMilestoneIDs(ctx context.Context, repo *repo_model.Repository, name string) ([]int64, error) {
+	if name == "" {
+		return nil, nil
+	}
+	m, err := issues_model.GetMilestoneByRepoIDANDName(ctx, repo.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	return []int64{m.ID}, nil
+}
+
+// ExportIssues returns an archive of the issues of a repository matching the options, the pull requests,
+// the attachments and the comments referencing other objects of the repository are not exported
+func ExportIssues(ctx context.Context, repo *repo_model.Repository, opts ExportIssuesOptions) (*IssueArchive, error) {
+	archive := &IssueArchive{
+		Version:    IssueArchiveVersion,
+		Repository: repo.FullName(),
+		Exported:   time.Now(),
+	}
+	labels := make(map[int64]*base.Label)
+	milestones := make(map[int64]*base.Milestone)
+
+	for page := 1; ; page++ {
+		issues, err := issues_model.Issues(ctx, &issues_model.IssuesOptions{
+			Paginator:        &db.ListOptions{Page: page, PageSize: 50},
+			RepoIDs:          []int64{repo.ID},
+			IsPull:           optional.Some(false),
+			IsClosed:         opts.IsClosed,
+			LabelIDs:         opts.LabelIDs,
+			MilestoneIDs:     opts.MilestoneIDs,
+			UpdatedAfterUnix: opts.UpdatedAfterUnix,
+			SortType:         "oldest",
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(issues) == 0 {
+			break
+		}
+		if err := exportIssues(ctx, archive, issues, labels, milestones, opts.IncludeComments); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, label := range labels {
+		archive.Labels = append(archive.Labels, label)
+	}
+	slices.SortFunc(archive.Labels, func(a, b *base.Label) int { return strings.Compare(a.Name, b.Name) })
+	for _, milestone := range milestones {
+		archive.Milestones = append(archive.Milestones, milestone)
+	}
+	slices.SortFunc(archive.Milestones, func(a, b *base.Milestone) int { return a.Created.Compare(b.Created) })
+	return archive, nil
+}
+
+func exportIssues(ctx context.Context, archive *IssueArchive, issues issues_model.IssueList, labels map[int64]*base.Label, milestones map[int64]*base.Milestone, includeComments bool) error {
+	if err := issues.LoadPosters(ctx); err != nil {
+		return err
+	}
+	if err := issues.LoadLabels(ctx); err != nil {
+		return err
+	}
+	if err := issues.LoadMilestones(ctx); err != nil {
+		return err
+	}
+	if err := issues.LoadAssignees(ctx); err != nil {
+		return err
+	}
+	issueIDs := make([]int64, 0, len(issues))
+	for _, issue := range issues {
+		issueIDs = append(issueIDs, issue.ID)
+	}
+	issueReactions, commentReactions, err := loadExportedReactions(ctx, issueIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		posterID, posterName, posterEmail := posterOf(issue.OriginalAuthor, issue.OriginalAuthorID, issue.Poster)
+		is := &base.Issue{
+			Number:      issue.Index,
+			PosterID:    posterID,
+			PosterName:  posterName,
+			PosterEmail: posterEmail,
+			Title:       issue.Title,
+			Content:     issue.Content,
+			Ref:         issue.Ref,
+			State:       util.Iif(issue.IsClosed, "closed", "open"),
+			IsLocked:    issue.IsLocked,
+			Created:     issue.CreatedUnix.AsLocalTime(),
+			Updated:     issue.UpdatedUnix.AsLocalTime(),
+			Reactions:   issueReactions[issue.ID],
+		}
+		if issue.IsClosed {
+			is.Closed = unixTimePtr(int64(issue.ClosedUnix))
+		}
+		for _, label := range issue.Labels {
+			if _, ok := labels[label.ID]; !ok {
+				labels[label.ID] = &base.Label{
+					Name:        label.Name,
+					Color:       label.Color,
+					Description: label.Description,
+					Exclusive:   label.Exclusive,
+				}
+			}
+			is.Labels = append(is.Labels, labels[label.ID])
+		}
+		if issue.Milestone != nil {
+			if _, ok := milestones[issue.MilestoneID]; !ok {
+				m := issue.Milestone
+				milestones[m.ID] = &base.Milestone{
+					Title:       m.Name,
+					Description: m.Content,
+					Deadline:    unixTimePtr(int64(m.DeadlineUnix)),
+					Created:     m.CreatedUnix.AsLocalTime(),
+					Updated:     unixTimePtr(int64(m.UpdatedUnix)),
+					Closed:      unixTimePtr(int64(m.ClosedDateUnix)),
+					State:       util.Iif(m.IsClosed, "closed", "open"),
+				}
+			}
+			is.Milestone = issue.Milestone.Name
+		}
+		for _, assignee := range issue.Assignees {
+			is.Assignees = append(is.Assignees, assignee.Name)
+		}
+		archive.Issues = append(archive.Issues, is)
+	}
+
+	if !includeComments {
+		return nil
+	}
+	comments := make(issues_model.CommentList, 0, len(issues)*2)
+	if err := db.GetEngine(ctx).In("issue_id", issueIDs).In("type", exportedCommentTypes).
+		OrderBy("created_unix, id").Find(&comments); err != nil {
+		return err
+	}
+	if err := comments.LoadPosters(ctx); err != nil {
+		return err
+	}
+	issueIndexes := make(map[int64]int64, len(issues))
+	for _, issue := range issues {
+		issueIndexes[issue.ID] = issue.Index
+	}
+	for _, comment := range comments {
+		posterID, posterName, posterEmail := posterOf(comment.OriginalAuthor, comment.OriginalAuthorID, comment.Poster)
+		c := &base.Comment{
+			IssueIndex:  issueIndexes[comment.IssueID],
+			Index:       comment.ID,
+			CommentType: comment.Type.String(),
+			PosterID:    posterID,
+			PosterName:  posterName,
+			PosterEmail: posterEmail,
+			Created:     comment.CreatedUnix.AsLocalTime(),
+			Updated:     comment.UpdatedUnix.AsLocalTime(),
+			Content:     comment.Content,
+			Reactions:   commentReactions[comment.ID],
+		}
+		if comment.Type == issues_model.CommentTypeChangeTitle {
+			c.Meta = map[string]any{"OldTitle": comment.OldTitle, "NewTitle": comment.NewTitle}
+		}
+		archive.Comments = append(archive.Comments, c)
+	}
+	return nil
+}
+
+// loadExportedReactions returns the reactions to the issues and to their comments
+func loadExportedReactions(ctx context.Context, issueIDs []int64) (issueReactions, commentReactions map[int64][]*base.Reaction, err error) {
+	reactions := make(issues_model.ReactionList, 0, len(issueIDs))
+	if err := db.GetEngine(ctx).In("issue_id", issueIDs).OrderBy("id").Find(&reactions); err != nil {
+		return nil, nil, err
+	}
+	userIDs := container.Set[int64]{}
+	for _, r := range reactions {
+		userIDs.Add(r.UserID)
+	}
+	users, err := user_model.GetUsersMapByIDs(ctx, userIDs.Values())
+	if err != nil {
+		return nil, nil, err
+	}
+	issueReactions = make(map[int64][]*base.Reaction)
+	commentReactions = make(map[int64][]*base.Reaction)
+	for _, r := range reactions {
+		userID, userName, _ := posterOf(r.OriginalAuthor, r.OriginalAuthorID, users[r.UserID])
+		reaction := &base.Reaction{UserID: userID, UserName: userName, Content: r.Type}
+		if r.CommentID > 0 {
+			commentReactions[r.CommentID] = append(commentReactions[r.CommentID], reaction)
+		} else {
+			issueReactions[r.IssueID] = append(issueReactions[r.IssueID], reaction)
+		}
+	}
+	return issueReactions, commentReactions, nil
+}
+
+// ImportIssuesOptions are the options of the import of an issue archive
+type ImportIssuesOptions struct {
+	// MapUsers attributes the issues, the comments and the reactions to the local users with the same names,
+	// otherwise they are posted by the doer and the names of their authors are kept as original authors
+	MapUsers bool
+	// UserMap maps the user names of the archive to the names of local users when MapUsers is set
+	UserMap map[string]string
+}
+
+// archiveUsers gives a key to every user name of the archive, the keys are the external user IDs of the uploader
+// because the user IDs of an archive may come from different sites
+type archiveUsers map[string]int64
+
+func (u archiveUsers) key(name string) int64 {
+	key, ok := u[name]
+	if !ok {
+		key = int64(len(u) + 1)
+		u[name] = key
+	}
+	return key
+}
+
+// ImportIssues adds the issues of an archive with their labels, milestones and comments to a repository,
+// the issues get new numbers, the labels and the milestones are matched by name or created, the archive is changed by the import
+func ImportIssues(ctx context.Context, doer *user_model.User, repo *repo_model.Repository, archive *IssueArchive, opts ImportIssuesOptions) (int, error) {
+	if archive.Version > IssueArchiveVersion {
+		return 0, util.NewInvalidArgumentErrorf("unsupported issue archive version %d", archive.Version)
+	}
+	if len(archive.Issues) == 0 {
+		return 0, nil
+	}
+	if err := repo.LoadOwner(ctx); err != nil {
+		return 0, err
+	}
+
+	uploader := NewGiteaLocalUploader(ctx, doer, repo.OwnerName, repo.Name)
+	uploader.repo = repo
+	uploader.sameApp = true
+	uploader.gitServiceType = structs.GiteaService
+
+	users := archiveUsers{}
+	for _, issue := range archive.Issues {
+		issue.PosterID = users.key(issue.PosterName)
+		for _, r := range issue.Reactions {
+			r.UserID = users.key(r.UserName)
+		}
+	}
+	for _, comment := range archive.Comments {
+		comment.PosterID = users.key(comment.PosterName)
+		for _, r := range comment.Reactions {
+			r.UserID = users.key(r.UserName)
+		}
+	}
+	for name, key := range users {
+		localName := util.IfZero(opts.UserMap[name], name)
+		switch {
+		case name == "" || localName == doer.Name:
+			uploader.userMap[key] = doer.ID
+			continue
+		case !opts.MapUsers:
+			uploader.userMap[key] = 0
+			continue
+		}
+		u, err := user_model.GetUserByName(ctx, localName)
+		if err != nil && !user_model.IsErrUserNotExist(err) {
+			return 0, err
+		}
+		uploader.userMap[key] = 0
+		if u != nil && u.IsIndividual() && u.IsActive && !u.ProhibitLogin {
+			uploader.userMap[key] = u.ID
+		}
+	}
+
+	var imported []*issues_model.Issue
+	err := db.WithTx(ctx, func(ctx context.Context) error {
+		if err := prepareImportLabels(ctx, uploader, archive); err != nil {
+			return err
+		}
+		if err := prepareImportMilestones(ctx, uploader, archive); err != nil {
+			return err
+		}
+
+		numbers := make(map[int64]int64, len(archive.Issues))
+		for _, issue := range archive.Issues {
+			index, err := db.GetNextResourceIndex(ctx, "issue_index", repo.ID)
+			if err != nil {
+				return err
+			}
+			if issue.Number > 0 {
+				numbers[issue.Number] = index
+			}
+			issue.Number = index
+			issue.Ref = ""
+		}
+		for batch := range slices.Chunk(archive.Issues, uploader.MaxBatchInsertSize("issue")) {
+			if err := uploader.CreateIssues(ctx, batch...); err != nil {
+				return err
+			}
+		}
+
+		comments := make([]*base.Comment, 0, len(archive.Comments))
+		for _, comment := range archive.Comments {
+			if index, ok := numbers[comment.IssueIndex]; ok {
+				comment.IssueIndex = index
+				comments = append(comments, comment)
+			}
+		}
+		for batch := range slices.Chunk(comments, uploader.MaxBatchInsertSize("comment")) {
+			if err := uploader.CreateComments(ctx, batch...); err != nil {
+				return err
+			}
+		}
+
+		for _, issue := range archive.Issues {
+			is := uploader.issues[issue.Number]
+			if opts.MapUsers {
+				if err := importIssueAssignees(ctx, repo, is, issue.Assignees, opts.UserMap); err != nil {
+					return err
+				}
+			}
+			imported = append(imported, is)
+		}
+		return uploader.Finish(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, issue := range imported {
+		issue_indexer.UpdateIssueIndexer(ctx, issue.ID)
+	}
+	return len(imported), nil
+}
+
+// prepareImportLabels matches the labels of the archive with the labels of the repository and of its organization,
+// the missing labels are created in the repository
+func prepareImportLabels(ctx context.Context, uploader *GiteaLocalUploader, archive *IssueArchive) error {
+	repo := uploader.repo
+	labels, err := issues_model.GetLabelsByRepoID(ctx, repo.ID, "", db.ListOptions{})
+	if err != nil {
+		return err
+	}
+	if repo.Owner.IsOrganization() {
+		orgLabels, err := issues_model.GetLabelsByOrgID(ctx, repo.OwnerID, "", db.ListOptions{})
+		if err != nil {
+			return err
+		}
+		labels = append(orgLabels, labels...)
+	}
+	for _, label := range labels {
+		uploader.labels[label.Name] = label
+	}
+
+	missing := make(map[string]*base.Label)
+	for _, label := range archive.Labels {
+		missing[label.Name] = label
+	}
+	for _, issue := range archive.Issues {
+		for _, label := range issue.Labels {
+			if _, ok := missing[label.Name]; !ok {
+				missing[label.Name] = label
+			}
+		}
+	}
+	toCreate := make([]*base.Label, 0, len(missing))
+	for name, label := range missing {
+		if _, ok := uploader.labels[name]; !ok && strings.TrimSpace(name) != "" {
+			toCreate = append(toCreate, label)
+		}
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+	slices.SortFunc(toCreate, func(a, b *base.Label) int { return strings.Compare(a.Name, b.Name) })
+	return uploader.CreateLabels(ctx, toCreate...)
+}
+
+// prepareImportMilestones matches the milestones of the archive with the milestones of the repository,
+// the missing milestones are created
+func prepareImportMilestones(ctx context.Context, uploader *GiteaLocalUploader, archive *IssueArchive) error {
+	milestones, err := db.Find[issues_model.Milestone](ctx, issues_model.FindMilestoneOptions{RepoID: uploader.repo.ID})
+	if err != nil {
+		return err
+	}
+	for _, m := range milestones {
+		uploader.milestones[m.Name] = m.ID
+	}
+
+	missing := make([]*base.Milestone, 0, len(archive.Milestones))
+	names := container.Set[string]{}
+	for _, m := range archive.Milestones {
+		if _, ok := uploader.milestones[m.Title]; !ok && strings.TrimSpace(m.Title) != "" && names.Add(m.Title) {
+			missing = append(missing, m)
+		}
+	}
+	for _, issue := range archive.Issues {
+		if _, ok := uploader.milestones[issue.Milestone]; !ok && strings.TrimSpace(issue.Milestone) != "" && names.Add(issue.Milestone) {
+			missing = append(missing, &base.Milestone{Title: issue.Milestone, State: "open"})
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return uploader.CreateMilestones(ctx, missing...)
+}
+
+// importIssueAssignees assigns the local users with the names of the assignees of the archive to an imported issue
+func importIssueAssignees(ctx context.Context, repo *repo_model.Repository, issue *issues_model.Issue, names []string, userMap map[string]string) error {
+	assignees := make([]*issues_model.IssueAssignees, 0, len(names))
+	for _, name := range names {
+		u, err := user_model.GetUserByName(ctx, util.IfZero(userMap[name], name))
+		if err != nil {
+			if user_model.IsErrUserNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if ok, err := access_model.CanBeAssigned(ctx, u, repo, false); err != nil {
+			return err
+		} else if ok {
+			assignees = append(assignees, &issues_model.IssueAssignees{IssueID: issue.ID, AssigneeID: u.ID})
+		}
+	}
+	if len(assignees) == 0 {
+		return nil
+	}
+	return db.Insert(ctx, assignees)
+}
+
+// MaxUploadedIssueArchiveSize is the max size of an issue archive uploaded to be imported
+const MaxUploadedIssueArchiveSize = 100 << 20
+
+// ErrIssueArchiveTooLarge is returned when an uploaded issue archive is larger than MaxUploadedIssueArchiveSize
+var ErrIssueArchiveTooLarge = errors.New("the issue archive is too large")
+
+// ReadUploadedIssueArchive reads an uploaded issue archive in the JSON or the CSV format
+func ReadUploadedIssueArchive(r io.Reader, format string) (*IssueArchive, error) {
+	if format != "json" && format != "csv" {
+		return nil, util.NewInvalidArgumentErrorf("unknown format %q, it must be json or csv", format)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadedIssueArchiveSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxUploadedIssueArchiveSize {
+		return nil, ErrIssueArchiveTooLarge
+	}
+	if format == "csv" {
+		return ReadIssuesCSV(bytes.NewReader(data))
+	}
+	return ReadIssueArchive(bytes.NewReader(data))
+}
+
+// ReadIssueArchive reads an issue archive in the JSON format
+func ReadIssueArchive(r io.Reader) (*IssueArchive, error) {
+	archive := &IssueArchive{}
+	if err := json.NewDecoder(r).Decode(archive); err != nil {
+		return nil, util.NewInvalidArgumentErrorf("invalid issue archive: %v", err)
+	}
+	return archive, nil
+}
+
+// WriteIssueArchive writes an issue archive in the JSON format
+func WriteIssueArchive(w io.Writer, archive *IssueArchive) error {
+	return json.NewEncoder(w).Encode(archive)
+}
+
+// issueCSVHeader are the columns of the issue archives in the CSV format, the labels and the assignees are separated by semicolons
+var issueCSVHeader = []string{"number", "title", "state", "author", "created", "updated", "closed", "labels", "milestone", "assignees", "content"}
+
+func formatCSVTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// WriteIssuesCSV writes the issues of an archive in the CSV format, the comments are not written
+func WriteIssuesCSV(w io.Writer, archive *IssueArchive) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(issueCSVHeader); err != nil {
+		return err
+	}
+	for _, issue := range archive.Issues {
+		labels := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			labels = append(labels, label.Name)
+		}
+		if err := cw.Write([]string{
+			strconv.FormatInt(issue.Number, 10),
+			issue.Title,
+			issue.State,
+			issue.PosterName,
+			formatCSVTime(&issue.Created),
+			formatCSVTime(&issue.Updated),
+			formatCSVTime(issue.Closed),
+			strings.Join(labels, ";"),
+			issue.Milestone,
+			strings.Join(issue.Assignees, ";"),
+			issue.Content,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func parseCSVTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func splitCSVList(value string) []string {
+	var ret []string
+	for _, s := range strings.Split(value, ";") {
+		if s = strings.TrimSpace(s); s != "" {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// ReadIssuesCSV reads an issue archive in the CSV format, the columns are found by the header and only the title is required
+func ReadIssuesCSV(r io.Reader) (*IssueArchive, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: the title column is missing")
+	}
+
+	archive := &IssueArchive{Version: IssueArchiveVersion}
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: %v", err)
+		}
+		value := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		issue := &base.Issue{
+			Title:      value("title"),
+			State:      util.Iif(strings.EqualFold(value("state"), "closed"), "closed", "open"),
+			PosterName: value("author"),
+			Milestone:  value("milestone"),
+			Assignees:  splitCSVList(value("assignees")),
+		}
+		if i, ok := columns["content"]; ok && i < len(record) {
+			issue.Content = record[i]
+		}
+		if issue.Title == "" {
+			return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: the title of line %d is empty", line)
+		}
+		if number := value("number"); number != "" {
+			if issue.Number, err = strconv.ParseInt(number, 10, 64); err != nil {
+				return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: invalid number of line %d", line)
+			}
+		}
+		for _, name := range []string{"created", "updated", "closed"} {
+			t, err := parseCSVTime(value(name))
+			if err != nil {
+				return nil, util.NewInvalidArgumentErrorf("invalid issue CSV: invalid %s time of line %d", name, line)
+			}
+			if t == nil {
+				continue
+			}
+			switch name {
+			case "created":
+				issue.Created = *t
+			case "updated":
+				issue.Updated = *t
+			case "closed":
+				issue.Closed = t
+			}
+		}
+		for _, label := range splitCSVList(value("labels")) {
+			issue.Labels = append(issue.Labels, &base.Label{Name: label})
+		}
+		archive.Issues = append(archive.Issues, issue)
+	}
+	return archive, nil
+}
+
+// IssueArchiveFilename returns the name of the file of an exported issue archive
+func IssueArchiveFilename(repo *repo_model.Repository, format string) string {
+	return fmt.Sprintf("%s-%s-issues.%s", repo.OwnerName, repo.Name, format)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package migrations
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/optional"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportIssues(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+
+	archive, err := ExportIssues(t.Context(), repo1, ExportIssuesOptions{IncludeComments: true})
+	require.NoError(t, err)
+	assert.Equal(t, "user2/repo1", archive.Repository)
+	// the pull requests are not exported
+	require.Len(t, archive.Issues, 2)
+	assert.Equal(t, "issue1", archive.Issues[0].Title)
+	assert.Equal(t, "user1", archive.Issues[0].PosterName)
+	user1 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 1})
+	assert.Equal(t, user1.GetPlaceholderEmail(), archive.Issues[0].PosterEmail)
+	assert.Equal(t, "label1", archive.Issues[0].Labels[0].Name)
+	assert.Equal(t, "closed", archive.Issues[1].State)
+	require.Len(t, archive.Labels, 2)
+	// the label comment is not exported
+	require.Len(t, archive.Comments, 2)
+	assert.EqualValues(t, 1, archive.Comments[0].IssueIndex)
+	assert.Equal(t, "org3", archive.Comments[0].PosterName)
+
+	archive, err = ExportIssues(t.Context(), repo1, ExportIssuesOptions{IsClosed: optional.Some(true)})
+	require.NoError(t, err)
+	require.Len(t, archive.Issues, 1)
+	assert.Equal(t, "issue5", archive.Issues[0].Title)
+	assert.Empty(t, archive.Comments)
+}
+
+func TestIssueArchiveFormats(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	archive, err := ExportIssues(t.Context(), repo1, ExportIssuesOptions{IncludeComments: true})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteIssueArchive(&buf, archive))
+	read, err := ReadIssueArchive(&buf)
+	require.NoError(t, err)
+	assert.Len(t, read.Issues, 2)
+	assert.Len(t, read.Comments, 2)
+	assert.Equal(t, archive.Issues[0].Created.Unix(), read.Issues[0].Created.Unix())
+
+	buf.Reset()
+	require.NoError(t, WriteIssuesCSV(&buf, archive))
+	read, err = ReadIssuesCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, read.Issues, 2)
+	assert.Equal(t, "issue1", read.Issues[0].Title)
+	assert.Equal(t, "label1", read.Issues[0].Labels[0].Name)
+	assert.Equal(t, "closed", read.Issues[1].State)
+	assert.Equal(t, archive.Issues[1].Created.Unix(), read.Issues[1].Created.Unix())
+
+	read, err = ReadIssuesCSV(strings.NewReader("Title,Labels,Assignees\nFirst,bug; ui,user2\n"))
+	require.NoError(t, err)
+	require.Len(t, read.Issues, 1)
+	assert.Equal(t, "open", read.Issues[0].State)
+	assert.Len(t, read.Issues[0].Labels, 2)
+	assert.Equal(t, []string{"user2"}, read.Issues[0].Assignees)
+
+	_, err = ReadIssuesCSV(strings.NewReader("name\nfirst\n"))
+	assert.Error(t, err)
+	_, err = ReadIssuesCSV(strings.NewReader("title\n\"\"\n"))
+	assert.Error(t, err)
+}
+
+func TestImportIssues(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	repo2 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 2})
+
+	archive, err := ExportIssues(t.Context(), repo1, ExportIssuesOptions{IncludeComments: true})
+	require.NoError(t, err)
+	n, err := ImportIssues(t.Context(), user2, repo2, archive, ImportIssuesOptions{MapUsers: true, UserMap: map[string]string{"org3": "user5"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	issue1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: repo2.ID, Index: 3})
+	assert.Equal(t, "issue1", issue1.Title)
+	assert.EqualValues(t, 1, issue1.PosterID)
+	assert.False(t, issue1.HasOriginalAuthor())
+	label1 := unittest.AssertExistsAndLoadBean(t, &issues_model.Label{RepoID: repo2.ID, Name: "label1"})
+	unittest.AssertExistsAndLoadBean(t, &issues_model.IssueLabel{IssueID: issue1.ID, LabelID: label1.ID})
+	comment := unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{IssueID: issue1.ID, Content: "good work!"})
+	assert.EqualValues(t, 5, comment.PosterID)
+	issue5 := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: repo2.ID, Index: 4})
+	assert.True(t, issue5.IsClosed)
+	repo2 = unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 2})
+	assert.Equal(t, 4, repo2.NumIssues)
+
+	// without mapping the users, the issues are posted by the doer with their original authors
+	archive, err = ExportIssues(t.Context(), repo1, ExportIssuesOptions{})
+	require.NoError(t, err)
+	_, err = ImportIssues(t.Context(), user2, repo2, archive, ImportIssuesOptions{})
+	require.NoError(t, err)
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: repo2.ID, Index: 5})
+	assert.EqualValues(t, 2, issue.PosterID)
+	assert.Equal(t, "user1", issue.OriginalAuthor)
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: repo2.ID, Index: 6})
+	assert.EqualValues(t, 2, issue.PosterID)
+	assert.False(t, issue.HasOriginalAuthor())
+}
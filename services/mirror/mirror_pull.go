@@ -6,6 +6,8 @@ package mirror
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -41,27 +43,13 @@ func UpdateAddress(ctx context.Context, m *repo_model.Mirror, addr string) error
 
 	remoteName := m.GetRemoteName()
 	repo := m.GetRepository(ctx)
-	// Remove old remote
-	err = gitrepo.GitRemoteRemove(ctx, repo, remoteName)
-	if err != nil && !git.IsRemoteNotExistError(err) {
-		return err
-	}
-
-	err = gitrepo.GitRemoteAdd(ctx, repo, remoteName, addr, gitrepo.RemoteOptionMirrorFetch)
-	if err != nil && !git.IsRemoteNotExistError(err) {
+	if err := updateMirrorRemote(ctx, repo, remoteName, addr); err != nil {
 		return err
 	}
 
 	if repo_service.HasWiki(ctx, m.Repo) {
 		wikiRemotePath := repo_module.WikiRemoteURL(ctx, addr)
-		// Remove old remote of wiki
-		err = gitrepo.GitRemoteRemove(ctx, repo.WikiStorageRepo(), remoteName)
-		if err != nil && !git.IsRemoteNotExistError(err) {
-			return err
-		}
-
-		err = gitrepo.GitRemoteAdd(ctx, repo.WikiStorageRepo(), remoteName, wikiRemotePath, gitrepo.RemoteOptionMirrorFetch)
-		if err != nil && !git.IsRemoteNotExistError(err) {
+		if err := updateMirrorRemote(ctx, repo.WikiStorageRepo(), remoteName, wikiRemotePath); err != nil {
 			return err
 		}
 	}
@@ -72,6 +60,27 @@ func UpdateAddress(ctx context.Context, m *repo_model.Mirror, addr string) error
 	return repo_model.UpdateRepositoryColsNoAutoTime(ctx, m.Repo, "original_url")
 }
 
+// updateMirrorRemote replaces the address of the remote of the mirror, the upstream of a converted mirror is updated too
+func updateMirrorRemote(ctx context.Context, storageRepo gitrepo.Repository, remoteName, addr string) error {
+	storageRepos := []gitrepo.Repository{storageRepo}
+	if gitrepo.IsConvertedMirror(storageRepo) {
+		storageRepos = append(storageRepos, gitrepo.ConvertedMirrorUpstream(storageRepo))
+	}
+	for _, storageRepo := range storageRepos {
+		// Remove old remote
+		err := gitrepo.GitRemoteRemove(ctx, storageRepo, remoteName)
+		if err != nil && !git.IsRemoteNotExistError(err) {
+			return err
+		}
+
+		err = gitrepo.GitRemoteAdd(ctx, storageRepo, remoteName, addr, gitrepo.RemoteOptionMirrorFetch)
+		if err != nil && !git.IsRemoteNotExistError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // mirrorSyncResult contains information of a updated reference.
 // If the oldCommitID is "0000000", it means a new reference, the value of newCommitID is empty.
 // If the newCommitID is "0000000", it means the reference is deleted, the value of oldCommitID is empty.
@@ -240,6 +249,19 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 	envs := proxy.EnvWithProxy(remoteURL.URL)
 	timeout := time.Duration(setting.Git.Timeout.Mirror) * time.Second
 
+	// a converted mirror fetches into its upstream, the results are the differences of the refs of the mirror
+	var fetchRepo gitrepo.Repository = m.Repo
+	var refsBeforeSync map[string]string
+	isConverted := gitrepo.IsConvertedMirror(m.Repo)
+	if isConverted {
+		fetchRepo = gitrepo.ConvertedMirrorUpstream(m.Repo)
+		var err error
+		if refsBeforeSync, err = getRefObjectIDs(ctx, m.Repo); err != nil {
+			log.Error("SyncMirrors [repo: %-v]: failed to get the refs: %v", m.Repo, err)
+			return nil, false
+		}
+	}
+
 	// use fetch but not remote update because git fetch support --tags but remote update doesn't
 	cmdFetch := func() *gitcmd.Command {
 		cmd := gitcmd.NewCommand("fetch", "--tags")
@@ -251,7 +273,7 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 
 	var err error
 	var fetchOutput string // it is from fetch's stderr
-	fetchStdout, fetchStderr, err := gitrepo.RunCmdString(ctx, m.Repo, cmdFetch())
+	fetchStdout, fetchStderr, err := gitrepo.RunCmdString(ctx, fetchRepo, cmdFetch())
 	if err != nil {
 		// sanitize the output, since it may contain the remote address, which may contain a password
 		stderrMessage := util.SanitizeCredentialURLs(fetchStderr)
@@ -262,10 +284,10 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 			log.Warn("SyncMirrors [repo: %-v]: failed to update mirror repository due to broken references:\nStdout: %s\nStderr: %s\nErr: %v\nAttempting Prune", m.Repo, stdoutMessage, stderrMessage, err)
 			err = nil
 			// Attempt prune
-			pruneErr := pruneBrokenReferences(ctx, m, m.Repo, timeout)
+			pruneErr := pruneBrokenReferences(ctx, m, fetchRepo, timeout)
 			if pruneErr == nil {
 				// Successful prune - reattempt mirror
-				fetchStdout, fetchStderr, err = gitrepo.RunCmdString(ctx, m.Repo, cmdFetch())
+				fetchStdout, fetchStderr, err = gitrepo.RunCmdString(ctx, fetchRepo, cmdFetch())
 				if err != nil {
 					// sanitize the output, since it may contain the remote address, which may contain a password
 					stderrMessage = util.SanitizeCredentialURLs(fetchStderr)
@@ -286,6 +308,18 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 	}
 	fetchOutput = fetchStderr // the result of "git fetch" is in stderr
 
+	if isConverted {
+		log.Trace("SyncMirrors [repo: %-v]: converting the fetched objects...", m.Repo)
+		if err := syncConvertedMirror(ctx, m.Repo, m.RepoID); err != nil {
+			log.Error("SyncMirrors [repo: %-v]: failed to convert the fetched objects: %v", m.Repo, err)
+			desc := fmt.Sprintf("Failed to convert the objects fetched by mirror repository (%s): %v", m.Repo.FullName(), err)
+			if err := system_model.CreateRepositoryNotice(desc); err != nil {
+				log.Error("CreateRepositoryNotice: %v", err)
+			}
+			return nil, false
+		}
+	}
+
 	if err := gitrepo.WriteCommitGraph(ctx, m.Repo); err != nil {
 		log.Error("SyncMirrors [repo: %-v]: %v", m.Repo, err)
 	}
@@ -328,8 +362,13 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 
 	if repo_service.HasWiki(ctx, m.Repo) {
 		log.Trace("SyncMirrors [repo: %-v Wiki]: running git remote update...", m.Repo)
+		var wikiFetchRepo gitrepo.Repository = m.Repo.WikiStorageRepo()
+		isWikiConverted := gitrepo.IsConvertedMirror(wikiFetchRepo)
+		if isWikiConverted {
+			wikiFetchRepo = gitrepo.ConvertedMirrorUpstream(wikiFetchRepo)
+		}
 		// the result of "git remote update" is in stderr
-		stdout, stderr, err := gitrepo.RunCmdString(ctx, m.Repo.WikiStorageRepo(), cmdRemoteUpdatePrune())
+		stdout, stderr, err := gitrepo.RunCmdString(ctx, wikiFetchRepo, cmdRemoteUpdatePrune())
 		if err != nil {
 			// sanitize the output, since it may contain the remote address, which may contain a password
 			stderrMessage := util.SanitizeCredentialURLs(stderr)
@@ -341,10 +380,10 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 				err = nil
 
 				// Attempt prune
-				pruneErr := pruneBrokenReferences(ctx, m, m.Repo.WikiStorageRepo(), timeout)
+				pruneErr := pruneBrokenReferences(ctx, m, wikiFetchRepo, timeout)
 				if pruneErr == nil {
 					// Successful prune - reattempt mirror
-					stdout, stderr, err = gitrepo.RunCmdString(ctx, m.Repo.WikiStorageRepo(), cmdRemoteUpdatePrune())
+					stdout, stderr, err = gitrepo.RunCmdString(ctx, wikiFetchRepo, cmdRemoteUpdatePrune())
 					if err != nil {
 						stderrMessage = util.SanitizeCredentialURLs(stderr)
 						stdoutMessage = util.SanitizeCredentialURLs(stdout)
@@ -366,6 +405,17 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 				log.Error("SyncMirrors [repo: %-v]: %v", m.Repo, err)
 			}
 		}
+		if isWikiConverted {
+			// the wiki has no records in the database which refer to its commits, so the mapping isn't stored
+			if _, err := gitrepo.SyncConvertedMirror(ctx, m.Repo.WikiStorageRepo()); err != nil {
+				log.Error("SyncMirrors [repo: %-v Wiki]: failed to convert the fetched objects: %v", m.Repo, err)
+				desc := fmt.Sprintf("Failed to convert the objects fetched by mirror repository wiki (%s): %v", m.Repo.FullName(), err)
+				if err := system_model.CreateRepositoryNotice(desc); err != nil {
+					log.Error("CreateRepositoryNotice: %v", err)
+				}
+				return nil, false
+			}
+		}
 		log.Trace("SyncMirrors [repo: %-v Wiki]: git remote update complete", m.Repo)
 	}
 
@@ -381,9 +431,63 @@ func runSync(ctx context.Context, m *repo_model.Mirror) ([]*mirrorSyncResult, bo
 	}
 
 	m.UpdatedUnix = timeutil.TimeStampNow()
+	if isConverted {
+		refsAfterSync, err := getRefObjectIDs(ctx, m.Repo)
+		if err != nil {
+			log.Error("SyncMirrors [repo: %-v]: failed to get the refs: %v", m.Repo, err)
+			return nil, false
+		}
+		return diffRefObjectIDs(refsBeforeSync, refsAfterSync), true
+	}
 	return parseRemoteUpdateOutput(fetchOutput, m.GetRemoteName()), true
 }
 
+// syncConvertedMirror converts the objects fetched by a converted mirror and stores their mapping,
+// so the commits referred by the upstream IDs can still be found
+func syncConvertedMirror(ctx context.Context, repo gitrepo.Repository, repoID int64) error {
+	mapping, err := gitrepo.SyncConvertedMirror(ctx, repo)
+	if err != nil {
+		return err
+	}
+	return repo_model.InsertObjectMappings(ctx, repoID, mapping)
+}
+
+// getRefObjectIDs returns the object IDs of all the refs of the repository
+func getRefObjectIDs(ctx context.Context, repo gitrepo.Repository) (map[string]string, error) {
+	stdout, _, err := gitrepo.RunCmdString(ctx, repo, gitcmd.NewCommand("for-each-ref", "--format=%(objectname) %(refname)"))
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[string]string)
+	for line := range strings.Lines(stdout) {
+		objectID, refName, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if ok {
+			refs[refName] = objectID
+		}
+	}
+	return refs, nil
+}
+
+// diffRefObjectIDs returns the created, updated and deleted refs like parseRemoteUpdateOutput
+func diffRefObjectIDs(before, after map[string]string) []*mirrorSyncResult {
+	results := make([]*mirrorSyncResult, 0, 3)
+	for _, refName := range slices.Sorted(maps.Keys(after)) {
+		oldID, ok := before[refName]
+		switch {
+		case !ok:
+			results = append(results, &mirrorSyncResult{refName: git.RefName(refName), oldCommitID: gitShortEmptySha})
+		case oldID != after[refName]:
+			results = append(results, &mirrorSyncResult{refName: git.RefName(refName), oldCommitID: oldID, newCommitID: after[refName]})
+		}
+	}
+	for _, refName := range slices.Sorted(maps.Keys(before)) {
+		if _, ok := after[refName]; !ok {
+			results = append(results, &mirrorSyncResult{refName: git.RefName(refName), newCommitID: gitShortEmptySha})
+		}
+	}
+	return results
+}
+
 func getRepoPullMirrorLockKey(repoID int64) string {
 	return fmt.Sprintf("repo_pull_mirror_%d", repoID)
 }
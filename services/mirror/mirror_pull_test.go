@@ -92,3 +92,21 @@ func Test_checkRecoverableSyncError(t *testing.T) {
 		assert.Equal(t, c.recoverable, checkRecoverableSyncError(c.message), "test case: %s", c.message)
 	}
 }
+
+func Test_diffRefObjectIDs(t *testing.T) {
+	before := map[string]string{
+		"refs/heads/main":    "1111",
+		"refs/heads/removed": "2222",
+		"refs/tags/v1":       "3333",
+	}
+	after := map[string]string{
+		"refs/heads/main": "4444",
+		"refs/heads/new":  "5555",
+		"refs/tags/v1":    "3333",
+	}
+	results := diffRefObjectIDs(before, after)
+	assert.Len(t, results, 3)
+	assert.Equal(t, &mirrorSyncResult{refName: "refs/heads/main", oldCommitID: "1111", newCommitID: "4444"}, results[0])
+	assert.Equal(t, &mirrorSyncResult{refName: "refs/heads/new", oldCommitID: gitShortEmptySha}, results[1])
+	assert.Equal(t, &mirrorSyncResult{refName: "refs/heads/removed", newCommitID: gitShortEmptySha}, results[2])
+}
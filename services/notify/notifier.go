@@ -10,6 +10,7 @@ import (
 	git_model "code.gitea.io/gitea/models/git"
 	issues_model "code.gitea.io/gitea/models/issues"
 	packages_model "code.gitea.io/gitea/models/packages"
+	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/git"
@@ -41,6 +42,11 @@ type Notifier interface {
 	IssueChangeRef(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldRef string)
 	IssueChangeLabels(ctx context.Context, doer *user_model.User, issue *issues_model.Issue,
 		addedLabels, removedLabels []*issues_model.Label)
+	IssueChangeParent(ctx context.Context, doer *user_model.User, issue, oldParent, newParent *issues_model.Issue)
+	IssueChangeType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldType *issues_model.IssueType)
+	IssueChangeFieldValues(ctx context.Context, doer *user_model.User, issue *issues_model.Issue)
+	IssueSLAEscalated(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, sla *issues_model.IssueSLA,
+		target issues_model.IssueSLATarget, status issues_model.IssueSLAStatus, receivers []*user_model.User)
 
 	NewPullRequest(ctx context.Context, pr *issues_model.PullRequest, mentions []*user_model.User)
 	MergePullRequest(ctx context.Context, doer *user_model.User, pr *issues_model.PullRequest)
@@ -75,6 +81,13 @@ type Notifier interface {
 	PackageCreate(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor)
 	PackageDelete(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor)
 
+	NewProject(ctx context.Context, doer *user_model.User, project *project_model.Project)
+	UpdateProject(ctx context.Context, doer *user_model.User, project *project_model.Project)
+	ChangeProjectStatus(ctx context.Context, doer *user_model.User, project *project_model.Project, isClosed bool)
+	DeleteProject(ctx context.Context, doer *user_model.User, project *project_model.Project)
+	IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, project *project_model.Project,
+		oldColumn, newColumn *project_model.Column)
+
 	ChangeDefaultBranch(ctx context.Context, repo *repo_model.Repository)
 
 	CreateCommitStatus(ctx context.Context, repo *repo_model.Repository, commit *repository.PushCommit, sender *user_model.User, status *git_model.CommitStatus)
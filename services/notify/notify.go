@@ -10,6 +10,7 @@ import (
 	git_model "code.gitea.io/gitea/models/git"
 	issues_model "code.gitea.io/gitea/models/issues"
 	packages_model "code.gitea.io/gitea/models/packages"
+	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/git"
@@ -274,6 +275,37 @@ func IssueChangeLabels(ctx context.Context, doer *user_model.User, issue *issues
 	}
 }
 
+// IssueChangeParent notifies an issue added to or removed from the sub-issues of a parent issue to notifiers,
+// oldParent is nil when the issue is added and newParent is nil when it is removed
+func IssueChangeParent(ctx context.Context, doer *user_model.User, issue, oldParent, newParent *issues_model.Issue) {
+	for _, notifier := range notifiers {
+		notifier.IssueChangeParent(ctx, doer, issue, oldParent, newParent)
+	}
+}
+
+// IssueChangeType notifies a change of the type of an issue to notifiers, oldType is nil if the issue had no type
+func IssueChangeType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldType *issues_model.IssueType) {
+	for _, notifier := range notifiers {
+		notifier.IssueChangeType(ctx, doer, issue, oldType)
+	}
+}
+
+// IssueChangeFieldValues notifies a change of the values of the custom fields of an issue to notifiers
+func IssueChangeFieldValues(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) {
+	for _, notifier := range notifiers {
+		notifier.IssueChangeFieldValues(ctx, doer, issue)
+	}
+}
+
+// IssueSLAEscalated notifies an SLA target of an issue which became at risk or breached to notifiers
+func IssueSLAEscalated(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, sla *issues_model.IssueSLA,
+	target issues_model.IssueSLATarget, status issues_model.IssueSLAStatus, receivers []*user_model.User,
+) {
+	for _, notifier := range notifiers {
+		notifier.IssueSLAEscalated(ctx, doer, issue, sla, target, status, receivers)
+	}
+}
+
 // CreateRepository notifies create repository to notifiers
 func CreateRepository(ctx context.Context, doer, u *user_model.User, repo *repo_model.Repository) {
 	for _, notifier := range notifiers {
@@ -386,6 +418,44 @@ func PackageDelete(ctx context.Context, doer *user_model.User, pd *packages_mode
 	}
 }
 
+// NewProject notifies creation of a project to notifiers
+func NewProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	for _, notifier := range notifiers {
+		notifier.NewProject(ctx, doer, project)
+	}
+}
+
+// UpdateProject notifies change of the title, the description or the card type of a project to notifiers
+func UpdateProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	for _, notifier := range notifiers {
+		notifier.UpdateProject(ctx, doer, project)
+	}
+}
+
+// ChangeProjectStatus notifies close or reopen of a project to notifiers
+func ChangeProjectStatus(ctx context.Context, doer *user_model.User, project *project_model.Project, isClosed bool) {
+	for _, notifier := range notifiers {
+		notifier.ChangeProjectStatus(ctx, doer, project, isClosed)
+	}
+}
+
+// DeleteProject notifies deletion of a project to notifiers
+func DeleteProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	for _, notifier := range notifiers {
+		notifier.DeleteProject(ctx, doer, project)
+	}
+}
+
+// IssueChangeProjectColumn notifies an issue added to, moved on or removed from the board of a project to notifiers,
+// oldColumn is nil when the issue is added and newColumn is nil when it is removed
+func IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, project *project_model.Project,
+	oldColumn, newColumn *project_model.Column,
+) {
+	for _, notifier := range notifiers {
+		notifier.IssueChangeProjectColumn(ctx, doer, issue, project, oldColumn, newColumn)
+	}
+}
+
 // ChangeDefaultBranch notifies change default branch to notifiers
 func ChangeDefaultBranch(ctx context.Context, repo *repo_model.Repository) {
 	for _, notifier := range notifiers {
@@ -10,6 +10,7 @@ import (
 	git_model "code.gitea.io/gitea/models/git"
 	issues_model "code.gitea.io/gitea/models/issues"
 	packages_model "code.gitea.io/gitea/models/packages"
+	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/git"
@@ -143,6 +144,24 @@ func (*NullNotifier) IssueChangeLabels(ctx context.Context, doer *user_model.Use
 	addedLabels, removedLabels []*issues_model.Label) {
 }
 
+// IssueChangeParent places a place holder function
+func (*NullNotifier) IssueChangeParent(ctx context.Context, doer *user_model.User, issue, oldParent, newParent *issues_model.Issue) {
+}
+
+// IssueChangeType places a place holder function
+func (*NullNotifier) IssueChangeType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldType *issues_model.IssueType) {
+}
+
+// IssueChangeFieldValues places a place holder function
+func (*NullNotifier) IssueChangeFieldValues(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) {
+}
+
+// IssueSLAEscalated places a place holder function
+func (*NullNotifier) IssueSLAEscalated(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, sla *issues_model.IssueSLA,
+	target issues_model.IssueSLATarget, status issues_model.IssueSLAStatus, receivers []*user_model.User,
+) {
+}
+
 // CreateRepository places a place holder function
 func (*NullNotifier) CreateRepository(ctx context.Context, doer, u *user_model.User, repo *repo_model.Repository) {
 }
@@ -207,6 +226,28 @@ func (*NullNotifier) PackageCreate(ctx context.Context, doer *user_model.User, p
 func (*NullNotifier) PackageDelete(ctx context.Context, doer *user_model.User, pd *packages_model.PackageDescriptor) {
 }
 
+// NewProject places a place holder function
+func (*NullNotifier) NewProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+}
+
+// UpdateProject places a place holder function
+func (*NullNotifier) UpdateProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+}
+
+// ChangeProjectStatus places a place holder function
+func (*NullNotifier) ChangeProjectStatus(ctx context.Context, doer *user_model.User, project *project_model.Project, isClosed bool) {
+}
+
+// DeleteProject places a place holder function
+func (*NullNotifier) DeleteProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+}
+
+// IssueChangeProjectColumn places a place holder function
+func (*NullNotifier) IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, project *project_model.Project,
+	oldColumn, newColumn *project_model.Column,
+) {
+}
+
 // ChangeDefaultBranch places a place holder function
 func (*NullNotifier) ChangeDefaultBranch(ctx context.Context, repo *repo_model.Repository) {
 }
@@ -0,0 +1,52 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// SetIssueFieldValues sets the values of custom fields of a project for an issue of the project,
+// the values are given by field ID and an empty value removes the value of the field
+func SetIssueFieldValues(ctx context.Context, project *project_model.Project, issue *issues_model.Issue, values map[int64]string) error {
+	if err := issue.LoadProject(ctx); err != nil {
+		return err
+	}
+	if issue.Project == nil || issue.Project.ID != project.ID {
+		return util.NewInvalidArgumentErrorf("issue #%d doesn't belong to the project", issue.Index)
+	}
+
+	fields, err := project_model.GetFields(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	fieldsByID := make(map[int64]*project_model.Field, len(fields))
+	for _, field := range fields {
+		fieldsByID[field.ID] = field
+	}
+	for fieldID, value := range values {
+		field, ok := fieldsByID[fieldID]
+		if !ok {
+			return project_model.ErrProjectFieldNotExist{FieldID: fieldID}
+		}
+		// check all the values before changing any of them
+		if _, err := field.NormalizeValue(value); err != nil {
+			return err
+		}
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		for fieldID, value := range values {
+			if err := project_model.SetFieldValue(ctx, fieldsByID[fieldID], issue.ID, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
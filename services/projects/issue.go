@@ -6,17 +6,23 @@ package project
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"code.gitea.io/gitea/models/db"
 	issues_model "code.gitea.io/gitea/models/issues"
 	project_model "code.gitea.io/gitea/models/project"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/optional"
+	"code.gitea.io/gitea/modules/util"
+	notify_service "code.gitea.io/gitea/services/notify"
 )
 
 // MoveIssuesOnProjectColumn moves or keeps issues in a column and sorts them inside that column
 func MoveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, column *project_model.Column, sortedIssueIDs map[int64]int64) error {
-	return db.WithTx(ctx, func(ctx context.Context) error {
+	var project *project_model.Project
+	movedIssues := make(map[int64]*issues_model.Issue) // issue id => issue moved from another column
+	oldColumnIDs := make(map[int64]int64)              // issue id => old column id
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
 		issueIDs := make([]int64, 0, len(sortedIssueIDs))
 		for _, issueID := range sortedIssueIDs {
 			issueIDs = append(issueIDs, issueID)
@@ -40,7 +46,7 @@ func MoveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, colum
 			return err
 		}
 
-		project, err := project_model.GetProjectByID(ctx, column.ProjectID)
+		project, err = project_model.GetProjectByID(ctx, column.ProjectID)
 		if err != nil {
 			return err
 		}
@@ -75,6 +81,8 @@ func MoveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, colum
 				}); err != nil {
 					return err
 				}
+				movedIssues[issueID] = curIssue
+				oldColumnIDs[issueID] = projectColumnID
 			}
 
 			_, err = db.Exec(ctx, "UPDATE `project_issue` SET project_board_id=?, sorting=? WHERE issue_id=?", column.ID, sorting, issueID)
@@ -83,7 +91,143 @@ func MoveIssuesOnProjectColumn(ctx context.Context, doer *user_model.User, colum
 			}
 		}
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	for issueID, issue := range movedIssues {
+		oldColumn, err := project_model.GetColumn(ctx, oldColumnIDs[issueID])
+		if err != nil {
+			return err
+		}
+		notify_service.IssueChangeProjectColumn(ctx, doer, issue, project, oldColumn, column)
+	}
+	return nil
+}
+
+// AddIssueToProject adds an issue to a column of a project, the default column is used if column is nil.
+// The issue is put at the given position of the column, or at its end if position is negative.
+// An issue can only belong to one project, it is removed from its previous project if it has one.
+func AddIssueToProject(ctx context.Context, doer *user_model.User, project *project_model.Project, issue *issues_model.Issue, column *project_model.Column, position int) error {
+	if column == nil {
+		var err error
+		if column, err = project.MustDefaultColumn(ctx); err != nil {
+			return err
+		}
+	}
+
+	oldProjectIssue, err := getProjectIssue(ctx, issue.ID)
+	if err != nil {
+		return err
+	}
+	if oldProjectIssue != nil && oldProjectIssue.ProjectID == project.ID {
+		return MoveIssueOnProject(ctx, doer, issue, column, position)
+	}
+
+	if err := issues_model.IssueAssignOrRemoveProject(ctx, issue, doer, project.ID, column.ID); err != nil {
+		return err
+	}
+	if position >= 0 {
+		if err := sortIssueInColumn(ctx, doer, issue, column, position); err != nil {
+			return err
+		}
+	}
+
+	if oldProjectIssue != nil {
+		if err := notifyIssueRemovedFromProject(ctx, doer, issue, oldProjectIssue); err != nil {
+			return err
+		}
+	}
+	notify_service.IssueChangeProjectColumn(ctx, doer, issue, project, nil, column)
+	return nil
+}
+
+// MoveIssueOnProject moves an issue of a project to a column of the same project and puts it at the given position,
+// or at the end of the column if position is negative.
+func MoveIssueOnProject(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, column *project_model.Column, position int) error {
+	projectIssue, err := getProjectIssue(ctx, issue.ID)
+	if err != nil {
+		return err
+	}
+	if projectIssue == nil || projectIssue.ProjectID != column.ProjectID {
+		return util.NewInvalidArgumentErrorf("issue %d is not in project %d", issue.ID, column.ProjectID)
+	}
+	return sortIssueInColumn(ctx, doer, issue, column, position)
+}
+
+// RemoveIssueFromProject removes an issue from its project
+func RemoveIssueFromProject(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) error {
+	projectIssue, err := getProjectIssue(ctx, issue.ID)
+	if err != nil {
+		return err
+	}
+	if projectIssue == nil {
+		return nil
+	}
+	if err := issues_model.IssueAssignOrRemoveProject(ctx, issue, doer, 0, 0); err != nil {
+		return err
+	}
+	return notifyIssueRemovedFromProject(ctx, doer, issue, projectIssue)
+}
+
+// ChangeIssueProject adds an issue to the default column of a project, or removes it from its project if projectID is 0
+func ChangeIssueProject(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, projectID int64) error {
+	if projectID == 0 {
+		return RemoveIssueFromProject(ctx, doer, issue)
+	}
+	project, err := project_model.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return AddIssueToProject(ctx, doer, project, issue, nil, -1)
+}
+
+func getProjectIssue(ctx context.Context, issueID int64) (*project_model.ProjectIssue, error) {
+	projectIssue := new(project_model.ProjectIssue)
+	has, err := db.GetEngine(ctx).Where("issue_id=?", issueID).Get(projectIssue)
+	if err != nil {
+		return nil, err
+	} else if !has {
+		return nil, nil
+	}
+	return projectIssue, nil
+}
+
+// sortIssueInColumn puts an issue of the project of a column at a position of the column
+func sortIssueInColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, column *project_model.Column, position int) error {
+	projectIssues, err := column.GetIssues(ctx)
+	if err != nil {
+		return err
+	}
+	issueIDs := make([]int64, 0, len(projectIssues)+1)
+	for _, projectIssue := range projectIssues {
+		if projectIssue.IssueID != issue.ID {
+			issueIDs = append(issueIDs, projectIssue.IssueID)
+		}
+	}
+	if position < 0 || position > len(issueIDs) {
+		position = len(issueIDs)
+	}
+	issueIDs = slices.Insert(issueIDs, position, issue.ID)
+
+	sortedIssueIDs := make(map[int64]int64, len(issueIDs))
+	for sorting, issueID := range issueIDs {
+		sortedIssueIDs[int64(sorting)] = issueID
+	}
+	return MoveIssuesOnProjectColumn(ctx, doer, column, sortedIssueIDs)
+}
+
+func notifyIssueRemovedFromProject(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, projectIssue *project_model.ProjectIssue) error {
+	project, err := project_model.GetProjectByID(ctx, projectIssue.ProjectID)
+	if err != nil {
+		return err
+	}
+	column, err := project_model.GetColumn(ctx, projectIssue.ProjectColumnID)
+	if err != nil {
+		return err
+	}
+	notify_service.IssueChangeProjectColumn(ctx, doer, issue, project, column, nil)
+	return nil
 }
 
 // LoadIssuesFromProject load issues assigned to each project column inside the given project
@@ -0,0 +1,51 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package project
+
+import (
+	"context"
+
+	project_model "code.gitea.io/gitea/models/project"
+	user_model "code.gitea.io/gitea/models/user"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+// NewProject creates a project and notifies about it
+func NewProject(ctx context.Context, doer *user_model.User, p *project_model.Project) error {
+	if err := project_model.NewProject(ctx, p); err != nil {
+		return err
+	}
+	notify_service.NewProject(ctx, doer, p)
+	return nil
+}
+
+// UpdateProject updates the title, the description and the card type of a project
+func UpdateProject(ctx context.Context, doer *user_model.User, p *project_model.Project) error {
+	if err := project_model.UpdateProject(ctx, p); err != nil {
+		return err
+	}
+	notify_service.UpdateProject(ctx, doer, p)
+	return nil
+}
+
+// ChangeProjectStatus closes or reopens a project, nothing happens if the project already has the status
+func ChangeProjectStatus(ctx context.Context, doer *user_model.User, p *project_model.Project, isClosed bool) error {
+	if p.IsClosed == isClosed {
+		return nil
+	}
+	if err := project_model.ChangeProjectStatus(ctx, p, isClosed); err != nil {
+		return err
+	}
+	notify_service.ChangeProjectStatus(ctx, doer, p, isClosed)
+	return nil
+}
+
+// DeleteProject deletes a project with its columns and removes its issues from it
+func DeleteProject(ctx context.Context, doer *user_model.User, p *project_model.Project) error {
+	if err := project_model.DeleteProjectByID(ctx, p.ID); err != nil {
+		return err
+	}
+	notify_service.DeleteProject(ctx, doer, p)
+	return nil
+}
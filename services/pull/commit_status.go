@@ -8,14 +8,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"code.gitea.io/gitea/models/db"
 	git_model "code.gitea.io/gitea/models/git"
 	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
 	"code.gitea.io/gitea/modules/commitstatus"
 	"code.gitea.io/gitea/modules/gitrepo"
 	"code.gitea.io/gitea/modules/glob"
 	"code.gitea.io/gitea/modules/log"
+	actions_service "code.gitea.io/gitea/services/actions"
 )
 
 // MergeRequiredContextsCommitStatus returns a commit status state for given required contexts
@@ -65,46 +68,106 @@ func MergeRequiredContextsCommitStatus(commitStatuses []*git_model.CommitStatus,
 	return commitstatus.CommitStatusPending
 }
 
+// GetRequiredStatusContexts returns the status contexts required to merge into the protected branch:
+// the contexts of the rule if its status check is enabled, and the contexts of the required workflows of the organization.
+// It returns nil if no status is required. As an empty list of contexts of the rule requires all the statuses,
+// "*" is added in that case to keep the statuses required along with the contexts of the required workflows.
+func GetRequiredStatusContexts(ctx context.Context, repo *repo_model.Repository, pb *git_model.ProtectedBranch) ([]string, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	workflowContexts, err := actions_service.GetRequiredWorkflowStatusContexts(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.EnableStatusCheck {
+		return workflowContexts, nil
+	}
+	return mergeStatusContexts(pb.StatusCheckContexts, workflowContexts), nil
+}
+
+// mergeStatusContexts returns the contexts of a protected branch rule along with the contexts of the required workflows
+func mergeStatusContexts(ruleContexts, workflowContexts []string) []string {
+	if len(ruleContexts) == 0 && len(workflowContexts) > 0 {
+		return append([]string{"*"}, workflowContexts...)
+	}
+	return append(slices.Clone(ruleContexts), workflowContexts...)
+}
+
 // IsPullCommitStatusPass returns if all required status checks PASS
 func IsPullCommitStatusPass(ctx context.Context, pr *issues_model.PullRequest) (bool, error) {
 	pb, err := git_model.GetFirstMatchProtectedBranchRule(ctx, pr.BaseRepoID, pr.BaseBranch)
 	if err != nil {
 		return false, fmt.Errorf("GetLatestCommitStatus: %w", err)
 	}
-	if pb == nil || !pb.EnableStatusCheck {
+	if pb == nil {
+		return true, nil
+	}
+	if err := pr.LoadBaseRepo(ctx); err != nil {
+		return false, fmt.Errorf("LoadBaseRepo: %w", err)
+	}
+	requiredContexts, err := GetRequiredStatusContexts(ctx, pr.BaseRepo, pb)
+	if err != nil {
+		return false, fmt.Errorf("GetRequiredStatusContexts: %w", err)
+	}
+	if !pb.EnableStatusCheck && len(requiredContexts) == 0 {
 		return true, nil
 	}
 
-	state, err := GetPullRequestCommitStatusState(ctx, pr)
+	commitStatuses, err := getPullRequestCommitStatuses(ctx, pr)
 	if err != nil {
 		return false, err
 	}
-	return state.IsSuccess(), nil
+	return MergeRequiredContextsCommitStatus(commitStatuses, requiredContexts).IsSuccess(), nil
 }
 
 // GetPullRequestCommitStatusState returns pull request merged commit status state
 func GetPullRequestCommitStatusState(ctx context.Context, pr *issues_model.PullRequest) (commitstatus.CommitStatusState, error) {
+	commitStatuses, err := getPullRequestCommitStatuses(ctx, pr)
+	if err != nil {
+		return "", err
+	}
+
+	pb, err := git_model.GetFirstMatchProtectedBranchRule(ctx, pr.BaseRepoID, pr.BaseBranch)
+	if err != nil {
+		return "", fmt.Errorf("LoadProtectedBranch: %w", err)
+	}
+	var requiredContexts []string
+	if pb != nil {
+		workflowContexts, err := actions_service.GetRequiredWorkflowStatusContexts(ctx, pr.BaseRepo)
+		if err != nil {
+			return "", fmt.Errorf("GetRequiredWorkflowStatusContexts: %w", err)
+		}
+		// the contexts of the rule are applied to the state even if its status check is disabled
+		requiredContexts = mergeStatusContexts(pb.StatusCheckContexts, workflowContexts)
+	}
+
+	return MergeRequiredContextsCommitStatus(commitStatuses, requiredContexts), nil
+}
+
+// getPullRequestCommitStatuses returns the latest commit statuses of the head commit of the pull request
+func getPullRequestCommitStatuses(ctx context.Context, pr *issues_model.PullRequest) ([]*git_model.CommitStatus, error) {
 	// Ensure HeadRepo is loaded
 	if err := pr.LoadHeadRepo(ctx); err != nil {
-		return "", fmt.Errorf("LoadHeadRepo: %w", err)
+		return nil, fmt.Errorf("LoadHeadRepo: %w", err)
 	}
 
 	// check if all required status checks are successful
 	headGitRepo, closer, err := gitrepo.RepositoryFromContextOrOpen(ctx, pr.HeadRepo)
 	if err != nil {
-		return "", fmt.Errorf("OpenRepository: %w", err)
+		return nil, fmt.Errorf("OpenRepository: %w", err)
 	}
 	defer closer.Close()
 
 	if pr.Flow == issues_model.PullRequestFlowGithub {
 		if exist, err := git_model.IsBranchExist(ctx, pr.HeadRepo.ID, pr.HeadBranch); err != nil {
-			return "", fmt.Errorf("IsBranchExist: %w", err)
+			return nil, fmt.Errorf("IsBranchExist: %w", err)
 		} else if !exist {
-			return "", errors.New("Head branch does not exist, can not merge")
+			return nil, errors.New("Head branch does not exist, can not merge")
 		}
 	}
 	if pr.Flow == issues_model.PullRequestFlowAGit && !gitrepo.IsReferenceExist(ctx, pr.HeadRepo, pr.GetGitHeadRefName()) {
-		return "", errors.New("Head branch does not exist, can not merge")
+		return nil, errors.New("Head branch does not exist, can not merge")
 	}
 
 	var sha string
@@ -114,26 +177,16 @@ func GetPullRequestCommitStatusState(ctx context.Context, pr *issues_model.PullR
 		sha, err = headGitRepo.GetRefCommitID(pr.GetGitHeadRefName())
 	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if err := pr.LoadBaseRepo(ctx); err != nil {
-		return "", fmt.Errorf("LoadBaseRepo: %w", err)
+		return nil, fmt.Errorf("LoadBaseRepo: %w", err)
 	}
 
 	commitStatuses, err := git_model.GetLatestCommitStatus(ctx, pr.BaseRepo.ID, sha, db.ListOptionsAll)
 	if err != nil {
-		return "", fmt.Errorf("GetLatestCommitStatus: %w", err)
+		return nil, fmt.Errorf("GetLatestCommitStatus: %w", err)
 	}
-
-	pb, err := git_model.GetFirstMatchProtectedBranchRule(ctx, pr.BaseRepoID, pr.BaseBranch)
-	if err != nil {
-		return "", fmt.Errorf("LoadProtectedBranch: %w", err)
-	}
-	var requiredContexts []string
-	if pb != nil {
-		requiredContexts = pb.StatusCheckContexts
-	}
-
-	return MergeRequiredContextsCommitStatus(commitStatuses, requiredContexts), nil
+	return commitStatuses, nil
 }
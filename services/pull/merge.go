@@ -296,6 +296,8 @@ func Merge(ctx context.Context, pr *issues_model.PullRequest, doer *user_model.U
 	// Reset cached commit count
 	cache.Remove(pr.Issue.Repo.GetCommitsCountCacheKey(pr.BaseBranch, true))
 
+	retargetStackedPulls(ctx, doer, pr)
+
 	return handleCloseCrossReferences(ctx, pr, doer)
 }
 
@@ -671,6 +673,8 @@ func MergedManually(ctx context.Context, pr *issues_model.PullRequest, doer *use
 	notify_service.MergePullRequest(ctx, doer, pr)
 	log.Info("manuallyMerged[%d]: Marked as manually merged into %s/%s by commit id: %s", pr.ID, pr.BaseRepo.Name, pr.BaseBranch, commitID)
 
+	retargetStackedPulls(ctx, doer, pr)
+
 	return handleCloseCrossReferences(ctx, pr, doer)
 }
 
@@ -322,6 +322,11 @@ func ChangeTargetBranch(ctx context.Context, pr *issues_model.PullRequest, doer
 			return fmt.Errorf("syncCommitDivergence: %w", err)
 		}
 
+		// The pull request is no longer stacked if the new target isn't the head branch of its parent
+		if err := unstackIfTargetChanged(ctx, pr); err != nil {
+			return fmt.Errorf("unstackIfTargetChanged: %w", err)
+		}
+
 		// Create comment
 		options := &issues_model.CreateCommentOptions{
 			Type:   issues_model.CommentTypeChangeTargetBranch,
@@ -650,17 +655,27 @@ func retargetBranchPulls(ctx context.Context, doer *user_model.User, repoID int6
 
 	var errs []error
 	for _, pr := range prs {
-		if err = pr.Issue.LoadRepo(ctx); err != nil {
-			errs = append(errs, err)
-		} else if err = ChangeTargetBranch(ctx, pr, doer, targetBranch); err != nil &&
-			!issues_model.IsErrIssueIsClosed(err) && !IsErrPullRequestHasMerged(err) &&
-			!issues_model.IsErrPullRequestAlreadyExists(err) {
+		if err := retargetPull(ctx, doer, pr, targetBranch); err != nil {
 			errs = append(errs, err)
 		}
 	}
 	return errors.Join(errs...)
 }
 
+// retargetPull changes the target branch of a pull request, it's skipped if the pull request is closed, merged
+// or if there is already a pull request from its head branch to the target branch
+func retargetPull(ctx context.Context, doer *user_model.User, pr *issues_model.PullRequest, targetBranch string) error {
+	if err := pr.Issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := ChangeTargetBranch(ctx, pr, doer, targetBranch); err != nil &&
+		!issues_model.IsErrIssueIsClosed(err) && !IsErrPullRequestHasMerged(err) &&
+		!issues_model.IsErrPullRequestAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
 // AdjustPullsCausedByBranchDeleted close all the pull requests who's head branch is the branch
 // Or Close all the plls who's base branch is the branch if setting.Repository.PullRequest.RetargetChildrenOnMerge is false.
 // If it's true, Retarget all these pulls to the default branch.
@@ -0,0 +1,84 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pull
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/gitrepo"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// RangeDiff is the comparison between two versions of the commits of a pull request
+type RangeDiff struct {
+	OldCommitID string
+	NewCommitID string
+	// IsForcePush is false if the new version only adds commits on top of the old version
+	IsForcePush bool
+	Entries     []*git.RangeDiffEntry
+}
+
+// GetRangeDiff compares the commits of a pull request when its head was oldCommitID with its commits when its head is newCommitID.
+// Both versions start at their merge base with the base branch, so the commits rebased by a force-push
+// only show up as modified if their changes differ.
+func GetRangeDiff(ctx context.Context, pr *issues_model.PullRequest, oldCommitID, newCommitID string) (*RangeDiff, error) {
+	if err := pr.LoadBaseRepo(ctx); err != nil {
+		return nil, err
+	}
+	gitRepo, closer, err := gitrepo.RepositoryFromContextOrOpen(ctx, pr.BaseRepo)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	getCommit := func(commitID string) (*git.Commit, error) {
+		if !git.IsStringLikelyCommitID(nil, commitID, 7) {
+			return nil, util.NewInvalidArgumentErrorf("invalid commit id %q", commitID)
+		}
+		commit, err := gitRepo.GetCommit(commitID)
+		if git.IsErrNotExist(err) {
+			return nil, util.NewInvalidArgumentErrorf("commit %s doesn't exist", commitID)
+		}
+		return commit, err
+	}
+	oldCommit, err := getCommit(oldCommitID)
+	if err != nil {
+		return nil, err
+	}
+	newCommit, err := getCommit(newCommitID)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeDiff := &RangeDiff{
+		OldCommitID: oldCommit.ID.String(),
+		NewCommitID: newCommit.ID.String(),
+	}
+	if rangeDiff.OldCommitID == rangeDiff.NewCommitID {
+		return rangeDiff, nil
+	}
+	if rangeDiff.IsForcePush, err = newCommit.IsForcePush(rangeDiff.OldCommitID); err != nil {
+		return nil, err
+	}
+
+	// the base branch has moved on since a pull request got merged, its merge base must be used for both versions
+	oldBase, newBase := pr.MergeBase, pr.MergeBase
+	if !pr.HasMerged {
+		baseRef := git.BranchPrefix + pr.BaseBranch
+		if oldBase, err = gitrepo.MergeBase(ctx, pr.BaseRepo, baseRef, rangeDiff.OldCommitID); err != nil {
+			return nil, err
+		}
+		if newBase, err = gitrepo.MergeBase(ctx, pr.BaseRepo, baseRef, rangeDiff.NewCommitID); err != nil {
+			return nil, err
+		}
+	}
+
+	rangeDiff.Entries, err = gitRepo.RangeDiff(oldBase, rangeDiff.OldCommitID, newBase, rangeDiff.NewCommitID)
+	if err != nil {
+		return nil, err
+	}
+	return rangeDiff, nil
+}
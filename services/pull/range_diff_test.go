@@ -0,0 +1,42 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pull
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRangeDiff(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	pr := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: 2}) // branch2 -> master
+
+	// a push which only adds a commit
+	rangeDiff, err := GetRangeDiff(t.Context(), pr, "5c050d3b6d2db231ab1f64e324f1b6b9a0b181c2", "985f0301dba5e7b34be866819cd15ad3d8f508ee")
+	require.NoError(t, err)
+	assert.False(t, rangeDiff.IsForcePush)
+	require.Len(t, rangeDiff.Entries, 2)
+	assert.Equal(t, git.RangeDiffUnchanged, rangeDiff.Entries[0].Relation)
+	assert.Equal(t, "5c050d3b6d2db231ab1f64e324f1b6b9a0b181c2", rangeDiff.Entries[0].OldCommitID)
+	assert.Equal(t, git.RangeDiffAdded, rangeDiff.Entries[1].Relation)
+	assert.Equal(t, "985f0301dba5e7b34be866819cd15ad3d8f508ee", rangeDiff.Entries[1].NewCommitID)
+
+	// the same commit has no changes
+	rangeDiff, err = GetRangeDiff(t.Context(), pr, "985f030", "985f0301dba5e7b34be866819cd15ad3d8f508ee")
+	require.NoError(t, err)
+	assert.Empty(t, rangeDiff.Entries)
+
+	_, err = GetRangeDiff(t.Context(), pr, "--output=x", "985f0301dba5e7b34be866819cd15ad3d8f508ee")
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, err = GetRangeDiff(t.Context(), pr, "0000000000000000000000000000000000000001", "985f0301dba5e7b34be866819cd15ad3d8f508ee")
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
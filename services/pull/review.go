@@ -69,11 +69,75 @@ func lineBlame(ctx context.Context, repo *repo_model.Repository, gitRepo *git.Re
 	return gitRepo.GetCommit(sha[:objectFormat.FullLength()])
 }
 
-// checkInvalidation checks if the line of code comment got changed by another commit.
-// If the line got changed the comment is going to be invalidated.
+// rangeBlame returns the latest commit which changed one of the lines of the range
+func rangeBlame(ctx context.Context, repo *repo_model.Repository, gitRepo *git.Repository, branch, file string, startLine, endLine uint) (*git.Commit, error) {
+	shas, err := gitrepo.LinesBlame(ctx, repo, branch, file, startLine, endLine)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *git.Commit
+	for _, sha := range shas {
+		commit, err := gitRepo.GetCommit(sha)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || commit.Committer.When.After(latest.Committer.When) {
+			latest = commit
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no result of blame for lines %d-%d of %s", startLine, endLine, file)
+	}
+	return latest, nil
+}
+
+// codeCommentBlame returns the latest commit which changed the lines of a code comment
+func codeCommentBlame(ctx context.Context, repo *repo_model.Repository, gitRepo *git.Repository, branch, file string, startLine, line int64) (*git.Commit, error) {
+	c := &issues_model.Comment{Type: issues_model.CommentTypeCode, Line: line, StartLine: startLine}
+	if c.IsMultiLine() {
+		return rangeBlame(ctx, repo, gitRepo, branch, file, uint(c.UnsignedStartLine()), uint(c.UnsignedLine()))
+	}
+	return lineBlame(ctx, repo, gitRepo, branch, file, uint(c.UnsignedLine()))
+}
+
+// validateCodeCommentLines checks the lines of a code comment, the start line of a range must be
+// on the same side of the diff as the line and before it. A comment without line is on the whole file.
+func validateCodeCommentLines(startLine, line int64) error {
+	if startLine == 0 || startLine == line {
+		return nil
+	}
+	if line == 0 {
+		return util.NewInvalidArgumentErrorf("a comment on a whole file can't have a start line")
+	}
+	if (startLine < 0) != (line < 0) {
+		return util.NewInvalidArgumentErrorf("start line %d and line %d must be on the same side of the diff", startLine, line)
+	}
+	if (line > 0 && startLine > line) || (line < 0 && startLine < line) {
+		return util.NewInvalidArgumentErrorf("start line %d must be before line %d", startLine, line)
+	}
+	return nil
+}
+
+// checkInvalidation checks if the lines of code comment got changed by another commit.
+// If the lines got changed the comment is going to be invalidated, a comment on a whole file is invalidated when the file is removed.
 func checkInvalidation(ctx context.Context, c *issues_model.Comment, repo *repo_model.Repository, gitRepo *git.Repository, branch string) error {
+	if c.IsFileComment() {
+		commit, err := gitRepo.GetBranchCommit(branch)
+		if err != nil {
+			return err
+		}
+		if _, err := commit.GetTreeEntryByPath(c.TreePath); git.IsErrNotExist(err) {
+			c.Invalidated = true
+			return issues_model.UpdateCommentInvalidate(ctx, c)
+		} else if err != nil {
+			return err
+		}
+		return nil
+	}
+
 	// FIXME differentiate between previous and proposed line
-	commit, err := lineBlame(ctx, repo, gitRepo, branch, c.TreePath, uint(c.UnsignedLine()))
+	commit, err := codeCommentBlame(ctx, repo, gitRepo, branch, c.TreePath, c.StartLine, c.Line)
 	if isErrBlameNotFoundOrNotEnoughLines(err) {
 		c.Invalidated = true
 		return issues_model.UpdateCommentInvalidate(ctx, c)
@@ -112,13 +176,21 @@ func InvalidateCodeComments(ctx context.Context, prs issues_model.PullRequestLis
 	return nil
 }
 
-// CreateCodeComment creates a comment on the code line
-func CreateCodeComment(ctx context.Context, doer *user_model.User, gitRepo *git.Repository, issue *issues_model.Issue, line int64, content, treePath string, pendingReview bool, replyReviewID int64, latestCommitID string, attachments []string) (*issues_model.Comment, error) {
+// CreateCodeComment creates a comment on the code line, on the range of lines from the start line to the line
+// if the start line isn't zero, or on the whole file if the line is zero
+func CreateCodeComment(ctx context.Context, doer *user_model.User, gitRepo *git.Repository, issue *issues_model.Issue, startLine, line int64, content, treePath string, pendingReview bool, replyReviewID int64, latestCommitID string, attachments []string) (*issues_model.Comment, error) {
 	var (
 		existsReview bool
 		err          error
 	)
 
+	if err := validateCodeCommentLines(startLine, line); err != nil {
+		return nil, err
+	}
+	if startLine == line {
+		startLine = 0
+	}
+
 	// CreateCodeComment() is used for:
 	// - Single comments
 	// - Comments that are part of a review
@@ -144,6 +216,7 @@ func CreateCodeComment(ctx context.Context, doer *user_model.User, gitRepo *git.
 			issue,
 			content,
 			treePath,
+			startLine,
 			line,
 			replyReviewID,
 			attachments,
@@ -185,6 +258,7 @@ func CreateCodeComment(ctx context.Context, doer *user_model.User, gitRepo *git.
 		issue,
 		content,
 		treePath,
+		startLine,
 		line,
 		review.ID,
 		attachments,
@@ -205,8 +279,8 @@ func CreateCodeComment(ctx context.Context, doer *user_model.User, gitRepo *git.
 	return comment, nil
 }
 
-// createCodeComment creates a plain code comment at the specified line / path
-func createCodeComment(ctx context.Context, doer *user_model.User, repo *repo_model.Repository, issue *issues_model.Issue, content, treePath string, line, reviewID int64, attachments []string) (*issues_model.Comment, error) {
+// createCodeComment creates a plain code comment at the specified lines / path
+func createCodeComment(ctx context.Context, doer *user_model.User, repo *repo_model.Repository, issue *issues_model.Issue, content, treePath string, startLine, line, reviewID int64, attachments []string) (*issues_model.Comment, error) {
 	var commitID, patch string
 	if err := issue.LoadPullRequest(ctx); err != nil {
 		return nil, fmt.Errorf("LoadPullRequest: %w", err)
@@ -255,7 +329,7 @@ func createCodeComment(ctx context.Context, doer *user_model.User, repo *repo_mo
 			// FIXME validate treePath
 			// Get latest commit referencing the commented line
 			// No need for get commit for base branch changes
-			commit, err := lineBlame(ctx, pr.BaseRepo, gitRepo, head, treePath, uint(line))
+			commit, err := codeCommentBlame(ctx, pr.BaseRepo, gitRepo, head, treePath, startLine, line)
 			if err == nil {
 				commitID = commit.ID.String()
 			} else if !isErrBlameNotFoundOrNotEnoughLines(err) {
@@ -274,17 +348,23 @@ func createCodeComment(ctx context.Context, doer *user_model.User, repo *repo_mo
 			commitID = headCommitID
 		}
 
-		patch, err = git.GetFileDiffCutAroundLine(
-			gitRepo, pr.MergeBase, headCommitID, treePath,
-			int64((&issues_model.Comment{Line: line}).UnsignedLine()), line < 0, setting.UI.CodeCommentLines,
-		)
-		if err != nil {
-			return nil, err
+		// the patch of a range comment contains all the commented lines
+		c := &issues_model.Comment{Type: issues_model.CommentTypeCode, Line: line, StartLine: startLine}
+		contextLines := setting.UI.CodeCommentLines + int(c.UnsignedLine()-c.UnsignedStartLine())
+
+		if !c.IsFileComment() {
+			patch, err = git.GetFileDiffCutAroundLine(
+				gitRepo, pr.MergeBase, headCommitID, treePath,
+				int64(c.UnsignedLine()), line < 0, contextLines,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// If patch is still empty (unchanged line), generate code context
-		if patch == "" && commitID != "" {
-			patch, err = gitdiff.GeneratePatchForUnchangedLine(gitRepo, commitID, treePath, line, setting.UI.CodeCommentLines)
+		if patch == "" && commitID != "" && !c.IsFileComment() {
+			patch, err = gitdiff.GeneratePatchForUnchangedLine(gitRepo, commitID, treePath, line, contextLines)
 			if err != nil {
 				// Log the error but don't fail comment creation
 				log.Debug("Unable to generate patch for unchanged line (file=%s, line=%d, commit=%s): %v", treePath, line, commitID, err)
@@ -292,18 +372,19 @@ func createCodeComment(ctx context.Context, doer *user_model.User, repo *repo_mo
 		}
 	}
 	return issues_model.CreateComment(ctx, &issues_model.CreateCommentOptions{
-		Type:        issues_model.CommentTypeCode,
-		Doer:        doer,
-		Repo:        repo,
-		Issue:       issue,
-		Content:     content,
-		LineNum:     line,
-		TreePath:    treePath,
-		CommitSHA:   commitID,
-		ReviewID:    reviewID,
-		Patch:       patch,
-		Invalidated: invalidated,
-		Attachments: attachments,
+		Type:         issues_model.CommentTypeCode,
+		Doer:         doer,
+		Repo:         repo,
+		Issue:        issue,
+		Content:      content,
+		LineNum:      line,
+		StartLineNum: startLine,
+		TreePath:     treePath,
+		CommitSHA:    commitID,
+		ReviewID:     reviewID,
+		Patch:        patch,
+		Invalidated:  invalidated,
+		Attachments:  attachments,
 	})
 }
 
@@ -9,6 +9,7 @@ import (
 	issues_model "code.gitea.io/gitea/models/issues"
 	"code.gitea.io/gitea/models/unittest"
 	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/util"
 	pull_service "code.gitea.io/gitea/services/pull"
 
 	"github.com/stretchr/testify/assert"
@@ -45,3 +46,10 @@ func TestDismissReview(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, pull_service.IsErrDismissRequestOnClosedPR(err))
 }
+
+func TestCreateCodeCommentInvalidLines(t *testing.T) {
+	for _, lines := range [][2]int64{{6, 5}, {-6, -5}, {-3, 5}, {3, -5}, {3, 0}} {
+		_, err := pull_service.CreateCodeComment(t.Context(), nil, nil, nil, lines[0], lines[1], "content", "README.md", false, 0, "", nil)
+		assert.ErrorIs(t, err, util.ErrInvalidArgument, "start line %d, line %d", lines[0], lines[1])
+	}
+}
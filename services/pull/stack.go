@@ -0,0 +1,236 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pull
+
+import (
+	"context"
+	"fmt"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	pull_model "code.gitea.io/gitea/models/pull"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/util"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+// maxStackDepth limits the walk through a stack so a broken chain can't loop forever
+const maxStackDepth = 100
+
+// StackEntry is a pull request of a stack with its position in the stack
+type StackEntry struct {
+	PullRequest  *issues_model.PullRequest
+	ParentPullID int64
+	AutoRebase   bool
+	Depth        int
+}
+
+// SetStackParent stacks a pull request on a parent pull request. The base branch of the pull request
+// must be the head branch of the parent and both must be open pull requests of the same repository.
+func SetStackParent(ctx context.Context, doer *user_model.User, pr, parent *issues_model.PullRequest, autoRebase bool) error {
+	if err := pr.LoadIssue(ctx); err != nil {
+		return err
+	}
+	if err := parent.LoadIssue(ctx); err != nil {
+		return err
+	}
+
+	switch {
+	case pr.ID == parent.ID:
+		return util.NewInvalidArgumentErrorf("pull request can't be stacked on itself")
+	case pr.HasMerged || pr.Issue.IsClosed:
+		return util.NewInvalidArgumentErrorf("pull request #%d is closed", pr.Index)
+	case parent.HasMerged || parent.Issue.IsClosed:
+		return util.NewInvalidArgumentErrorf("parent pull request #%d is closed", parent.Index)
+	case parent.BaseRepoID != pr.BaseRepoID || parent.HeadRepoID != parent.BaseRepoID || parent.Flow != issues_model.PullRequestFlowGithub:
+		return util.NewInvalidArgumentErrorf("parent pull request #%d must have its head branch in the same repository", parent.Index)
+	case pr.BaseBranch != parent.HeadBranch:
+		return util.NewInvalidArgumentErrorf("base branch %q of pull request #%d is not the head branch %q of the parent pull request", pr.BaseBranch, pr.Index, parent.HeadBranch)
+	}
+
+	// the parent must not be stacked on the pull request itself
+	pullID := parent.ID
+	for range maxStackDepth {
+		exist, link, err := pull_model.GetStackParent(ctx, pullID)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return pull_model.SetStackParent(ctx, doer.ID, pr.ID, parent.ID, autoRebase)
+		}
+		if link.ParentPullID == pr.ID {
+			return util.NewInvalidArgumentErrorf("pull request #%d is already below #%d in the stack", parent.Index, pr.Index)
+		}
+		pullID = link.ParentPullID
+	}
+	return util.NewInvalidArgumentErrorf("stack of pull request #%d is too deep", parent.Index)
+}
+
+// RemoveStackParent unstacks a pull request from its parent, its own children stay stacked on it
+func RemoveStackParent(ctx context.Context, pr *issues_model.PullRequest) error {
+	return pull_model.RemoveStackParent(ctx, pr.ID)
+}
+
+// GetStack returns all the pull requests of the stack the pull request belongs to, from the bottom to the top.
+// Every pull request is followed by the pull requests stacked on it, an empty list is returned if it isn't stacked.
+func GetStack(ctx context.Context, pr *issues_model.PullRequest) ([]*StackEntry, error) {
+	rootID := pr.ID
+	for range maxStackDepth {
+		exist, link, err := pull_model.GetStackParent(ctx, rootID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		rootID = link.ParentPullID
+	}
+
+	root, err := issues_model.GetPullRequestByID(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+	if err := root.LoadIssue(ctx); err != nil {
+		return nil, err
+	}
+
+	entries := []*StackEntry{{PullRequest: root}}
+	visited := map[int64]bool{root.ID: true}
+	var walk func(parent *StackEntry) error
+	walk = func(parent *StackEntry) error {
+		if parent.Depth >= maxStackDepth {
+			return nil
+		}
+		children, err := pull_model.GetStackChildren(ctx, parent.PullRequest.ID)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if visited[child.PullID] {
+				continue
+			}
+			visited[child.PullID] = true
+			childPR, err := issues_model.GetPullRequestByID(ctx, child.PullID)
+			if err != nil {
+				if issues_model.IsErrPullRequestNotExist(err) {
+					continue
+				}
+				return err
+			}
+			if err := childPR.LoadIssue(ctx); err != nil {
+				return err
+			}
+			entry := &StackEntry{
+				PullRequest:  childPR,
+				ParentPullID: parent.PullRequest.ID,
+				AutoRebase:   child.AutoRebase,
+				Depth:        parent.Depth + 1,
+			}
+			entries = append(entries, entry)
+			if err := walk(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(entries[0]); err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 1 {
+		return []*StackEntry{}, nil
+	}
+	return entries, nil
+}
+
+// retargetStackedPulls moves the pull requests stacked on a merged pull request to its base branch,
+// they are stacked on the parent of the merged pull request if it has one, and the merged pull request leaves its stack.
+// The pull requests which have enabled auto rebase are rebased onto their new base branch.
+func retargetStackedPulls(ctx context.Context, doer *user_model.User, pr *issues_model.PullRequest) {
+	children, err := pull_model.GetStackChildren(ctx, pr.ID)
+	if err != nil {
+		log.Error("GetStackChildren[%d]: %v", pr.ID, err)
+		return
+	}
+
+	var newParentID int64
+	if exist, link, err := pull_model.GetStackParent(ctx, pr.ID); err != nil {
+		log.Error("GetStackParent[%d]: %v", pr.ID, err)
+		return
+	} else if exist {
+		newParentID = link.ParentPullID
+	}
+	if err := pull_model.ReplaceStackParent(ctx, pr.ID, newParentID); err != nil {
+		log.Error("ReplaceStackParent[%d]: %v", pr.ID, err)
+		return
+	}
+	if err := pull_model.RemoveStackParent(ctx, pr.ID); err != nil {
+		log.Error("RemoveStackParent[%d]: %v", pr.ID, err)
+	}
+
+	for _, child := range children {
+		if err := retargetStackedPull(ctx, doer, child, pr.HeadBranch, pr.BaseBranch); err != nil {
+			log.Error("retargetStackedPull[%d] onto %s: %v", child.PullID, pr.BaseBranch, err)
+		}
+	}
+}
+
+func retargetStackedPull(ctx context.Context, doer *user_model.User, link *pull_model.Stack, parentBranch, targetBranch string) error {
+	child, err := issues_model.GetPullRequestByID(ctx, link.PullID)
+	if err != nil {
+		return err
+	}
+	if err := child.LoadIssue(ctx); err != nil {
+		return err
+	}
+	// it has been retargeted by the deletion of the parent branch or by hand in the meantime
+	if child.HasMerged || child.Issue.IsClosed || child.BaseBranch != parentBranch {
+		return nil
+	}
+
+	oldBranch := child.BaseBranch
+	if err := retargetPull(ctx, doer, child, targetBranch); err != nil {
+		return fmt.Errorf("retargetPull: %w", err)
+	}
+	if child.BaseBranch == oldBranch {
+		return nil
+	}
+	notify_service.PullRequestChangeTargetBranch(ctx, doer, child, oldBranch)
+
+	if !link.AutoRebase {
+		return nil
+	}
+	if err := child.LoadHeadRepo(ctx); err != nil {
+		return err
+	}
+	if child.HeadRepo == nil {
+		return nil
+	}
+	if _, rebaseAllowed, err := IsUserAllowedToUpdate(ctx, child, doer); err != nil {
+		return err
+	} else if !rebaseAllowed {
+		log.Info("Skip rebasing stacked pull request %-v: %s is not allowed to rebase it", child, doer.Name)
+		return nil
+	}
+	if err := Update(ctx, child, doer, "", true); err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	return nil
+}
+
+// unstackIfTargetChanged removes the parent of a pull request whose base branch isn't the head branch of the parent anymore
+func unstackIfTargetChanged(ctx context.Context, pr *issues_model.PullRequest) error {
+	exist, link, err := pull_model.GetStackParent(ctx, pr.ID)
+	if err != nil || !exist {
+		return err
+	}
+	parent, err := issues_model.GetPullRequestByID(ctx, link.ParentPullID)
+	if err != nil && !issues_model.IsErrPullRequestNotExist(err) {
+		return err
+	}
+	if parent != nil && parent.HeadBranch == pr.BaseBranch {
+		return nil
+	}
+	return pull_model.RemoveStackParent(ctx, pr.ID)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package pull
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	pull_model "code.gitea.io/gitea/models/pull"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetStackParent(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	parent := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: 2}) // branch2 -> master
+	child := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: 5})  // pr-to-update -> branch2
+
+	assert.ErrorIs(t, SetStackParent(t.Context(), doer, child, child, false), util.ErrInvalidArgument)
+	assert.ErrorIs(t, SetStackParent(t.Context(), doer, parent, child, false), util.ErrInvalidArgument)
+
+	require.NoError(t, SetStackParent(t.Context(), doer, child, parent, true))
+	unittest.AssertExistsAndLoadBean(t, &pull_model.Stack{PullID: child.ID, ParentPullID: parent.ID, DoerID: doer.ID, AutoRebase: true})
+
+	for _, pr := range []*issues_model.PullRequest{parent, child} {
+		stack, err := GetStack(t.Context(), pr)
+		require.NoError(t, err)
+		if assert.Len(t, stack, 2) {
+			assert.Equal(t, parent.ID, stack[0].PullRequest.ID)
+			assert.Zero(t, stack[0].Depth)
+			assert.Equal(t, child.ID, stack[1].PullRequest.ID)
+			assert.Equal(t, parent.ID, stack[1].ParentPullID)
+			assert.Equal(t, 1, stack[1].Depth)
+			assert.True(t, stack[1].AutoRebase)
+		}
+	}
+
+	require.NoError(t, RemoveStackParent(t.Context(), child))
+	stack, err := GetStack(t.Context(), parent)
+	require.NoError(t, err)
+	assert.Empty(t, stack)
+}
+
+func TestReplaceStackParent(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	require.NoError(t, pull_model.SetStackParent(t.Context(), 2, 5, 2, false))
+	require.NoError(t, pull_model.SetStackParent(t.Context(), 2, 2, 1, false))
+
+	// the parent of #5 is merged, it is stacked on the parent of the merged pull request
+	require.NoError(t, pull_model.ReplaceStackParent(t.Context(), 2, 1))
+	unittest.AssertExistsAndLoadBean(t, &pull_model.Stack{PullID: 5, ParentPullID: 1})
+
+	// the bottom of the stack is merged, #5 isn't stacked anymore
+	require.NoError(t, pull_model.ReplaceStackParent(t.Context(), 1, 0))
+	unittest.AssertNotExistsBean(t, &pull_model.Stack{PullID: 5})
+	unittest.AssertNotExistsBean(t, &pull_model.Stack{PullID: 2})
+}
+
+func TestRetargetStackedPulls(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	doer := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+	require.NoError(t, pull_model.SetStackParent(t.Context(), doer.ID, 5, 2, false))
+	require.NoError(t, pull_model.SetStackParent(t.Context(), doer.ID, 2, 1, false))
+
+	// #5 has already been retargeted by another path, it must not be retargeted again
+	child := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: 5})
+	child.BaseBranch = "master"
+	require.NoError(t, child.UpdateCols(t.Context(), "base_branch"))
+
+	merged := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: 2})
+	retargetStackedPulls(t.Context(), doer, merged)
+
+	// the merged pull request leaves the stack, its children are stacked on its parent
+	unittest.AssertNotExistsBean(t, &pull_model.Stack{PullID: 2})
+	unittest.AssertExistsAndLoadBean(t, &pull_model.Stack{PullID: 5, ParentPullID: 1})
+	stack, err := GetStack(t.Context(), merged)
+	require.NoError(t, err)
+	assert.Empty(t, stack)
+	unittest.AssertNotExistsBean(t, &issues_model.Comment{IssueID: child.IssueID, Type: issues_model.CommentTypeChangeTargetBranch})
+}
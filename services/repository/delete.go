@@ -170,6 +170,8 @@ func DeleteRepositoryDirectly(ctx context.Context, repoID int64, ignoreOrgTeams
 		&webhook.Webhook{RepoID: repoID},
 		&secret_model.Secret{RepoID: repoID},
 		&actions_model.ActionTaskStep{RepoID: repoID},
+		&actions_model.ActionTaskAnnotation{RepoID: repoID},
+		&actions_model.ActionTaskSummary{RepoID: repoID},
 		&actions_model.ActionTask{RepoID: repoID},
 		&actions_model.ActionRunJob{RepoID: repoID},
 		&actions_model.ActionRun{RepoID: repoID},
@@ -178,7 +180,18 @@ func DeleteRepositoryDirectly(ctx context.Context, repoID int64, ignoreOrgTeams
 		&actions_model.ActionSchedule{RepoID: repoID},
 		&actions_model.ActionArtifact{RepoID: repoID},
 		&actions_model.ActionRunnerToken{RepoID: repoID},
+		&actions_model.ActionApprovedContributor{RepoID: repoID},
+		&actions_model.ActionJobStat{RepoID: repoID},
+		&actions_model.ActionRunnerLabelStat{RepoID: repoID},
+		&actions_model.ActionTestResult{RepoID: repoID},
+		&actions_model.ActionTestCase{RepoID: repoID},
+		&actions_model.ActionRequiredWorkflow{RepoID: repoID},
 		&issues_model.IssuePin{RepoID: repoID},
+		&issues_model.IssueSLAPolicy{RepoID: repoID},
+		&issues_model.IssueSLA{RepoID: repoID},
+		&issues_model.IssueSchedule{RepoID: repoID},
+		&issues_model.MilestoneSnapshot{RepoID: repoID},
+		&repo_model.ObjectMapping{RepoID: repoID},
 	); err != nil {
 		return fmt.Errorf("deleteBeans: %w", err)
 	}
@@ -0,0 +1,178 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package files
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	"code.gitea.io/gitea/models/unit"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/gitrepo"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/services/pull"
+)
+
+// ApplyCodeSuggestions commits the changes suggested by code comments of a pull request to its head branch
+// in one commit, the reviewers who suggested them are added as co-authors.
+// The suggestions are rejected if the commented lines have been changed since the comments were made.
+func ApplyCodeSuggestions(ctx context.Context, doer *user_model.User, pr *issues_model.PullRequest, comments []*issues_model.Comment, message string) (*structs.FilesResponse, error) {
+	if len(comments) == 0 {
+		return nil, util.NewInvalidArgumentErrorf("no suggestion to apply")
+	}
+	if err := pr.LoadIssue(ctx); err != nil {
+		return nil, err
+	}
+	if pr.HasMerged || pr.Issue.IsClosed {
+		return nil, util.NewInvalidArgumentErrorf("pull request #%d is closed", pr.Index)
+	}
+	if pr.Flow != issues_model.PullRequestFlowGithub {
+		return nil, util.NewInvalidArgumentErrorf("suggestions can't be applied to agit pull requests")
+	}
+	if err := pr.LoadHeadRepo(ctx); err != nil {
+		return nil, err
+	}
+	if pr.HeadRepo == nil {
+		return nil, util.NewInvalidArgumentErrorf("head repository of pull request #%d doesn't exist", pr.Index)
+	}
+
+	perm, err := access_model.GetUserRepoPermission(ctx, pr.HeadRepo, doer)
+	if err != nil {
+		return nil, err
+	}
+	if !perm.CanWrite(unit.TypeCode) && !issues_model.CanMaintainerWriteToBranch(ctx, perm, pr.HeadBranch, doer) {
+		return nil, util.NewPermissionDeniedErrorf("no permission to push to branch %s", pr.HeadBranch)
+	}
+
+	// group the suggestions by file
+	suggestions := make(map[string][]*issues_model.CodeSuggestion)
+	treePaths := make([]string, 0, len(comments))
+	coAuthors := make([]*user_model.User, 0, len(comments))
+	for _, comment := range comments {
+		if comment.IssueID != pr.IssueID {
+			return nil, util.NewInvalidArgumentErrorf("comment %d doesn't belong to pull request #%d", comment.ID, pr.Index)
+		}
+		if err := comment.LoadReview(ctx); err != nil {
+			return nil, err
+		}
+		if comment.Review != nil && comment.Review.Type == issues_model.ReviewTypePending {
+			return nil, util.NewInvalidArgumentErrorf("comment %d belongs to a pending review", comment.ID)
+		}
+		suggestion := comment.CodeSuggestion()
+		if suggestion == nil {
+			return nil, util.NewInvalidArgumentErrorf("comment %d has no suggestion which can be applied", comment.ID)
+		}
+		if _, ok := suggestions[comment.TreePath]; !ok {
+			treePaths = append(treePaths, comment.TreePath)
+		}
+		suggestions[comment.TreePath] = append(suggestions[comment.TreePath], suggestion)
+
+		if err := comment.LoadPoster(ctx); err != nil {
+			return nil, err
+		}
+		if comment.PosterID != doer.ID && !comment.Poster.IsGhost() && !slices.ContainsFunc(coAuthors, func(u *user_model.User) bool { return u.ID == comment.PosterID }) {
+			coAuthors = append(coAuthors, comment.Poster)
+		}
+	}
+
+	gitRepo, closer, err := gitrepo.RepositoryFromContextOrOpen(ctx, pr.HeadRepo)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	headCommit, err := gitRepo.GetBranchCommit(pr.HeadBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*ChangeRepoFile, 0, len(treePaths))
+	for _, treePath := range treePaths {
+		entry, err := headCommit.GetTreeEntryByPath(treePath)
+		if err != nil {
+			return nil, util.NewInvalidArgumentErrorf("file %s doesn't exist in branch %s", treePath, pr.HeadBranch)
+		}
+		blob := entry.Blob()
+		if blob.Size() > setting.UI.MaxDisplayFileSize {
+			return nil, util.NewInvalidArgumentErrorf("file %s is too large", treePath)
+		}
+		content, err := blob.GetBlobContent(blob.Size())
+		if err != nil {
+			return nil, err
+		}
+		content, err = applySuggestionsToContent(content, suggestions[treePath])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", treePath, err)
+		}
+		files = append(files, &ChangeRepoFile{
+			Operation:     "update",
+			TreePath:      treePath,
+			ContentReader: strings.NewReader(content),
+			SHA:           entry.ID.String(),
+		})
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" {
+		message = "Apply suggestions from code review"
+		if len(comments) == 1 {
+			message = "Apply suggestion from code review"
+		}
+	}
+	for _, coAuthor := range coAuthors {
+		message = pull.AddCommitMessageTailer(message, "Co-authored-by", coAuthor.NewGitSig().String())
+	}
+
+	resp, err := ChangeRepoFiles(ctx, pr.HeadRepo, doer, &ChangeRepoFilesOptions{
+		LastCommitID: headCommit.ID.String(),
+		OldBranch:    pr.HeadBranch,
+		Message:      message,
+		Files:        files,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		if err := issues_model.MarkConversation(ctx, comment, doer, true); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// applySuggestionsToContent replaces the lines of a file by the suggested lines,
+// the lines to replace must be the ones which were commented
+func applySuggestionsToContent(content string, suggestions []*issues_model.CodeSuggestion) (string, error) {
+	// apply the suggestions from the bottom of the file so the line numbers of the other ones don't change
+	slices.SortFunc(suggestions, func(a, b *issues_model.CodeSuggestion) int {
+		return int(b.StartLine - a.StartLine)
+	})
+
+	lines := strings.Split(content, "\n")
+	for i, suggestion := range suggestions {
+		if i > 0 && suggestion.EndLine >= suggestions[i-1].StartLine {
+			return "", util.NewInvalidArgumentErrorf("suggestions for line %d and %d overlap", suggestion.StartLine, suggestions[i-1].StartLine)
+		}
+		start, end := int(suggestion.StartLine)-1, int(suggestion.EndLine)
+		if end > len(lines) || !slices.Equal(lines[start:end], suggestion.OriginalLines) {
+			return "", util.NewInvalidArgumentErrorf("line %d has changed since the suggestion was made", suggestion.StartLine)
+		}
+
+		suggested := slices.Clone(suggestion.SuggestedLines)
+		if strings.HasSuffix(lines[end-1], "\r") {
+			for j := range suggested {
+				suggested[j] += "\r"
+			}
+		}
+		lines = slices.Replace(lines, start, end, suggested...)
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package files
+
+import (
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplySuggestionsToContent(t *testing.T) {
+	content := "line1\nline2\nline3\nline4\n"
+
+	result, err := applySuggestionsToContent(content, []*issues_model.CodeSuggestion{
+		{StartLine: 1, EndLine: 1, OriginalLines: []string{"line1"}, SuggestedLines: []string{"first"}},
+		{StartLine: 3, EndLine: 3, OriginalLines: []string{"line3"}, SuggestedLines: []string{"third", "third bis"}},
+		{StartLine: 4, EndLine: 4, OriginalLines: []string{"line4"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "first\nline2\nthird\nthird bis\n", result)
+
+	result, err = applySuggestionsToContent("line1\r\nline2\r\n", []*issues_model.CodeSuggestion{
+		{StartLine: 2, EndLine: 2, OriginalLines: []string{"line2\r"}, SuggestedLines: []string{"second"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "line1\r\nsecond\r\n", result)
+
+	// the commented line has changed since the suggestion was made
+	_, err = applySuggestionsToContent(content, []*issues_model.CodeSuggestion{
+		{StartLine: 2, EndLine: 2, OriginalLines: []string{"old line2"}, SuggestedLines: []string{"second"}},
+	})
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+
+	_, err = applySuggestionsToContent(content, []*issues_model.CodeSuggestion{
+		{StartLine: 10, EndLine: 10, OriginalLines: []string{"line10"}},
+	})
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+
+	// two suggestions for the same line
+	_, err = applySuggestionsToContent(content, []*issues_model.CodeSuggestion{
+		{StartLine: 2, EndLine: 2, OriginalLines: []string{"line2"}, SuggestedLines: []string{"a"}},
+		{StartLine: 2, EndLine: 2, OriginalLines: []string{"line2"}, SuggestedLines: []string{"b"}},
+	})
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
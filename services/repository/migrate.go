@@ -102,6 +102,17 @@ func MigrateRepositoryGitData(ctx context.Context, u *user_model.User,
 		return repo, fmt.Errorf("clone error: %w", err)
 	}
 
+	if opts.ObjectFormatName != "" {
+		mapping, err := convertClonedObjectFormat(ctx, repo, opts.ObjectFormatName, opts.Mirror)
+		if err != nil {
+			return repo, err
+		}
+		// the commit IDs of the issues and pull requests which are migrated later are converted by the mapping
+		if err := repo_model.InsertObjectMappings(ctx, repo.ID, mapping); err != nil {
+			return repo, err
+		}
+	}
+
 	if err := gitrepo.WriteCommitGraph(ctx, repo); err != nil {
 		return repo, err
 	}
@@ -112,6 +123,11 @@ func MigrateRepositoryGitData(ctx context.Context, u *user_model.User,
 			return repo, fmt.Errorf("clone wiki error: %w", err)
 		}
 		repo.DefaultWikiBranch = defaultWikiBranch
+		if defaultWikiBranch != "" && opts.ObjectFormatName != "" {
+			if _, err := convertClonedObjectFormat(ctx, repo.WikiStorageRepo(), opts.ObjectFormatName, opts.Mirror); err != nil {
+				return repo, fmt.Errorf("convert wiki error: %w", err)
+			}
+		}
 	}
 
 	if repo.OwnerID == u.ID {
@@ -225,7 +241,11 @@ func MigrateRepositoryGitData(ctx context.Context, u *user_model.User,
 
 			// this is necessary for sync local tags from remote
 			configName := fmt.Sprintf("remote.%s.fetch", mirrorModel.GetRemoteName())
-			if stdout, _, err := gitrepo.RunCmdString(ctx, repo,
+			var fetchRepo gitrepo.Repository = repo
+			if gitrepo.IsConvertedMirror(repo) {
+				fetchRepo = gitrepo.ConvertedMirrorUpstream(repo)
+			}
+			if stdout, _, err := gitrepo.RunCmdString(ctx, fetchRepo,
 				gitcmd.NewCommand("config").
 					AddOptionValues("--add", configName, `+refs/tags/*:refs/tags/*`)); err != nil {
 				log.Error("MigrateRepositoryGitData(git config --add <remote> +refs/tags/*:refs/tags/*) in %v: Stdout: %s\nError: %v", repo, stdout, err)
@@ -257,6 +277,37 @@ func MigrateRepositoryGitData(ctx context.Context, u *user_model.User,
 	})
 }
 
+// convertClonedObjectFormat converts a cloned repository to the object format requested by the migration if they differ,
+// a mirror keeps its upstream with the cloned object format, see gitrepo.ConvertMirrorObjectFormat
+func convertClonedObjectFormat(ctx context.Context, storageRepo gitrepo.Repository, objectFormatName string, mirror bool) (map[string]string, error) {
+	objectFormat := git.ObjectFormatFromName(objectFormatName)
+	if objectFormat == nil {
+		return nil, util.NewInvalidArgumentErrorf("unsupported object format %q", objectFormatName)
+	}
+	gitRepo, err := gitrepo.OpenRepository(ctx, storageRepo)
+	if err != nil {
+		return nil, err
+	}
+	clonedObjectFormat, err := gitRepo.GetObjectFormat()
+	gitRepo.Close()
+	if err != nil {
+		return nil, err
+	}
+	if clonedObjectFormat.Name() == objectFormat.Name() {
+		return nil, nil
+	}
+	convert := gitrepo.ConvertObjectFormat
+	if mirror {
+		convert = gitrepo.ConvertMirrorObjectFormat
+	}
+	var mapping map[string]string
+	err = convert(ctx, storageRepo, objectFormat, func(_ context.Context, converted map[string]string) error {
+		mapping = converted
+		return nil
+	})
+	return mapping, err
+}
+
 // CleanUpMigrateInfo finishes migrating repository and/or wiki with things that don't need to be done for mirrors.
 func CleanUpMigrateInfo(ctx context.Context, repo *repo_model.Repository) (*repo_model.Repository, error) {
 	if err := gitrepo.CreateDelegateHooks(ctx, repo); err != nil {
@@ -282,5 +333,15 @@ func CleanUpMigrateInfo(ctx context.Context, repo *repo_model.Repository) (*repo
 		}
 	}
 
+	// a converted mirror keeps the upstream history to sync from, it's never fetched again
+	if err := gitrepo.RemoveConvertedMirrorUpstream(ctx, repo); err != nil {
+		return repo, fmt.Errorf("RemoveConvertedMirrorUpstream: %w", err)
+	}
+	if hasWiki {
+		if err := gitrepo.RemoveConvertedMirrorUpstream(ctx, repo.WikiStorageRepo()); err != nil {
+			return repo, fmt.Errorf("RemoveConvertedMirrorUpstream (wiki): %w", err)
+		}
+	}
+
 	return repo, UpdateRepository(ctx, repo, false)
 }
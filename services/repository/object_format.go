@@ -0,0 +1,115 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/gitrepo"
+	"code.gitea.io/gitea/modules/log"
+	repo_module "code.gitea.io/gitea/modules/repository"
+	"code.gitea.io/gitea/modules/util"
+)
+
+// objectIDColumns lists the database columns which refer to commits of a repository,
+// the scope condition selects the rows of the repository and gets the repository ID as its argument
+var objectIDColumns = []struct {
+	table, column, scopeCond string
+}{
+	{"commit_status", "sha", "repo_id = ?"},
+	{"commit_status_index", "sha", "repo_id = ?"},
+	{"commit_status_summary", "sha", "repo_id = ?"},
+	{"release", "sha1", "repo_id = ?"},
+	{"action_run", "commit_sha", "repo_id = ?"},
+	{"action_run_job", "commit_sha", "repo_id = ?"},
+	{"action_task", "commit_sha", "repo_id = ?"},
+	{"repo_indexer_status", "commit_sha", "repo_id = ?"},
+	{"pull_request", "merge_base", "base_repo_id = ?"},
+	{"pull_request", "merged_commit_id", "base_repo_id = ?"},
+	{"comment", "commit_sha", "issue_id IN (SELECT id FROM issue WHERE repo_id = ?)"},
+	{"review", "commit_id", "issue_id IN (SELECT id FROM issue WHERE repo_id = ?)"},
+}
+
+// ConvertObjectFormat rewrites the git history of the repository and its wiki with another object format.
+// Every commit and tag gets a new ID, the mapping from the old IDs to the new ones is stored and is used to
+// update the commit statuses, the commit references of issues and pull requests and the other records
+// which refer to commits. Mirrors keep their upstream with its object format, the new objects fetched
+// from the upstream are converted when the mirror is synchronized.
+func ConvertObjectFormat(ctx context.Context, repo *repo_model.Repository, objectFormatName string) error {
+	objectFormat := git.ObjectFormatFromName(objectFormatName)
+	if objectFormat == nil {
+		return util.NewInvalidArgumentErrorf("unsupported object format %q", objectFormatName)
+	}
+	if repo.ObjectFormatName == objectFormat.Name() {
+		return util.NewInvalidArgumentErrorf("repository %s already uses the object format %s", repo.FullName(), objectFormat.Name())
+	}
+	convert := gitrepo.ConvertObjectFormat
+	if repo.IsMirror {
+		if gitrepo.IsConvertedMirror(repo) {
+			return util.NewInvalidArgumentErrorf("mirror repository %s has already been converted from the object format of its upstream", repo.FullName())
+		}
+		convert = gitrepo.ConvertMirrorObjectFormat
+	}
+
+	oldObjectFormatName := repo.ObjectFormatName
+	var numObjects int
+	// the converted repositories replace the original ones only if the database has been updated
+	updateDatabase := func(ctx context.Context, mapping map[string]string) error {
+		numObjects = len(mapping)
+		return db.WithTx(ctx, func(ctx context.Context) error {
+			repo.ObjectFormatName = objectFormat.Name()
+			if err := repo_model.UpdateRepositoryColsNoAutoTime(ctx, repo, "object_format_name"); err != nil {
+				return err
+			}
+			return updateObjectIDsByMapping(ctx, repo.ID, mapping)
+		})
+	}
+	if err := convert(ctx, repo, objectFormat, func(ctx context.Context, mapping map[string]string) error {
+		if !HasWiki(ctx, repo) {
+			return updateDatabase(ctx, mapping)
+		}
+		if err := convert(ctx, repo.WikiStorageRepo(), objectFormat, func(ctx context.Context, _ map[string]string) error {
+			return updateDatabase(ctx, mapping)
+		}); err != nil {
+			return fmt.Errorf("ConvertObjectFormat[wiki]: %w", err)
+		}
+		return nil
+	}); err != nil {
+		repo.ObjectFormatName = oldObjectFormatName
+		return fmt.Errorf("ConvertObjectFormat: %w", err)
+	}
+
+	log.Info("Repository %s has been converted to the object format %s, %d commits and tags were rewritten", repo.FullName(), objectFormat.Name(), numObjects)
+
+	// all the branches exist already, only their commit IDs are updated
+	if _, err := repo_module.SyncRepoBranches(ctx, repo.ID, repo.OwnerID); err != nil {
+		return fmt.Errorf("SyncRepoBranches: %w", err)
+	}
+	return nil
+}
+
+// updateObjectIDsByMapping stores the mapping of a converted repository and updates the database records which refer to its commits
+func updateObjectIDsByMapping(ctx context.Context, repoID int64, mapping map[string]string) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		if err := repo_model.InsertObjectMappings(ctx, repoID, mapping); err != nil {
+			return err
+		}
+		for _, c := range objectIDColumns {
+			if err := repo_model.UpdateObjectIDsByMapping(ctx, repoID, c.table, c.column, c.scopeCond); err != nil {
+				return fmt.Errorf("update %s.%s: %w", c.table, c.column, err)
+			}
+		}
+		return issues_model.UpdatePushCommentsCommitIDs(ctx, repoID, func(commitID string) string {
+			if newID, ok := mapping[commitID]; ok {
+				return newID
+			}
+			return commitID
+		})
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	git_model "code.gitea.io/gitea/models/git"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/git"
+	"code.gitea.io/gitea/modules/gitrepo"
+	"code.gitea.io/gitea/modules/setting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertObjectFormatRejectsInvalidTargets(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+
+	assert.Error(t, ConvertObjectFormat(t.Context(), repo, "md5"))
+	assert.Error(t, ConvertObjectFormat(t.Context(), repo, repo.ObjectFormatName))
+}
+
+func TestUpdateObjectIDsByMapping(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	oldID := "1234123412341234123412341234123412341234"
+	newID := "5678567856785678567856785678567856785678567856785678567856785678"
+	require.NoError(t, updateObjectIDsByMapping(t.Context(), 1, map[string]string{oldID: newID}))
+
+	unittest.AssertNotExistsBean(t, &git_model.CommitStatus{RepoID: 1, SHA: oldID})
+	status := unittest.AssertExistsAndLoadBean(t, &git_model.CommitStatus{ID: 1})
+	assert.Equal(t, newID, status.SHA)
+
+	// the statuses of other repositories must not be touched
+	require.NoError(t, updateObjectIDsByMapping(t.Context(), 2, map[string]string{newID: oldID}))
+	status = unittest.AssertExistsAndLoadBean(t, &git_model.CommitStatus{ID: 1})
+	assert.Equal(t, newID, status.SHA)
+}
+
+func TestCleanUpMigrateInfoRemovesConvertedUpstream(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	storageRepos := []gitrepo.Repository{repo, repo.WikiStorageRepo()}
+	for _, storageRepo := range storageRepos {
+		upstreamPath := filepath.Join(setting.RepoRootPath, gitrepo.ConvertedMirrorUpstream(storageRepo).RelativePath())
+		require.NoError(t, git.InitRepository(t.Context(), upstreamPath, true, git.Sha1ObjectFormat.Name()))
+		require.True(t, gitrepo.IsConvertedMirror(storageRepo))
+	}
+
+	_, err := CleanUpMigrateInfo(t.Context(), repo)
+	require.NoError(t, err)
+	for _, storageRepo := range storageRepos {
+		assert.False(t, gitrepo.IsConvertedMirror(storageRepo))
+		assert.NoDirExists(t, filepath.Join(setting.RepoRootPath, gitrepo.ConvertedMirrorUpstream(storageRepo).RelativePath()))
+	}
+}
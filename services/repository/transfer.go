@@ -229,6 +229,21 @@ func transferOwnership(ctx context.Context, doer *user_model.User, newOwnerName
 		}
 	}
 
+	// Sub-issues must be in a repository of the owner of their parent
+	if err := issues_model.DeleteSubIssueLinksOfRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("DeleteSubIssueLinksOfRepo: %w", err)
+	}
+
+	// Issue types are defined by the owner
+	if err := issues_model.ClearIssueTypesOfRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("ClearIssueTypesOfRepo: %w", err)
+	}
+
+	// The SLA policies can refer to the issue types, the labels and the teams of the owner
+	if err := issues_model.DeleteOwnerIssueSLAPoliciesOfRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("DeleteOwnerIssueSLAPoliciesOfRepo: %w", err)
+	}
+
 	if newOwner.IsOrganization() {
 		teams, err := organization.FindOrgTeams(ctx, newOwner.ID)
 		if err != nil {
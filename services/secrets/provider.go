@@ -0,0 +1,210 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	repo_model "code.gitea.io/gitea/models/repo"
+	secret_model "code.gitea.io/gitea/models/secret"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/glob"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// Provider reads the secrets of an external secret manager
+type Provider interface {
+	// ReadSecret returns the key-value pairs of the secret at the path
+	ReadSecret(ctx context.Context, path string) (map[string]string, error)
+}
+
+// NewProviderFunc creates a provider with the settings of the external secrets
+type NewProviderFunc func(cfg *setting.ActionsExternalSecrets) (Provider, error)
+
+var providerMap = map[string]NewProviderFunc{}
+
+// RegisterProvider registers a provider with a function to create it
+func RegisterProvider(name string, fn NewProviderFunc) {
+	providerMap[name] = fn
+}
+
+// NewProvider creates the provider configured by the settings
+func NewProvider(cfg *setting.ActionsExternalSecrets) (Provider, error) {
+	fn, ok := providerMap[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported external secret provider %q", cfg.Provider)
+	}
+	return fn(cfg)
+}
+
+// Reference is a reference to a key of a secret of the external secret manager, like "vault://path/of/secret#key"
+type Reference struct {
+	Path string
+	Key  string
+}
+
+// ParseReference parses the value of a secret as a reference to the external secret manager,
+// it returns false if the external secrets are disabled or the value doesn't use the scheme of the provider
+func ParseReference(value string) (*Reference, bool, error) {
+	if !setting.Actions.ExternalSecrets.Enabled {
+		return nil, false, nil
+	}
+	rest, ok := strings.CutPrefix(strings.TrimSpace(value), setting.Actions.ExternalSecrets.Provider+"://")
+	if !ok {
+		return nil, false, nil
+	}
+	secretPath, key, _ := strings.Cut(rest, "#")
+	if secretPath == "" || key == "" {
+		return nil, true, util.NewInvalidArgumentErrorf("the reference should be like %s://path#key", setting.Actions.ExternalSecrets.Provider)
+	}
+	// the cleaned path is required, so the path can't escape the allowed paths
+	if path.Clean("/"+secretPath) != "/"+secretPath {
+		return nil, true, util.NewInvalidArgumentErrorf("the path %q of the reference is not clean", secretPath)
+	}
+	return &Reference{Path: secretPath, Key: key}, true, nil
+}
+
+// isReferenceAllowed returns whether the secret of the owner or the repository can reference the path,
+// repoName is empty for the secrets of the owner, which are checked with their own patterns as they are shared by all the repositories
+func isReferenceAllowed(ref *Reference, ownerName, repoName string) bool {
+	patterns := setting.Actions.ExternalSecrets.AllowedPaths
+	if repoName == "" {
+		patterns = setting.Actions.ExternalSecrets.OwnerAllowedPaths
+	}
+	for _, pattern := range patterns {
+		if strings.Contains(pattern, "{repo}") {
+			if repoName == "" {
+				continue
+			}
+			pattern = strings.ReplaceAll(pattern, "{repo}", glob.QuoteMeta(repoName))
+		}
+		pattern = strings.ReplaceAll(pattern, "{owner}", glob.QuoteMeta(ownerName))
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			log.Error("invalid allowed path %q of the external secrets: %v", pattern, err)
+			continue
+		}
+		if g.Match(ref.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateReference checks the reference if the data of the secret is a reference to the external secret manager
+func validateReference(ctx context.Context, ownerID, repoID int64, data string) error {
+	ref, ok, err := ParseReference(data)
+	if !ok || err != nil {
+		return err
+	}
+
+	var ownerName, repoName string
+	if repoID > 0 {
+		repo, err := repo_model.GetRepositoryByID(ctx, repoID)
+		if err != nil {
+			return err
+		}
+		ownerName, repoName = repo.OwnerName, repo.Name
+	} else {
+		owner, err := user_model.GetUserByID(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		ownerName = owner.Name
+	}
+	if !isReferenceAllowed(ref, ownerName, repoName) {
+		return util.NewInvalidArgumentErrorf("the path %q is not allowed to be referenced", ref.Path)
+	}
+	return nil
+}
+
+// secretCache caches the secrets read from the external secret manager by their paths
+var secretCache = sync.OnceValue(func() *expirable.LRU[string, map[string]string] {
+	return expirable.NewLRU[string, map[string]string](1000, nil, setting.Actions.ExternalSecrets.CacheTTL)
+})
+
+// resolveReference returns the value referenced by the reference, and whether it's read from the cache
+func resolveReference(ctx context.Context, ref *Reference) (string, bool, error) {
+	cfg := &setting.Actions.ExternalSecrets
+	useCache := cfg.CacheTTL > 0
+	cacheKey := cfg.Provider + "\x00" + ref.Path
+
+	var data map[string]string
+	var cached bool
+	if useCache {
+		data, cached = secretCache().Get(cacheKey)
+	}
+	if !cached {
+		provider, err := NewProvider(cfg)
+		if err != nil {
+			return "", false, err
+		}
+		if data, err = provider.ReadSecret(ctx, ref.Path); err != nil {
+			return "", false, err
+		}
+		if useCache {
+			secretCache().Add(cacheKey, data)
+		}
+	}
+
+	v, ok := data[ref.Key]
+	if !ok {
+		return "", cached, util.NewNotExistErrorf("key %q does not exist in secret %q", ref.Key, ref.Path)
+	}
+	return v, cached, nil
+}
+
+// NewTaskResolver returns a resolver of the secrets of the task, it resolves the references to the external secret manager
+// and records the accesses. It returns nil if the external secrets are disabled.
+func NewTaskResolver(task *actions_model.ActionTask) secret_model.Resolver {
+	if !setting.Actions.ExternalSecrets.Enabled {
+		return nil
+	}
+	return func(ctx context.Context, secret *secret_model.Secret, value string) (string, error) {
+		ref, ok, err := ParseReference(value)
+		if !ok {
+			return value, nil
+		}
+
+		accessLog := &secret_model.AccessLog{
+			SecretID:   secret.ID,
+			OwnerID:    secret.OwnerID,
+			RepoID:     secret.RepoID,
+			SecretName: secret.Name,
+			Reference:  strings.TrimSpace(value),
+			TaskID:     task.ID,
+			TaskRepoID: task.RepoID,
+		}
+		var resolved string
+		if err == nil {
+			// the settings could have been changed since the secret was saved, so check it again
+			repo := task.Job.Run.Repo
+			repoName := ""
+			if secret.RepoID > 0 {
+				repoName = repo.Name
+			}
+			if !isReferenceAllowed(ref, repo.OwnerName, repoName) {
+				err = util.NewPermissionDeniedErrorf("the path %q is not allowed to be referenced", ref.Path)
+			} else {
+				resolved, accessLog.Cached, err = resolveReference(ctx, ref)
+			}
+		}
+		if err != nil {
+			accessLog.Error = err.Error()
+		}
+		if err := secret_model.InsertAccessLog(ctx, accessLog); err != nil {
+			log.Error("InsertAccessLog: %v", err)
+		}
+		return resolved, err
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package secrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	repo_model "code.gitea.io/gitea/models/repo"
+	secret_model "code.gitea.io/gitea/models/secret"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	reads int
+}
+
+func (p *testProvider) ReadSecret(_ context.Context, secretPath string) (map[string]string, error) {
+	p.reads++
+	if secretPath == "user2/repo1/deploy" {
+		return map[string]string{"password": "p@ss"}, nil
+	}
+	return nil, util.NewNotExistErrorf("secret %q does not exist", secretPath)
+}
+
+func mockExternalSecrets(t *testing.T) *testProvider {
+	p := &testProvider{}
+	RegisterProvider("test", func(*setting.ActionsExternalSecrets) (Provider, error) {
+		return p, nil
+	})
+	t.Cleanup(test.MockVariableValue(&setting.Actions.ExternalSecrets, setting.ActionsExternalSecrets{
+		Enabled:           true,
+		Provider:          "test",
+		CacheTTL:          time.Minute,
+		AllowedPaths:      []string{"{owner}/{repo}/*", "shared/{owner}/*"},
+		OwnerAllowedPaths: []string{"{owner}/*", "{owner}/{repo}/*"},
+	}))
+	return p
+}
+
+func TestParseReference(t *testing.T) {
+	mockExternalSecrets(t)
+
+	cases := []struct {
+		value string
+		ref   *Reference
+		ok    bool
+		valid bool
+	}{
+		{"plain value", nil, false, true},
+		{"vault://a/b#key", nil, false, true},
+		{"test://a/b#key", &Reference{Path: "a/b", Key: "key"}, true, true},
+		{" test://a/b#key\n", &Reference{Path: "a/b", Key: "key"}, true, true},
+		{"test://a/b", nil, true, false},
+		{"test://#key", nil, true, false},
+		{"test://a/../b#key", nil, true, false},
+		{"test:///a/b#key", nil, true, false},
+	}
+	for _, c := range cases {
+		ref, ok, err := ParseReference(c.value)
+		assert.Equal(t, c.ok, ok, "ParseReference(%q)", c.value)
+		assert.Equal(t, c.valid, err == nil, "ParseReference(%q)", c.value)
+		assert.Equal(t, c.ref, ref, "ParseReference(%q)", c.value)
+	}
+
+	setting.Actions.ExternalSecrets.Enabled = false
+	_, ok, err := ParseReference("test://a/b#key")
+	assert.False(t, ok)
+	assert.NoError(t, err)
+}
+
+func TestIsReferenceAllowed(t *testing.T) {
+	mockExternalSecrets(t)
+
+	cases := []struct {
+		path     string
+		owner    string
+		repo     string
+		expected bool
+	}{
+		{"user2/repo1/deploy", "user2", "repo1", true},
+		// a repository can't reference the paths of the other repositories of its owner
+		{"user2/repo2/deploy", "user2", "repo1", false},
+		{"user2/deploy", "user2", "repo1", false},
+		{"user2/repo1/deploy/db", "user2", "repo1", true},
+		// the secrets of the owner are shared by its repositories, the patterns with {repo} don't apply to them
+		{"user2/repo1/deploy", "user2", "", true},
+		{"user2/deploy", "user2", "", true},
+		{"user3/deploy", "user2", "", false},
+		{"shared/user2/deploy", "user2", "", false},
+		{"shared/user2/deploy", "user2", "repo1", true},
+		{"shared/user3/deploy", "user2", "repo1", false},
+		{"user2/re*/deploy", "user2", "re*", true},
+		{"user2/repo1/deploy", "user2", "re*", false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, isReferenceAllowed(&Reference{Path: c.path, Key: "k"}, c.owner, c.repo), "path %q", c.path)
+	}
+}
+
+func TestCreateOrUpdateSecretReference(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	mockExternalSecrets(t)
+
+	_, _, err := CreateOrUpdateSecret(t.Context(), 0, 1, "DEPLOY", "test://user2/repo1/deploy#password", "")
+	require.NoError(t, err)
+	_, _, err = CreateOrUpdateSecret(t.Context(), 0, 1, "OTHER", "test://user2/repo2/deploy#password", "")
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+	_, _, err = CreateOrUpdateSecret(t.Context(), 2, 0, "SHARED", "test://user2/repo1/deploy#password", "")
+	require.NoError(t, err)
+	_, _, err = CreateOrUpdateSecret(t.Context(), 2, 0, "OTHER", "test://shared/user2/deploy#password", "")
+	assert.ErrorIs(t, err, util.ErrInvalidArgument)
+}
+
+func TestNewTaskResolver(t *testing.T) {
+	require.NoError(t, unittest.PrepareTestDatabase())
+	p := mockExternalSecrets(t)
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	task := &actions_model.ActionTask{
+		ID:     100,
+		RepoID: repo.ID,
+		Job:    &actions_model.ActionRunJob{Run: &actions_model.ActionRun{Repo: repo}},
+	}
+	resolve := NewTaskResolver(task)
+	require.NotNil(t, resolve)
+
+	secret := &secret_model.Secret{ID: 10, RepoID: repo.ID, Name: "DEPLOY"}
+	v, err := resolve(t.Context(), secret, "plain value")
+	require.NoError(t, err)
+	assert.Equal(t, "plain value", v)
+	unittest.AssertCount(t, &secret_model.AccessLog{}, 0)
+
+	ref := "test://user2/repo1/deploy#password"
+	v, err = resolve(t.Context(), secret, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "p@ss", v)
+	v, err = resolve(t.Context(), secret, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "p@ss", v)
+	assert.Equal(t, 1, p.reads)
+	unittest.AssertExistsAndLoadBean(t, &secret_model.AccessLog{SecretID: 10, TaskID: 100, Reference: ref, Cached: false})
+	unittest.AssertExistsAndLoadBean(t, &secret_model.AccessLog{SecretID: 10, TaskID: 100, Reference: ref, Cached: true})
+
+	_, err = resolve(t.Context(), secret, "test://user2/repo1/deploy#missing")
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	_, err = resolve(t.Context(), secret, "test://user2/repo2/deploy#password")
+	assert.ErrorIs(t, err, util.ErrPermissionDenied)
+	accessLog := unittest.AssertExistsAndLoadBean(t, &secret_model.AccessLog{Reference: "test://user2/repo2/deploy#password"})
+	assert.NotEmpty(t, accessLog.Error)
+	unittest.AssertCount(t, &secret_model.AccessLog{}, 4)
+
+	setting.Actions.ExternalSecrets.Enabled = false
+	assert.Nil(t, NewTaskResolver(task))
+}
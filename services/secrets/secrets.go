@@ -14,6 +14,9 @@ func CreateOrUpdateSecret(ctx context.Context, ownerID, repoID int64, name, data
 	if err := ValidateName(name); err != nil {
 		return nil, false, err
 	}
+	if err := validateReference(ctx, ownerID, repoID, data); err != nil {
+		return nil, false, err
+	}
 
 	s, err := db.Find[secret_model.Secret](ctx, secret_model.FindSecretsOptions{
 		OwnerID: ownerID,
@@ -0,0 +1,100 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.gitea.io/gitea/modules/json"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+)
+
+func init() {
+	RegisterProvider("vault", newVaultProvider)
+}
+
+// vaultProvider reads the secrets of the KV version 2 secrets engine of HashiCorp Vault
+type vaultProvider struct {
+	cfg    *setting.ActionsExternalSecrets
+	client *http.Client
+}
+
+func newVaultProvider(cfg *setting.ActionsExternalSecrets) (Provider, error) {
+	if cfg.VaultAddress == "" {
+		return nil, errors.New("no address is configured for the vault provider")
+	}
+	return &vaultProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+func (p *vaultProvider) ReadSecret(ctx context.Context, secretPath string) (map[string]string, error) {
+	// the API to read the latest version of a secret: GET /v1/:mount/data/:path
+	u := fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimSuffix(p.cfg.VaultAddress, "/"),
+		util.PathEscapeSegments(strings.Trim(p.cfg.VaultMount, "/")), util.PathEscapeSegments(secretPath))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.cfg.VaultToken)
+	if p.cfg.VaultNamespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.VaultNamespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read the response of vault: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, util.NewNotExistErrorf("secret %q does not exist in vault", secretPath)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(body, &errResp)
+		return nil, fmt.Errorf("vault responded %d: %s", resp.StatusCode, strings.Join(errResp.Errors, "; "))
+	}
+
+	var result struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode the response of vault: %w", err)
+	}
+	if result.Data.Data == nil {
+		// the latest version of the secret has been deleted
+		return nil, util.NewNotExistErrorf("secret %q does not exist in vault", secretPath)
+	}
+
+	data := make(map[string]string, len(result.Data.Data))
+	for k, v := range result.Data.Data {
+		if s, ok := v.(string); ok {
+			data[k] = s
+			continue
+		}
+		// the values which aren't strings are passed as JSON
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("encode the value of key %q: %w", k, err)
+		}
+		data[k] = string(b)
+	}
+	return data, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultProvider(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+		assert.Equal(t, "team", r.Header.Get("X-Vault-Namespace"))
+		switch r.URL.Path {
+		case "/v1/kv/data/org/repo/deploy":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"p@ss","port":5432,"tags":["a"]},"metadata":{"version":3}}}`))
+		case "/v1/kv/data/org/deleted":
+			_, _ = w.Write([]byte(`{"data":{"data":null,"metadata":{"version":2}}}`))
+		case "/v1/kv/data/org/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer s.Close()
+
+	p, err := NewProvider(&setting.ActionsExternalSecrets{
+		Provider:       "vault",
+		Timeout:        time.Second,
+		VaultAddress:   s.URL + "/",
+		VaultToken:     "test-token",
+		VaultMount:     "kv",
+		VaultNamespace: "team",
+	})
+	require.NoError(t, err)
+
+	data, err := p.ReadSecret(t.Context(), "org/repo/deploy")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "p@ss", "port": "5432", "tags": `["a"]`}, data)
+
+	_, err = p.ReadSecret(t.Context(), "org/missing")
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	_, err = p.ReadSecret(t.Context(), "org/deleted")
+	assert.ErrorIs(t, err, util.ErrNotExist)
+	_, err = p.ReadSecret(t.Context(), "org/forbidden")
+	assert.ErrorContains(t, err, "permission denied")
+
+	_, err = NewProvider(&setting.ActionsExternalSecrets{Provider: "vault"})
+	assert.Error(t, err)
+}
+
+// TestVaultProviderDevServer runs against a Vault server started by "vault server -dev",
+// the secret should be written by "vault kv put secret/gitea-test password=secret" beforehand.
+func TestVaultProviderDevServer(t *testing.T) {
+	addr, token := os.Getenv("TEST_VAULT_ADDR"), os.Getenv("TEST_VAULT_TOKEN")
+	if addr == "" || token == "" {
+		t.Skip("TEST_VAULT_ADDR and TEST_VAULT_TOKEN are not set")
+	}
+
+	p, err := NewProvider(&setting.ActionsExternalSecrets{
+		Provider:     "vault",
+		Timeout:      10 * time.Second,
+		VaultAddress: addr,
+		VaultToken:   token,
+		VaultMount:   "secret",
+	})
+	require.NoError(t, err)
+
+	data, err := p.ReadSecret(t.Context(), "gitea-test")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", data["password"])
+
+	_, err = p.ReadSecret(t.Context(), "gitea-test-missing")
+	assert.ErrorIs(t, err, util.ErrNotExist)
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package sla
+
+import (
+	"context"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	access_model "code.gitea.io/gitea/models/perm/access"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/timeutil"
+	"code.gitea.io/gitea/modules/util"
+	notify_service "code.gitea.io/gitea/services/notify"
+)
+
+type slaNotifier struct {
+	notify_service.NullNotifier
+}
+
+var _ notify_service.Notifier = &slaNotifier{}
+
+// NewNotifier create a new slaNotifier notifier
+func NewNotifier() notify_service.Notifier {
+	return &slaNotifier{}
+}
+
+func (n *slaNotifier) NewIssue(ctx context.Context, issue *issues_model.Issue, mentions []*user_model.User) {
+	if err := UpdateIssueSLA(ctx, issue); err != nil {
+		log.Error("UpdateIssueSLA [issue_id: %d]: %v", issue.ID, err)
+	}
+}
+
+func (n *slaNotifier) IssueChangeLabels(ctx context.Context, doer *user_model.User, issue *issues_model.Issue,
+	addedLabels, removedLabels []*issues_model.Label,
+) {
+	if err := UpdateIssueSLA(ctx, issue); err != nil {
+		log.Error("UpdateIssueSLA [issue_id: %d]: %v", issue.ID, err)
+	}
+}
+
+func (n *slaNotifier) IssueClearLabels(ctx context.Context, doer *user_model.User, issue *issues_model.Issue) {
+	if err := UpdateIssueSLA(ctx, issue); err != nil {
+		log.Error("UpdateIssueSLA [issue_id: %d]: %v", issue.ID, err)
+	}
+}
+
+func (n *slaNotifier) IssueChangeType(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldType *issues_model.IssueType) {
+	if err := UpdateIssueSLA(ctx, issue); err != nil {
+		log.Error("UpdateIssueSLA [issue_id: %d]: %v", issue.ID, err)
+	}
+}
+
+// CreateIssueComment records the first response to an issue, a response is a comment of a user who can triage
+// the issue other than its poster
+func (n *slaNotifier) CreateIssueComment(ctx context.Context, doer *user_model.User, repo *repo_model.Repository,
+	issue *issues_model.Issue, comment *issues_model.Comment, mentions []*user_model.User,
+) {
+	if issue.IsPull || doer.ID == issue.PosterID {
+		return
+	}
+	perm, err := access_model.GetUserRepoPermission(ctx, repo, doer)
+	if err != nil {
+		log.Error("GetUserRepoPermission: %v", err)
+		return
+	}
+	if !perm.CanWriteIssuesOrPulls(false) {
+		return
+	}
+	if err := issues_model.SetIssueSLAResponded(ctx, issue.ID, timeutil.TimeStampNow()); err != nil {
+		log.Error("SetIssueSLAResponded [issue_id: %d]: %v", issue.ID, err)
+	}
+}
+
+func (n *slaNotifier) IssueChangeStatus(ctx context.Context, doer *user_model.User, commitID string, issue *issues_model.Issue, actionComment *issues_model.Comment, isClosed bool) {
+	if issue.IsPull {
+		return
+	}
+	if err := issues_model.SetIssueSLAResolved(ctx, issue.ID, util.Iif(isClosed, timeutil.TimeStampNow(), 0)); err != nil {
+		log.Error("SetIssueSLAResolved [issue_id: %d]: %v", issue.ID, err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package sla
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	org_model "code.gitea.io/gitea/models/organization"
+	repo_model "code.gitea.io/gitea/models/repo"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/container"
+	"code.gitea.io/gitea/modules/log"
+	"code.gitea.io/gitea/modules/timeutil"
+	notify_service "code.gitea.io/gitea/services/notify"
+
+	"xorm.io/builder"
+)
+
+// escalationBatchSize is the number of SLAs escalated by a query of the cron task
+const escalationBatchSize = 100
+
+// Init registers the notifier keeping the SLAs of the issues up to date
+func Init() error {
+	notify_service.RegisterNotifier(NewNotifier())
+	return nil
+}
+
+// UpdateIssueSLA applies the strictest SLA policy of the repository matching the issue, the SLA of the issue
+// is removed if no policy matches. The state of an SLA is kept while its policy and its deadlines don't change.
+func UpdateIssueSLA(ctx context.Context, issue *issues_model.Issue) error {
+	if issue.IsPull {
+		return nil
+	}
+	policies, err := issues_model.GetIssueSLAPoliciesByRepoID(ctx, issue.RepoID)
+	if err != nil {
+		return err
+	}
+	return updateIssueSLA(ctx, policies, issue)
+}
+
+func updateIssueSLA(ctx context.Context, policies issues_model.IssueSLAPolicyList, issue *issues_model.Issue) error {
+	if err := issue.LoadLabels(ctx); err != nil {
+		return err
+	}
+	policy := policies.Match(issue)
+	if policy == nil {
+		issue.SLA = nil
+		return issues_model.DeleteIssueSLA(ctx, issue.ID)
+	}
+
+	issue.SLA = nil
+	if err := issue.LoadSLA(ctx); err != nil {
+		return err
+	}
+	existing := issue.SLA
+
+	s := policy.NewIssueSLA(issue, timeutil.TimeStampNow())
+	if existing != nil {
+		s.FirstRespondedUnix = existing.FirstRespondedUnix
+		s.ResolvedUnix = existing.ResolvedUnix
+		if existing.PolicyID == s.PolicyID && existing.FirstResponseDueUnix == s.FirstResponseDueUnix &&
+			existing.ResolutionDueUnix == s.ResolutionDueUnix {
+			s.FirstResponseNotified = existing.FirstResponseNotified
+			s.ResolutionNotified = existing.ResolutionNotified
+		}
+	}
+	if issue.IsClosed && s.ResolvedUnix == 0 {
+		s.ResolvedUnix = issue.ClosedUnix
+		if s.FirstRespondedUnix == 0 {
+			s.FirstRespondedUnix = issue.ClosedUnix
+		}
+	}
+	if err := issues_model.SaveIssueSLA(ctx, s); err != nil {
+		return err
+	}
+	issue.SLA = s
+	return nil
+}
+
+// RefreshRepoIssueSLAs applies the SLA policies of a repository to all its issues, it has to be called when
+// the policies are changed
+func RefreshRepoIssueSLAs(ctx context.Context, repo *repo_model.Repository) error {
+	policies, err := issues_model.GetIssueSLAPoliciesByRepoID(ctx, repo.ID)
+	if err != nil {
+		return err
+	}
+	return db.Iterate(ctx, builder.Eq{"repo_id": repo.ID, "is_pull": false}, func(ctx context.Context, issue *issues_model.Issue) error {
+		return updateIssueSLA(ctx, policies, issue)
+	})
+}
+
+// CheckIssueSLAs escalates the SLA targets of the open issues which became at risk or breached since their last check
+func CheckIssueSLAs(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return db.ErrCancelledf("during escalation of issue SLAs")
+		default:
+		}
+
+		now := timeutil.TimeStampNow()
+		slas, err := issues_model.FindIssueSLAsToEscalate(ctx, now, escalationBatchSize)
+		if err != nil {
+			return fmt.Errorf("FindIssueSLAsToEscalate: %w", err)
+		}
+		for _, s := range slas {
+			if err := escalateIssueSLA(ctx, s, now); err != nil {
+				return fmt.Errorf("escalateIssueSLA [issue_id: %d]: %w", s.IssueID, err)
+			}
+		}
+		if len(slas) < escalationBatchSize {
+			return nil
+		}
+	}
+}
+
+func escalateIssueSLA(ctx context.Context, s *issues_model.IssueSLA, now timeutil.TimeStamp) error {
+	type escalation struct {
+		target issues_model.IssueSLATarget
+		status issues_model.IssueSLAStatus
+	}
+	var escalations []escalation
+	if status := s.FirstResponseStatusAt(now); isEscalated(status, s.FirstResponseNotified) {
+		escalations = append(escalations, escalation{issues_model.IssueSLATargetFirstResponse, status})
+		s.FirstResponseNotified = status
+	}
+	if status := s.ResolutionStatusAt(now); isEscalated(status, s.ResolutionNotified) {
+		escalations = append(escalations, escalation{issues_model.IssueSLATargetResolution, status})
+		s.ResolutionNotified = status
+	}
+	// the escalations are recorded first so that an error can't send them again
+	if err := issues_model.UpdateIssueSLANotified(ctx, s); err != nil {
+		return err
+	}
+	if len(escalations) == 0 {
+		return nil
+	}
+
+	issue, err := issues_model.GetIssueByID(ctx, s.IssueID)
+	if err != nil {
+		return err
+	}
+	if err := issue.LoadRepo(ctx); err != nil {
+		return err
+	}
+	if err := s.LoadPolicy(ctx); err != nil {
+		return err
+	}
+	issue.SLA = s
+
+	doer := issue.Repo.MustOwner(ctx)
+	for _, e := range escalations {
+		receivers, err := escalationReceivers(ctx, issue, s.Policy, e.status)
+		if err != nil {
+			return err
+		}
+		log.Trace("Escalating the %s SLA of issue %d: %s", e.target, issue.ID, e.status)
+		notify_service.IssueSLAEscalated(ctx, doer, issue, s, e.target, e.status, receivers)
+	}
+	return nil
+}
+
+// isEscalated returns whether the status of a target has to be escalated after the last escalated status
+func isEscalated(status, notified issues_model.IssueSLAStatus) bool {
+	return (status == issues_model.IssueSLAStatusAtRisk || status == issues_model.IssueSLAStatusBreached) && status > notified
+}
+
+// escalationReceivers returns the users to notify of an escalation: the assignees of the issue if the policy
+// escalates to them and the members of the escalation team when the target is breached
+func escalationReceivers(ctx context.Context, issue *issues_model.Issue, policy *issues_model.IssueSLAPolicy, status issues_model.IssueSLAStatus) ([]*user_model.User, error) {
+	var receivers []*user_model.User
+	seen := make(container.Set[int64])
+	add := func(users []*user_model.User) {
+		for _, u := range users {
+			if seen.Add(u.ID) {
+				receivers = append(receivers, u)
+			}
+		}
+	}
+	if policy.EscalateToAssignees {
+		if err := issue.LoadAssignees(ctx); err != nil {
+			return nil, err
+		}
+		add(issue.Assignees)
+	}
+	if status == issues_model.IssueSLAStatusBreached && policy.EscalationTeamID > 0 {
+		members, err := org_model.GetTeamMembers(ctx, &org_model.SearchMembersOptions{TeamID: policy.EscalationTeamID})
+		if err != nil {
+			return nil, err
+		}
+		add(members)
+	}
+	return receivers, nil
+}
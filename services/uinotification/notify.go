@@ -109,6 +109,18 @@ func (ns *notificationService) IssueChangeStatus(ctx context.Context, doer *user
 	})
 }
 
+func (ns *notificationService) IssueSLAEscalated(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, sla *issues_model.IssueSLA,
+	target issues_model.IssueSLATarget, status issues_model.IssueSLAStatus, receivers []*user_model.User,
+) {
+	for _, receiver := range receivers {
+		_ = ns.issueQueue.Push(issueNotificationOpts{
+			IssueID:              issue.ID,
+			NotificationAuthorID: doer.ID,
+			ReceiverID:           receiver.ID,
+		})
+	}
+}
+
 func (ns *notificationService) IssueChangeTitle(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, oldTitle string) {
 	if err := issue.LoadPullRequest(ctx); err != nil {
 		log.Error("issue.LoadPullRequest: %v", err)
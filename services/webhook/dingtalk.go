@@ -188,6 +188,18 @@ func (dingtalkConvertor) WorkflowJob(p *api.WorkflowJobPayload) (DingtalkPayload
 	return createDingtalkPayload(text, text, "Workflow Job", p.WorkflowJob.HTMLURL), nil
 }
 
+func (dingtalkConvertor) Project(p *api.ProjectPayload) (DingtalkPayload, error) {
+	text, _ := getProjectPayloadInfo(p, noneLinkFormatter, true)
+
+	return createDingtalkPayload(text, text, "view project", p.Project.HTMLURL), nil
+}
+
+func (dingtalkConvertor) ProjectCard(p *api.ProjectCardPayload) (DingtalkPayload, error) {
+	text, _ := getProjectCardPayloadInfo(p, noneLinkFormatter, true)
+
+	return createDingtalkPayload(text, text, "view issue", p.Issue.HTMLURL), nil
+}
+
 func createDingtalkPayload(title, text, singleTitle, singleURL string) DingtalkPayload {
 	return DingtalkPayload{
 		MsgType: "actionCard",
@@ -290,6 +290,18 @@ func (d discordConvertor) WorkflowJob(p *api.WorkflowJobPayload) (DiscordPayload
 	return d.createPayload(p.Sender, text, "", p.WorkflowJob.HTMLURL, color), nil
 }
 
+func (d discordConvertor) Project(p *api.ProjectPayload) (DiscordPayload, error) {
+	text, color := getProjectPayloadInfo(p, noneLinkFormatter, false)
+
+	return d.createPayload(p.Sender, text, "", p.Project.HTMLURL, color), nil
+}
+
+func (d discordConvertor) ProjectCard(p *api.ProjectCardPayload) (DiscordPayload, error) {
+	text, color := getProjectCardPayloadInfo(p, noneLinkFormatter, false)
+
+	return d.createPayload(p.Sender, text, "", p.Issue.HTMLURL, color), nil
+}
+
 func newDiscordRequest(_ context.Context, w *webhook_model.Webhook, t *webhook_model.HookTask) (*http.Request, []byte, error) {
 	meta := &DiscordMeta{}
 	if err := json.Unmarshal([]byte(w.Meta), meta); err != nil {
@@ -191,6 +191,18 @@ func (feishuConvertor) WorkflowJob(p *api.WorkflowJobPayload) (FeishuPayload, er
 	return newFeishuTextPayload(text), nil
 }
 
+func (feishuConvertor) Project(p *api.ProjectPayload) (FeishuPayload, error) {
+	text, _ := getProjectPayloadInfo(p, noneLinkFormatter, true)
+
+	return newFeishuTextPayload(text), nil
+}
+
+func (feishuConvertor) ProjectCard(p *api.ProjectCardPayload) (FeishuPayload, error) {
+	text, _ := getProjectCardPayloadInfo(p, noneLinkFormatter, true)
+
+	return newFeishuTextPayload(text), nil
+}
+
 // feishuGenSign generates a signature for Feishu webhook
 // https://open.feishu.cn/document/client-docs/bot-v3/add-custom-bot
 func feishuGenSign(secret string, timestamp int64) string {
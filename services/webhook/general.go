@@ -133,6 +133,12 @@ func getIssuesPayloadInfo(p *api.IssuePayload, linkFormatter linkFormatter, with
 			linkFormatter(mileStoneLink, p.Issue.Milestone.Title), titleLink)
 	case api.HookIssueDemilestoned:
 		text = fmt.Sprintf("[%s] Issue milestone cleared: %s", repoLink, titleLink)
+	case api.HookIssueSLAAtRisk:
+		text = fmt.Sprintf("[%s] Issue %s SLA at risk: %s", repoLink, strings.ReplaceAll(p.SLA.Target, "_", " "), titleLink)
+		color = orangeColor
+	case api.HookIssueSLABreached:
+		text = fmt.Sprintf("[%s] Issue %s SLA breached: %s", repoLink, strings.ReplaceAll(p.SLA.Target, "_", " "), titleLink)
+		color = redColor
 	}
 	if withSender {
 		text += " by " + linkFormatter(setting.AppURL+url.PathEscape(p.Sender.UserName), p.Sender.UserName)
@@ -422,3 +428,52 @@ func ToHook(repoLink string, w *webhook_model.Webhook) (*api.Hook, error) {
 		BranchFilter:        w.BranchFilter,
 	}, nil
 }
+
+func getProjectPayloadInfo(p *api.ProjectPayload, linkFormatter linkFormatter, withSender bool) (text string, color int) {
+	refLink := linkFormatter(p.Project.HTMLURL, p.Project.Title)
+
+	switch p.Action {
+	case api.HookProjectCreated:
+		text = "Project created: " + refLink
+		color = greenColor
+	case api.HookProjectEdited:
+		text = "Project edited: " + refLink
+		color = yellowColor
+	case api.HookProjectClosed:
+		text = "Project closed: " + refLink
+		color = redColor
+	case api.HookProjectReopened:
+		text = "Project reopened: " + refLink
+		color = greenColor
+	case api.HookProjectDeleted:
+		text = "Project deleted: " + refLink
+		color = redColor
+	}
+	if withSender {
+		text += " by " + linkFormatter(setting.AppURL+url.PathEscape(p.Sender.UserName), p.Sender.UserName)
+	}
+
+	return text, color
+}
+
+func getProjectCardPayloadInfo(p *api.ProjectCardPayload, linkFormatter linkFormatter, withSender bool) (text string, color int) {
+	issueLink := linkFormatter(p.Issue.HTMLURL, fmt.Sprintf("%s#%d %s", p.Repository.FullName, p.Issue.Index, p.Issue.Title))
+	projectLink := linkFormatter(p.Project.HTMLURL, p.Project.Title)
+
+	switch p.Action {
+	case api.HookProjectCardCreated:
+		text = fmt.Sprintf("Issue %s added to project %s", issueLink, projectLink)
+		color = greenColor
+	case api.HookProjectCardMoved:
+		text = fmt.Sprintf("Issue %s moved from %s to %s in project %s", issueLink, p.OldColumn.Title, p.Column.Title, projectLink)
+		color = yellowColor
+	case api.HookProjectCardDeleted:
+		text = fmt.Sprintf("Issue %s removed from project %s", issueLink, projectLink)
+		color = redColor
+	}
+	if withSender {
+		text += " by " + linkFormatter(setting.AppURL+url.PathEscape(p.Sender.UserName), p.Sender.UserName)
+	}
+
+	return text, color
+}
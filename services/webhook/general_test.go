@@ -341,6 +341,41 @@ func packageTestPayload() *api.PackagePayload {
 	}
 }
 
+func projectCardTestPayload() *api.ProjectCardPayload {
+	return &api.ProjectCardPayload{
+		Action: api.HookProjectCardMoved,
+		Sender: &api.User{
+			UserName:  "user1",
+			AvatarURL: "http://localhost:3000/user1/avatar",
+		},
+		Repository: &api.Repository{
+			HTMLURL:  "http://localhost:3000/test/repo",
+			Name:     "repo",
+			FullName: "test/repo",
+		},
+		Project: &api.Project{
+			ID:      1,
+			Title:   "Roadmap",
+			HTMLURL: "http://localhost:3000/test/repo/projects/1",
+		},
+		Column: &api.ProjectColumn{
+			ID:    2,
+			Title: "In Progress",
+		},
+		OldColumn: &api.ProjectColumn{
+			ID:    1,
+			Title: "To Do",
+		},
+		Issue: &api.Issue{
+			ID:      2,
+			Index:   2,
+			URL:     "http://localhost:3000/api/v1/repos/test/repo/issues/2",
+			HTMLURL: "http://localhost:3000/test/repo/issues/2",
+			Title:   "crash",
+		},
+	}
+}
+
 func TestGetIssuesPayloadInfo(t *testing.T) {
 	p := issueTestPayload()
 
@@ -647,3 +682,36 @@ func TestGetIssueCommentPayloadInfo(t *testing.T) {
 		assert.Equal(t, c.color, color, "case %d", i)
 	}
 }
+
+func TestGetProjectCardPayloadInfo(t *testing.T) {
+	p := projectCardTestPayload()
+
+	cases := []struct {
+		action api.HookProjectCardAction
+		text   string
+		color  int
+	}{
+		{
+			api.HookProjectCardCreated,
+			"Issue test/repo#2 crash added to project Roadmap by user1",
+			greenColor,
+		},
+		{
+			api.HookProjectCardMoved,
+			"Issue test/repo#2 crash moved from To Do to In Progress in project Roadmap by user1",
+			yellowColor,
+		},
+		{
+			api.HookProjectCardDeleted,
+			"Issue test/repo#2 crash removed from project Roadmap by user1",
+			redColor,
+		},
+	}
+
+	for i, c := range cases {
+		p.Action = c.action
+		text, color := getProjectCardPayloadInfo(p, noneLinkFormatter, true)
+		assert.Equal(t, c.text, text, "case %d", i)
+		assert.Equal(t, c.color, color, "case %d", i)
+	}
+}
@@ -265,6 +265,18 @@ func (m matrixConvertor) WorkflowJob(p *api.WorkflowJobPayload) (MatrixPayload,
 	return m.newPayload(text)
 }
 
+func (m matrixConvertor) Project(p *api.ProjectPayload) (MatrixPayload, error) {
+	text, _ := getProjectPayloadInfo(p, htmlLinkFormatter, true)
+
+	return m.newPayload(text)
+}
+
+func (m matrixConvertor) ProjectCard(p *api.ProjectCardPayload) (MatrixPayload, error) {
+	text, _ := getProjectCardPayloadInfo(p, htmlLinkFormatter, true)
+
+	return m.newPayload(text)
+}
+
 var urlRegex = regexp.MustCompile(`<a [^>]*?href="([^">]*?)">(.*?)</a>`)
 
 func getMessageBody(htmlText string) string {
@@ -346,6 +346,34 @@ func (msteamsConvertor) WorkflowJob(p *api.WorkflowJobPayload) (MSTeamsPayload,
 	), nil
 }
 
+func (msteamsConvertor) Project(p *api.ProjectPayload) (MSTeamsPayload, error) {
+	title, color := getProjectPayloadInfo(p, noneLinkFormatter, false)
+
+	return createMSTeamsPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Project.HTMLURL,
+		color,
+		&MSTeamsFact{"Project:", p.Project.Title},
+	), nil
+}
+
+func (msteamsConvertor) ProjectCard(p *api.ProjectCardPayload) (MSTeamsPayload, error) {
+	title, color := getProjectCardPayloadInfo(p, noneLinkFormatter, false)
+
+	return createMSTeamsPayload(
+		p.Repository,
+		p.Sender,
+		title,
+		"",
+		p.Issue.HTMLURL,
+		color,
+		&MSTeamsFact{"Project:", p.Project.Title},
+	), nil
+}
+
 func createMSTeamsPayload(r *api.Repository, s *api.User, title, text, actionTarget string, color int, fact *MSTeamsFact) MSTeamsPayload {
 	facts := make([]MSTeamsFact, 0, 2)
 	if r != nil {
@@ -13,6 +13,7 @@ import (
 	packages_model "code.gitea.io/gitea/models/packages"
 	"code.gitea.io/gitea/models/perm"
 	access_model "code.gitea.io/gitea/models/perm/access"
+	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	user_model "code.gitea.io/gitea/models/user"
 	"code.gitea.io/gitea/modules/git"
@@ -22,9 +23,11 @@ import (
 	"code.gitea.io/gitea/modules/repository"
 	"code.gitea.io/gitea/modules/setting"
 	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
 	webhook_module "code.gitea.io/gitea/modules/webhook"
 	"code.gitea.io/gitea/services/convert"
 	notify_service "code.gitea.io/gitea/services/notify"
+	project_service "code.gitea.io/gitea/services/projects"
 )
 
 func init() {
@@ -641,6 +644,37 @@ func (m *webhookNotifier) IssueChangeMilestone(ctx context.Context, doer *user_m
 	}
 }
 
+func (m *webhookNotifier) IssueSLAEscalated(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, sla *issues_model.IssueSLA,
+	target issues_model.IssueSLATarget, status issues_model.IssueSLAStatus, receivers []*user_model.User,
+) {
+	if err := issue.LoadAttributes(ctx); err != nil {
+		log.Error("issue.LoadAttributes failed: %v", err)
+		return
+	}
+	if err := sla.LoadPolicy(ctx); err != nil {
+		log.Error("LoadPolicy: %v", err)
+		return
+	}
+
+	due := util.Iif(target == issues_model.IssueSLATargetFirstResponse, sla.FirstResponseDueUnix, sla.ResolutionDueUnix)
+	permission, _ := access_model.GetUserRepoPermission(ctx, issue.Repo, doer)
+	if err := PrepareWebhooks(ctx, EventSource{Repository: issue.Repo}, webhook_module.HookEventIssues, &api.IssuePayload{
+		Action:     util.Iif(status == issues_model.IssueSLAStatusBreached, api.HookIssueSLABreached, api.HookIssueSLAAtRisk),
+		Index:      issue.Index,
+		Issue:      convert.ToAPIIssue(ctx, doer, issue),
+		Repository: convert.ToRepo(ctx, issue.Repo, permission),
+		Sender:     convert.ToUser(ctx, doer, nil),
+		SLA: &api.IssueSLA{
+			Policy: sla.Policy.Name,
+			Target: string(target),
+			Status: status.String(),
+			Due:    due.AsTime(),
+		},
+	}); err != nil {
+		log.Error("PrepareWebhooks: %v", err)
+	}
+}
+
 func (m *webhookNotifier) PushCommits(ctx context.Context, pusher *user_model.User, repo *repo_model.Repository, opts *repository.PushUpdateOptions, commits *repository.PushCommits) {
 	apiPusher := convert.ToUser(ctx, pusher, nil)
 	apiCommits, apiHeadCommit, err := commits.ToAPIPayloadCommits(ctx, repo)
@@ -982,6 +1016,92 @@ func notifyPackage(ctx context.Context, sender *user_model.User, pd *packages_mo
 	}
 }
 
+func (m *webhookNotifier) NewProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	notifyProject(ctx, doer, project, api.HookProjectCreated)
+}
+
+func (m *webhookNotifier) UpdateProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	notifyProject(ctx, doer, project, api.HookProjectEdited)
+}
+
+func (m *webhookNotifier) ChangeProjectStatus(ctx context.Context, doer *user_model.User, project *project_model.Project, isClosed bool) {
+	notifyProject(ctx, doer, project, util.Iif(isClosed, api.HookProjectClosed, api.HookProjectReopened))
+}
+
+func (m *webhookNotifier) DeleteProject(ctx context.Context, doer *user_model.User, project *project_model.Project) {
+	notifyProject(ctx, doer, project, api.HookProjectDeleted)
+}
+
+func notifyProject(ctx context.Context, doer *user_model.User, project *project_model.Project, action api.HookProjectAction) {
+	payload := &api.ProjectPayload{
+		Action: action,
+		Sender: convert.ToUser(ctx, doer, nil),
+	}
+
+	var source EventSource
+	if project.RepoID > 0 {
+		if err := project.LoadRepo(ctx); err != nil {
+			log.Error("LoadRepo: %v", err)
+			return
+		}
+		source.Repository = project.Repo
+		payload.Repository = convert.ToRepo(ctx, project.Repo, access_model.Permission{AccessMode: perm.AccessModeOwner})
+	} else {
+		if err := project.LoadOwner(ctx); err != nil {
+			log.Error("LoadOwner: %v", err)
+			return
+		}
+		source.Owner = project.Owner
+		if project.Owner.IsOrganization() {
+			payload.Organization = convert.ToOrganization(ctx, organization.OrgFromUser(project.Owner))
+		}
+	}
+
+	if action != api.HookProjectDeleted {
+		if err := project_service.LoadIssueNumbersForProject(ctx, project, nil); err != nil {
+			log.Error("LoadIssueNumbersForProject: %v", err)
+			return
+		}
+	}
+	payload.Project = convert.ToAPIProject(ctx, project, nil)
+
+	if err := PrepareWebhooks(ctx, source, webhook_module.HookEventProject, payload); err != nil {
+		log.Error("PrepareWebhooks [project_id: %d]: %v", project.ID, err)
+	}
+}
+
+func (m *webhookNotifier) IssueChangeProjectColumn(ctx context.Context, doer *user_model.User, issue *issues_model.Issue, project *project_model.Project, oldColumn, newColumn *project_model.Column) {
+	if err := issue.LoadRepo(ctx); err != nil {
+		log.Error("LoadRepo: %v", err)
+		return
+	}
+
+	payload := &api.ProjectCardPayload{
+		Project:    convert.ToAPIProject(ctx, project, nil),
+		Issue:      convert.ToAPIIssue(ctx, doer, issue),
+		Repository: convert.ToRepo(ctx, issue.Repo, access_model.Permission{AccessMode: perm.AccessModeOwner}),
+		Sender:     convert.ToUser(ctx, doer, nil),
+	}
+	switch {
+	case oldColumn == nil:
+		payload.Action = api.HookProjectCardCreated
+	case newColumn == nil:
+		payload.Action = api.HookProjectCardDeleted
+	default:
+		payload.Action = api.HookProjectCardMoved
+	}
+	if newColumn != nil {
+		payload.Column = convert.ToAPIProjectColumn(newColumn)
+	}
+	if oldColumn != nil {
+		payload.OldColumn = convert.ToAPIProjectColumn(oldColumn)
+	}
+
+	if err := PrepareWebhooks(ctx, EventSource{Repository: issue.Repo}, webhook_module.HookEventProjectCard, payload); err != nil {
+		log.Error("PrepareWebhooks [issue_id: %d, project_id: %d]: %v", issue.ID, project.ID, err)
+	}
+}
+
 func (*webhookNotifier) WorkflowJobStatusUpdate(ctx context.Context, repo *repo_model.Repository, sender *user_model.User, job *actions_model.ActionRunJob, task *actions_model.ActionTask) {
 	source := EventSource{
 		Repository: repo,
@@ -31,6 +31,8 @@ type payloadConvertor[T any] interface {
 	Status(*api.CommitStatusPayload) (T, error)
 	WorkflowRun(*api.WorkflowRunPayload) (T, error)
 	WorkflowJob(*api.WorkflowJobPayload) (T, error)
+	Project(*api.ProjectPayload) (T, error)
+	ProjectCard(*api.ProjectCardPayload) (T, error)
 }
 
 func convertUnmarshalledJSON[T, P any](convert func(P) (T, error), data []byte) (t T, err error) {
@@ -86,6 +88,10 @@ func newPayload[T any](rc payloadConvertor[T], data []byte, event webhook_module
 		return convertUnmarshalledJSON(rc.WorkflowRun, data)
 	case webhook_module.HookEventWorkflowJob:
 		return convertUnmarshalledJSON(rc.WorkflowJob, data)
+	case webhook_module.HookEventProject:
+		return convertUnmarshalledJSON(rc.Project, data)
+	case webhook_module.HookEventProjectCard:
+		return convertUnmarshalledJSON(rc.ProjectCard, data)
 	}
 	return t, fmt.Errorf("newPayload unsupported event: %s", event)
 }
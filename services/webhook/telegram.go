@@ -192,6 +192,18 @@ func (telegramConvertor) WorkflowJob(p *api.WorkflowJobPayload) (TelegramPayload
 	return createTelegramPayloadHTML(text), nil
 }
 
+func (telegramConvertor) Project(p *api.ProjectPayload) (TelegramPayload, error) {
+	text, _ := getProjectPayloadInfo(p, htmlLinkFormatter, true)
+
+	return createTelegramPayloadHTML(text), nil
+}
+
+func (telegramConvertor) ProjectCard(p *api.ProjectCardPayload) (TelegramPayload, error) {
+	text, _ := getProjectCardPayloadInfo(p, htmlLinkFormatter, true)
+
+	return createTelegramPayloadHTML(text), nil
+}
+
 func createTelegramPayloadHTML(msgHTML string) TelegramPayload {
 	// https://core.telegram.org/bots/api#formatting-options
 	return TelegramPayload{
@@ -0,0 +1,105 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	actions_web "code.gitea.io/gitea/routers/web/repo/actions"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestActionsAnnotations(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		session := loginUser(t, user2.Name)
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+
+		apiRepo := createActionsTestRepo(t, token, "actions-annotations", false)
+		runner := newMockRunner()
+		runner.registerAsRepoRunner(t, user2.Name, apiRepo.Name, "mock-runner", []string{"ubuntu-latest"}, false)
+
+		wfContent := `name: lint
+on: push
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+`
+		opts := getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "create workflow", wfContent)
+		createWorkflowFile(t, token, user2.Name, apiRepo.Name, ".gitea/workflows/lint.yml", opts)
+		runner.execTask(t, runner.fetchTask(t), &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+
+		// push a commit to a new branch, the lint job reports an annotation on the new file
+		opts = getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "add app.go", "package main\n\nvar a = x\n")
+		opts.NewBranchName = "feature"
+		fileResp := createWorkflowFile(t, token, user2.Name, apiRepo.Name, "app.go", opts)
+		task := runner.fetchTask(t)
+		now := time.Now()
+		runner.execTask(t, task, &mockTaskOutcome{
+			result: runnerv1.Result_RESULT_FAILURE,
+			logRows: []*runnerv1.LogRow{
+				{Time: timestamppb.New(now), Content: "make lint"},
+				{Time: timestamppb.New(now), Content: "::error file=app.go,line=3,title=typecheck::undefined: x"},
+				{Time: timestamppb.New(now), Content: "::error file=app.go,line=3,title=typecheck::undefined: x"},
+				{Time: timestamppb.New(now), Content: "::warning::the linter is outdated"},
+				{Time: timestamppb.New(now), Content: "::step-summary::## Lint report%0A%0A**1** issue found"},
+			},
+		})
+
+		unittest.AssertCount(t, &actions_model.ActionTaskAnnotation{TaskID: task.Id}, 2)
+		annotation := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionTaskAnnotation{TaskID: task.Id, Level: actions_model.AnnotationLevelError})
+		assert.Equal(t, fileResp.Commit.SHA, annotation.CommitSHA)
+		assert.EqualValues(t, 1, annotation.LogIndex)
+
+		t.Run("RunView", func(t *testing.T) {
+			runIndex := task.Context.GetFields()["run_number"].GetStringValue()
+			req := NewRequest(t, "POST", fmt.Sprintf("/%s/%s/actions/runs/%s/jobs/0", user2.Name, apiRepo.Name, runIndex))
+			resp := session.MakeRequest(t, req, http.StatusOK)
+			var view actions_web.ViewResponse
+			DecodeJSON(t, resp, &view)
+
+			annotations := view.State.CurrentJob.Annotations
+			require.Len(t, annotations, 2)
+			assert.Equal(t, "error", annotations[0].Level)
+			assert.Equal(t, "typecheck", annotations[0].Title)
+			assert.Equal(t, "undefined: x", annotations[0].Message)
+			assert.Equal(t, fmt.Sprintf("/%s/%s/src/commit/%s/app.go#L3", user2.Name, apiRepo.Name, fileResp.Commit.SHA), annotations[0].Link)
+			assert.Equal(t, "warning", annotations[1].Level)
+			assert.Empty(t, annotations[1].Link)
+
+			summaries := view.State.CurrentJob.Summaries
+			require.Len(t, summaries, 1)
+			assert.Contains(t, string(summaries[0].ContentHTML), "Lint report</h2>")
+			assert.Contains(t, string(summaries[0].ContentHTML), "<strong>1</strong> issue found")
+		})
+
+		t.Run("PullDiff", func(t *testing.T) {
+			ctx := NewAPITestContext(t, user2.Name, apiRepo.Name, auth_model.AccessTokenScopeWriteRepository)
+			pr, err := doAPICreatePullRequest(ctx, user2.Name, apiRepo.Name, apiRepo.DefaultBranch, "feature")(t)
+			require.NoError(t, err)
+
+			req := NewRequest(t, "GET", fmt.Sprintf("/%s/%s/pulls/%d/files", user2.Name, apiRepo.Name, pr.Index))
+			resp := session.MakeRequest(t, req, http.StatusOK)
+			htmlDoc := NewHTMLParser(t, resp.Body)
+			assert.Equal(t, 1, htmlDoc.doc.Find(".diff-annotation-error").Length())
+			assert.Contains(t, htmlDoc.doc.Find(".diff-annotation-error").Text(), "undefined: x")
+			// the annotation without a file isn't shown in the diff
+			assert.Equal(t, 0, htmlDoc.doc.Find(".diff-annotation-warning").Length())
+		})
+	})
+}
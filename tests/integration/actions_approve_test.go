@@ -13,6 +13,7 @@ import (
 
 	actions_model "code.gitea.io/gitea/models/actions"
 	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/db"
 	repo_model "code.gitea.io/gitea/models/repo"
 	"code.gitea.io/gitea/models/unittest"
 	user_model "code.gitea.io/gitea/models/user"
@@ -20,6 +21,7 @@ import (
 	"code.gitea.io/gitea/modules/util"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestApproveAllRunsOnPullRequestPage(t *testing.T) {
@@ -141,3 +143,135 @@ jobs:
 		assert.Equal(t, actions_model.StatusWaiting, run2.Status)
 	})
 }
+
+func TestActionsApprovalPolicy(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		user2Session := loginUser(t, user2.Name)
+		user2Token := getTokenForLoggedInUser(t, user2Session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+		user4 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 4})
+		user4Token := getTokenForLoggedInUser(t, loginUser(t, user4.Name), auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+
+		apiBaseRepo := createActionsTestRepo(t, user2Token, "approval-policy", false)
+		baseRepo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: apiBaseRepo.ID})
+		user2APICtx := NewAPITestContext(t, baseRepo.OwnerName, baseRepo.Name, auth_model.AccessTokenScopeWriteRepository)
+		defer doAPIDeleteRepository(user2APICtx)(t)
+
+		wfTreePath := ".gitea/workflows/pull.yml"
+		wfFileContent := `name: Pull
+on: pull_request
+jobs:
+  unit-test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo unit-test
+`
+		opts := getWorkflowCreateFileOptions(user2, baseRepo.DefaultBranch, "create "+wfTreePath, wfFileContent)
+		createWorkflowFile(t, user2Token, baseRepo.OwnerName, baseRepo.Name, wfTreePath, opts)
+
+		req := NewRequestWithJSON(t, "POST", fmt.Sprintf("/api/v1/repos/%s/%s/forks", baseRepo.OwnerName, baseRepo.Name),
+			&api.CreateForkOption{
+				Name: util.ToPointer("approval-policy-fork"),
+			}).AddTokenAuth(user4Token)
+		resp := MakeRequest(t, req, http.StatusAccepted)
+		var apiForkRepo api.Repository
+		DecodeJSON(t, resp, &apiForkRepo)
+		user4APICtx := NewAPITestContext(t, user4.Name, apiForkRepo.Name, auth_model.AccessTokenScopeWriteRepository)
+		defer doAPIDeleteRepository(user4APICtx)(t)
+
+		// every pull request of user4 triggers a new run
+		createPullRun := func(t *testing.T, branch string) *actions_model.ActionRun {
+			doAPICreateFile(user4APICtx, branch+".txt", &api.CreateFileOptions{
+				FileOptions: api.FileOptions{
+					NewBranchName: branch,
+					Message:       "create " + branch + ".txt",
+				},
+				ContentBase64: base64.StdEncoding.EncodeToString([]byte(branch)),
+			})(t)
+			_, err := doAPICreatePullRequest(user4APICtx, baseRepo.OwnerName, baseRepo.Name, baseRepo.DefaultBranch, user4.Name+":"+branch)(t)
+			require.NoError(t, err)
+
+			runs, err := db.Find[actions_model.ActionRun](t.Context(), actions_model.FindRunOptions{RepoID: baseRepo.ID, TriggerUserID: user4.ID})
+			require.NoError(t, err)
+			require.NotEmpty(t, runs)
+			return runs[0]
+		}
+		runAPIURL := func(run *actions_model.ActionRun, action string) string {
+			return fmt.Sprintf("/api/v1/repos/%s/%s/actions/runs/%d/%s", baseRepo.OwnerName, baseRepo.Name, run.ID, action)
+		}
+
+		t.Run("Reject", func(t *testing.T) {
+			run := createPullRun(t, "reject")
+			assert.True(t, run.NeedApproval)
+
+			// the run is listed as awaiting approval
+			req := NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/actions/runs?status=action_required", baseRepo.OwnerName, baseRepo.Name)).AddTokenAuth(user2Token)
+			resp := MakeRequest(t, req, http.StatusOK)
+			var runs api.ActionWorkflowRunsResponse
+			DecodeJSON(t, resp, &runs)
+			require.Len(t, runs.Entries, 1)
+			assert.Equal(t, run.ID, runs.Entries[0].ID)
+			assert.Equal(t, "action_required", runs.Entries[0].Conclusion)
+			resp = user2Session.MakeRequest(t, NewRequest(t, "GET", baseRepo.Link()+"/actions?need_approval=true"), http.StatusOK)
+			assert.Equal(t, 1, NewHTMLParser(t, resp.Body).Find(".run-list .flex-item").Length())
+
+			// user4 can't approve or reject the run
+			MakeRequest(t, NewRequest(t, "POST", runAPIURL(run, "reject")).AddTokenAuth(user4Token), http.StatusForbidden)
+
+			MakeRequest(t, NewRequest(t, "POST", runAPIURL(run, "reject")).AddTokenAuth(user2Token), http.StatusNoContent)
+			run = unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRun{ID: run.ID})
+			assert.False(t, run.NeedApproval)
+			assert.Zero(t, run.ApprovedBy)
+			assert.Equal(t, actions_model.StatusCancelled, run.Status)
+			unittest.AssertNotExistsBean(t, &actions_model.ActionApprovedContributor{RepoID: baseRepo.ID, UserID: user4.ID})
+
+			// the run isn't awaiting approval anymore
+			MakeRequest(t, NewRequest(t, "POST", runAPIURL(run, "approve")).AddTokenAuth(user2Token), http.StatusBadRequest)
+		})
+
+		t.Run("FirstTimeContributors", func(t *testing.T) {
+			// the rejection isn't remembered, the next run still needs approval
+			run := createPullRun(t, "first-time")
+			assert.True(t, run.NeedApproval)
+
+			MakeRequest(t, NewRequest(t, "POST", runAPIURL(run, "approve")).AddTokenAuth(user2Token), http.StatusNoContent)
+			run = unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRun{ID: run.ID})
+			assert.False(t, run.NeedApproval)
+			assert.Equal(t, user2.ID, run.ApprovedBy)
+			assert.Equal(t, actions_model.StatusWaiting, run.Status)
+			unittest.AssertExistsAndLoadBean(t, &actions_model.ActionApprovedContributor{RepoID: baseRepo.ID, UserID: user4.ID, ApprovedBy: user2.ID})
+
+			// the approval is remembered
+			run = createPullRun(t, "approved")
+			assert.False(t, run.NeedApproval)
+		})
+
+		t.Run("Forks", func(t *testing.T) {
+			req := NewRequestWithValues(t, "POST", baseRepo.Link()+"/settings/actions/general/approval_policy", map[string]string{
+				"approval_policy": string(repo_model.ActionsApprovalForks),
+			})
+			user2Session.MakeRequest(t, req, http.StatusSeeOther)
+			req = NewRequestWithValues(t, "POST", baseRepo.Link()+"/settings/actions/general/approval_policy", map[string]string{
+				"approval_policy": "unknown",
+			})
+			user2Session.MakeRequest(t, req, http.StatusBadRequest)
+
+			// every run of a fork pull request needs approval even if the contributor has been approved
+			run := createPullRun(t, "forks")
+			assert.True(t, run.NeedApproval)
+		})
+
+		t.Run("RemoveApprovedContributor", func(t *testing.T) {
+			resp := user2Session.MakeRequest(t, NewRequest(t, "GET", baseRepo.Link()+"/settings/actions/general"), http.StatusOK)
+			htmlDoc := NewHTMLParser(t, resp.Body)
+			checked, _ := htmlDoc.Find(`input[name="approval_policy"][checked]`).Attr("value")
+			assert.Equal(t, string(repo_model.ActionsApprovalForks), checked)
+			deleteURL, exists := htmlDoc.Find(".flex-item-trailing .link-action").Last().Attr("data-url")
+			require.True(t, exists)
+
+			user2Session.MakeRequest(t, NewRequest(t, "POST", deleteURL), http.StatusOK)
+			unittest.AssertNotExistsBean(t, &actions_model.ActionApprovedContributor{RepoID: baseRepo.ID, UserID: user4.ID})
+			user2Session.MakeRequest(t, NewRequest(t, "POST", deleteURL), http.StatusNotFound)
+		})
+	})
+}
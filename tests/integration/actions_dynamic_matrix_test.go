@@ -0,0 +1,170 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/url"
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsDynamicMatrix(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		session := loginUser(t, user2.Name)
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+
+		apiRepo := createActionsTestRepo(t, token, "actions-dynamic-matrix", false)
+		runner := newMockRunner()
+		runner.registerAsRepoRunner(t, user2.Name, apiRepo.Name, "mock-runner", []string{"ubuntu-latest"}, false)
+
+		workflowContent := func(treePath, strategy string) string {
+			return `name: dynamic-matrix
+on:
+  push:
+    paths:
+      - '` + treePath + `'
+jobs:
+  setup:
+    runs-on: ubuntu-latest
+    outputs:
+      matrix: ${{ steps.gen.outputs.matrix }}
+    steps:
+      - id: gen
+        run: echo "matrix=..." >> "$GITHUB_OUTPUT"
+  build:
+    runs-on: ${{ matrix.os }}
+    needs: setup
+    strategy:
+` + strategy + `
+      matrix: ${{ fromJSON(needs.setup.outputs.matrix) }}
+    steps:
+      - run: echo ${{ matrix.version }}
+  report:
+    runs-on: ubuntu-latest
+    needs: build
+    steps:
+      - run: echo '${{ toJSON(needs.build.outputs) }}'
+`
+		}
+
+		runSetup := func(t *testing.T, treePath, strategy, matrix string) *actions_model.ActionRun {
+			opts := getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "create "+treePath, workflowContent(treePath, strategy))
+			createWorkflowFile(t, token, user2.Name, apiRepo.Name, treePath, opts)
+
+			task := runner.fetchTask(t)
+			_, job, run := getTaskAndJobAndRunByTaskID(t, task.Id)
+			assert.Equal(t, "setup", job.JobID)
+
+			// the placeholder of the matrix is blocked until the setup job is done
+			build := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{RunID: run.ID, JobID: "build"})
+			assert.Equal(t, actions_model.DynamicMatrixPending, build.DynamicMatrix)
+			assert.Equal(t, actions_model.StatusBlocked, build.Status)
+
+			runner.execTask(t, task, &mockTaskOutcome{
+				result:  runnerv1.Result_RESULT_SUCCESS,
+				outputs: map[string]string{"matrix": matrix},
+			})
+			return run
+		}
+
+		getBuildJobs := func(t *testing.T, run *actions_model.ActionRun) map[string]*actions_model.ActionRunJob {
+			jobs, err := actions_model.GetRunJobsByRunID(t.Context(), run.ID)
+			require.NoError(t, err)
+			buildJobs := make(map[string]*actions_model.ActionRunJob)
+			for _, job := range jobs {
+				if job.JobID == "build" {
+					buildJobs[job.Name] = job
+				}
+			}
+			return buildJobs
+		}
+
+		t.Run("MaxParallel", func(t *testing.T) {
+			run := runSetup(t, ".gitea/workflows/dynamic-matrix-max-parallel.yml", "      max-parallel: 2",
+				`{"os":["ubuntu-latest"],"version":[1,2,3]}`)
+
+			buildJobs := getBuildJobs(t, run)
+			require.Len(t, buildJobs, 3)
+			for _, name := range []string{"build (ubuntu-latest, 1)", "build (ubuntu-latest, 2)", "build (ubuntu-latest, 3)"} {
+				require.Contains(t, buildJobs, name)
+				assert.Equal(t, actions_model.DynamicMatrixExpanded, buildJobs[name].DynamicMatrix)
+				assert.Equal(t, []string{"ubuntu-latest"}, buildJobs[name].RunsOn)
+			}
+
+			// only 2 jobs of the matrix can run at the same time
+			task1 := runner.fetchTask(t)
+			task2 := runner.fetchTask(t)
+			runner.fetchNoTask(t)
+			assert.Equal(t, "build (ubuntu-latest, 1)", getTaskJobNameByTaskID(t, token, user2.Name, apiRepo.Name, task1.Id))
+			assert.Equal(t, "build (ubuntu-latest, 2)", getTaskJobNameByTaskID(t, token, user2.Name, apiRepo.Name, task2.Id))
+
+			runner.execTask(t, task1, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+			task3 := runner.fetchTask(t)
+			assert.Equal(t, "build (ubuntu-latest, 3)", getTaskJobNameByTaskID(t, token, user2.Name, apiRepo.Name, task3.Id))
+			runner.execTask(t, task2, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+			runner.execTask(t, task3, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+
+			// the job needing the matrix runs when all the jobs of the matrix are done
+			task := runner.fetchTask(t)
+			assert.Equal(t, "report", getTaskJobNameByTaskID(t, token, user2.Name, apiRepo.Name, task.Id))
+			assert.Equal(t, runnerv1.Result_RESULT_SUCCESS, task.Needs["build"].Result)
+			runner.execTask(t, task, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+		})
+
+		t.Run("FailFast", func(t *testing.T) {
+			run := runSetup(t, ".gitea/workflows/dynamic-matrix-fail-fast.yml", "      max-parallel: 1",
+				`{"os":["ubuntu-latest"],"version":[1,2,3]}`)
+
+			task := runner.fetchTask(t)
+			assert.Equal(t, "build (ubuntu-latest, 1)", getTaskJobNameByTaskID(t, token, user2.Name, apiRepo.Name, task.Id))
+			runner.execTask(t, task, &mockTaskOutcome{result: runnerv1.Result_RESULT_FAILURE})
+
+			// the other jobs of the matrix are cancelled, and the job needing the matrix is skipped
+			runner.fetchNoTask(t)
+			buildJobs := getBuildJobs(t, run)
+			require.Len(t, buildJobs, 3)
+			assert.Equal(t, actions_model.StatusFailure, buildJobs["build (ubuntu-latest, 1)"].Status)
+			assert.Equal(t, actions_model.StatusCancelled, buildJobs["build (ubuntu-latest, 2)"].Status)
+			assert.Equal(t, actions_model.StatusCancelled, buildJobs["build (ubuntu-latest, 3)"].Status)
+			report := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{RunID: run.ID, JobID: "report"})
+			assert.Equal(t, actions_model.StatusSkipped, report.Status)
+		})
+
+		t.Run("NoFailFast", func(t *testing.T) {
+			run := runSetup(t, ".gitea/workflows/dynamic-matrix-no-fail-fast.yml", "      fail-fast: false",
+				`{"os":["ubuntu-latest"],"version":[1,2]}`)
+
+			task1 := runner.fetchTask(t)
+			task2 := runner.fetchTask(t)
+			runner.execTask(t, task1, &mockTaskOutcome{result: runnerv1.Result_RESULT_FAILURE})
+			runner.execTask(t, task2, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+
+			buildJobs := getBuildJobs(t, run)
+			require.Len(t, buildJobs, 2)
+			statuses := []actions_model.Status{buildJobs["build (ubuntu-latest, 1)"].Status, buildJobs["build (ubuntu-latest, 2)"].Status}
+			assert.ElementsMatch(t, []actions_model.Status{actions_model.StatusFailure, actions_model.StatusSuccess}, statuses)
+			runner.fetchNoTask(t)
+		})
+
+		t.Run("InvalidMatrix", func(t *testing.T) {
+			run := runSetup(t, ".gitea/workflows/dynamic-matrix-invalid.yml", "      fail-fast: true", `not json`)
+
+			runner.fetchNoTask(t)
+			build := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{RunID: run.ID, JobID: "build"})
+			assert.Equal(t, actions_model.StatusFailure, build.Status)
+			assert.Equal(t, actions_model.DynamicMatrixPending, build.DynamicMatrix)
+			report := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{RunID: run.ID, JobID: "report"})
+			assert.Equal(t, actions_model.StatusSkipped, report.Status)
+		})
+	})
+}
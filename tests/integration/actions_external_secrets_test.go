@@ -0,0 +1,133 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	repo_model "code.gitea.io/gitea/models/repo"
+	secret_model "code.gitea.io/gitea/models/secret"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/test"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionsExternalSecrets(t *testing.T) {
+	var reads atomic.Int32
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/user2/actions-external-secrets/deploy" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		reads.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"p@ss"}}}`))
+	}))
+	defer vault.Close()
+
+	// the default allowed paths are kept
+	cfg := setting.Actions.ExternalSecrets
+	cfg.Enabled = true
+	cfg.Provider = "vault"
+	cfg.CacheTTL = time.Minute
+	cfg.VaultAddress = vault.URL
+	cfg.VaultToken = "test-token"
+	cfg.VaultMount = "secret"
+	defer test.MockVariableValue(&setting.Actions.ExternalSecrets, cfg)()
+
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		session := loginUser(t, user2.Name)
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+
+		apiRepo := createActionsTestRepo(t, token, "actions-external-secrets", false)
+		repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: apiRepo.ID})
+		runner := newMockRunner()
+		runner.registerAsRepoRunner(t, user2.Name, apiRepo.Name, "mock-runner", []string{"ubuntu-latest"}, false)
+
+		secretsLink := fmt.Sprintf("/api/v1/repos/%s/%s/actions/secrets", user2.Name, apiRepo.Name)
+		// a repository can't reference the paths of the other repositories of its owner
+		req := NewRequestWithJSON(t, "PUT", secretsLink+"/OTHER", api.CreateOrUpdateSecretOption{
+			Data: "vault://user2/repo1/deploy#password",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusBadRequest)
+		// but the secrets of the owner, which are shared by its repositories, can
+		req = NewRequestWithJSON(t, "PUT", "/api/v1/user/actions/secrets/SHARED", api.CreateOrUpdateSecretOption{
+			Data: "vault://user2/actions-external-secrets/deploy#password",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+		req = NewRequestWithJSON(t, "PUT", secretsLink+"/DEPLOY", api.CreateOrUpdateSecretOption{
+			Data: "vault://user2/actions-external-secrets/deploy#password",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+
+		treePath := ".gitea/workflows/deploy.yml"
+		workflow := `name: deploy
+on:
+  push:
+    paths:
+      - '` + treePath + `'
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    steps:
+      - run: ./deploy.sh
+`
+		opts := getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "create "+treePath, workflow)
+		createWorkflowFile(t, token, user2.Name, apiRepo.Name, treePath, opts)
+
+		task := runner.fetchTask(t)
+		assert.Equal(t, "p@ss", task.Secrets["DEPLOY"])
+		assert.Equal(t, "p@ss", task.Secrets["SHARED"])
+		// all the secrets reference the same path, which is read once and cached
+		assert.EqualValues(t, 1, reads.Load())
+		runner.execTask(t, task, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+		unittest.AssertExistsAndLoadBean(t, &secret_model.AccessLog{RepoID: repo.ID, SecretName: "DEPLOY", TaskID: task.Id, Error: ""})
+
+		// the task using a secret which can't be resolved fails instead of being sent to the runner
+		req = NewRequestWithJSON(t, "PUT", secretsLink+"/MISSING", api.CreateOrUpdateSecretOption{
+			Data: "vault://user2/actions-external-secrets/deploy#missing",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+		opts = getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "rerun "+treePath, workflow+"# rerun\n")
+		req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/contents/%s", user2.Name, apiRepo.Name, treePath)).AddTokenAuth(token)
+		var contents api.ContentsResponse
+		DecodeJSON(t, MakeRequest(t, req, http.StatusOK), &contents)
+		req = NewRequestWithJSON(t, "PUT", fmt.Sprintf("/api/v1/repos/%s/%s/contents/%s", user2.Name, apiRepo.Name, treePath), &api.UpdateFileOptions{
+			FileOptions:   opts.FileOptions,
+			SHA:           contents.SHA,
+			ContentBase64: opts.ContentBase64,
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+		runner.fetchNoTask(t)
+
+		accessLog := unittest.AssertExistsAndLoadBean(t, &secret_model.AccessLog{RepoID: repo.ID, SecretName: "MISSING"})
+		assert.NotEmpty(t, accessLog.Error)
+		failedTask := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionTask{ID: accessLog.TaskID})
+		assert.Equal(t, actions_model.StatusFailure, failedTask.Status)
+		failedJob := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunJob{ID: failedTask.JobID})
+		assert.Equal(t, actions_model.StatusFailure, failedJob.Status)
+		assert.Contains(t, failedJob.ErrorMessage, "MISSING")
+
+		req = NewRequest(t, "GET", fmt.Sprintf("/%s/%s/settings/actions/secrets", user2.Name, apiRepo.Name))
+		resp := session.MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "vault://user2/actions-external-secrets/deploy#missing")
+	})
+}
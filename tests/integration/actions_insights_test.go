@@ -0,0 +1,62 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/modules/timeutil"
+	actions_service "code.gitea.io/gitea/services/actions"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsInsights(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	day := timeutil.TimeStamp(actions_model.StatDay(time.Now()).AddDate(0, 0, -1).Unix())
+	require.NoError(t, actions_model.SaveStats(t.Context(), day, []*actions_model.ActionJobStat{
+		{RepoID: 1, OwnerID: 2, Day: day, WorkflowID: "ci.yaml", JobName: "repo1-job", NumTasks: 3, NumFailures: 1, RunSeconds: 90},
+		{RepoID: 1, OwnerID: 2, Day: day, WorkflowID: "ci.yaml", JobName: "repo1-job", StepName: "repo1-step", NumTasks: 3, RunSeconds: 60},
+		{RepoID: 3, OwnerID: 3, Day: day, WorkflowID: "ci.yaml", JobName: "repo3-job", NumTasks: 1, RunSeconds: 30},
+	}, []*actions_model.ActionRunnerLabelStat{
+		{RepoID: 1, OwnerID: 2, Day: day, Label: "repo1-label", NumTasks: 3, BusySeconds: 90},
+	}))
+
+	session := loginUser(t, "user2")
+
+	t.Run("Repo", func(t *testing.T) {
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/actions/insights?days=7"), http.StatusOK)
+		body := resp.Body.String()
+		assert.Contains(t, body, "repo1-job")
+		assert.Contains(t, body, "repo1-step")
+		assert.Contains(t, body, "repo1-label")
+		assert.NotContains(t, body, "repo3-job")
+
+		resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/actions/insights/data?days=7"), http.StatusOK)
+		var data struct {
+			Daily []*actions_service.DailyInsight `json:"daily"`
+		}
+		DecodeJSON(t, resp, &data)
+		require.Len(t, data.Daily, 7)
+		assert.EqualValues(t, 3, data.Daily[6].NumTasks)
+		assert.Zero(t, data.Daily[5].NumTasks)
+
+		// user4 isn't an admin of the repository
+		session4 := loginUser(t, "user4")
+		session4.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/actions/insights"), http.StatusNotFound)
+	})
+
+	t.Run("Org", func(t *testing.T) {
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/org/org3/settings/actions/insights"), http.StatusOK)
+		body := resp.Body.String()
+		assert.Contains(t, body, "repo3-job")
+		assert.NotContains(t, body, "repo1-job")
+	})
+}
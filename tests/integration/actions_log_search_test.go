@@ -0,0 +1,126 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/routers/web/repo/actions"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestActionsLogSearchAndStream(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		session := loginUser(t, user2.Name)
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteUser)
+
+		apiRepo := createActionsTestRepo(t, token, "actions-log-search", false)
+		runner := newMockRunner()
+		runner.registerAsRepoRunner(t, user2.Name, apiRepo.Name, "mock-runner", []string{"ubuntu-latest"}, false)
+
+		treePath := ".gitea/workflows/log-search.yml"
+		workflow := `name: log-search
+on:
+  push:
+    paths:
+      - '` + treePath + `'
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: go build
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: go test
+`
+		opts := getWorkflowCreateFileOptions(user2, apiRepo.DefaultBranch, "create "+treePath, workflow)
+		createWorkflowFile(t, token, user2.Name, apiRepo.Name, treePath, opts)
+
+		now := time.Now()
+		logs := map[string][]string{
+			"build": {"compiling", "build OK"},
+			"test":  {"--- FAIL: TestFoo", "foo_test.go:12: unexpected error", "FAIL"},
+		}
+		var runIndex int64
+		for range 2 {
+			task := runner.fetchTask(t)
+			_, job, run := getTaskAndJobAndRunByTaskID(t, task.Id)
+			runIndex = run.Index
+			rows := make([]*runnerv1.LogRow, 0, len(logs[job.JobID]))
+			for i, content := range logs[job.JobID] {
+				rows = append(rows, &runnerv1.LogRow{Time: timestamppb.New(now.Add(time.Duration(i) * time.Second)), Content: content})
+			}
+			runner.execTask(t, task, &mockTaskOutcome{result: runnerv1.Result_RESULT_FAILURE, logRows: rows})
+		}
+		runLink := fmt.Sprintf("/%s/%s/actions/runs/%d", user2.Name, apiRepo.Name, runIndex)
+
+		t.Run("Search", func(t *testing.T) {
+			req := NewRequest(t, "GET", runLink+"/logs/search?q=fail")
+			resp := session.MakeRequest(t, req, http.StatusOK)
+			var result actions.LogSearchResponse
+			DecodeJSON(t, resp, &result)
+			assert.False(t, result.Truncated)
+			require.Len(t, result.Matches, 2)
+			assert.Equal(t, "test", result.Matches[0].JobName)
+			assert.Equal(t, "--- FAIL: TestFoo", result.Matches[0].Message)
+			assert.EqualValues(t, 1, result.Matches[0].Line)
+			assert.Equal(t, "FAIL", result.Matches[1].Message)
+			assert.EqualValues(t, 3, result.Matches[1].Line)
+			assert.Equal(t, fmt.Sprintf("%s/jobs/%d#jobstep-0-3", runLink, result.Matches[1].JobIndex), result.Matches[1].Link)
+
+			req = NewRequest(t, "GET", runLink+"/logs/search?q=")
+			resp = session.MakeRequest(t, req, http.StatusOK)
+			result = actions.LogSearchResponse{}
+			DecodeJSON(t, resp, &result)
+			assert.Empty(t, result.Matches)
+		})
+
+		t.Run("DownloadRunLogs", func(t *testing.T) {
+			run := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRun{RepoID: apiRepo.ID, Index: runIndex})
+			req := NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/actions/runs/%d/logs", user2.Name, apiRepo.Name, run.ID)).
+				AddTokenAuth(token)
+			resp := MakeRequest(t, req, http.StatusOK)
+			body := resp.Body.String()
+			assert.Contains(t, body, "===== build =====\n")
+			assert.Contains(t, body, "===== test =====\n")
+			assert.Contains(t, body, " build OK\n")
+			assert.Contains(t, body, " foo_test.go:12: unexpected error\n")
+			assert.Contains(t, resp.Header().Get("Content-Disposition"), "attachment")
+
+			req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/actions/runs/%d/logs", user2.Name, apiRepo.Name, run.ID+1000)).
+				AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusNotFound)
+		})
+
+		t.Run("Stream", func(t *testing.T) {
+			// the run is done, so the stream sends the final state and stops
+			cursors := url.QueryEscape(`[{"step":0,"cursor":0,"expanded":true}]`)
+			req := NewRequest(t, "GET", runLink+"/jobs/0/stream?logCursors="+cursors)
+			resp := session.MakeRequest(t, req, http.StatusOK)
+			assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+			body := resp.Body.String()
+			assert.True(t, strings.HasPrefix(body, "event: state\n"), body)
+			assert.Contains(t, body, `"done":true`)
+			assert.Contains(t, body, `"message":"compiling"`)
+
+			req = NewRequest(t, "GET", runLink+"/jobs/0/stream?logCursors=invalid")
+			session.MakeRequest(t, req, http.StatusBadRequest)
+		})
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/db"
+	git_model "code.gitea.io/gitea/models/git"
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/commitstatus"
+	api "code.gitea.io/gitea/modules/structs"
+	pull_service "code.gitea.io/gitea/services/pull"
+	repo_service "code.gitea.io/gitea/services/repository"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsRequiredWorkflow(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, u *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		org3 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 3})
+		session := loginUser(t, user2.Name)
+		token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteRepository, auth_model.AccessTokenScopeWriteOrganization)
+
+		createOrgRepo := func(name string) *repo_model.Repository {
+			repo, err := repo_service.CreateRepository(t.Context(), user2, org3, repo_service.CreateRepoOptions{
+				Name:          name,
+				AutoInit:      true,
+				Readme:        "Default",
+				DefaultBranch: "main",
+			})
+			require.NoError(t, err)
+			return repo
+		}
+		ciRepo := createOrgRepo("required-ci")
+		targetRepo := createOrgRepo("required-target")
+		otherRepo := createOrgRepo("other-target")
+
+		treePath := ".gitea/workflows/lint.yml"
+		workflow := `name: lint
+on:
+  pull_request:
+jobs:
+  check:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+`
+		opts := getWorkflowCreateFileOptions(user2, ciRepo.DefaultBranch, "create "+treePath, workflow)
+		createWorkflowFile(t, token, org3.Name, ciRepo.Name, treePath, opts)
+
+		link := fmt.Sprintf("/api/v1/orgs/%s/actions/required-workflows", org3.Name)
+		t.Run("CreateInvalid", func(t *testing.T) {
+			req := NewRequestWithJSON(t, "POST", link, &api.CreateActionRequiredWorkflowOption{
+				RepoName:     ciRepo.Name,
+				WorkflowPath: ".gitea/workflows/missing.yml",
+			}).AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+			req = NewRequestWithJSON(t, "POST", link, &api.CreateActionRequiredWorkflowOption{
+				RepoName:     ciRepo.Name,
+				WorkflowPath: "README.md",
+			}).AddTokenAuth(token)
+			MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+			// only the owners of the organization can manage the required workflows
+			user4Token := getTokenForLoggedInUser(t, loginUser(t, "user4"), auth_model.AccessTokenScopeWriteOrganization)
+			req = NewRequest(t, "GET", link).AddTokenAuth(user4Token)
+			MakeRequest(t, req, http.StatusForbidden)
+		})
+
+		createOpts := &api.CreateActionRequiredWorkflowOption{
+			RepoName:     ciRepo.Name,
+			WorkflowPath: treePath,
+			RepoPattern:  "required-*",
+		}
+		req := NewRequestWithJSON(t, "POST", link, createOpts).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		var apiRequiredWorkflow api.ActionRequiredWorkflow
+		DecodeJSON(t, resp, &apiRequiredWorkflow)
+		assert.Equal(t, ciRepo.Name, apiRequiredWorkflow.RepoName)
+		assert.Equal(t, treePath, apiRequiredWorkflow.WorkflowPath)
+		req = NewRequestWithJSON(t, "POST", link, createOpts).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusConflict)
+
+		req = NewRequest(t, "GET", link).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		var apiRequiredWorkflows []*api.ActionRequiredWorkflow
+		DecodeJSON(t, resp, &apiRequiredWorkflows)
+		require.Len(t, apiRequiredWorkflows, 1)
+		assert.Equal(t, apiRequiredWorkflow.ID, apiRequiredWorkflows[0].ID)
+
+		// protect the default branch of the target repository without the status check,
+		// the required workflow is still required to merge the pull requests
+		req = NewRequestWithJSON(t, "POST", fmt.Sprintf("/api/v1/repos/%s/%s/branch_protections", org3.Name, targetRepo.Name), &api.CreateBranchProtectionOption{
+			RuleName: targetRepo.DefaultBranch,
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+		pb, err := git_model.GetFirstMatchProtectedBranchRule(t.Context(), targetRepo.ID, targetRepo.DefaultBranch)
+		require.NoError(t, err)
+		contexts, err := pull_service.GetRequiredStatusContexts(t.Context(), targetRepo, pb)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"required-ci/lint.yml / *"}, contexts)
+
+		req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/orgs/%s/actions/runners/registration-token", org3.Name)).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		var registrationToken struct {
+			Token string `json:"token"`
+		}
+		DecodeJSON(t, resp, &registrationToken)
+		runner := newMockRunner()
+		runner.doRegister(t, "mock-runner", registrationToken.Token, []string{"ubuntu-latest"}, false)
+
+		fileOpts := getWorkflowCreateFileOptions(user2, targetRepo.DefaultBranch, "add main.go", "package main")
+		fileOpts.NewBranchName = "feature"
+		createWorkflowFile(t, token, org3.Name, targetRepo.Name, "main.go", fileOpts)
+		req = NewRequestWithJSON(t, "POST", fmt.Sprintf("/api/v1/repos/%s/%s/pulls", org3.Name, targetRepo.Name), &api.CreatePullRequestOption{
+			Head:  "feature",
+			Base:  targetRepo.DefaultBranch,
+			Title: "add main.go",
+		}).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusCreated)
+		var apiPull api.PullRequest
+		DecodeJSON(t, resp, &apiPull)
+		pull := unittest.AssertExistsAndLoadBean(t, &issues_model.PullRequest{ID: apiPull.ID})
+
+		task := runner.fetchTask(t)
+		_, job, run := getTaskAndJobAndRunByTaskID(t, task.Id)
+		assert.Equal(t, targetRepo.ID, run.RepoID)
+		assert.Equal(t, apiRequiredWorkflow.ID, run.RequiredWorkflowID)
+		assert.Equal(t, ciRepo.Name+"/"+treePath, run.WorkflowID)
+		assert.Equal(t, "check", job.JobID)
+
+		pass, err := pull_service.IsPullCommitStatusPass(t.Context(), pull)
+		require.NoError(t, err)
+		assert.False(t, pass)
+
+		runner.execTask(t, task, &mockTaskOutcome{result: runnerv1.Result_RESULT_SUCCESS})
+		status := unittest.AssertExistsAndLoadBean(t, &git_model.CommitStatus{RepoID: targetRepo.ID, SHA: run.CommitSHA})
+		assert.Equal(t, "required-ci/lint.yml / check (pull_request)", status.Context)
+		pass, err = pull_service.IsPullCommitStatusPass(t.Context(), pull)
+		require.NoError(t, err)
+		assert.True(t, pass)
+
+		// the contexts of the rule are applied to the state of the pull request even if its status check is disabled,
+		// but they aren't required to merge it
+		pb.StatusCheckContexts = []string{"missing-ci"}
+		_, err = db.GetEngine(t.Context()).ID(pb.ID).Cols("status_check_contexts").Update(pb)
+		require.NoError(t, err)
+		state, err := pull_service.GetPullRequestCommitStatusState(t.Context(), pull)
+		require.NoError(t, err)
+		assert.Equal(t, commitstatus.CommitStatusPending, state)
+		pass, err = pull_service.IsPullCommitStatusPass(t.Context(), pull)
+		require.NoError(t, err)
+		assert.True(t, pass)
+		pb.StatusCheckContexts = nil
+		_, err = db.GetEngine(t.Context()).ID(pb.ID).Cols("status_check_contexts").Update(pb)
+		require.NoError(t, err)
+
+		// the workflow file of the run is in the designated repository
+		req = NewRequest(t, "GET", fmt.Sprintf("/%s/%s/actions/runs/%d/workflow", org3.Name, targetRepo.Name, run.Index))
+		resp = session.MakeRequest(t, req, http.StatusSeeOther)
+		assert.Equal(t, fmt.Sprintf("/%s/%s/src/branch/main/%s", org3.Name, ciRepo.Name, treePath), resp.Header().Get("Location"))
+
+		req = NewRequest(t, "GET", fmt.Sprintf("/org/%s/settings/actions/required_workflows", org3.Name))
+		resp = session.MakeRequest(t, req, http.StatusOK)
+		assert.Contains(t, resp.Body.String(), ciRepo.Name+"/"+treePath)
+
+		// the repositories not matching the pattern are not targeted
+		unittest.AssertNotExistsBean(t, &actions_model.ActionRun{RepoID: otherRepo.ID})
+
+		// the trigger of the workflow is stored, it is read again when the default branch of the designated repository is pushed to
+		rw := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRequiredWorkflow{ID: apiRequiredWorkflow.ID})
+		assert.True(t, rw.TriggeredByPullRequest)
+		assert.NotEmpty(t, rw.CommitSHA)
+		_, err = db.GetEngine(t.Context()).ID(rw.ID).Cols("commit_sha", "triggered_by_pull_request").Update(&actions_model.ActionRequiredWorkflow{})
+		require.NoError(t, err)
+		contexts, err = pull_service.GetRequiredStatusContexts(t.Context(), targetRepo, pb)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"required-ci/lint.yml / *"}, contexts)
+		unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRequiredWorkflow{ID: rw.ID, CommitSHA: rw.CommitSHA, TriggeredByPullRequest: true})
+
+		req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/contents/%s", org3.Name, ciRepo.Name, treePath)).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		var contents api.ContentsResponse
+		DecodeJSON(t, resp, &contents)
+		req = NewRequestWithJSON(t, "PUT", fmt.Sprintf("/api/v1/repos/%s/%s/contents/%s", org3.Name, ciRepo.Name, treePath), &api.UpdateFileOptions{
+			FileOptions:   api.FileOptions{BranchName: ciRepo.DefaultBranch, Message: "lint on push"},
+			SHA:           contents.SHA,
+			ContentBase64: base64.StdEncoding.EncodeToString([]byte(strings.Replace(workflow, "pull_request:", "push:", 1))),
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+		rw = unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRequiredWorkflow{ID: rw.ID})
+		assert.False(t, rw.TriggeredByPullRequest)
+		contexts, err = pull_service.GetRequiredStatusContexts(t.Context(), targetRepo, pb)
+		require.NoError(t, err)
+		assert.Empty(t, contexts)
+
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", link, apiRequiredWorkflow.ID)).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusNoContent)
+		MakeRequest(t, req, http.StatusNotFound)
+		contexts, err = pull_service.GetRequiredStatusContexts(t.Context(), targetRepo, pb)
+		require.NoError(t, err)
+		assert.Empty(t, contexts)
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/modules/setting"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsRunnerScaler(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	require.NoError(t, actions_model.InsertScaleDecision(t.Context(), &actions_model.ActionScaleDecision{
+		Type:       actions_model.ScaleDecisionStart,
+		Labels:     []string{"scaler-test-label"},
+		NumWaiting: 3,
+		NumRunners: 2,
+	}))
+	require.NoError(t, actions_model.InsertScaleDecision(t.Context(), &actions_model.ActionScaleDecision{
+		Type:    actions_model.ScaleDecisionError,
+		Labels:  []string{"scaler-test-label"},
+		Message: "quota exceeded",
+	}))
+
+	session := loginUser(t, "user1")
+
+	t.Run("Disabled", func(t *testing.T) {
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/-/admin/actions/runners"), http.StatusOK)
+		htmlDoc := NewHTMLParser(t, resp.Body)
+		AssertHTMLElement(t, htmlDoc, ".runner-scaler", false)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		defer test.MockVariableValue(&setting.Actions.Scaler.Enabled, true)()
+
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/-/admin/actions/runners"), http.StatusOK)
+		htmlDoc := NewHTMLParser(t, resp.Body)
+		rows := htmlDoc.Find(".runner-scaler tbody tr")
+		require.Equal(t, 2, rows.Length())
+		assert.Contains(t, rows.Eq(0).Text(), "quota exceeded")
+		assert.Contains(t, rows.Eq(1).Text(), "scaler-test-label")
+
+		// the scaler is only shown to the site admins
+		resp = loginUser(t, "user2").MakeRequest(t, NewRequest(t, "GET", "/user/settings/actions/runners"), http.StatusOK)
+		AssertHTMLElement(t, NewHTMLParser(t, resp.Body), ".runner-scaler", false)
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/junit"
+	"code.gitea.io/gitea/routers/api/actions"
+	actions_service "code.gitea.io/gitea/services/actions"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestActionsTestReport(t *testing.T) {
+	defer prepareTestEnvActionsArtifacts(t)()
+
+	// task 48 of the job 193 of the run 792 (index 188) of user5/repo4, pushed to the default branch
+	token, err := actions_service.CreateAuthorizationToken(48, 792, 193)
+	require.NoError(t, err)
+
+	uploadArtifact := func(t *testing.T, name string, files map[string]string) {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for fileName, content := range files {
+			w, err := zw.Create(fileName)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+
+		req := NewRequestWithBody(t, "POST", "/twirp/github.actions.results.api.v1.ArtifactService/CreateArtifact", toProtoJSON(&actions.CreateArtifactRequest{
+			Version:                 4,
+			Name:                    name,
+			WorkflowRunBackendId:    "792",
+			WorkflowJobRunBackendId: "193",
+		})).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusOK)
+		var uploadResp actions.CreateArtifactResponse
+		require.NoError(t, protojson.Unmarshal(resp.Body.Bytes(), &uploadResp))
+		idx := strings.Index(uploadResp.SignedUploadUrl, "/twirp/")
+		MakeRequest(t, NewRequestWithBody(t, "PUT", uploadResp.SignedUploadUrl[idx:]+"&comp=block", &buf), http.StatusCreated)
+
+		req = NewRequestWithBody(t, "POST", "/twirp/github.actions.results.api.v1.ArtifactService/FinalizeArtifact", toProtoJSON(&actions.FinalizeArtifactRequest{
+			Name:                    name,
+			WorkflowRunBackendId:    "792",
+			WorkflowJobRunBackendId: "193",
+		})).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusOK)
+	}
+
+	report := `<testsuites>
+	<testsuite name="pkg/a">
+		<testcase classname="pkg/a" name="TestPass" time="0.1"/>
+		<testcase classname="pkg/a" name="TestFail" time="0.2"><failure message="expected true">a_test.go:10: the stack trace</failure></testcase>
+		<testcase classname="pkg/a" name="TestSkip"><skipped/></testcase>
+	</testsuite>
+</testsuites>`
+
+	t.Run("Ingest", func(t *testing.T) {
+		uploadArtifact(t, "gitea-test-report", map[string]string{
+			"reports/a.xml":  report,
+			"reports/b.xml":  `<testsuite name="pkg/b"><testcase classname="pkg/b" name="TestB"/></testsuite>`,
+			"reports/c.txt":  "not a report",
+			"reports/d.xml":  "<invalid",
+			"reports/e.json": "{}",
+		})
+		unittest.AssertCount(t, &actions_model.ActionTestResult{RunID: 792}, 4)
+		failed := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionTestResult{RunID: 792, Status: junit.StatusFailed})
+		assert.Equal(t, "TestFail", failed.Name)
+		assert.Equal(t, "expected true", failed.Message)
+		assert.Equal(t, "a_test.go:10: the stack trace", failed.Details)
+		assert.EqualValues(t, 193, failed.JobID)
+
+		// the run is on the default branch, so the tests are counted in their history
+		tc := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionTestCase{RepoID: 4, Name: "TestFail"})
+		assert.EqualValues(t, 1, tc.NumRuns)
+		assert.EqualValues(t, 1, tc.NumFailures)
+		unittest.AssertNotExistsBean(t, &actions_model.ActionTestCase{RepoID: 4, Name: "TestSkip"})
+	})
+
+	t.Run("UploadAgain", func(t *testing.T) {
+		uploadArtifact(t, "gitea-test-report", map[string]string{"reports/a.xml": report})
+		unittest.AssertCount(t, &actions_model.ActionTestResult{RunID: 792}, 3)
+
+		// the results reported again by the same task aren't counted twice
+		tc := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionTestCase{RepoID: 4, Name: "TestFail"})
+		assert.EqualValues(t, 1, tc.NumRuns)
+	})
+
+	t.Run("OtherArtifact", func(t *testing.T) {
+		uploadArtifact(t, "test-report", map[string]string{"reports/a.xml": report})
+		unittest.AssertCount(t, &actions_model.ActionTestResult{RunID: 792}, 3)
+	})
+
+	t.Run("TooManyEntries", func(t *testing.T) {
+		files := map[string]string{"reports/a.xml": report}
+		for i := range 1000 {
+			files[fmt.Sprintf("reports/empty-%d.txt", i)] = ""
+		}
+		uploadArtifact(t, "gitea-test-report-big", files)
+		unittest.AssertCount(t, &actions_model.ActionTestResult{RunID: 792}, 3)
+	})
+
+	t.Run("Pages", func(t *testing.T) {
+		session := loginUser(t, "user5")
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/user5/repo4/actions/runs/188/tests"), http.StatusOK)
+		body := resp.Body.String()
+		assert.Contains(t, body, "TestFail")
+		assert.Contains(t, body, "the stack trace")
+		// only the failed tests are shown by default
+		assert.NotContains(t, body, "TestPass")
+
+		resp = session.MakeRequest(t, NewRequest(t, "GET", "/user5/repo4/actions/runs/188/tests?status=all"), http.StatusOK)
+		assert.Contains(t, resp.Body.String(), "TestPass")
+
+		// the summary is shown in the run view
+		resp = session.MakeRequest(t, NewRequest(t, "POST", "/user5/repo4/actions/runs/188/jobs/0"), http.StatusOK)
+		var view struct {
+			TestSummary *actions_model.TestSummary `json:"testSummary"`
+		}
+		DecodeJSON(t, resp, &view)
+		assert.Equal(t, &actions_model.TestSummary{Passed: 1, Failed: 1, Skipped: 1}, view.TestSummary)
+
+		session.MakeRequest(t, NewRequest(t, "GET", "/user5/repo4/actions/flaky-tests"), http.StatusOK)
+	})
+}
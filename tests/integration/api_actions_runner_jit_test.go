@@ -0,0 +1,122 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	actions_model "code.gitea.io/gitea/models/actions"
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/models/db"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/json"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/timeutil"
+	actions_service "code.gitea.io/gitea/services/actions"
+
+	runnerv1 "code.gitea.io/actions-proto-go/runner/v1"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIActionsRunnerJITConfig(t *testing.T) {
+	onGiteaRun(t, testAPIActionsRunnerJITConfig)
+}
+
+func testAPIActionsRunnerJITConfig(t *testing.T, _ *url.URL) {
+	token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteRepository)
+	apiURL := "/api/v1/repos/user2/repo1/actions/runners/generate-jitconfig"
+
+	generate := func(t *testing.T, name string) (*api.ActionRunnerJITConfig, *actions_service.JITRunnerConfig) {
+		req := NewRequestWithJSON(t, "POST", apiURL, &api.GenerateRunnerJITConfigOption{
+			Name:   name,
+			Labels: []string{"ubuntu-latest", "gpu"},
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		var jitConfig api.ActionRunnerJITConfig
+		DecodeJSON(t, resp, &jitConfig)
+
+		bs, err := base64.StdEncoding.DecodeString(jitConfig.EncodedJITConfig)
+		require.NoError(t, err)
+		var config actions_service.JITRunnerConfig
+		require.NoError(t, json.Unmarshal(bs, &config))
+		return &jitConfig, &config
+	}
+
+	fetchTask := func(config *actions_service.JITRunnerConfig) error {
+		client := newMockRunnerClient(config.UUID, config.Token)
+		_, err := client.runnerServiceClient.FetchTask(t.Context(), connect.NewRequest(&runnerv1.FetchTaskRequest{}))
+		return err
+	}
+
+	t.Run("Generate", func(t *testing.T) {
+		jitConfig, config := generate(t, "jit-runner")
+		assert.Equal(t, "jit-runner", jitConfig.Runner.Name)
+		assert.True(t, jitConfig.Runner.Ephemeral)
+		assert.Len(t, jitConfig.Runner.Labels, 2)
+		assert.Equal(t, jitConfig.Runner.ID, config.ID)
+		assert.Equal(t, setting.AppURL, config.Address)
+		assert.Equal(t, []string{"ubuntu-latest", "gpu"}, config.Labels)
+		assert.True(t, config.Ephemeral)
+
+		runner := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunner{ID: config.ID})
+		assert.Equal(t, int64(1), runner.RepoID)
+		assert.Zero(t, runner.OwnerID)
+		assert.InDelta(t, time.Now().Add(setting.Actions.JITRunnerTimeout).Unix(), int64(runner.Expires), 60)
+
+		// the runner can connect with the config without registering
+		require.NoError(t, fetchTask(config))
+		runner = unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunner{ID: config.ID})
+		assert.NotZero(t, runner.LastOnline)
+		assert.False(t, runner.IsExpired())
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		_, config := generate(t, "jit-runner-expired")
+		_, err := db.GetEngine(t.Context()).ID(config.ID).Cols("expires").
+			Update(&actions_model.ActionRunner{Expires: timeutil.TimeStamp(time.Now().Add(-time.Minute).Unix())})
+		require.NoError(t, err)
+
+		err = fetchTask(config)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "runner registration has expired")
+
+		n, err := actions_model.DeleteExpiredRunners(t.Context())
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, n)
+		unittest.AssertNotExistsBean(t, &actions_model.ActionRunner{ID: config.ID})
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		req := NewRequestWithJSON(t, "POST", apiURL, &api.GenerateRunnerJITConfigOption{Name: "jit-runner"}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+		req = NewRequestWithJSON(t, "POST", apiURL, &api.GenerateRunnerJITConfigOption{Name: "jit-runner", Labels: []string{" "}}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		// user4 isn't an admin of the repository
+		user4Token := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteRepository)
+		req = NewRequestWithJSON(t, "POST", apiURL, &api.GenerateRunnerJITConfigOption{Name: "jit-runner", Labels: []string{"linux"}}).AddTokenAuth(user4Token)
+		MakeRequest(t, req, http.StatusForbidden)
+	})
+
+	t.Run("Global", func(t *testing.T) {
+		adminToken := getUserToken(t, "user1", auth_model.AccessTokenScopeWriteAdmin)
+		req := NewRequestWithJSON(t, "POST", "/api/v1/admin/actions/runners/generate-jitconfig", &api.GenerateRunnerJITConfigOption{
+			Name:   "global-jit-runner",
+			Labels: []string{"linux"},
+		}).AddTokenAuth(adminToken)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		var jitConfig api.ActionRunnerJITConfig
+		DecodeJSON(t, resp, &jitConfig)
+		runner := unittest.AssertExistsAndLoadBean(t, &actions_model.ActionRunner{ID: jitConfig.Runner.ID})
+		assert.Zero(t, runner.OwnerID)
+		assert.Zero(t, runner.RepoID)
+	})
+}
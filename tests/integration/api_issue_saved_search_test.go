@@ -0,0 +1,97 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueSavedSearches(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteUser, auth_model.AccessTokenScopeReadIssue)
+	otherToken := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteUser, auth_model.AccessTokenScopeReadIssue)
+
+	req := NewRequestWithJSON(t, "POST", "/api/v1/user/issue_saved_searches", &api.CreateIssueSavedSearchOption{
+		Name:   "Repo1 bugs",
+		Query:  "is:open repo:user2/repo1 label:label1",
+		Shared: true,
+	}).AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusCreated)
+	var search api.IssueSavedSearch
+	DecodeJSON(t, resp, &search)
+	assert.Equal(t, "issues", search.Type)
+	assert.Equal(t, "user2", search.Owner.UserName)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/user/issue_saved_searches", &api.CreateIssueSavedSearchOption{
+		Name:  "Invalid",
+		Query: "updated:>soon",
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequest(t, "GET", "/api/v1/user/issue_saved_searches").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var searches []*api.IssueSavedSearch
+	DecodeJSON(t, resp, &searches)
+	assert.Len(t, searches, 1)
+
+	// a shared search can be run but not changed by other users
+	runURL := fmt.Sprintf("/api/v1/user/issue_saved_searches/%d/issues", search.ID)
+	for _, tok := range []string{token, otherToken} {
+		req = NewRequest(t, "GET", runURL).AddTokenAuth(tok)
+		resp = MakeRequest(t, req, http.StatusOK)
+		var issues []*api.Issue
+		DecodeJSON(t, resp, &issues)
+		if assert.Len(t, issues, 1) {
+			assert.EqualValues(t, 1, issues[0].ID)
+		}
+	}
+	searchURL := fmt.Sprintf("/api/v1/user/issue_saved_searches/%d", search.ID)
+	req = NewRequestWithJSON(t, "PATCH", searchURL, &api.EditIssueSavedSearchOption{Shared: util.ToPointer(false)}).AddTokenAuth(otherToken)
+	MakeRequest(t, req, http.StatusNotFound)
+
+	// the repositories of a shared search are limited to the ones visible to the user running it
+	req = NewRequestWithJSON(t, "PATCH", searchURL, &api.EditIssueSavedSearchOption{Query: util.ToPointer("repo:user2/repo2")}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusOK)
+	req = NewRequest(t, "GET", runURL).AddTokenAuth(otherToken)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var issues []*api.Issue
+	DecodeJSON(t, resp, &issues)
+	assert.Empty(t, issues)
+	req = NewRequest(t, "GET", runURL).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &issues)
+	assert.NotEmpty(t, issues)
+
+	// the page size is capped by the maximum number of items of a response
+	req = NewRequestWithJSON(t, "PATCH", searchURL, &api.EditIssueSavedSearchOption{Query: util.ToPointer("repo:user2/*")}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusOK)
+	req = NewRequest(t, "GET", runURL).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &issues)
+	assert.Greater(t, len(issues), 1)
+	defer test.MockVariableValue(&setting.API.MaxResponseItems, 1)()
+	req = NewRequest(t, "GET", runURL+"?limit=50").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &issues)
+	assert.Len(t, issues, 1)
+
+	req = NewRequest(t, "DELETE", searchURL).AddTokenAuth(otherToken)
+	MakeRequest(t, req, http.StatusNotFound)
+	req = NewRequest(t, "DELETE", searchURL).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	req = NewRequest(t, "GET", searchURL).AddTokenAuth(otherToken)
+	MakeRequest(t, req, http.StatusNotFound)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPISubIssues(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	parent := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 1, Index: 1}) // user2/repo1#1
+	child := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 2, Index: 2})  // user2/repo2#2
+
+	token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeReadRepository)
+	subIssuesURL := fmt.Sprintf("/api/v1/repos/user2/repo1/issues/%d/sub_issues", parent.Index)
+
+	// issues of other owners can't be sub-issues
+	req := NewRequestWithJSON(t, "POST", subIssuesURL, &api.IssueMeta{Owner: "org3", Name: "repo3", Index: 1}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", subIssuesURL, &api.IssueMeta{Owner: "user2", Name: "repo2", Index: child.Index}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusCreated)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.SubIssue{IssueID: child.ID, ParentID: parent.ID})
+
+	req = NewRequest(t, "GET", subIssuesURL).AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusOK)
+	var subIssues []*api.Issue
+	DecodeJSON(t, resp, &subIssues)
+	if assert.Len(t, subIssues, 1) {
+		assert.Equal(t, child.ID, subIssues[0].ID)
+	}
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo2/issues/%d/parent", child.Index)).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var apiParent api.Issue
+	DecodeJSON(t, resp, &apiParent)
+	assert.Equal(t, parent.ID, apiParent.ID)
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo2/issues?parent=%d", parent.ID)).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var issues []*api.Issue
+	DecodeJSON(t, resp, &issues)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, child.ID, issues[0].ID)
+	}
+
+	req = NewRequestWithJSON(t, "DELETE", subIssuesURL, &api.IssueMeta{Owner: "user2", Name: "repo2", Index: child.Index}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &issues_model.SubIssue{IssueID: child.ID})
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/user2/repo2/issues/%d/parent", child.Index)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNotFound)
+}
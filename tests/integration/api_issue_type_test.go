@@ -0,0 +1,93 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIIssueTypes(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteOrganization, auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeReadRepository)
+
+	req := NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/issue_types", &api.CreateIssueTypeOption{Name: "Bug", Icon: "octicon-bug", Color: "#d73a4a"}).AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusCreated)
+	var issueType api.IssueType
+	DecodeJSON(t, resp, &issueType)
+	assert.Equal(t, "Bug", issueType.Name)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/issue_types", &api.CreateIssueTypeOption{Name: "bug"}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	fieldsURL := fmt.Sprintf("/api/v1/orgs/org3/issue_types/%d/fields", issueType.ID)
+	req = NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateIssueFieldOption{Name: "Severity", Type: "select", Options: []string{"low", "high"}}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	var field api.IssueField
+	DecodeJSON(t, resp, &field)
+	assert.Equal(t, []string{"low", "high"}, field.Options)
+
+	req = NewRequest(t, "GET", "/api/v1/orgs/org3/issue_types").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var issueTypes []*api.IssueType
+	DecodeJSON(t, resp, &issueTypes)
+	if assert.Len(t, issueTypes, 1) {
+		assert.Len(t, issueTypes[0].Fields, 1)
+	}
+
+	// the values are checked against the fields of the type
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/org3/repo3/issues", &api.CreateIssueOption{
+		Title:  "typed issue",
+		Type:   "Bug",
+		Fields: map[string]string{"Severity": "urgent"},
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequestWithJSON(t, "POST", "/api/v1/repos/org3/repo3/issues", &api.CreateIssueOption{
+		Title:  "typed issue",
+		Type:   "bug",
+		Fields: map[string]string{"severity": "high"},
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	var issue api.Issue
+	DecodeJSON(t, resp, &issue)
+	if assert.NotNil(t, issue.Type) {
+		assert.Equal(t, issueType.ID, issue.Type.ID)
+	}
+	assert.Equal(t, map[string]string{"Severity": "high"}, issue.Fields)
+
+	req = NewRequest(t, "GET", "/api/v1/repos/org3/repo3/issues?state=all&issue_type=Bug&issue_field=Severity:high").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var issues []*api.Issue
+	DecodeJSON(t, resp, &issues)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, issue.ID, issues[0].ID)
+	}
+	req = NewRequest(t, "GET", "/api/v1/repos/org3/repo3/issues?state=all&issue_type=Bug&issue_field=Severity:low").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &issues)
+	assert.Empty(t, issues)
+
+	// an empty type removes the type and the values of its fields
+	req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("/api/v1/repos/org3/repo3/issues/%d", issue.Index), &api.EditIssueOption{Type: new(string)}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	DecodeJSON(t, resp, &issue)
+	assert.Nil(t, issue.Type)
+	unittest.AssertNotExistsBean(t, &issues_model.IssueFieldValue{IssueID: issue.ID})
+
+	req = NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/orgs/org3/issue_types/%d", issueType.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &issues_model.IssueType{ID: issueType.ID})
+	unittest.AssertNotExistsBean(t, &issues_model.IssueField{ID: field.ID})
+}
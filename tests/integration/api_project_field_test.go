@@ -0,0 +1,146 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIProjectFields(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	project := unittest.AssertExistsAndLoadBean(t, &project_model.Project{ID: 1})
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: project.RepoID})
+	owner := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: repo.OwnerID})
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: 1})
+
+	session := loginUser(t, owner.Name)
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+	fieldsURL := fmt.Sprintf("/api/v1/repos/%s/%s/projects/%d/fields", owner.Name, repo.Name, project.ID)
+
+	req := NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateProjectFieldOption{
+		Name:    "Status",
+		Type:    "single_select",
+		Options: []*api.ProjectFieldOption{{Name: "Todo"}, {Name: "Done", Color: "#2ea44f"}},
+	}).AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusCreated)
+	var status api.ProjectField
+	DecodeJSON(t, resp, &status)
+	assert.Equal(t, "single_select", status.Type)
+	require.Len(t, status.Options, 2)
+
+	req = NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateProjectFieldOption{
+		Name: "Sprint",
+		Type: "iteration",
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequest(t, "GET", fieldsURL).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var fields []*api.ProjectField
+	DecodeJSON(t, resp, &fields)
+	assert.Len(t, fields, 1)
+
+	valueURL := fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d/project_fields/%d", owner.Name, repo.Name, issue.Index, status.ID)
+	req = NewRequestWithJSON(t, "PUT", valueURL, &api.SetProjectFieldValueOption{
+		Value: fmt.Sprint(status.Options[1].ID),
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var value api.ProjectFieldValue
+	DecodeJSON(t, resp, &value)
+	assert.Equal(t, "Done", value.DisplayValue)
+
+	req = NewRequestWithJSON(t, "PUT", valueURL, &api.SetProjectFieldValueOption{Value: "100"}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d/project_fields", owner.Name, repo.Name, issue.Index)).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var values []*api.ProjectFieldValue
+	DecodeJSON(t, resp, &values)
+	require.Len(t, values, 1)
+	assert.Equal(t, "Status", values[0].FieldName)
+
+	req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", fieldsURL, status.ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.FieldValue{FieldID: status.ID})
+}
+
+func TestAPIOwnerProjectFields(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	t.Run("Organization", func(t *testing.T) {
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteOrganization)
+		req := NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/projects", &api.CreateProjectOption{
+			Title: "Org project",
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		var project api.Project
+		DecodeJSON(t, resp, &project)
+		fieldsURL := fmt.Sprintf("/api/v1/orgs/org3/projects/%d/fields", project.ID)
+
+		req = NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateProjectFieldOption{
+			Name:    "Status",
+			Type:    "single_select",
+			Options: []*api.ProjectFieldOption{{Name: "Todo"}},
+		}).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusCreated)
+		var field api.ProjectField
+		DecodeJSON(t, resp, &field)
+
+		req = NewRequestWithJSON(t, "PATCH", fmt.Sprintf("%s/%d", fieldsURL, field.ID), &api.EditProjectFieldOption{
+			Name: util.ToPointer("State"),
+		}).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		DecodeJSON(t, resp, &field)
+		assert.Equal(t, "State", field.Name)
+
+		otherToken := getUserToken(t, "user5", auth_model.AccessTokenScopeWriteOrganization)
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", fieldsURL, field.ID)).AddTokenAuth(otherToken)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		req = NewRequest(t, "DELETE", fmt.Sprintf("%s/%d", fieldsURL, field.ID)).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusNoContent)
+		unittest.AssertNotExistsBean(t, &project_model.Field{ID: field.ID})
+	})
+
+	t.Run("User", func(t *testing.T) {
+		fieldsURL := "/api/v1/users/user2/projects/4/fields"
+
+		otherToken := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteUser)
+		req := NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateProjectFieldOption{
+			Name: "Estimate",
+			Type: "number",
+		}).AddTokenAuth(otherToken)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteUser)
+		req = NewRequestWithJSON(t, "POST", fieldsURL, &api.CreateProjectFieldOption{
+			Name: "Estimate",
+			Type: "number",
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+
+		req = NewRequest(t, "GET", fieldsURL)
+		resp := MakeRequest(t, req, http.StatusOK)
+		var fields []*api.ProjectField
+		DecodeJSON(t, resp, &fields)
+		require.Len(t, fields, 1)
+		assert.Equal(t, "Estimate", fields[0].Name)
+	})
+}
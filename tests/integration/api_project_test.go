@@ -0,0 +1,207 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	issues_model "code.gitea.io/gitea/models/issues"
+	project_model "code.gitea.io/gitea/models/project"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/util"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIRepoProjects(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	repo := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	owner := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: repo.OwnerID})
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: repo.ID, Index: 4})
+
+	session := loginUser(t, owner.Name)
+	token := getTokenForLoggedInUser(t, session, auth_model.AccessTokenScopeWriteIssue)
+	projectsURL := fmt.Sprintf("/api/v1/repos/%s/%s/projects", owner.Name, repo.Name)
+
+	req := NewRequestWithJSON(t, "POST", projectsURL, &api.CreateProjectOption{
+		Title:    "API project",
+		Template: "basic_kanban",
+	}).AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusCreated)
+	var project api.Project
+	DecodeJSON(t, resp, &project)
+	assert.Equal(t, "API project", project.Title)
+	assert.Equal(t, repo.ID, project.RepoID)
+	assert.Equal(t, api.StateOpen, project.State)
+	projectURL := fmt.Sprintf("%s/%d", projectsURL, project.ID)
+
+	req = NewRequestWithJSON(t, "PATCH", projectURL, &api.EditProjectOption{
+		Description: util.ToPointer("edited"),
+		State:       util.ToPointer("closed"),
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &project)
+	assert.Equal(t, "edited", project.Description)
+	assert.Equal(t, api.StateClosed, project.State)
+	assert.NotNil(t, project.Closed)
+
+	req = NewRequest(t, "GET", projectsURL+"?state=closed").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var projects []*api.Project
+	DecodeJSON(t, resp, &projects)
+	require.Len(t, projects, 1)
+	assert.Equal(t, project.ID, projects[0].ID)
+
+	req = NewRequest(t, "GET", projectURL+"/columns").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var columns []*api.ProjectColumn
+	DecodeJSON(t, resp, &columns)
+	require.Len(t, columns, 4) // the default "Backlog" column and the columns of the template
+	assert.True(t, columns[0].Default)
+
+	req = NewRequestWithJSON(t, "POST", projectURL+"/columns", &api.CreateProjectColumnOption{
+		Title: "Blocked",
+		Color: "#ff0000",
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	var column api.ProjectColumn
+	DecodeJSON(t, resp, &column)
+	assert.Equal(t, "Blocked", column.Title)
+
+	req = NewRequestWithJSON(t, "POST", projectURL+"/columns", &api.CreateProjectColumnOption{
+		Title: "Bad color",
+		Color: "red",
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	columnURL := fmt.Sprintf("%s/columns/%d", projectURL, column.ID)
+	req = NewRequestWithJSON(t, "PATCH", columnURL, &api.EditProjectColumnOption{
+		Sorting: util.ToPointer(0),
+		Default: util.ToPointer(true),
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &column)
+	assert.True(t, column.Default)
+	assert.Equal(t, 0, column.Sorting)
+
+	req = NewRequestWithJSON(t, "PATCH", columnURL, &api.EditProjectColumnOption{
+		Default: util.ToPointer(false),
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// the issue is added to the default column
+	req = NewRequestWithJSON(t, "POST", projectURL+"/issues", &api.AddProjectIssueOption{
+		IssueID: issue.ID,
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	var card api.ProjectIssue
+	DecodeJSON(t, resp, &card)
+	assert.Equal(t, column.ID, card.ColumnID)
+	assert.Equal(t, issue.Index, card.Issue.Index)
+
+	issueURL := fmt.Sprintf("%s/issues/%d", projectURL, issue.ID)
+	req = NewRequestWithJSON(t, "PATCH", issueURL, &api.MoveProjectIssueOption{
+		ColumnID: columns[1].ID,
+	}).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	DecodeJSON(t, resp, &card)
+	assert.Equal(t, columns[1].ID, card.ColumnID)
+	assert.Equal(t, 0, card.Position)
+
+	req = NewRequest(t, "GET", fmt.Sprintf("%s/issues?column_id=%d", projectURL, columns[1].ID)).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	var cards []*api.ProjectIssue
+	DecodeJSON(t, resp, &cards)
+	require.Len(t, cards, 1)
+	assert.Equal(t, issue.ID, cards[0].Issue.ID)
+
+	// a column of another project is refused
+	req = NewRequestWithJSON(t, "PATCH", issueURL, &api.MoveProjectIssueOption{
+		ColumnID: 1,
+	}).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNotFound)
+
+	req = NewRequest(t, "DELETE", issueURL).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.ProjectIssue{ProjectID: project.ID, IssueID: issue.ID})
+
+	req = NewRequest(t, "DELETE", fmt.Sprintf("%s/columns/%d", projectURL, columns[2].ID)).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	req = NewRequest(t, "DELETE", columnURL).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	// a reader can list the projects but not change them
+	readerToken := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteIssue)
+	req = NewRequest(t, "GET", projectURL).AddTokenAuth(readerToken)
+	MakeRequest(t, req, http.StatusOK)
+	req = NewRequest(t, "DELETE", projectURL).AddTokenAuth(readerToken)
+	MakeRequest(t, req, http.StatusForbidden)
+
+	req = NewRequest(t, "DELETE", projectURL).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusNoContent)
+	unittest.AssertNotExistsBean(t, &project_model.Project{ID: project.ID})
+}
+
+func TestAPIOwnerProjects(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	t.Run("Organization", func(t *testing.T) {
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteOrganization, auth_model.AccessTokenScopeWriteIssue)
+		req := NewRequestWithJSON(t, "POST", "/api/v1/orgs/org3/projects", &api.CreateProjectOption{
+			Title: "Org project",
+		}).AddTokenAuth(token)
+		resp := MakeRequest(t, req, http.StatusCreated)
+		var project api.Project
+		DecodeJSON(t, resp, &project)
+		assert.EqualValues(t, 3, project.OwnerID)
+
+		// issues of the repositories of the organization can be added
+		issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 3, Index: 1})
+		req = NewRequestWithJSON(t, "POST", fmt.Sprintf("/api/v1/orgs/org3/projects/%d/issues", project.ID), &api.AddProjectIssueOption{
+			IssueID: issue.ID,
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusCreated)
+
+		// but not the issues of other owners
+		req = NewRequestWithJSON(t, "POST", fmt.Sprintf("/api/v1/orgs/org3/projects/%d/issues", project.ID), &api.AddProjectIssueOption{
+			IssueID: 1,
+		}).AddTokenAuth(token)
+		MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+		otherToken := getUserToken(t, "user5", auth_model.AccessTokenScopeWriteOrganization)
+		req = NewRequest(t, "DELETE", fmt.Sprintf("/api/v1/orgs/org3/projects/%d", project.ID)).AddTokenAuth(otherToken)
+		MakeRequest(t, req, http.StatusForbidden)
+	})
+
+	t.Run("User", func(t *testing.T) {
+		req := NewRequest(t, "GET", "/api/v1/users/user2/projects/4")
+		resp := MakeRequest(t, req, http.StatusOK)
+		var project api.Project
+		DecodeJSON(t, resp, &project)
+		assert.Equal(t, "project on user2", project.Title)
+
+		otherToken := getUserToken(t, "user4", auth_model.AccessTokenScopeWriteUser)
+		req = NewRequestWithJSON(t, "PATCH", "/api/v1/users/user2/projects/4", &api.EditProjectOption{
+			Title: util.ToPointer("hijacked"),
+		}).AddTokenAuth(otherToken)
+		MakeRequest(t, req, http.StatusForbidden)
+
+		token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteUser)
+		req = NewRequestWithJSON(t, "PATCH", "/api/v1/users/user2/projects/4", &api.EditProjectOption{
+			Title: util.ToPointer("renamed"),
+		}).AddTokenAuth(token)
+		resp = MakeRequest(t, req, http.StatusOK)
+		DecodeJSON(t, resp, &project)
+		assert.Equal(t, "renamed", project.Title)
+	})
+}
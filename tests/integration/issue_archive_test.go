@@ -0,0 +1,127 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	auth_model "code.gitea.io/gitea/models/auth"
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/services/migrations"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIssueImportRequest(t *testing.T, url, filename string, content []byte, fields ...string) *RequestWrapper {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for i := 0; i+1 < len(fields); i += 2 {
+		require.NoError(t, writer.WriteField(fields[i], fields[i+1]))
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	req := NewRequestWithBody(t, "POST", url, body)
+	req.Header.Add("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAPIIssueArchive(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	token := getUserToken(t, "user2", auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeWriteRepository)
+	req := NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues/export?state=all").AddTokenAuth(token)
+	resp := MakeRequest(t, req, http.StatusOK)
+	archive, err := migrations.ReadIssueArchive(resp.Body)
+	require.NoError(t, err)
+	require.Len(t, archive.Issues, 2)
+	assert.Len(t, archive.Comments, 2)
+
+	req = NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues/export?state=all&format=csv&labels=label2").AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusOK)
+	assert.Equal(t, "text/csv", resp.Header().Get("Content-Type"))
+	csvArchive, err := migrations.ReadIssuesCSV(resp.Body)
+	require.NoError(t, err)
+	require.Len(t, csvArchive.Issues, 1)
+	assert.Equal(t, "issue5", csvArchive.Issues[0].Title)
+	MakeRequest(t, NewRequest(t, "GET", "/api/v1/repos/user2/repo1/issues/export?labels=unknown").AddTokenAuth(token), http.StatusUnprocessableEntity)
+
+	var buf bytes.Buffer
+	require.NoError(t, migrations.WriteIssueArchive(&buf, archive))
+	// only site administrators can map the users
+	req = newIssueImportRequest(t, "/api/v1/repos/user2/repo2/issues/import?map_users=true", "issues.json", buf.Bytes()).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusForbidden)
+	req = newIssueImportRequest(t, "/api/v1/repos/user2/repo2/issues/import", "issues.txt", buf.Bytes()).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusUnprocessableEntity)
+
+	req = newIssueImportRequest(t, "/api/v1/repos/user2/repo2/issues/import", "issues.json", buf.Bytes()).AddTokenAuth(token)
+	resp = MakeRequest(t, req, http.StatusCreated)
+	var result api.IssueImportResult
+	DecodeJSON(t, resp, &result)
+	assert.Equal(t, 2, result.Imported)
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 2, Index: 3})
+	assert.Equal(t, "issue1", issue.Title)
+	assert.Equal(t, "user1", issue.OriginalAuthor)
+	assert.EqualValues(t, 2, issue.PosterID)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Label{RepoID: 2, Name: "label1"})
+
+	// the importer must be an administrator of the repository
+	token = getUserToken(t, "user4", auth_model.AccessTokenScopeWriteIssue, auth_model.AccessTokenScopeWriteRepository)
+	req = newIssueImportRequest(t, "/api/v1/repos/user2/repo1/issues/import", "issues.json", buf.Bytes()).AddTokenAuth(token)
+	MakeRequest(t, req, http.StatusForbidden)
+}
+
+func TestIssueArchive(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user1")
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find("#issue-import-modal").Length())
+	exportLink, _ := htmlDoc.Find(".issue-list-archive .menu a.item").Last().Attr("href")
+	assert.True(t, strings.HasPrefix(exportLink, "/user2/repo1/issues/export?format=csv"), exportLink)
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues/export?state=closed&format=csv"), http.StatusOK)
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[1], "issue5")
+
+	session.MakeRequest(t, NewRequestWithValues(t, "POST", "/user2/repo1/issues/import", map[string]string{}), http.StatusBadRequest)
+	csvContent := "title,state,author,labels\nImported bug,open,user5,bug;label1\nImported task,closed,,\n"
+	session.MakeRequest(t, newIssueImportRequest(t, "/user2/repo1/issues/import", "issues.csv", []byte(csvContent)), http.StatusOK)
+	bug := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 1, Title: "Imported bug"})
+	assert.EqualValues(t, 6, bug.Index)
+	assert.EqualValues(t, 1, bug.PosterID)
+	assert.Equal(t, "user5", bug.OriginalAuthor)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Label{RepoID: 1, Name: "bug"})
+	task := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 1, Title: "Imported task"})
+	assert.True(t, task.IsClosed)
+	repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+	assert.Equal(t, 2, repo1.NumClosedIssues)
+
+	// site administrators can post the issues as the local users
+	csvContent = "title,author\nMapped bug,user5\n"
+	session.MakeRequest(t, newIssueImportRequest(t, "/user2/repo1/issues/import", "issues.csv", []byte(csvContent), "map_users", "on"), http.StatusOK)
+	bug = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 1, Title: "Mapped bug"})
+	assert.EqualValues(t, 5, bug.PosterID)
+	assert.False(t, bug.HasOriginalAuthor())
+
+	// readers can export the issues but can't import them
+	session = loginUser(t, "user4")
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues"), http.StatusOK)
+	assert.Equal(t, 0, NewHTMLParser(t, resp.Body).Find("#issue-import-modal").Length())
+	session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues/export"), http.StatusOK)
+	session.MakeRequest(t, newIssueImportRequest(t, "/user2/repo1/issues/import", "issues.csv", []byte(csvContent)), http.StatusNotFound)
+}
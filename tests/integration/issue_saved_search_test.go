@@ -0,0 +1,59 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueSavedSearches(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	req := NewRequestWithValues(t, "POST", "/issues/saved_searches/new", map[string]string{
+		"name":      "Repo1 bugs",
+		"query":     "repo:user2/repo1 label:label1",
+		"is_pinned": "on",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	search := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSavedSearch{OwnerID: 2, Name: "Repo1 bugs"})
+	assert.True(t, search.IsPinned)
+
+	req = NewRequestWithValues(t, "POST", "/issues/saved_searches/new", map[string]string{
+		"name":  "Invalid",
+		"query": "updated:>soon",
+	})
+	session.MakeRequest(t, req, http.StatusBadRequest)
+
+	link := fmt.Sprintf("/issues?saved_search=%d", search.ID)
+	req = NewRequest(t, "GET", link)
+	resp := session.MakeRequest(t, req, http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.Find("#issue-list .flex-item").Length())
+	assert.Contains(t, htmlDoc.Find(".flex-container-nav a.active").Text(), "Repo1 bugs")
+
+	// the search isn't shared yet
+	otherSession := loginUser(t, "user4")
+	otherSession.MakeRequest(t, NewRequest(t, "GET", link), http.StatusNotFound)
+	otherSession.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/issues/saved_searches/%d/pin", search.ID)), http.StatusNotFound)
+
+	req = NewRequestWithValues(t, "POST", fmt.Sprintf("/issues/saved_searches/%d/edit", search.ID), map[string]string{
+		"name":      "Repo1 bugs",
+		"query":     "repo:user2/repo1 label:label1",
+		"is_shared": "on",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	otherSession.MakeRequest(t, NewRequest(t, "GET", link), http.StatusOK)
+
+	session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/issues/saved_searches/%d/delete", search.ID)), http.StatusOK)
+	unittest.AssertNotExistsBean(t, &issues_model.IssueSavedSearch{ID: search.ID})
+}
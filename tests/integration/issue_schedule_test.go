@@ -0,0 +1,108 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	repo_model "code.gitea.io/gitea/models/repo"
+	"code.gitea.io/gitea/models/unittest"
+	user_model "code.gitea.io/gitea/models/user"
+	"code.gitea.io/gitea/modules/timeutil"
+	issue_service "code.gitea.io/gitea/services/issue"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSchedule(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, giteaURL *url.URL) {
+		user2 := unittest.AssertExistsAndLoadBean(t, &user_model.User{ID: 2})
+		repo1 := unittest.AssertExistsAndLoadBean(t, &repo_model.Repository{ID: 1})
+		require.NoError(t, createOrReplaceFileInBranch(user2, repo1, ".gitea/ISSUE_TEMPLATE/weekly.md", repo1.DefaultBranch, `---
+name: Weekly sync
+about: The agenda of the weekly sync
+title: Weekly sync
+labels: ["label2"]
+---
+## Agenda
+`))
+
+		session := loginUser(t, "user2")
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/issue_schedules/new"), http.StatusOK)
+		assert.Contains(t, NewHTMLParser(t, resp.Body).Find("#schedule-template").Text(), "Weekly sync")
+
+		req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/issue_schedules/new", map[string]string{
+			"name":          "Weekly",
+			"template_file": ".gitea/ISSUE_TEMPLATE/weekly.md",
+			"spec":          "not a spec",
+			"is_active":     "on",
+		})
+		session.MakeRequest(t, req, http.StatusBadRequest)
+		unittest.AssertNotExistsBean(t, &issues_model.IssueSchedule{RepoID: 1})
+
+		req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/issue_schedules/new", map[string]string{
+			"name":           "Weekly",
+			"template_file":  ".gitea/ISSUE_TEMPLATE/weekly.md",
+			"spec":           "0 9 * * 1",
+			"label_ids":      "1",
+			"assignee_ids":   "2",
+			"close_previous": "on",
+			"is_active":      "on",
+		})
+		session.MakeRequest(t, req, http.StatusOK)
+		schedule := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{RepoID: 1, Name: "Weekly"})
+		assert.Equal(t, []int64{1}, schedule.LabelIDs)
+		assert.Equal(t, user2.ID, schedule.CreatorID)
+		assert.Greater(t, schedule.Next, timeutil.TimeStampNow())
+		resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/issue_schedules"), http.StatusOK)
+		assert.Contains(t, NewHTMLParser(t, resp.Body).Find(".flex-list .flex-item-title").Text(), "Weekly")
+
+		// the labels of the schedule and of the template are added
+		session.MakeRequest(t, NewRequestf(t, "POST", "/user2/repo1/settings/issue_schedules/%d/run", schedule.ID), http.StatusOK)
+		schedule = unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{ID: schedule.ID})
+		first := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: schedule.LastIssueID})
+		assert.Equal(t, "Weekly sync", first.Title)
+		assert.Contains(t, first.Content, "## Agenda")
+		assert.Equal(t, user2.ID, first.PosterID)
+		require.NoError(t, first.LoadLabels(t.Context()))
+		assert.Len(t, first.Labels, 2)
+		unittest.AssertExistsAndLoadBean(t, &issues_model.IssueAssignees{IssueID: first.ID, AssigneeID: user2.ID})
+		assert.NotZero(t, schedule.Prev)
+
+		// the cron task runs the due schedules and closes the issue of the previous run
+		schedule.Next = timeutil.TimeStampNow() - 1
+		require.NoError(t, issues_model.UpdateIssueScheduleRun(t.Context(), schedule))
+		require.NoError(t, issue_service.RunDueIssueSchedules(t.Context()))
+		schedule = unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{ID: schedule.ID})
+		assert.NotEqual(t, first.ID, schedule.LastIssueID)
+		assert.Greater(t, schedule.Next, timeutil.TimeStampNow())
+		first = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: first.ID})
+		assert.True(t, first.IsClosed)
+		second := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: schedule.LastIssueID})
+		assert.False(t, second.IsClosed)
+
+		// an inactive schedule isn't run by the cron task
+		req = NewRequestWithValues(t, "POST", fmt.Sprintf("/user2/repo1/settings/issue_schedules/%d/edit", schedule.ID), map[string]string{
+			"name":          "Weekly",
+			"template_file": ".gitea/ISSUE_TEMPLATE/weekly.md",
+			"spec":          "0 9 * * 1",
+		})
+		session.MakeRequest(t, req, http.StatusOK)
+		schedule = unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{ID: schedule.ID})
+		assert.False(t, schedule.IsActive)
+		assert.Empty(t, schedule.LabelIDs)
+		schedule.Next = timeutil.TimeStampNow() - 1
+		require.NoError(t, issues_model.UpdateIssueScheduleRun(t.Context(), schedule))
+		require.NoError(t, issue_service.RunDueIssueSchedules(t.Context()))
+		unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSchedule{ID: schedule.ID, LastIssueID: second.ID})
+
+		session.MakeRequest(t, NewRequestf(t, "POST", "/user2/repo1/settings/issue_schedules/%d/delete", schedule.ID), http.StatusOK)
+		unittest.AssertNotExistsBean(t, &issues_model.IssueSchedule{ID: schedule.ID})
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	activities_model "code.gitea.io/gitea/models/activities"
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/json"
+	"code.gitea.io/gitea/modules/setting"
+	api "code.gitea.io/gitea/modules/structs"
+	"code.gitea.io/gitea/modules/test"
+	"code.gitea.io/gitea/modules/timeutil"
+	sla_service "code.gitea.io/gitea/services/sla"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSLA(t *testing.T) {
+	onGiteaRun(t, func(t *testing.T, giteaURL *url.URL) {
+		// every hour is a business hour
+		defer test.MockVariableValue(&setting.IssueSLA.WorkdayStart, 0)()
+		defer test.MockVariableValue(&setting.IssueSLA.WorkdayEnd, 0)()
+		defer test.MockVariableValue(&setting.IssueSLA.WarningThreshold, 50)()
+
+		var payloads []api.IssuePayload
+		provider := newMockWebhookProvider(func(r *http.Request) {
+			content, _ := io.ReadAll(r.Body)
+			var payload api.IssuePayload
+			assert.NoError(t, json.Unmarshal(content, &payload))
+			payloads = append(payloads, payload)
+		}, http.StatusOK)
+		defer provider.Close()
+
+		session := loginUser(t, "user2")
+		testAPICreateWebhookForRepo(t, session, "user2", "repo1", provider.URL(), "issues")
+
+		req := NewRequestWithValues(t, "POST", "/user2/repo1/settings/issue_sla/new", map[string]string{
+			"name":                  "Triage",
+			"first_response_hours":  "2",
+			"resolution_hours":      "10",
+			"escalate_to_assignees": "on",
+		})
+		session.MakeRequest(t, req, http.StatusOK)
+		policy := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSLAPolicy{RepoID: 1, Name: "Triage"})
+		assert.True(t, policy.EscalateToAssignees)
+		resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/settings/issue_sla"), http.StatusOK)
+		assert.Contains(t, NewHTMLParser(t, resp.Body).Find(".flex-list .flex-item-title").Text(), "Triage")
+
+		req = NewRequestWithValues(t, "POST", "/user2/repo1/settings/issue_sla/new", map[string]string{
+			"name": "No target",
+		})
+		session.MakeRequest(t, req, http.StatusBadRequest)
+
+		// the policy applies to the existing issues and to the new ones
+		unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSLA{IssueID: 1, PolicyID: policy.ID})
+		testNewIssue(t, session, "user2", "repo1", "SLA issue", "Description")
+		issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 1, Title: "SLA issue"})
+		sla := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSLA{IssueID: issue.ID, PolicyID: policy.ID})
+		assert.Equal(t, issue.CreatedUnix+2*3600, sla.FirstResponseDueUnix)
+		require.NoError(t, db.Insert(t.Context(), &issues_model.IssueAssignees{IssueID: issue.ID, AssigneeID: 2}))
+		payloads = nil
+
+		defer timeutil.MockUnset()
+		timeutil.MockSet(issue.CreatedUnix.AddDuration(time.Hour).AsTime())
+		require.NoError(t, sla_service.CheckIssueSLAs(t.Context()))
+		require.Len(t, payloads, 1)
+		assert.EqualValues(t, "sla_at_risk", payloads[0].Action)
+		assert.Equal(t, issue.Index, payloads[0].Index)
+		assert.Equal(t, "Triage", payloads[0].SLA.Policy)
+		assert.Equal(t, "first_response", payloads[0].SLA.Target)
+		assert.Eventually(t, func() bool {
+			return unittest.GetCount(t, &activities_model.Notification{UserID: 2, IssueID: issue.ID}) > 0
+		}, 5*time.Second, 100*time.Millisecond)
+
+		resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/issues"), http.StatusOK)
+		htmlDoc := NewHTMLParser(t, resp.Body)
+		assert.Equal(t, 1, htmlDoc.Find("#issue-list .issue-sla-label.orange").Length())
+
+		// an escalation is sent once
+		require.NoError(t, sla_service.CheckIssueSLAs(t.Context()))
+		assert.Len(t, payloads, 1)
+
+		timeutil.MockSet(issue.CreatedUnix.AddDuration(2 * time.Hour).AsTime())
+		require.NoError(t, sla_service.CheckIssueSLAs(t.Context()))
+		require.Len(t, payloads, 2)
+		assert.EqualValues(t, "sla_breached", payloads[1].Action)
+		assert.Equal(t, "breached", payloads[1].SLA.Status)
+
+		// closing the issue resolves it
+		req = NewRequestWithValues(t, "POST", "/user2/repo1/issues/status", map[string]string{
+			"issue_ids": fmt.Sprint(issue.ID),
+			"action":    "close",
+		})
+		session.MakeRequest(t, req, http.StatusOK)
+		sla = unittest.AssertExistsAndLoadBean(t, &issues_model.IssueSLA{IssueID: issue.ID})
+		assert.Positive(t, sla.ResolvedUnix)
+		timeutil.MockSet(issue.CreatedUnix.AddDuration(20 * time.Hour).AsTime())
+		payloads = nil
+		require.NoError(t, sla_service.CheckIssueSLAs(t.Context()))
+		assert.Empty(t, payloads)
+
+		session.MakeRequest(t, NewRequest(t, "POST", fmt.Sprintf("/user2/repo1/settings/issue_sla/%d/delete", policy.ID)), http.StatusOK)
+		unittest.AssertNotExistsBean(t, &issues_model.IssueSLAPolicy{ID: policy.ID})
+		unittest.AssertNotExistsBean(t, &issues_model.IssueSLA{IssueID: issue.ID})
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueTypes(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	req := NewRequest(t, "POST", "/org/org3/settings/issue_types/initialize")
+	session.MakeRequest(t, req, http.StatusOK)
+	bug := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueType{OwnerID: 3, Name: "Bug"})
+
+	req = NewRequestWithValues(t, "POST", fmt.Sprintf("/org/org3/settings/issue_types/%d/fields/new", bug.ID), map[string]string{
+		"name":    "Severity",
+		"type":    "select",
+		"options": "low\nhigh",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	severity := unittest.AssertExistsAndLoadBean(t, &issues_model.IssueField{TypeID: bug.ID, Name: "Severity"})
+
+	req = NewRequest(t, "GET", "/org/org3/settings/issue_types")
+	resp := session.MakeRequest(t, req, http.StatusOK)
+	assert.Contains(t, resp.Body.String(), "Severity")
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/org3/repo3/issues/new?issue_type=%d", bug.ID))
+	resp = session.MakeRequest(t, req, http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	AssertHTMLElement(t, htmlDoc, fmt.Sprintf(`select[name="issue_type_id"] option[value="%d"][selected]`, bug.ID), true)
+
+	req = NewRequestWithValues(t, "POST", "/org3/repo3/issues/new", map[string]string{
+		"title":         "typed issue",
+		"issue_type_id": fmt.Sprint(bug.ID),
+		fmt.Sprintf("issue_field_%d", severity.ID): "high",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	issue := unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{RepoID: 3, Title: "typed issue"})
+	assert.Equal(t, bug.ID, issue.TypeID)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.IssueFieldValue{IssueID: issue.ID, FieldID: severity.ID, Value: "high"})
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/org3/repo3/issues/%d", issue.Index))
+	resp = session.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	AssertHTMLElement(t, htmlDoc, ".issue-type", true)
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/org3/repo3/issues?state=all&issue_type=%d&issue_field_%d=high", bug.ID, severity.ID))
+	resp = session.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	AssertHTMLElement(t, htmlDoc, "#issue-list > .flex-item", 1)
+
+	req = NewRequestWithValues(t, "POST", fmt.Sprintf("/org3/repo3/issues/%d/issue_type", issue.Index), map[string]string{
+		"type_id": "0",
+	})
+	session.MakeRequest(t, req, http.StatusOK)
+	issue = unittest.AssertExistsAndLoadBean(t, &issues_model.Issue{ID: issue.ID})
+	assert.Zero(t, issue.TypeID)
+	unittest.AssertExistsAndLoadBean(t, &issues_model.Comment{Type: issues_model.CommentTypeChangeIssueType, IssueID: issue.ID, OldTitle: "Bug"})
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Gitea Authors. All rights reserved.
+// SPDX-License-Identifier: MIT
+
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	issues_model "code.gitea.io/gitea/models/issues"
+	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMilestoneAnalytics(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	session := loginUser(t, "user2")
+	resp := session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/milestone/1/analytics"), http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Equal(t, "/user2/repo1/milestone/1/analytics/data", htmlDoc.Find("#repo-milestone-analytics").AttrOr("data-url", ""))
+	// the snapshots of the days not collected yet are computed
+	assert.Positive(t, htmlDoc.Find(".milestone-snapshots tbody tr").Length())
+	unittest.AssertNotExistsBean(t, &issues_model.MilestoneSnapshot{MilestoneID: 1})
+
+	var data struct {
+		Burndown []struct {
+			Day        int64 `json:"day"`
+			OpenIssues int   `json:"open_issues"`
+		} `json:"burndown"`
+		Velocity []struct {
+			Name string `json:"name"`
+		} `json:"velocity"`
+	}
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/milestone/1/analytics/data"), http.StatusOK)
+	DecodeJSON(t, resp, &data)
+	require.NotEmpty(t, data.Burndown)
+	assert.Equal(t, 1, data.Burndown[len(data.Burndown)-1].OpenIssues)
+	require.Len(t, data.Velocity, 1)
+	assert.Equal(t, "milestone3", data.Velocity[0].Name)
+
+	resp = session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/milestone/1/analytics/export"), http.StatusOK)
+	assert.Equal(t, "text/csv", resp.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	assert.Equal(t, "date,total_issues,open_issues,closed_issues,open_estimate_hours,closed_estimate_hours", lines[0])
+	assert.Len(t, lines, len(data.Burndown)+1)
+
+	session.MakeRequest(t, NewRequest(t, "POST", "/user2/repo1/milestones/1/analytics/backfill"), http.StatusOK)
+	assert.Equal(t, len(data.Burndown), unittest.GetCount(t, &issues_model.MilestoneSnapshot{MilestoneID: 1}))
+
+	// readers can see the charts but can't backfill the snapshots
+	session = loginUser(t, "user5")
+	session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/milestone/1/analytics"), http.StatusOK)
+	session.MakeRequest(t, NewRequest(t, "POST", "/user2/repo1/milestones/1/analytics/backfill"), http.StatusNotFound)
+	session.MakeRequest(t, NewRequest(t, "GET", "/user2/repo1/milestone/99/analytics/data"), http.StatusNotFound)
+}
@@ -7,11 +7,15 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"code.gitea.io/gitea/models/db"
+	issues_model "code.gitea.io/gitea/models/issues"
 	project_model "code.gitea.io/gitea/models/project"
 	repo_model "code.gitea.io/gitea/models/repo"
 	"code.gitea.io/gitea/models/unit"
 	"code.gitea.io/gitea/models/unittest"
+	"code.gitea.io/gitea/modules/timeutil"
 	"code.gitea.io/gitea/tests"
 
 	"github.com/stretchr/testify/assert"
@@ -83,3 +87,131 @@ func TestMoveRepoProjectColumns(t *testing.T) {
 
 	assert.NoError(t, project_model.DeleteProjectByID(t.Context(), project1.ID))
 }
+
+func TestRepoProjectFields(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	sess := loginUser(t, "user2")
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/projects/1/fields/new", map[string]string{
+		"name":    "Status",
+		"type":    "single_select",
+		"options": "Todo\nDone #2ea44f",
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	field := unittest.AssertExistsAndLoadBean(t, &project_model.Field{ProjectID: 1, Name: "Status"})
+	assert.Len(t, field.Options, 2)
+	assert.Equal(t, "#2ea44f", field.Options[1].Color)
+
+	req = NewRequest(t, "GET", "/user2/repo1/projects/1/fields")
+	resp := sess.MakeRequest(t, req, http.StatusOK)
+	assert.Contains(t, resp.Body.String(), "Status")
+
+	// set the value on the board and from the sidebar of the issue
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/projects/1/issues/1/fields", map[string]string{
+		fmt.Sprintf("field_%d", field.ID): fmt.Sprint(field.Options[1].ID),
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/issues/2/project_fields", map[string]string{
+		fmt.Sprintf("field_%d", field.ID): fmt.Sprint(field.Options[0].ID),
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/issues/2/project_fields", map[string]string{
+		fmt.Sprintf("field_%d", field.ID): "100",
+	})
+	sess.MakeRequest(t, req, http.StatusBadRequest)
+
+	req = NewRequest(t, "GET", "/user2/repo1/issues/1")
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	assert.Contains(t, htmlDoc.doc.Find(".issue-sidebar-project-fields").Text(), "Done")
+
+	// only the issues with the filtered value are on the board
+	req = NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/1?field_%d=%d", field.ID, field.Options[1].ID))
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.doc.Find(".issue-card").Length())
+	assert.Equal(t, 1, htmlDoc.doc.Find(`.issue-card[data-issue="1"] .issue-card-fields`).Length())
+
+	req = NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/1?field_%d=(none)", field.ID))
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Zero(t, htmlDoc.doc.Find(`.issue-card[data-issue="1"], .issue-card[data-issue="2"]`).Length())
+}
+
+func TestRepoProjectViews(t *testing.T) {
+	defer tests.PrepareTestEnv(t)()
+
+	sess := loginUser(t, "user2")
+	req := NewRequestWithValues(t, "POST", "/user2/repo1/projects/1/views/new", map[string]string{
+		"name":  "Planning",
+		"type":  "table",
+		"query": "labels=1&sort=title",
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	view := unittest.AssertExistsAndLoadBean(t, &project_model.View{ProjectID: 1, Name: "Planning"})
+	assert.Equal(t, project_model.ViewTypeTable, view.Type)
+	assert.Equal(t, "labels=1", view.Filter)
+	viewLink := fmt.Sprintf("/user2/repo1/projects/1/views/%d", view.ID)
+
+	// a view opened without query string uses its saved filters
+	req = NewRequest(t, "GET", viewLink)
+	resp := sess.MakeRequest(t, req, http.StatusSeeOther)
+	assert.Equal(t, viewLink+"?labels=1", resp.Header().Get("Location"))
+
+	req = NewRequest(t, "GET", viewLink+"?sort=-title")
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc := NewHTMLParser(t, resp.Body)
+	rows := htmlDoc.doc.Find(".project-view-table tr[data-issue]")
+	assert.Equal(t, 4, rows.Length())
+	issueID, _ := rows.First().Attr("data-issue")
+	assert.Equal(t, "5", issueID)
+	assert.Equal(t, 1, htmlDoc.doc.Find(".project-view-tabs .item.active").Length())
+
+	// the issues without milestone are in the last group
+	req = NewRequest(t, "GET", viewLink+"?sort=column&group=milestone")
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	groups := htmlDoc.doc.Find(".project-view-table h4")
+	assert.Equal(t, 3, groups.Length())
+	assert.Contains(t, groups.Last().Text(), "None")
+
+	req = NewRequestWithValues(t, "POST", viewLink+"/edit", map[string]string{
+		"name":  "Planning",
+		"query": "assignee=1&sort=-deadline&group=column&page=2",
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	view = unittest.AssertExistsAndLoadBean(t, &project_model.View{ID: view.ID})
+	assert.Equal(t, "assignee=1", view.Filter)
+	assert.Equal(t, "-deadline", view.SortType)
+	assert.Equal(t, "column", view.GroupBy)
+
+	req = NewRequestWithValues(t, "POST", viewLink+"/edit", map[string]string{
+		"name":  "Planning",
+		"query": "group=poster",
+	})
+	sess.MakeRequest(t, req, http.StatusBadRequest)
+
+	// only the issues with a due date are on the timeline
+	_, err := db.GetEngine(t.Context()).ID(1).Cols("deadline_unix").Update(&issues_model.Issue{
+		DeadlineUnix: timeutil.TimeStamp(time.Date(2000, 3, 15, 0, 0, 0, 0, time.UTC).Unix()),
+	})
+	assert.NoError(t, err)
+	req = NewRequestWithValues(t, "POST", "/user2/repo1/projects/1/views/new", map[string]string{
+		"name": "Roadmap",
+		"type": "roadmap",
+	})
+	sess.MakeRequest(t, req, http.StatusOK)
+	roadmap := unittest.AssertExistsAndLoadBean(t, &project_model.View{ProjectID: 1, Name: "Roadmap"})
+	req = NewRequest(t, "GET", fmt.Sprintf("/user2/repo1/projects/1/views/%d", roadmap.ID))
+	resp = sess.MakeRequest(t, req, http.StatusOK)
+	htmlDoc = NewHTMLParser(t, resp.Body)
+	assert.Equal(t, 1, htmlDoc.doc.Find(`.project-roadmap-row[data-issue="1"] .project-roadmap-bar`).Length())
+	assert.Equal(t, 3, htmlDoc.doc.Find(".project-roadmap-month").Length())
+	assert.Equal(t, 3, htmlDoc.doc.Find(".project-view-roadmap .flex-item").Length())
+
+	req = NewRequest(t, "POST", viewLink+"/delete")
+	sess.MakeRequest(t, req, http.StatusOK)
+	unittest.AssertNotExistsBean(t, &project_model.View{ID: view.ID})
+	req = NewRequest(t, "GET", viewLink)
+	sess.MakeRequest(t, req, http.StatusNotFound)
+}
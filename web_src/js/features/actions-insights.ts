@@ -0,0 +1,27 @@
+import {createApp} from 'vue';
+
+export async function initActionsInsights() {
+  const el = document.querySelector<HTMLElement>('#actions-insights-trends');
+  if (!el) return;
+
+  const {default: ActionsInsightsTrends} = await import(/* webpackChunkName: "actions-insights-graph" */'../components/ActionsInsightsTrends.vue');
+  try {
+    const View = createApp(ActionsInsightsTrends, {
+      dataUrl: el.getAttribute('data-url'),
+      locale: {
+        durations: el.getAttribute('data-locale-durations'),
+        rates: el.getAttribute('data-locale-rates'),
+        p50RunTime: el.getAttribute('data-locale-p50-run-time'),
+        p95RunTime: el.getAttribute('data-locale-p95-run-time'),
+        avgQueueTime: el.getAttribute('data-locale-avg-queue-time'),
+        failureRate: el.getAttribute('data-locale-failure-rate'),
+        flakyRate: el.getAttribute('data-locale-flaky-rate'),
+        loadingInfo: el.getAttribute('data-locale-loading-info'),
+      },
+    });
+    View.mount(el);
+  } catch (err) {
+    console.error('ActionsInsightsTrends failed to load', err);
+    el.textContent = el.getAttribute('data-locale-component-failed-to-load');
+  }
+}
@@ -16,6 +16,7 @@ export function initRepositoryActionView() {
     actionsURL: el.getAttribute('data-actions-url'),
     locale: {
       approve: el.getAttribute('data-locale-approve'),
+      reject: el.getAttribute('data-locale-reject'),
       cancel: el.getAttribute('data-locale-cancel'),
       rerun: el.getAttribute('data-locale-rerun'),
       rerun_all: el.getAttribute('data-locale-rerun-all'),
@@ -23,6 +24,20 @@ export function initRepositoryActionView() {
       commit: el.getAttribute('data-locale-runs-commit'),
       pushedBy: el.getAttribute('data-locale-runs-pushed-by'),
       artifactsTitle: el.getAttribute('data-locale-artifacts-title'),
+      testsTitle: el.getAttribute('data-locale-tests-title'),
+      testsFailed: el.getAttribute('data-locale-tests-failed'),
+      testsPassed: el.getAttribute('data-locale-tests-passed'),
+      testsSkipped: el.getAttribute('data-locale-tests-skipped'),
+      annotationsTitle: el.getAttribute('data-locale-annotations-title'),
+      annotationLevels: {
+        error: el.getAttribute('data-locale-annotations-error'),
+        warning: el.getAttribute('data-locale-annotations-warning'),
+        notice: el.getAttribute('data-locale-annotations-notice'),
+      },
+      stepSummary: el.getAttribute('data-locale-step-summary'),
+      searchLogs: el.getAttribute('data-locale-search-logs'),
+      searchLogsNoResults: el.getAttribute('data-locale-search-logs-no-results'),
+      searchLogsTruncated: el.getAttribute('data-locale-search-logs-truncated'),
       areYouSure: el.getAttribute('data-locale-are-you-sure'),
       artifactExpired: el.getAttribute('data-locale-artifact-expired'),
       confirmDeleteArtifact: el.getAttribute('data-locale-confirm-delete-artifact'),
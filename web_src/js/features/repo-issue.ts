@@ -319,6 +319,9 @@ export function initRepoPullRequestReview() {
     elReviewPanel.querySelector('.close')!.addEventListener('click', () => tippy.hide());
   }
 
+  // the line of the last clicked "add-code-comment" button, a shift-click on a following line of the same side comments on the range
+  let lastCommentLine: {path: string, side: string, idx: number} | null = null;
+
   addDelegatedEventListener(document, 'click', '.add-code-comment', async (el, e) => {
     e.preventDefault();
 
@@ -326,6 +329,11 @@ export function initRepoPullRequestReview() {
     const side = el.getAttribute('data-side')!;
     const idx = el.getAttribute('data-idx')!;
     const path = el.closest('[data-path]')?.getAttribute('data-path');
+    let startIdx = '';
+    if (e.shiftKey && lastCommentLine?.path === path && lastCommentLine.side === side && lastCommentLine.idx < Number(idx)) {
+      startIdx = String(lastCommentLine.idx);
+    }
+    lastCommentLine = {path: String(path), side, idx: Number(idx)};
     const tr = el.closest('tr')!;
     const lineType = tr.getAttribute('data-line-type')!;
 
@@ -348,12 +356,32 @@ export function initRepoPullRequestReview() {
       const response = await GET(el.closest('[data-new-comment-url]')?.getAttribute('data-new-comment-url') ?? '');
       td.innerHTML = await response.text();
       td.querySelector<HTMLInputElement>("input[name='line']")!.value = idx;
+      td.querySelector<HTMLInputElement>("input[name='start_line']")!.value = startIdx;
       td.querySelector<HTMLInputElement>("input[name='side']")!.value = (side === 'left' ? 'previous' : 'proposed');
       td.querySelector<HTMLInputElement>("input[name='path']")!.value = String(path);
       const editor = await initComboMarkdownEditor(td.querySelector<HTMLElement>('.combo-markdown-editor')!);
       editor.focus();
     }
   });
+
+  addDelegatedEventListener(document, 'click', '.add-file-comment', async (el, e) => {
+    e.preventDefault();
+
+    const path = el.getAttribute('data-path')!;
+    const container = el.closest('.diff-file-box')?.querySelector('.diff-file-comments');
+    if (!container || container.querySelector('.new-file-comment .comment-code-cloud')) return;
+
+    const response = await GET(el.getAttribute('data-new-comment-url')!);
+    const holder = createElementFromHTML(await response.text());
+    container.querySelector('.new-file-comment')?.remove();
+    holder.classList.add('new-file-comment');
+    holder.querySelector<HTMLInputElement>("input[name='line']")!.value = '0';
+    holder.querySelector<HTMLInputElement>("input[name='side']")!.value = 'proposed';
+    holder.querySelector<HTMLInputElement>("input[name='path']")!.value = path;
+    container.append(holder);
+    const editor = await initComboMarkdownEditor(holder.querySelector<HTMLElement>('.combo-markdown-editor')!);
+    editor.focus();
+  });
 }
 
 export function initRepoIssueReferenceIssue() {
@@ -0,0 +1,27 @@
+import {createApp} from 'vue';
+
+export async function initRepoMilestoneAnalytics() {
+  const el = document.querySelector<HTMLElement>('#repo-milestone-analytics');
+  if (!el) return;
+
+  const {default: RepoMilestoneAnalytics} = await import(/* webpackChunkName: "milestone-analytics-graph" */'../components/RepoMilestoneAnalytics.vue');
+  try {
+    const View = createApp(RepoMilestoneAnalytics, {
+      dataUrl: el.getAttribute('data-url'),
+      locale: {
+        burndown: el.getAttribute('data-locale-burndown'),
+        velocity: el.getAttribute('data-locale-velocity'),
+        openIssues: el.getAttribute('data-locale-open-issues'),
+        closedIssues: el.getAttribute('data-locale-closed-issues'),
+        openEstimate: el.getAttribute('data-locale-open-estimate'),
+        closedEstimate: el.getAttribute('data-locale-closed-estimate'),
+        ideal: el.getAttribute('data-locale-ideal'),
+        loadingInfo: el.getAttribute('data-locale-loading-info'),
+      },
+    });
+    View.mount(el);
+  } catch (err) {
+    console.error('RepoMilestoneAnalytics failed to load', err);
+    el.textContent = el.getAttribute('data-locale-component-failed-to-load');
+  }
+}
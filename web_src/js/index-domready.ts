@@ -52,6 +52,8 @@ import {initCommonIssueListQuickGoto} from './features/common-issue-list.ts';
 import {initRepoContributors} from './features/contributors.ts';
 import {initRepoCodeFrequency} from './features/code-frequency.ts';
 import {initRepoRecentCommits} from './features/recent-commits.ts';
+import {initRepoMilestoneAnalytics} from './features/repo-milestone-analytics.ts';
+import {initActionsInsights} from './features/actions-insights.ts';
 import {initRepoDiffCommitBranchesAndTags} from './features/repo-diff-commit.ts';
 import {initGlobalSelectorObserver} from './modules/observer.ts';
 import {initRepositorySearch} from './features/repo-search.ts';
@@ -143,6 +145,8 @@ const initPerformanceTracer = callInitFunctions([
   initRepoContributors,
   initRepoCodeFrequency,
   initRepoRecentCommits,
+  initRepoMilestoneAnalytics,
+  initActionsInsights,
 
   initCommitStatuses,
   initCaptcha,